@@ -67,6 +67,16 @@ func main() {
 
 	store := db.NewStore(connPool)
 
+	secrets, err := util.LoadSecretCipher(config.SecretEncryptionKey)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("cannot create secret cipher")
+	}
+	if secrets != nil {
+		if err = service.EncryptWeatherlinkSecrets(ctx, store, secrets, logger); err != nil {
+			logger.Fatal().Err(err).Msg("cannot encrypt weatherlink secrets")
+		}
+	}
+
 	service.ScheduleJobs(ctx, store, config, logger)
 
 	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
@@ -76,6 +86,12 @@ func main() {
 
 	g, ctx := errgroup.WithContext(ctx)
 	runGinServer(ctx, g, config, store, tokenMaker, logger)
+	if config.UMBServerAddress != "" {
+		runUMBServer(ctx, g, config, store, logger)
+	}
+	if config.MQTT.Broker != "" {
+		runMQTTSubscriber(ctx, g, config, store, logger)
+	}
 
 	err = g.Wait()
 	if err != nil {
@@ -91,3 +107,21 @@ func runGinServer(ctx context.Context, g *errgroup.Group, config util.Config, st
 
 	server.Start(ctx, g)
 }
+
+func runUMBServer(ctx context.Context, g *errgroup.Group, config util.Config, store db.Store, logger *zerolog.Logger) {
+	server, err := server.NewUMBServer(config.UMBServerAddress, config.UMBAllowedSources, store, logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("cannot create umb server")
+	}
+
+	server.Start(ctx, g)
+}
+
+func runMQTTSubscriber(ctx context.Context, g *errgroup.Group, config util.Config, store db.Store, logger *zerolog.Logger) {
+	subscriber, err := server.NewMQTTSubscriber(config.MQTT, config.MQTTUsername, config.MQTTPassword, store, logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("cannot create mqtt subscriber")
+	}
+
+	subscriber.Start(ctx, g)
+}
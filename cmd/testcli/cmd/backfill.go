@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/spf13/cobra"
+	"golang.org/x/time/rate"
+)
+
+var backfillConfig = service.DefaultDavisBackfillConfig
+
+var backfillCmd = &cobra.Command{
+	Use:   "backfill",
+	Short: "Backfill observation gaps from external apis",
+}
+
+var backfillDavisCmd = &cobra.Command{
+	Use:   "davis",
+	Short: "Backfill the weatherlink v2 stations from the historic api",
+	Run: func(cmd *cobra.Command, args []string) {
+		backfillDavis()
+	},
+}
+
+func init() {
+	backfillCmd.AddCommand(backfillDavisCmd)
+	backfillDavisCmd.Flags().DurationVar(&backfillConfig.Lookback, "lookback", backfillConfig.Lookback, "how far back to search for gaps")
+	backfillDavisCmd.Flags().DurationVar(&backfillConfig.MinGap, "min-gap", backfillConfig.MinGap, "shortest interval without observations to backfill")
+}
+
+func backfillDavis() {
+	ctx := context.Background()
+
+	connPool, store := dbConnect(ctx)
+	defer connPool.Close()
+
+	davisClient := sensor.NewRateLimitedFetcher(
+		&http.Client{Timeout: 30 * time.Second},
+		rate.NewLimiter(service.DavisRateLimit, service.DavisRateBurst),
+	)
+	secrets, err := util.LoadSecretCipher(config.SecretEncryptionKey)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("cannot create secret cipher")
+	}
+	davisFactory := service.NewDavisFactory(davisClient, secrets, logger)
+
+	start := time.Now()
+	if err := service.BackfillDavisObservations(ctx, davisFactory, backfillConfig, store, logger); err != nil {
+		logger.Fatal().Err(err).Msg("backfill failed")
+	}
+	logger.Log().Dur("duration", time.Since(start)).Msg("done backfilling davis stations")
+}
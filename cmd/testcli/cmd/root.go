@@ -50,7 +50,7 @@ var rootCmd = &cobra.Command{
 
 func init() {
 	cobra.OnInitialize(initCmd)
-	rootCmd.AddCommand(seedCmd, lufftCmd)
+	rootCmd.AddCommand(seedCmd, lufftCmd, backfillCmd)
 	rootCmd.PersistentFlags().CountP("verbose", "v", "increase verbosity level (up to -vvv)")
 	rootCmd.PersistentFlags().StringVar(&testDBName, "db", "testweather", "db name")
 	rootCmd.PersistentFlags().BoolVarP(&resetDB, "reset", "r", false, "reset db")
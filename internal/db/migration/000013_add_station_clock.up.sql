@@ -0,0 +1,11 @@
+CREATE TABLE "station_clock" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT UNIQUE NOT NULL,
+  "timezone" VARCHAR(64) NOT NULL DEFAULT 'Asia/Manila',
+  "clock_offset" INTEGER NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "station_clock"
+  ADD CONSTRAINT "station_clock_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,13 @@
+CREATE TABLE "upload_station" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "protocol" VARCHAR(16) NOT NULL,
+  "upload_id" VARCHAR(64) NOT NULL,
+  "upload_key" VARCHAR(255) NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "upload_station"
+  ADD CONSTRAINT "upload_station_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "upload_station_protocol_upload_id_unique" UNIQUE ("protocol", "upload_id");
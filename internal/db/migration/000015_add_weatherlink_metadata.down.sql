@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS "weatherlink_change";
+
+ALTER TABLE "weatherlink"
+  DROP COLUMN IF EXISTS "wl_station_id",
+  DROP COLUMN IF EXISTS "lat",
+  DROP COLUMN IF EXISTS "lon",
+  DROP COLUMN IF EXISTS "elevation",
+  DROP COLUMN IF EXISTS "firmware_version",
+  DROP COLUMN IF EXISTS "recording_interval",
+  DROP COLUMN IF EXISTS "synced_at";
@@ -0,0 +1,23 @@
+ALTER TABLE "weatherlink"
+  ADD COLUMN "wl_station_id" INTEGER,
+  ADD COLUMN "lat" REAL,
+  ADD COLUMN "lon" REAL,
+  ADD COLUMN "elevation" REAL,
+  ADD COLUMN "firmware_version" VARCHAR(50),
+  ADD COLUMN "recording_interval" INTEGER,
+  ADD COLUMN "synced_at" timestamptz;
+
+CREATE TABLE "weatherlink_change" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "field" VARCHAR(50) NOT NULL,
+  "old_value" VARCHAR(255),
+  "new_value" VARCHAR(255),
+  "status" VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+  "reviewed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "weatherlink_change"
+  ADD CONSTRAINT "weatherlink_change_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
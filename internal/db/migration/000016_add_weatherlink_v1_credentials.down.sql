@@ -0,0 +1,34 @@
+-- encodes a query string value: every byte outside the unreserved characters is percent-encoded
+CREATE FUNCTION pg_temp.url_encode(s text) RETURNS text AS $$
+  SELECT COALESCE(
+    string_agg(
+      CASE WHEN t.c ~ '^[A-Za-z0-9._~-]$' THEN t.c
+        ELSE regexp_replace(upper(encode(convert_to(t.c, 'UTF8'), 'hex')), '(..)', '%\1', 'g') END,
+      '' ORDER BY t.ord
+    ),
+    ''
+  )
+  FROM regexp_split_to_table(s, '') WITH ORDINALITY AS t(c, ord)
+$$ LANGUAGE sql IMMUTABLE STRICT;
+
+-- credentials encrypted after the up migration can not be restored
+UPDATE "observations_station" s
+SET
+  "station_url" = 'https://api.weatherlink.com/v1/NoaaExt.json?user=' || pg_temp.url_encode(w."v1_user") ||
+    '&pass=' || pg_temp.url_encode(w."v1_pass") ||
+    '&apiToken=' || pg_temp.url_encode(w."v1_api_token"),
+  "updated_at" = now()
+FROM "weatherlink" w
+WHERE w."station_id" = s."id"
+  AND w."v1_user" IS NOT NULL AND w."v1_pass" IS NOT NULL AND w."v1_api_token" IS NOT NULL
+  AND w."v1_pass" NOT LIKE 'enc:%' AND w."v1_api_token" NOT LIKE 'enc:%';
+
+DROP FUNCTION pg_temp.url_encode(text);
+
+DELETE FROM "weatherlink"
+WHERE "v1_user" IS NOT NULL AND "uuid" IS NULL AND "api_key" IS NULL;
+
+ALTER TABLE "weatherlink"
+  DROP COLUMN IF EXISTS "v1_user",
+  DROP COLUMN IF EXISTS "v1_pass",
+  DROP COLUMN IF EXISTS "v1_api_token";
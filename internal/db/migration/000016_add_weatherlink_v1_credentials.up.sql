@@ -0,0 +1,53 @@
+ALTER TABLE "weatherlink"
+  ADD COLUMN "v1_user" VARCHAR(255),
+  ADD COLUMN "v1_pass" VARCHAR(255),
+  ADD COLUMN "v1_api_token" VARCHAR(255);
+
+-- decodes a query string value: '+' is a space and %XX a percent-encoded byte
+CREATE FUNCTION pg_temp.url_decode(s text) RETURNS text AS $$
+  SELECT COALESCE(
+    convert_from(
+      string_agg(
+        CASE WHEN t.m[1] IS NOT NULL THEN decode(substr(t.m[1], 2), 'hex') ELSE convert_to(t.m[2], 'UTF8') END,
+        ''::bytea ORDER BY t.ord
+      ),
+      'UTF8'
+    ),
+    ''
+  )
+  FROM regexp_matches(replace(s, '+', ' '), '(%[0-9A-Fa-f]{2})|([^%]+|%)', 'g') WITH ORDINALITY AS t(m, ord)
+$$ LANGUAGE sql IMMUTABLE STRICT;
+
+-- move the v1 credentials out of the station url query string
+UPDATE "weatherlink" w
+SET
+  "v1_user" = pg_temp.url_decode(substring(s."station_url" FROM '[?&]user=([^&]*)')),
+  "v1_pass" = pg_temp.url_decode(substring(s."station_url" FROM '[?&]pass=([^&]*)')),
+  "v1_api_token" = pg_temp.url_decode(substring(s."station_url" FROM '[?&]apiToken=([^&]*)')),
+  "updated_at" = now()
+FROM "observations_station" s
+WHERE w."station_id" = s."id"
+  AND s."station_type" = 'MO'
+  AND s."station_url" ~ '[?&]user=' AND s."station_url" ~ '[?&]pass=' AND s."station_url" ~ '[?&]apiToken=';
+
+INSERT INTO "weatherlink" ("station_id", "v1_user", "v1_pass", "v1_api_token")
+SELECT
+  s."id",
+  pg_temp.url_decode(substring(s."station_url" FROM '[?&]user=([^&]*)')),
+  pg_temp.url_decode(substring(s."station_url" FROM '[?&]pass=([^&]*)')),
+  pg_temp.url_decode(substring(s."station_url" FROM '[?&]apiToken=([^&]*)'))
+FROM "observations_station" s
+WHERE s."station_type" = 'MO'
+  AND s."station_url" ~ '[?&]user=' AND s."station_url" ~ '[?&]pass=' AND s."station_url" ~ '[?&]apiToken='
+  AND NOT EXISTS (SELECT 1 FROM "weatherlink" w WHERE w."station_id" = s."id");
+
+UPDATE "observations_station" s
+SET
+  "station_url" = NULL,
+  "updated_at" = now()
+FROM "weatherlink" w
+WHERE w."station_id" = s."id"
+  AND w."v1_user" IS NOT NULL
+  AND s."station_url" ~ '[?&]apiToken=';
+
+DROP FUNCTION pg_temp.url_decode(text);
@@ -0,0 +1,4 @@
+ALTER TABLE "misol_station"
+  DROP COLUMN IF EXISTS "status",
+  DROP COLUMN IF EXISTS "created_at",
+  DROP COLUMN IF EXISTS "updated_at";
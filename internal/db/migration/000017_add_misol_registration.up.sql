@@ -0,0 +1,4 @@
+ALTER TABLE "misol_station"
+  ADD COLUMN "status" VARCHAR(16) NOT NULL DEFAULT 'APPROVED',
+  ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  ADD COLUMN "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z';
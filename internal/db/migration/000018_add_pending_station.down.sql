@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "pending_station_message";
+DROP TABLE IF EXISTS "pending_station";
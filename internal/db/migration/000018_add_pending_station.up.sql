@@ -0,0 +1,22 @@
+CREATE TABLE "pending_station" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "mobile_number" VARCHAR(50) NOT NULL,
+  "message_count" INT NOT NULL DEFAULT 0,
+  "first_seen_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "last_seen_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "pending_station"
+  ADD CONSTRAINT "pending_station_mobile_number_unique" UNIQUE ("mobile_number");
+
+CREATE TABLE "pending_station_message" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "pending_station_id" BIGINT NOT NULL,
+  "msg" TEXT NOT NULL,
+  "received_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "pending_station_message"
+  ADD CONSTRAINT "pending_station_message_pending_station_id_fkey" FOREIGN KEY ("pending_station_id") REFERENCES "pending_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+CREATE INDEX "pending_station_message_pending_station_id_idx" ON "pending_station_message" ("pending_station_id");
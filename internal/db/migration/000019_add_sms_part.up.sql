@@ -0,0 +1,11 @@
+CREATE TABLE "sms_part" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "sender" VARCHAR(50) NOT NULL,
+  "ref_id" VARCHAR(64) NOT NULL,
+  "seq_num" INT NOT NULL,
+  "msg" TEXT NOT NULL,
+  "received_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "sms_part"
+  ADD CONSTRAINT "sms_part_sender_ref_id_seq_num_unique" UNIQUE ("sender", "ref_id", "seq_num");
@@ -0,0 +1,16 @@
+CREATE TABLE "sms_outbox" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "mobile_number" VARCHAR(50) NOT NULL,
+  "message" TEXT NOT NULL,
+  "status" VARCHAR(16) NOT NULL DEFAULT 'QUEUED',
+  "attempts" INT NOT NULL DEFAULT 0,
+  "last_error" TEXT,
+  "delivery_status" VARCHAR(64),
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "sent_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+CREATE INDEX "sms_outbox_status_next_attempt_at_idx" ON "sms_outbox" ("status", "next_attempt_at");
+CREATE INDEX "sms_outbox_mobile_number_idx" ON "sms_outbox" ("mobile_number");
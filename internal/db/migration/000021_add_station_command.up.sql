@@ -0,0 +1,19 @@
+CREATE TABLE "station_command" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "command" VARCHAR(16) NOT NULL,
+  "interval" INT,
+  "message" TEXT NOT NULL DEFAULT '',
+  "status" VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+  "sms_outbox_id" BIGINT,
+  "ack_message" TEXT,
+  "acknowledged_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "station_command"
+  ADD CONSTRAINT "station_command_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "station_command_sms_outbox_id_fkey" FOREIGN KEY ("sms_outbox_id") REFERENCES "sms_outbox" ("id") ON DELETE SET NULL;
+
+CREATE INDEX "station_command_station_id_status_idx" ON "station_command" ("station_id", "status");
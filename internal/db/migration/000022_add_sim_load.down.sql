@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "glabs_load_transaction_id_idx";
+
+ALTER TABLE "sim_cards"
+  DROP COLUMN IF EXISTS "load_promo",
+  DROP COLUMN IF EXISTS "load_validity_days",
+  DROP COLUMN IF EXISTS "load_expires_at",
+  DROP COLUMN IF EXISTS "load_requested_at";
@@ -0,0 +1,7 @@
+ALTER TABLE "sim_cards"
+  ADD COLUMN "load_promo" VARCHAR(255),
+  ADD COLUMN "load_validity_days" INT,
+  ADD COLUMN "load_expires_at" timestamptz,
+  ADD COLUMN "load_requested_at" timestamptz;
+
+CREATE INDEX "glabs_load_transaction_id_idx" ON "glabs_load" ("transaction_id");
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "sim_card_assignment";
+
+ALTER TABLE "sim_cards"
+  DROP CONSTRAINT IF EXISTS "sim_cards_iccid_unique",
+  DROP COLUMN IF EXISTS "iccid",
+  DROP COLUMN IF EXISTS "status";
@@ -0,0 +1,20 @@
+ALTER TABLE "sim_cards"
+  ADD COLUMN "iccid" VARCHAR(22),
+  ADD COLUMN "status" VARCHAR(16) NOT NULL DEFAULT 'ACTIVE',
+  ADD CONSTRAINT "sim_cards_iccid_unique" UNIQUE ("iccid");
+
+CREATE TABLE "sim_card_assignment" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "mobile_number" VARCHAR(50) NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "assigned_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "unassigned_at" timestamptz
+);
+
+ALTER TABLE "sim_card_assignment"
+  ADD CONSTRAINT "sim_card_assignment_mobile_number_fkey" FOREIGN KEY ("mobile_number") REFERENCES "sim_cards" ("mobile_number") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "sim_card_assignment_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- a SIM is in at most one station and a station has at most one SIM at a time
+CREATE UNIQUE INDEX "sim_card_assignment_mobile_number_active_idx" ON "sim_card_assignment" ("mobile_number") WHERE "unassigned_at" IS NULL;
+CREATE UNIQUE INDEX "sim_card_assignment_station_id_active_idx" ON "sim_card_assignment" ("station_id") WHERE "unassigned_at" IS NULL;
@@ -0,0 +1,14 @@
+CREATE TABLE "station_status_history" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "from_status" VARCHAR(16),
+  "to_status" VARCHAR(16) NOT NULL,
+  "reason" TEXT NOT NULL DEFAULT '',
+  "last_observed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "station_status_history"
+  ADD CONSTRAINT "station_status_history_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+CREATE INDEX "station_status_history_station_id_created_at_idx" ON "station_status_history" ("station_id", "created_at" DESC);
@@ -0,0 +1,2 @@
+ALTER TABLE "observations_station"
+  DROP COLUMN IF EXISTS "reporting_interval";
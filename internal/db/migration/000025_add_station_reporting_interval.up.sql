@@ -0,0 +1,2 @@
+ALTER TABLE "observations_station"
+  ADD COLUMN "reporting_interval" INTEGER;
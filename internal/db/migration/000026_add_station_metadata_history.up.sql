@@ -0,0 +1,38 @@
+CREATE TABLE "station_metadata_history" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "lat" REAL,
+  "lon" REAL,
+  "elevation" REAL,
+  "station_type" VARCHAR(50),
+  "station_type2" VARCHAR(50),
+  "logger_version" VARCHAR(50),
+  "logger_serial" VARCHAR(64),
+  "temp_height" REAL,
+  "temp_serial" VARCHAR(64),
+  "wind_height" REAL,
+  "wind_serial" VARCHAR(64),
+  "rain_height" REAL,
+  "rain_serial" VARCHAR(64),
+  "pres_height" REAL,
+  "pres_serial" VARCHAR(64),
+  "reason" TEXT NOT NULL DEFAULT '',
+  "effective_from" timestamptz NOT NULL,
+  "effective_to" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  CONSTRAINT "station_metadata_history_effective_range_check" CHECK ("effective_to" IS NULL OR "effective_to" > "effective_from")
+);
+
+ALTER TABLE "station_metadata_history"
+  ADD CONSTRAINT "station_metadata_history_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+CREATE INDEX "station_metadata_history_station_id_effective_from_idx" ON "station_metadata_history" ("station_id", "effective_from" DESC);
+-- a station has one current version
+CREATE UNIQUE INDEX "station_metadata_history_station_id_current_idx" ON "station_metadata_history" ("station_id") WHERE "effective_to" IS NULL;
+
+-- the current metadata of existing stations is valid for all of their observations
+INSERT INTO "station_metadata_history" (
+  "station_id", "lat", "lon", "elevation", "station_type", "station_type2", "logger_version", "reason", "effective_from"
+)
+SELECT "id", "lat", "lon", "elevation", "station_type", "station_type2", "logger_version", 'initial', '0001-01-01 00:00:00Z'
+FROM "observations_station";
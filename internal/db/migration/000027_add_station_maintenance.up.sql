@@ -0,0 +1,26 @@
+CREATE TABLE "station_maintenance" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "technician_id" BIGINT,
+  "visit_date" DATE NOT NULL,
+  "actions" TEXT NOT NULL DEFAULT '',
+  "parts_replaced" TEXT[] NOT NULL DEFAULT '{}',
+  "sensors_calibrated" TEXT[] NOT NULL DEFAULT '{}',
+  "photos" TEXT[] NOT NULL DEFAULT '{}',
+  "window_start" timestamptz,
+  "window_end" timestamptz,
+  "window_status" VARCHAR(16),
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  CONSTRAINT "station_maintenance_window_check" CHECK (
+    ("window_start" IS NULL AND "window_end" IS NULL AND "window_status" IS NULL)
+    OR ("window_start" IS NOT NULL AND "window_end" > "window_start" AND "window_status" IS NOT NULL)
+  )
+);
+
+ALTER TABLE "station_maintenance"
+  ADD CONSTRAINT "station_maintenance_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "station_maintenance_technician_id_fkey" FOREIGN KEY ("technician_id") REFERENCES "users" ("id") ON DELETE SET NULL;
+
+CREATE INDEX "station_maintenance_station_id_visit_date_idx" ON "station_maintenance" ("station_id", "visit_date" DESC);
+CREATE INDEX "station_maintenance_window_idx" ON "station_maintenance" ("window_start", "window_end") WHERE "window_start" IS NOT NULL;
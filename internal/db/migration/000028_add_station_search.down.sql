@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "observations_station_province_region_idx";
+DROP INDEX IF EXISTS "observations_station_address_trgm_idx";
+DROP INDEX IF EXISTS "observations_station_name_trgm_idx";
@@ -0,0 +1,5 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX "observations_station_name_trgm_idx" ON "observations_station" USING gin ("name" gin_trgm_ops);
+CREATE INDEX "observations_station_address_trgm_idx" ON "observations_station" USING gin ("address" gin_trgm_ops);
+CREATE INDEX "observations_station_province_region_idx" ON "observations_station" ("province", "region");
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "observations_station_mobile_number_unique";
+
+ALTER TABLE "observations_station"
+  ADD CONSTRAINT "observations_station_mobile_number_unique" UNIQUE ("mobile_number");
@@ -0,0 +1,5 @@
+ALTER TABLE "observations_station"
+  DROP CONSTRAINT "observations_station_mobile_number_unique";
+
+-- deleted stations release their mobile number
+CREATE UNIQUE INDEX "observations_station_mobile_number_unique" ON "observations_station" ("mobile_number") WHERE "deleted_at" = '0001-01-01 00:00:00Z';
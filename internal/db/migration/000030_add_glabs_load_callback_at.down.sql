@@ -0,0 +1,2 @@
+ALTER TABLE "glabs_load"
+  DROP COLUMN IF EXISTS "callback_at";
@@ -0,0 +1,5 @@
+ALTER TABLE "glabs_load"
+  ADD COLUMN "callback_at" timestamptz;
+
+-- loads that were already updated by a callback are not completed again
+UPDATE "glabs_load" SET "callback_at" = "updated_at" WHERE "updated_at" <> '0001-01-01 00:00:00Z';
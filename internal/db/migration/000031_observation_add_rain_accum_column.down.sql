@@ -0,0 +1 @@
+ALTER TABLE "observations_observation" DROP COLUMN "rain_accum";
@@ -0,0 +1 @@
+ALTER TABLE "observations_observation" ADD COLUMN "rain_accum" REAL;
@@ -0,0 +1,3 @@
+ALTER TABLE "observations_mo_observation"
+  DROP COLUMN "tx_timestamp",
+  DROP COLUMN "tn_timestamp";
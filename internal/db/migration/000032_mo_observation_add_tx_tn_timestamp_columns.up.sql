@@ -0,0 +1,3 @@
+ALTER TABLE "observations_mo_observation"
+  ADD COLUMN "tx_timestamp" timestamptz,
+  ADD COLUMN "tn_timestamp" timestamptz;
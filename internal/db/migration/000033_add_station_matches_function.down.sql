@@ -0,0 +1,2 @@
+DROP FUNCTION IF EXISTS station_matches(observations_station, text, text, text, text, text, text, text, text, date, date, real, real, real, real, real, real, real);
+DROP FUNCTION IF EXISTS escape_like(text);
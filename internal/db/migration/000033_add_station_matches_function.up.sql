@@ -0,0 +1,45 @@
+-- escape_like escapes the LIKE wildcards so that s is matched literally
+CREATE FUNCTION escape_like(s text) RETURNS text
+LANGUAGE sql IMMUTABLE STRICT
+AS $$
+  SELECT replace(replace(replace(s, '\', '\\'), '%', '\%'), '_', '\_')
+$$;
+
+-- station_matches is the station search filter shared by the station and latest observation lists.
+-- A NULL filter matches all stations, the circle and bbox filters are in degrees.
+CREATE FUNCTION station_matches(
+  stn observations_station,
+  p_status text,
+  p_q text,
+  p_station_type text,
+  p_station_type2 text,
+  p_province text,
+  p_region text,
+  p_provider_id text,
+  p_priority_level text,
+  p_installed_from date,
+  p_installed_to date,
+  p_cx real,
+  p_cy real,
+  p_r real,
+  p_xmin real,
+  p_ymin real,
+  p_xmax real,
+  p_ymax real
+) RETURNS boolean
+LANGUAGE sql STABLE
+AS $$
+  SELECT stn.deleted_at = '0001-01-01 00:00:00Z'
+    AND (p_status IS NULL OR stn.status = p_status)
+    AND (p_q IS NULL OR stn.name ILIKE '%' || escape_like(p_q) || '%' OR stn.address ILIKE '%' || escape_like(p_q) || '%' OR stn.name % p_q)
+    AND (p_station_type IS NULL OR stn.station_type = p_station_type)
+    AND (p_station_type2 IS NULL OR stn.station_type2 = p_station_type2)
+    AND (p_province IS NULL OR stn.province = p_province)
+    AND (p_region IS NULL OR stn.region = p_region)
+    AND (p_provider_id IS NULL OR stn.provider_id = p_provider_id)
+    AND (p_priority_level IS NULL OR stn.priority_level = p_priority_level)
+    AND (p_installed_from IS NULL OR stn.date_installed >= p_installed_from)
+    AND (p_installed_to IS NULL OR stn.date_installed <= p_installed_to)
+    AND (p_r IS NULL OR ST_DWithin(stn.geom, ST_Point(p_cx, p_cy, 4326), p_r))
+    AND (p_xmin IS NULL OR stn.geom && ST_MakeEnvelope(p_xmin, p_ymin, p_xmax, p_ymax, 4326))
+$$;
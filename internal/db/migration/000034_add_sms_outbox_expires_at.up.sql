@@ -0,0 +1,2 @@
+ALTER TABLE "sms_outbox"
+  ADD COLUMN "expires_at" timestamptz;
@@ -0,0 +1,28 @@
+-- name: ListStationDailyDataStatus :many
+SELECT
+  h.station_id,
+  (h.timestamp AT TIME ZONE COALESCE(c.timezone, 'Asia/Manila'))::date AS day,
+  COALESCE(h.data_status, '')::text AS data_status,
+  count(h.id) AS record_count,
+  COALESCE(sum(h.data_count), 0)::bigint AS data_count
+FROM observations_stationhealth h
+LEFT JOIN station_clock c ON c.station_id = h.station_id
+WHERE h.timestamp >= @start_date AND h.timestamp < @end_date
+GROUP BY h.station_id, day, h.data_status
+ORDER BY h.station_id, day;
+
+-- name: ListStationDailyRecordCounts :many
+SELECT
+  r.station_id,
+  (r.timestamp AT TIME ZONE COALESCE(c.timezone, 'Asia/Manila'))::date AS day,
+  count(*) AS record_count
+FROM (
+  SELECT o.station_id, o.timestamp FROM observations_observation o
+  WHERE o.timestamp >= @start_date AND o.timestamp < @end_date
+  UNION ALL
+  SELECT m.station_id, m.timestamp FROM observations_mo_observation m
+  WHERE m.timestamp >= @start_date AND m.timestamp < @end_date
+) r
+LEFT JOIN station_clock c ON c.station_id = r.station_id
+GROUP BY r.station_id, day
+ORDER BY r.station_id, day;
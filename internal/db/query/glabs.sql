@@ -7,3 +7,24 @@ INSERT INTO glabs_load (
 ) VALUES (
   $1, $2, $3, $4
 ) RETURNING *;
+
+-- name: CreateGLabsLoadCallback :one
+INSERT INTO glabs_load (
+  promo,
+  transaction_id,
+  status,
+  mobile_number,
+  callback_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, now(), now()
+) RETURNING *;
+
+-- name: CompleteGLabsLoad :one
+UPDATE glabs_load
+SET
+  status = $1,
+  callback_at = now(),
+  updated_at = now()
+WHERE transaction_id = $2 AND mobile_number = $3 AND callback_at IS NULL
+RETURNING *;
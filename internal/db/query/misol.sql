@@ -1,14 +1,36 @@
 -- name: CreateMisolStation :one
 INSERT INTO misol_station (
   id,
-  station_id
+  station_id,
+  status
 ) VALUES (
-  $1, $2
+  @id, @station_id, COALESCE(sqlc.narg(status), 'APPROVED')
 ) RETURNING *;
 
 -- name: GetMisolStation :one
 SELECT * FROM misol_station
 WHERE id = $1 LIMIT 1;
 
+-- name: ListMisolStations :many
+SELECT * FROM misol_station
+WHERE
+  (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
+ORDER BY id
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountMisolStations :one
+SELECT count(*) FROM misol_station
+WHERE (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END);
+
+-- name: UpdateMisolStation :one
+UPDATE misol_station
+SET
+  station_id = COALESCE(sqlc.narg(station_id), station_id),
+  status = COALESCE(sqlc.narg(status), status),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
 -- name: DeleteMisolStation :exec
 DELETE FROM misol_station WHERE id = $1;
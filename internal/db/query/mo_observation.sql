@@ -11,11 +11,28 @@ INSERT INTO observations_mo_observation (
   srad,
   hi,
   wchill,
+  rain,
+  tx,
+  tn,
+  tx_timestamp,
+  tn_timestamp,
+  wrun,
+  thwi,
+  thswi,
+  senergy,
+  sradx,
+  uvi,
+  uvdose,
+  uvx,
+  hdd,
+  cdd,
+  et,
+  wdirx,
   timestamp,
   qc_level,
   station_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31
 ) RETURNING *;
 
 -- name: GetStationMOObservation :one
@@ -31,6 +48,13 @@ ORDER BY timestamp DESC
 LIMIT sqlc.narg('limit')
 OFFSET sqlc.arg('offset');
 
+-- name: ListStationMOObservationTimestamps :many
+SELECT timestamp FROM observations_mo_observation
+WHERE station_id = @station_id
+  AND timestamp >= @start_date
+  AND timestamp <= @end_date
+ORDER BY timestamp;
+
 -- name: ListMOObservations :many
 SELECT * FROM observations_mo_observation
 WHERE station_id = ANY(@station_ids::bigint[])
@@ -4,6 +4,7 @@ INSERT INTO observations_observation (
   rr,
   rain_tips,
   rain_cumulative_tips,
+  rain_accum,
   rh,
   temp,
   td,
@@ -18,7 +19,7 @@ INSERT INTO observations_observation (
   qc_level,
   station_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
 ) RETURNING *;
 
 -- name: GetStationObservation :one
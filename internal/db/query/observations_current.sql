@@ -11,6 +11,15 @@ WITH RankedRows AS (
     JOIN observations_current obs 
     ON stn.id = obs.station_id
   WHERE obs.timestamp > NOW() - INTERVAL '1 hour'
+    AND station_matches(stn,
+      sqlc.narg('status')::text, sqlc.narg('q')::text,
+      sqlc.narg('station_type')::text, sqlc.narg('station_type2')::text,
+      sqlc.narg('province')::text, sqlc.narg('region')::text,
+      sqlc.narg('provider_id')::text, sqlc.narg('priority_level')::text,
+      sqlc.narg('installed_from')::date, sqlc.narg('installed_to')::date,
+      sqlc.narg('cx')::real, sqlc.narg('cy')::real, sqlc.narg('r')::real,
+      sqlc.narg('xmin')::real, sqlc.narg('ymin')::real, sqlc.narg('xmax')::real, sqlc.narg('ymax')::real
+    )
 )
 SELECT *
 FROM RankedRows
@@ -24,7 +33,7 @@ SELECT
 FROM observations_station stn 
   JOIN observations_current obs 
   ON stn.id = obs.station_id
-WHERE stn.id = $1
+WHERE stn.id = $1 AND stn.deleted_at = '0001-01-01 00:00:00Z'
 ORDER BY obs.timestamp DESC
 LIMIT 1;
 
@@ -33,6 +42,7 @@ WITH NearestStation AS (
   SELECT
 	id, name, lat, lon, elevation, address
   FROM observations_station
+  WHERE deleted_at = '0001-01-01 00:00:00Z'
   ORDER BY geom <-> ST_Point(@lon::real, @lat::real, 4326)
   LIMIT 1
 )
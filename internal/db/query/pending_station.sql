@@ -0,0 +1,49 @@
+-- name: UpsertPendingStation :one
+INSERT INTO pending_station (
+  mobile_number,
+  message_count
+) VALUES (
+  $1, 1
+)
+ON CONFLICT (mobile_number) DO UPDATE SET
+  message_count = pending_station.message_count + 1,
+  last_seen_at = now()
+RETURNING *;
+
+-- name: GetPendingStation :one
+SELECT * FROM pending_station
+WHERE mobile_number = $1 LIMIT 1;
+
+-- name: ListPendingStations :many
+SELECT * FROM pending_station
+ORDER BY last_seen_at DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountPendingStations :one
+SELECT count(*) FROM pending_station;
+
+-- name: DeletePendingStation :exec
+DELETE FROM pending_station WHERE id = $1;
+
+-- name: DeletePendingStationMessage :exec
+WITH deleted AS (
+  DELETE FROM pending_station_message WHERE id = $1
+  RETURNING pending_station_id
+)
+UPDATE pending_station
+SET message_count = message_count - 1
+WHERE id IN (SELECT pending_station_id FROM deleted);
+
+-- name: CreatePendingStationMessage :one
+INSERT INTO pending_station_message (
+  pending_station_id,
+  msg
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: ListPendingStationMessages :many
+SELECT * FROM pending_station_message
+WHERE pending_station_id = $1
+ORDER BY received_at, id;
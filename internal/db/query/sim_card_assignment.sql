@@ -0,0 +1,25 @@
+-- name: CreateSimCardAssignment :one
+INSERT INTO sim_card_assignment (
+  mobile_number,
+  station_id
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: GetActiveSimCardAssignment :one
+SELECT * FROM sim_card_assignment
+WHERE mobile_number = $1 AND unassigned_at IS NULL
+LIMIT 1;
+
+-- name: ListSimCardAssignments :many
+SELECT * FROM sim_card_assignment
+WHERE
+  (CASE WHEN sqlc.narg('mobile_number')::text IS NOT NULL THEN mobile_number = sqlc.narg('mobile_number') ELSE TRUE END)
+  AND (CASE WHEN sqlc.narg('station_id')::bigint IS NOT NULL THEN station_id = sqlc.narg('station_id') ELSE TRUE END)
+ORDER BY assigned_at DESC, id DESC;
+
+-- name: CloseSimCardAssignments :exec
+UPDATE sim_card_assignment
+SET unassigned_at = now()
+WHERE unassigned_at IS NULL
+  AND (mobile_number = sqlc.arg('mobile_number') OR station_id = sqlc.arg('station_id'));
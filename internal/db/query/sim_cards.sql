@@ -1,11 +1,87 @@
 -- name: CreateSimCard :one
 INSERT INTO sim_cards (
   mobile_number,
-  type
+  type,
+  iccid
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 ) RETURNING *;
 
 -- name: GetSimCard :one
 SELECT * FROM sim_cards
-WHERE mobile_number = $1 LIMIT 1;
\ No newline at end of file
+WHERE mobile_number = $1 LIMIT 1;
+
+-- name: ListSimCards :many
+SELECT * FROM sim_cards
+WHERE
+  (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
+  AND (CASE WHEN sqlc.narg('type')::text IS NOT NULL THEN type = sqlc.narg('type') ELSE TRUE END)
+ORDER BY mobile_number
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountSimCards :one
+SELECT count(*) FROM sim_cards
+WHERE
+  (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
+  AND (CASE WHEN sqlc.narg('type')::text IS NOT NULL THEN type = sqlc.narg('type') ELSE TRUE END);
+
+-- name: UpdateSimCard :one
+UPDATE sim_cards
+SET
+  type = COALESCE(sqlc.narg('type'), type),
+  iccid = COALESCE(sqlc.narg('iccid'), iccid),
+  status = COALESCE(sqlc.narg('status'), status),
+  updated_at = now()
+WHERE mobile_number = sqlc.arg('mobile_number')
+RETURNING *;
+
+-- name: DeleteSimCard :exec
+DELETE FROM sim_cards WHERE mobile_number = $1;
+
+-- name: UpdateSimCardLoad :one
+UPDATE sim_cards
+SET
+  load_promo = sqlc.narg('load_promo'),
+  load_validity_days = sqlc.narg('load_validity_days'),
+  load_expires_at = sqlc.narg('load_expires_at'),
+  updated_at = now()
+WHERE mobile_number = sqlc.arg('mobile_number')
+RETURNING *;
+
+-- name: ListSimCardsDueForLoad :many
+SELECT c.* FROM sim_cards c
+WHERE c.load_promo IS NOT NULL
+  AND (c.load_requested_at IS NULL OR c.load_requested_at < sqlc.arg('requested_before'))
+  AND (
+    c.load_expires_at < sqlc.arg('expires_before')
+    OR EXISTS (
+      SELECT 1 FROM observations_station s
+      WHERE s.mobile_number = c.mobile_number
+        AND s.deleted_at = '0001-01-01 00:00:00Z'
+        AND COALESCE(s.status, '') NOT IN ('INACTIVE', 'MAINTENANCE')
+        AND NOT EXISTS (
+          SELECT 1 FROM observations_observation o
+          WHERE o.station_id = s.id AND o.timestamp >= sqlc.arg('silent_since')
+        )
+        -- top up once per silence episode: nothing requested since the last observation
+        AND (c.load_requested_at IS NULL OR c.load_requested_at < (
+          SELECT max(o.timestamp) FROM observations_observation o WHERE o.station_id = s.id
+        ))
+    )
+  )
+ORDER BY c.load_expires_at NULLS FIRST;
+
+-- name: MarkSimCardLoadRequested :exec
+UPDATE sim_cards
+SET
+  load_requested_at = now(),
+  updated_at = now()
+WHERE mobile_number = $1;
+
+-- name: ExtendSimCardLoad :exec
+UPDATE sim_cards
+SET
+  load_expires_at = GREATEST(COALESCE(load_expires_at, now()), now()) + make_interval(days => load_validity_days),
+  updated_at = now()
+WHERE mobile_number = $1 AND load_validity_days IS NOT NULL;
@@ -0,0 +1,57 @@
+-- name: CreateSmsOutbox :one
+INSERT INTO sms_outbox (
+  mobile_number,
+  message,
+  expires_at
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetSmsOutbox :one
+SELECT * FROM sms_outbox
+WHERE id = $1 LIMIT 1;
+
+-- name: ListSmsOutbox :many
+SELECT * FROM sms_outbox
+WHERE
+  (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
+  AND (CASE WHEN sqlc.narg('mobile_number')::text IS NOT NULL THEN mobile_number = sqlc.narg('mobile_number') ELSE TRUE END)
+ORDER BY id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountSmsOutbox :one
+SELECT count(*) FROM sms_outbox
+WHERE
+  (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
+  AND (CASE WHEN sqlc.narg('mobile_number')::text IS NOT NULL THEN mobile_number = sqlc.narg('mobile_number') ELSE TRUE END);
+
+-- name: ClaimDueSmsOutbox :many
+-- Claimed messages stay SENDING until the send result is stored.
+-- The claim expires so that the messages of an interrupted dispatch are sent again.
+UPDATE sms_outbox
+SET
+  status = 'SENDING',
+  next_attempt_at = now() + interval '10 minutes',
+  updated_at = now()
+WHERE id IN (
+  SELECT id FROM sms_outbox
+  WHERE status IN ('QUEUED', 'SENDING') AND next_attempt_at <= now()
+  ORDER BY next_attempt_at, id
+  LIMIT $1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateSmsOutboxStatus :one
+UPDATE sms_outbox
+SET
+  status = @status,
+  attempts = @attempts,
+  last_error = sqlc.narg('last_error'),
+  delivery_status = COALESCE(sqlc.narg('delivery_status'), delivery_status),
+  next_attempt_at = COALESCE(sqlc.narg('next_attempt_at'), next_attempt_at),
+  sent_at = COALESCE(sqlc.narg('sent_at'), sent_at),
+  updated_at = now()
+WHERE id = @id
+RETURNING *;
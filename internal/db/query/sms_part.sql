@@ -0,0 +1,22 @@
+-- name: CreateSmsPart :exec
+INSERT INTO sms_part (
+  sender,
+  ref_id,
+  seq_num,
+  msg
+) VALUES (
+  $1, $2, $3, $4
+) ON CONFLICT (sender, ref_id, seq_num) DO NOTHING;
+
+-- name: ListSmsParts :many
+SELECT * FROM sms_part
+WHERE sender = $1 AND ref_id = $2
+ORDER BY seq_num;
+
+-- name: DeleteSmsParts :execrows
+DELETE FROM sms_part
+WHERE sender = $1 AND ref_id = $2;
+
+-- name: DeleteStaleSmsParts :exec
+DELETE FROM sms_part
+WHERE received_at < $1;
@@ -18,9 +18,10 @@ INSERT INTO observations_station (
   province,
   region,
   address,
+  reporting_interval,
   geom
 ) VALUES (
-  $1, @lat, @lon, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, 
+  $1, @lat, @lon, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
   CASE
     WHEN @lon::real IS NOT NULL AND @lat::real IS NOT NULL THEN ST_Point(@lon::real, @lat::real, 4326)
     ELSE ST_GeomFromEWKT('POINT EMPTY')
@@ -29,49 +30,53 @@ INSERT INTO observations_station (
 
 -- name: GetStation :one
 SELECT * FROM observations_station
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND deleted_at = '0001-01-01 00:00:00Z' LIMIT 1;
 
 -- name: GetStationByMobileNumber :one
 SELECT * FROM observations_station
-WHERE mobile_number = $1 LIMIT 1;
+WHERE mobile_number = $1 AND deleted_at = '0001-01-01 00:00:00Z' LIMIT 1;
 
 -- name: ListStations :many
-SELECT * FROM observations_station
-WHERE
-  (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
-ORDER BY id
+SELECT * FROM observations_station stn
+WHERE station_matches(stn,
+  sqlc.narg('status')::text, sqlc.narg('q')::text,
+  sqlc.narg('station_type')::text, sqlc.narg('station_type2')::text,
+  sqlc.narg('province')::text, sqlc.narg('region')::text,
+  sqlc.narg('provider_id')::text, sqlc.narg('priority_level')::text,
+  sqlc.narg('installed_from')::date, sqlc.narg('installed_to')::date,
+  sqlc.narg('cx')::real, sqlc.narg('cy')::real, sqlc.narg('r')::real,
+  sqlc.narg('xmin')::real, sqlc.narg('ymin')::real, sqlc.narg('xmax')::real, sqlc.narg('ymax')::real
+)
+ORDER BY
+  CASE WHEN sqlc.narg('sort')::text = 'relevance' THEN similarity(name, sqlc.narg('q')) END DESC,
+  CASE WHEN sqlc.narg('sort') = 'name' THEN name END,
+  CASE WHEN sqlc.narg('sort') = '-name' THEN name END DESC NULLS LAST,
+  CASE WHEN sqlc.narg('sort') = 'date_installed' THEN date_installed END,
+  CASE WHEN sqlc.narg('sort') = '-date_installed' THEN date_installed END DESC NULLS LAST,
+  CASE WHEN sqlc.narg('sort') = 'priority_level' THEN priority_level END,
+  CASE WHEN sqlc.narg('sort') = '-priority_level' THEN priority_level END DESC NULLS LAST,
+  CASE WHEN sqlc.narg('sort') = '-id' THEN id END DESC,
+  id
 LIMIT sqlc.narg('limit')
 OFFSET sqlc.arg('offset');
 
--- name: ListStationsWithinRadius :many
+-- name: ListStationsByMobileNumbers :many
 SELECT * FROM observations_station
-WHERE ST_DWithin(geom, ST_Point(@cx::real, @cy::real, 4326), @r::real)
-  AND (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
-ORDER BY id
-LIMIT sqlc.narg('limit')
-OFFSET sqlc.arg('offset');
-
--- name: ListStationsWithinBBox :many
-SELECT * FROM observations_station
-WHERE geom && ST_MakeEnvelope(@xmin::real, @ymin::real, @xmax::real, @ymax::real, 4326)
-  AND (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
-ORDER BY id
-LIMIT sqlc.narg('limit')
-OFFSET sqlc.arg('offset');
+WHERE mobile_number = ANY(@mobile_numbers::text[])
+  AND deleted_at = '0001-01-01 00:00:00Z'
+ORDER BY id;
 
 -- name: CountStations :one
-SELECT count(*) FROM observations_station
-WHERE (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END);
-
--- name: CountStationsWithinRadius :one
-SELECT count(*) FROM observations_station
-WHERE ST_DWithin(geom, ST_Point(@cx::real, @cy::real, 4326), @r::real)
-  AND (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END);
-
--- name: CountStationsWithinBBox :one
-SELECT count(*) FROM observations_station
-WHERE geom && ST_MakeEnvelope(@xmin::real, @ymin::real, @xmax::real, @ymax::real, 4326)
-  AND (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END);
+SELECT count(*) FROM observations_station stn
+WHERE station_matches(stn,
+  sqlc.narg('status')::text, sqlc.narg('q')::text,
+  sqlc.narg('station_type')::text, sqlc.narg('station_type2')::text,
+  sqlc.narg('province')::text, sqlc.narg('region')::text,
+  sqlc.narg('provider_id')::text, sqlc.narg('priority_level')::text,
+  sqlc.narg('installed_from')::date, sqlc.narg('installed_to')::date,
+  sqlc.narg('cx')::real, sqlc.narg('cy')::real, sqlc.narg('r')::real,
+  sqlc.narg('xmin')::real, sqlc.narg('ymin')::real, sqlc.narg('xmax')::real, sqlc.narg('ymax')::real
+);
 
 -- name: UpdateStation :one
 UPDATE observations_station
@@ -94,10 +99,54 @@ SET
   province = COALESCE(sqlc.narg(province), province),
   region = COALESCE(sqlc.narg(region), region),
   address = COALESCE(sqlc.narg(address), address),
+  reporting_interval = COALESCE(sqlc.narg(reporting_interval), reporting_interval),
   geom = COALESCE(ST_POINT(sqlc.narg(lon), sqlc.narg(lat), 4326), geom),
   updated_at = now()
-WHERE id = sqlc.arg(id)
+WHERE id = sqlc.arg(id) AND deleted_at = '0001-01-01 00:00:00Z'
+RETURNING *;
+
+-- name: DeleteStation :execrows
+UPDATE observations_station
+SET
+  deleted_at = now(),
+  updated_at = now()
+WHERE id = $1 AND deleted_at = '0001-01-01 00:00:00Z';
+
+-- name: ClearStationMobileNumber :exec
+UPDATE observations_station
+SET
+  mobile_number = NULL,
+  updated_at = now()
+WHERE mobile_number = $1;
+
+-- name: GetStationStatusForUpdate :one
+SELECT status FROM observations_station
+WHERE id = $1 AND deleted_at = '0001-01-01 00:00:00Z'
+FOR UPDATE;
+
+-- name: ListStationsLastObserved :many
+SELECT
+  s.id,
+  s.station_type,
+  s.status,
+  max(c.timestamp) AS last_observed_at
+FROM observations_station s
+LEFT JOIN observations_current c ON c.station_id = s.id
+WHERE s.deleted_at = '0001-01-01 00:00:00Z'
+GROUP BY s.id
+ORDER BY s.id;
+
+-- name: GetDeletedStation :one
+SELECT * FROM observations_station
+WHERE id = $1 AND deleted_at <> '0001-01-01 00:00:00Z' LIMIT 1;
+
+-- name: RestoreStation :one
+UPDATE observations_station
+SET
+  deleted_at = '0001-01-01 00:00:00Z',
+  updated_at = now()
+WHERE id = $1 AND deleted_at <> '0001-01-01 00:00:00Z'
 RETURNING *;
 
--- name: DeleteStation :exec
-DELETE FROM observations_station WHERE id = $1;
+-- name: PurgeStation :exec
+DELETE FROM observations_station WHERE id = $1 AND deleted_at <> '0001-01-01 00:00:00Z';
@@ -0,0 +1,61 @@
+-- name: GetStationClock :one
+SELECT * FROM station_clock
+WHERE station_id = $1 LIMIT 1;
+
+-- name: UpsertStationClock :one
+INSERT INTO station_clock (
+  station_id,
+  timezone,
+  clock_offset
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (station_id) DO UPDATE
+SET
+  timezone = EXCLUDED.timezone,
+  clock_offset = EXCLUDED.clock_offset,
+  updated_at = now()
+RETURNING *;
+
+-- name: DeleteStationClock :exec
+DELETE FROM station_clock WHERE station_id = $1;
+
+-- name: ListStationClockDrift :many
+SELECT
+  s.id AS station_id,
+  s.name,
+  COALESCE(c.timezone, 'Asia/Manila')::text AS timezone,
+  COALESCE(c.clock_offset, 0)::int AS clock_offset,
+  count(h.id) AS sample_count,
+  avg(h.minutes_difference)::real AS avg_minutes_difference,
+  (percentile_cont(0.5) WITHIN GROUP (ORDER BY h.minutes_difference))::real AS median_minutes_difference,
+  min(h.minutes_difference)::int AS min_minutes_difference,
+  max(h.minutes_difference)::int AS max_minutes_difference,
+  count(h.id) FILTER (WHERE h.error_msg <> '') AS out_of_range_count,
+  max(h.timestamp)::timestamptz AS last_timestamp
+FROM observations_stationhealth h
+JOIN observations_station s ON s.id = h.station_id
+LEFT JOIN station_clock c ON c.station_id = s.id
+WHERE h.minutes_difference IS NOT NULL
+  AND s.deleted_at = '0001-01-01 00:00:00Z'
+  AND (CASE WHEN @is_start_date::bool THEN h.timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN h.timestamp <= @end_date ELSE TRUE END)
+GROUP BY s.id, s.name, c.timezone, c.clock_offset
+HAVING abs(percentile_cont(0.5) WITHIN GROUP (ORDER BY h.minutes_difference) - COALESCE(c.clock_offset, 0)) >= @min_drift::real
+ORDER BY abs(percentile_cont(0.5) WITHIN GROUP (ORDER BY h.minutes_difference) - COALESCE(c.clock_offset, 0)) DESC, s.id
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationClockDrift :one
+SELECT count(*) FROM (
+  SELECT h.station_id
+  FROM observations_stationhealth h
+  JOIN observations_station s ON s.id = h.station_id
+  LEFT JOIN station_clock c ON c.station_id = h.station_id
+  WHERE h.minutes_difference IS NOT NULL
+    AND s.deleted_at = '0001-01-01 00:00:00Z'
+    AND (CASE WHEN @is_start_date::bool THEN h.timestamp >= @start_date ELSE TRUE END)
+    AND (CASE WHEN @is_end_date::bool THEN h.timestamp <= @end_date ELSE TRUE END)
+  GROUP BY h.station_id, c.clock_offset
+  HAVING abs(percentile_cont(0.5) WITHIN GROUP (ORDER BY h.minutes_difference) - COALESCE(c.clock_offset, 0)) >= @min_drift::real
+) AS drift;
@@ -0,0 +1,54 @@
+-- name: CreateStationCommand :one
+INSERT INTO station_command (
+  station_id,
+  command,
+  interval
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetStationCommand :one
+SELECT * FROM station_command
+WHERE id = $1 LIMIT 1;
+
+-- name: GetOutstandingStationCommand :one
+SELECT * FROM station_command
+WHERE station_id = $1 AND command = $2 AND status = 'PENDING'
+ORDER BY id
+LIMIT 1;
+
+-- name: ListStationCommands :many
+SELECT c.*, o.status AS sms_status
+FROM station_command c
+LEFT JOIN sms_outbox o ON o.id = c.sms_outbox_id
+WHERE
+  c.station_id = sqlc.arg('station_id')
+  AND (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN c.status = sqlc.narg('status') ELSE TRUE END)
+ORDER BY c.id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationCommands :one
+SELECT count(*) FROM station_command
+WHERE
+  station_id = sqlc.arg('station_id')
+  AND (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END);
+
+-- name: UpdateStationCommandMessage :one
+UPDATE station_command
+SET
+  message = $1,
+  sms_outbox_id = $2,
+  updated_at = now()
+WHERE id = $3
+RETURNING *;
+
+-- name: AcknowledgeStationCommand :one
+UPDATE station_command
+SET
+  status = $1,
+  ack_message = $2,
+  acknowledged_at = now(),
+  updated_at = now()
+WHERE id = $3 AND status = 'PENDING'
+RETURNING *;
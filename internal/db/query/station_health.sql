@@ -25,12 +25,68 @@ INSERT INTO observations_stationhealth (
 SELECT * FROM observations_stationhealth
 WHERE station_id = $1 AND id = $2 LIMIT 1;
 
+-- name: ListStationBatteryDaily :many
+SELECT
+  h.station_id,
+  s.name,
+  (h.timestamp AT TIME ZONE COALESCE(c.timezone, 'Asia/Manila'))::date AS day,
+  min(h.vb1)::real AS vb1_min,
+  max(h.vb1)::real AS vb1_max,
+  max(h.bp1) AS bp1_max,
+  count(h.id) AS sample_count
+FROM observations_stationhealth h
+JOIN observations_station s ON s.id = h.station_id
+LEFT JOIN station_clock c ON c.station_id = s.id
+WHERE h.vb1 > 0
+  AND s.deleted_at = '0001-01-01 00:00:00Z'
+  AND h.timestamp >= @since
+  AND (sqlc.narg('station_id')::bigint IS NULL OR h.station_id = sqlc.narg('station_id'))
+GROUP BY h.station_id, s.name, day
+ORDER BY h.station_id, day;
+
 -- name: ListStationHealths :many
 SELECT * FROM observations_stationhealth
-WHERE station_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END)
+ORDER BY timestamp DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: GetStationHealthSummary :one
+SELECT
+  count(*) AS count,
+  min(timestamp) AS first_timestamp,
+  max(timestamp) AS last_timestamp,
+  min(vb1) AS vb1_min,
+  max(vb1) AS vb1_max,
+  avg(vb1) AS vb1_avg,
+  min(vb2) AS vb2_min,
+  max(vb2) AS vb2_max,
+  avg(vb2) AS vb2_avg,
+  min(curr) AS curr_min,
+  max(curr) AS curr_max,
+  avg(curr) AS curr_avg,
+  min(bp1) AS bp1_min,
+  max(bp1) AS bp1_max,
+  avg(bp1) AS bp1_avg,
+  min(bp2) AS bp2_min,
+  max(bp2) AS bp2_max,
+  avg(bp2) AS bp2_avg,
+  min(ss) AS ss_min,
+  max(ss) AS ss_max,
+  avg(ss::real) AS ss_avg,
+  min(temp_arq) AS temp_arq_min,
+  max(temp_arq) AS temp_arq_max,
+  avg(temp_arq) AS temp_arq_avg,
+  min(rh_arq) AS rh_arq_min,
+  max(rh_arq) AS rh_arq_max,
+  avg(rh_arq) AS rh_arq_avg,
+  COALESCE((array_agg(data_status ORDER BY timestamp DESC))[1], '')::text AS last_data_status
+FROM observations_stationhealth
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END);
 
 -- name: UpdateStationHealth :one
 UPDATE observations_stationhealth
@@ -0,0 +1,84 @@
+-- name: CreateStationMaintenance :one
+INSERT INTO station_maintenance (
+  station_id,
+  technician_id,
+  visit_date,
+  actions,
+  parts_replaced,
+  sensors_calibrated,
+  photos,
+  window_start,
+  window_end,
+  window_status
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: GetStationMaintenance :one
+SELECT * FROM station_maintenance
+WHERE station_id = $1 AND id = $2 LIMIT 1;
+
+-- name: ListStationMaintenance :many
+SELECT * FROM station_maintenance
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN visit_date >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN visit_date <= @end_date ELSE TRUE END)
+ORDER BY visit_date DESC, id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationMaintenance :one
+SELECT count(*) FROM station_maintenance
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN visit_date >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN visit_date <= @end_date ELSE TRUE END);
+
+-- name: UpdateStationMaintenance :one
+UPDATE station_maintenance
+SET
+  technician_id = COALESCE(sqlc.narg(technician_id), technician_id),
+  visit_date = COALESCE(sqlc.narg(visit_date), visit_date),
+  actions = COALESCE(sqlc.narg(actions), actions),
+  parts_replaced = COALESCE(sqlc.narg(parts_replaced), parts_replaced),
+  sensors_calibrated = COALESCE(sqlc.narg(sensors_calibrated), sensors_calibrated),
+  photos = COALESCE(sqlc.narg(photos), photos),
+  window_start = COALESCE(sqlc.narg(window_start), window_start),
+  window_end = COALESCE(sqlc.narg(window_end), window_end),
+  window_status = COALESCE(sqlc.narg(window_status), window_status),
+  updated_at = now()
+WHERE station_id = sqlc.arg(station_id) AND id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteStationMaintenance :exec
+DELETE FROM station_maintenance WHERE station_id = $1 AND id = $2;
+
+-- name: ListStationMaintenanceWindowsToOpen :many
+SELECT * FROM station_maintenance
+WHERE window_status = 'PLANNED'
+  AND window_start <= @now::timestamptz
+  AND window_end > @now::timestamptz
+ORDER BY window_start;
+
+-- name: ListStationMaintenanceWindowsToClose :many
+SELECT * FROM station_maintenance
+WHERE window_status IN ('PLANNED', 'OPEN')
+  AND window_end <= @now::timestamptz
+ORDER BY window_end;
+
+-- name: UpdateStationMaintenanceWindowStatus :exec
+UPDATE station_maintenance
+SET
+  window_status = $2,
+  updated_at = now()
+WHERE id = $1;
+
+-- name: CountOpenStationMaintenanceWindows :one
+SELECT count(*) FROM station_maintenance
+WHERE station_id = $1 AND window_status = 'OPEN';
+
+-- name: ListStationMaintenanceWindowsInRange :many
+SELECT * FROM station_maintenance
+WHERE station_id = ANY(@station_ids::bigint[])
+  AND window_start <= @end_date::timestamptz
+  AND window_end > @start_date::timestamptz
+ORDER BY station_id, window_start;
@@ -0,0 +1,53 @@
+-- name: CreateStationMetadataHistory :one
+INSERT INTO station_metadata_history (
+  station_id,
+  lat,
+  lon,
+  elevation,
+  station_type,
+  station_type2,
+  logger_version,
+  logger_serial,
+  temp_height,
+  temp_serial,
+  wind_height,
+  wind_serial,
+  rain_height,
+  rain_serial,
+  pres_height,
+  pres_serial,
+  reason,
+  effective_from
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+) RETURNING *;
+
+-- name: GetCurrentStationMetadataForUpdate :one
+SELECT * FROM station_metadata_history
+WHERE station_id = $1 AND effective_to IS NULL
+LIMIT 1
+FOR UPDATE;
+
+-- name: CloseStationMetadataHistory :exec
+UPDATE station_metadata_history
+SET
+  effective_to = sqlc.arg(effective_to)
+WHERE id = sqlc.arg(id);
+
+-- name: ListStationMetadataInRange :many
+SELECT * FROM station_metadata_history
+WHERE station_id = ANY(@station_ids::bigint[])
+  AND effective_from <= @end_date::timestamptz
+  AND (effective_to IS NULL OR effective_to > @start_date::timestamptz)
+ORDER BY station_id, effective_from;
+
+-- name: ListStationMetadataHistory :many
+SELECT * FROM station_metadata_history
+WHERE station_id = @station_id
+ORDER BY effective_from DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationMetadataHistory :one
+SELECT count(*) FROM station_metadata_history
+WHERE station_id = @station_id;
@@ -0,0 +1,25 @@
+-- name: CreateStationStatusHistory :one
+INSERT INTO station_status_history (
+  station_id,
+  from_status,
+  to_status,
+  reason,
+  last_observed_at
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListStationStatusHistory :many
+SELECT * FROM station_status_history
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN created_at >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN created_at <= @end_date ELSE TRUE END)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationStatusHistory :one
+SELECT count(*) FROM station_status_history
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN created_at >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN created_at <= @end_date ELSE TRUE END);
@@ -0,0 +1,24 @@
+-- name: CreateUploadStation :one
+INSERT INTO upload_station (
+  station_id,
+  protocol,
+  upload_id,
+  upload_key
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetUploadStation :one
+SELECT u.* FROM upload_station u
+JOIN observations_station s ON s.id = u.station_id
+WHERE u.protocol = $1 AND u.upload_id = $2
+  AND s.deleted_at = '0001-01-01 00:00:00Z'
+LIMIT 1;
+
+-- name: ListUploadStations :many
+SELECT * FROM upload_station
+WHERE station_id = $1
+ORDER BY id;
+
+-- name: DeleteUploadStation :exec
+DELETE FROM upload_station WHERE station_id = $1 AND id = $2;
@@ -3,15 +3,61 @@ INSERT INTO weatherlink (
   station_id,
   uuid,
   api_key,
-  api_secret
+  api_secret,
+  v1_user,
+  v1_pass,
+  v1_api_token
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5, $6, $7
 ) RETURNING *;
 
+-- name: GetWeatherlinkStation :one
+SELECT * FROM weatherlink
+WHERE station_id = $1 LIMIT 1;
+
 -- name: ListWeatherlinkStations :many
 SELECT * FROM weatherlink
 WHERE
-  uuid IS NOT NULL OR (api_key IS NOT NULL AND api_secret IS NOT NULL)
+  (
+    uuid IS NOT NULL
+    OR (api_key IS NOT NULL AND api_secret IS NOT NULL)
+    OR (v1_user IS NOT NULL AND v1_pass IS NOT NULL AND v1_api_token IS NOT NULL)
+  )
+  AND station_id IN (SELECT id FROM observations_station WHERE deleted_at = '0001-01-01 00:00:00Z')
 ORDER BY id
 LIMIT sqlc.narg('limit')
 OFFSET sqlc.arg('offset');
+
+-- name: GetWeatherlinkStationByWLID :one
+SELECT * FROM weatherlink
+WHERE api_key = $1 AND wl_station_id = $2 LIMIT 1;
+
+-- name: UpdateWeatherlinkStation :one
+UPDATE weatherlink
+SET
+  uuid = COALESCE(sqlc.narg(uuid), uuid),
+  api_key = COALESCE(sqlc.narg(api_key), api_key),
+  api_secret = COALESCE(sqlc.narg(api_secret), api_secret),
+  v1_user = COALESCE(sqlc.narg(v1_user), v1_user),
+  v1_pass = COALESCE(sqlc.narg(v1_pass), v1_pass),
+  v1_api_token = COALESCE(sqlc.narg(v1_api_token), v1_api_token),
+  updated_at = now()
+WHERE station_id = sqlc.arg(station_id)
+RETURNING *;
+
+-- name: UpdateWeatherlinkStationMetadata :one
+UPDATE weatherlink
+SET
+  wl_station_id = @wl_station_id,
+  lat = @lat,
+  lon = @lon,
+  elevation = @elevation,
+  firmware_version = @firmware_version,
+  recording_interval = @recording_interval,
+  synced_at = now(),
+  updated_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteWeatherlinkStation :exec
+DELETE FROM weatherlink WHERE station_id = $1;
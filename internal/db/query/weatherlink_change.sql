@@ -0,0 +1,34 @@
+-- name: CreateWeatherlinkChange :one
+INSERT INTO weatherlink_change (
+  station_id,
+  field,
+  old_value,
+  new_value
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetWeatherlinkChange :one
+SELECT * FROM weatherlink_change
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWeatherlinkChanges :many
+SELECT * FROM weatherlink_change
+WHERE
+  (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END)
+ORDER BY id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountWeatherlinkChanges :one
+SELECT count(*) FROM weatherlink_change
+WHERE (CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status') ELSE TRUE END);
+
+-- name: UpdateWeatherlinkChangeStatus :one
+UPDATE weatherlink_change
+SET
+  status = @status,
+  reviewed_at = now(),
+  updated_at = now()
+WHERE id = @id
+RETURNING *;
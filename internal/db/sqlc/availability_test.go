@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type AvailabilityTestSuite struct {
+	suite.Suite
+}
+
+func TestAvailabilityTestSuite(t *testing.T) {
+	suite.Run(t, new(AvailabilityTestSuite))
+}
+
+func (ts *AvailabilityTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *AvailabilityTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *AvailabilityTestSuite) TestListStationDailyRecordCounts() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	n := 3
+	for range n {
+		createRandomObservation(t, station.ID)
+	}
+	createRandomMOObservation(t, station.ID)
+
+	records, err := testStore.ListStationDailyRecordCounts(context.Background(), ListStationDailyRecordCountsParams{
+		StartDate: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, records)
+
+	var total int64
+	for _, r := range records {
+		require.Equal(t, station.ID, r.StationID)
+		require.True(t, r.Day.Valid)
+		total += r.RecordCount
+	}
+	require.Equal(t, int64(n+1), total)
+}
+
+func (ts *AvailabilityTestSuite) TestListStationDailyDataStatus() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	n := 2
+	for range n {
+		createRandomStationHealth(t, station.ID)
+	}
+
+	rows, err := testStore.ListStationDailyDataStatus(context.Background(), ListStationDailyDataStatusParams{
+		StartDate: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, rows)
+
+	var total int64
+	for _, r := range rows {
+		require.Equal(t, station.ID, r.StationID)
+		require.Empty(t, r.DataStatus)
+		total += r.RecordCount
+	}
+	require.Equal(t, int64(n), total)
+}
@@ -14,6 +14,10 @@ const (
 
 var ErrRecordNotFound = pgx.ErrNoRows
 
+var ErrInvalidStatusTransition = errors.New("invalid station status transition")
+
+var ErrInvalidEffectiveFrom = errors.New("effective_from must be after the start of the current station metadata")
+
 var ErrUniqueViolation = &pgconn.PgError{
 	Code: UniqueViolation,
 }
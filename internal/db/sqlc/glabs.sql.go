@@ -11,6 +11,38 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const completeGLabsLoad = `-- name: CompleteGLabsLoad :one
+UPDATE glabs_load
+SET
+  status = $1,
+  callback_at = now(),
+  updated_at = now()
+WHERE transaction_id = $2 AND mobile_number = $3 AND callback_at IS NULL
+RETURNING id, status, promo, transaction_id, mobile_number, created_at, updated_at, callback_at
+`
+
+type CompleteGLabsLoadParams struct {
+	Status        pgtype.Text `json:"status"`
+	TransactionID pgtype.Int4 `json:"transaction_id"`
+	MobileNumber  string      `json:"mobile_number"`
+}
+
+func (q *Queries) CompleteGLabsLoad(ctx context.Context, arg CompleteGLabsLoadParams) (GlabsLoad, error) {
+	row := q.db.QueryRow(ctx, completeGLabsLoad, arg.Status, arg.TransactionID, arg.MobileNumber)
+	var i GlabsLoad
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Promo,
+		&i.TransactionID,
+		&i.MobileNumber,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CallbackAt,
+	)
+	return i, err
+}
+
 const createGLabsLoad = `-- name: CreateGLabsLoad :one
 INSERT INTO glabs_load (
   promo,
@@ -19,7 +51,7 @@ INSERT INTO glabs_load (
   mobile_number
 ) VALUES (
   $1, $2, $3, $4
-) RETURNING id, status, promo, transaction_id, mobile_number, created_at, updated_at
+) RETURNING id, status, promo, transaction_id, mobile_number, created_at, updated_at, callback_at
 `
 
 type CreateGLabsLoadParams struct {
@@ -45,6 +77,48 @@ func (q *Queries) CreateGLabsLoad(ctx context.Context, arg CreateGLabsLoadParams
 		&i.MobileNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CallbackAt,
+	)
+	return i, err
+}
+
+const createGLabsLoadCallback = `-- name: CreateGLabsLoadCallback :one
+INSERT INTO glabs_load (
+  promo,
+  transaction_id,
+  status,
+  mobile_number,
+  callback_at,
+  updated_at
+) VALUES (
+  $1, $2, $3, $4, now(), now()
+) RETURNING id, status, promo, transaction_id, mobile_number, created_at, updated_at, callback_at
+`
+
+type CreateGLabsLoadCallbackParams struct {
+	Promo         pgtype.Text `json:"promo"`
+	TransactionID pgtype.Int4 `json:"transaction_id"`
+	Status        pgtype.Text `json:"status"`
+	MobileNumber  string      `json:"mobile_number"`
+}
+
+func (q *Queries) CreateGLabsLoadCallback(ctx context.Context, arg CreateGLabsLoadCallbackParams) (GlabsLoad, error) {
+	row := q.db.QueryRow(ctx, createGLabsLoadCallback,
+		arg.Promo,
+		arg.TransactionID,
+		arg.Status,
+		arg.MobileNumber,
+	)
+	var i GlabsLoad
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Promo,
+		&i.TransactionID,
+		&i.MobileNumber,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CallbackAt,
 	)
 	return i, err
 }
@@ -34,6 +34,41 @@ func (ts *GLabsTestSuite) TestCreateGLabsLoad() {
 	createRandomGlabsLoad(t, station.MobileNumber.String)
 }
 
+func (ts *GLabsTestSuite) TestCreateGLabsLoadCallback() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	arg := CreateGLabsLoadCallbackParams{
+		Promo: pgtype.Text{
+			String: util.RandomString(10),
+			Valid:  true,
+		},
+		TransactionID: pgtype.Int4{
+			Int32: int32(util.RandomInt(1000000, 9999999)),
+			Valid: true,
+		},
+		Status: pgtype.Text{
+			String: util.RandomString(10),
+			Valid:  true,
+		},
+		MobileNumber: station.MobileNumber.String,
+	}
+
+	gLabsLoad, err := testStore.CreateGLabsLoadCallback(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.TransactionID, gLabsLoad.TransactionID)
+	require.Equal(t, arg.Status, gLabsLoad.Status)
+	require.True(t, gLabsLoad.CallbackAt.Valid)
+
+	// a recorded callback is not completed again
+	_, err = testStore.CompleteGLabsLoad(context.Background(), CompleteGLabsLoadParams{
+		Status:        arg.Status,
+		TransactionID: arg.TransactionID,
+		MobileNumber:  arg.MobileNumber,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
 func createRandomGlabsLoad(t *testing.T, mobileNumber string) GlabsLoad {
 	arg := CreateGLabsLoadParams{
 		Promo: pgtype.Text{
@@ -7,26 +7,48 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countMisolStations = `-- name: CountMisolStations :one
+SELECT count(*) FROM misol_station
+WHERE (CASE WHEN $1::text IS NOT NULL THEN status = $1 ELSE TRUE END)
+`
+
+func (q *Queries) CountMisolStations(ctx context.Context, status pgtype.Text) (int64, error) {
+	row := q.db.QueryRow(ctx, countMisolStations, status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createMisolStation = `-- name: CreateMisolStation :one
 INSERT INTO misol_station (
   id,
-  station_id
+  station_id,
+  status
 ) VALUES (
-  $1, $2
-) RETURNING id, station_id
+  $1, $2, COALESCE($3, 'APPROVED')
+) RETURNING id, station_id, status, created_at, updated_at
 `
 
 type CreateMisolStationParams struct {
-	ID        int64 `json:"id"`
-	StationID int64 `json:"station_id"`
+	ID        int64       `json:"id"`
+	StationID int64       `json:"station_id"`
+	Status    pgtype.Text `json:"status"`
 }
 
 func (q *Queries) CreateMisolStation(ctx context.Context, arg CreateMisolStationParams) (MisolStation, error) {
-	row := q.db.QueryRow(ctx, createMisolStation, arg.ID, arg.StationID)
+	row := q.db.QueryRow(ctx, createMisolStation, arg.ID, arg.StationID, arg.Status)
 	var i MisolStation
-	err := row.Scan(&i.ID, &i.StationID)
+	err := row.Scan(
+		&i.ID,
+		&i.StationID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
 	return i, err
 }
 
@@ -40,13 +62,89 @@ func (q *Queries) DeleteMisolStation(ctx context.Context, id int64) error {
 }
 
 const getMisolStation = `-- name: GetMisolStation :one
-SELECT id, station_id FROM misol_station
+SELECT id, station_id, status, created_at, updated_at FROM misol_station
 WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetMisolStation(ctx context.Context, id int64) (MisolStation, error) {
 	row := q.db.QueryRow(ctx, getMisolStation, id)
 	var i MisolStation
-	err := row.Scan(&i.ID, &i.StationID)
+	err := row.Scan(
+		&i.ID,
+		&i.StationID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listMisolStations = `-- name: ListMisolStations :many
+SELECT id, station_id, status, created_at, updated_at FROM misol_station
+WHERE
+  (CASE WHEN $1::text IS NOT NULL THEN status = $1 ELSE TRUE END)
+ORDER BY id
+LIMIT $3
+OFFSET $2
+`
+
+type ListMisolStationsParams struct {
+	Status pgtype.Text `json:"status"`
+	Offset int32       `json:"offset"`
+	Limit  pgtype.Int4 `json:"limit"`
+}
+
+func (q *Queries) ListMisolStations(ctx context.Context, arg ListMisolStationsParams) ([]MisolStation, error) {
+	rows, err := q.db.Query(ctx, listMisolStations, arg.Status, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MisolStation{}
+	for rows.Next() {
+		var i MisolStation
+		if err := rows.Scan(
+			&i.ID,
+			&i.StationID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateMisolStation = `-- name: UpdateMisolStation :one
+UPDATE misol_station
+SET
+  station_id = COALESCE($1, station_id),
+  status = COALESCE($2, status),
+  updated_at = now()
+WHERE id = $3
+RETURNING id, station_id, status, created_at, updated_at
+`
+
+type UpdateMisolStationParams struct {
+	StationID pgtype.Int8 `json:"station_id"`
+	Status    pgtype.Text `json:"status"`
+	ID        int64       `json:"id"`
+}
+
+func (q *Queries) UpdateMisolStation(ctx context.Context, arg UpdateMisolStationParams) (MisolStation, error) {
+	row := q.db.QueryRow(ctx, updateMisolStation, arg.StationID, arg.Status, arg.ID)
+	var i MisolStation
+	err := row.Scan(
+		&i.ID,
+		&i.StationID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
 	return i, err
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -44,6 +45,65 @@ func (ts *MisolStationTestSuite) TestGetStation() {
 	require.Equal(t, stn.StationID, gotStn.StationID)
 }
 
+func (ts *MisolStationTestSuite) TestListStations() {
+	t := ts.T()
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomMisolStation(t)
+	}
+	stn := createRandomStation(t, false)
+	_, err := testStore.CreateMisolStation(context.Background(), CreateMisolStationParams{
+		ID:        util.RandomInt[int64](1001, 2000),
+		StationID: stn.ID,
+		Status:    pgtype.Text{String: "PENDING", Valid: true},
+	})
+	require.NoError(t, err)
+
+	gotStns, err := testStore.ListMisolStations(context.Background(), ListMisolStationsParams{
+		Limit:  pgtype.Int4{Int32: 3, Valid: true},
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, gotStns, 3)
+
+	pending := pgtype.Text{String: "PENDING", Valid: true}
+	gotStns, err = testStore.ListMisolStations(context.Background(), ListMisolStationsParams{Status: pending})
+	require.NoError(t, err)
+	require.Len(t, gotStns, 1)
+	require.Equal(t, stn.ID, gotStns[0].StationID)
+
+	count, err := testStore.CountMisolStations(context.Background(), pending)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	count, err = testStore.CountMisolStations(context.Background(), pgtype.Text{})
+	require.NoError(t, err)
+	require.Equal(t, int64(n+1), count)
+}
+
+func (ts *MisolStationTestSuite) TestUpdateStation() {
+	t := ts.T()
+	mStn := createRandomMisolStation(t)
+	stn := createRandomStation(t, false)
+
+	gotStn, err := testStore.UpdateMisolStation(context.Background(), UpdateMisolStationParams{
+		ID:        mStn.ID,
+		StationID: pgtype.Int8{Int64: stn.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, stn.ID, gotStn.StationID)
+	require.Equal(t, mStn.Status, gotStn.Status)
+	require.True(t, gotStn.UpdatedAt.Time.After(mStn.UpdatedAt.Time))
+
+	gotStn, err = testStore.UpdateMisolStation(context.Background(), UpdateMisolStationParams{
+		ID:     mStn.ID,
+		Status: pgtype.Text{String: "PENDING", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, stn.ID, gotStn.StationID)
+	require.Equal(t, "PENDING", gotStn.Status)
+}
+
 func (ts *MisolStationTestSuite) TestDeleteStation() {
 	t := ts.T()
 	stn := createRandomMisolStation(t)
@@ -70,6 +130,7 @@ func createRandomMisolStation(t *testing.T) MisolStation {
 
 	require.Equal(t, arg.StationID, mStn.StationID)
 	require.Equal(t, arg.ID, mStn.ID)
+	require.Equal(t, "APPROVED", mStn.Status)
 
 	return mStn
 }
@@ -80,29 +80,63 @@ INSERT INTO observations_mo_observation (
   srad,
   hi,
   wchill,
+  rain,
+  tx,
+  tn,
+  tx_timestamp,
+  tn_timestamp,
+  wrun,
+  thwi,
+  thswi,
+  senergy,
+  sradx,
+  uvi,
+  uvdose,
+  uvx,
+  hdd,
+  cdd,
+  et,
+  wdirx,
   timestamp,
   qc_level,
   station_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
-) RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31
+) RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at, tx_timestamp, tn_timestamp
 `
 
 type CreateStationMOObservationParams struct {
-	Pres      pgtype.Float4      `json:"pres"`
-	Rr        pgtype.Float4      `json:"rr"`
-	Rh        pgtype.Float4      `json:"rh"`
-	Temp      pgtype.Float4      `json:"temp"`
-	Td        pgtype.Float4      `json:"td"`
-	Wdir      pgtype.Float4      `json:"wdir"`
-	Wspd      pgtype.Float4      `json:"wspd"`
-	Wspdx     pgtype.Float4      `json:"wspdx"`
-	Srad      pgtype.Float4      `json:"srad"`
-	Hi        pgtype.Float4      `json:"hi"`
-	Wchill    pgtype.Float4      `json:"wchill"`
-	Timestamp pgtype.Timestamptz `json:"timestamp"`
-	QcLevel   int32              `json:"qc_level"`
-	StationID int64              `json:"station_id"`
+	Pres        pgtype.Float4      `json:"pres"`
+	Rr          pgtype.Float4      `json:"rr"`
+	Rh          pgtype.Float4      `json:"rh"`
+	Temp        pgtype.Float4      `json:"temp"`
+	Td          pgtype.Float4      `json:"td"`
+	Wdir        pgtype.Float4      `json:"wdir"`
+	Wspd        pgtype.Float4      `json:"wspd"`
+	Wspdx       pgtype.Float4      `json:"wspdx"`
+	Srad        pgtype.Float4      `json:"srad"`
+	Hi          pgtype.Float4      `json:"hi"`
+	Wchill      pgtype.Float4      `json:"wchill"`
+	Rain        pgtype.Float4      `json:"rain"`
+	Tx          pgtype.Float4      `json:"tx"`
+	Tn          pgtype.Float4      `json:"tn"`
+	TxTimestamp pgtype.Timestamptz `json:"tx_timestamp"`
+	TnTimestamp pgtype.Timestamptz `json:"tn_timestamp"`
+	Wrun        pgtype.Float4      `json:"wrun"`
+	Thwi        pgtype.Float4      `json:"thwi"`
+	Thswi       pgtype.Float4      `json:"thswi"`
+	Senergy     pgtype.Float4      `json:"senergy"`
+	Sradx       pgtype.Float4      `json:"sradx"`
+	Uvi         pgtype.Float4      `json:"uvi"`
+	Uvdose      pgtype.Float4      `json:"uvdose"`
+	Uvx         pgtype.Float4      `json:"uvx"`
+	Hdd         pgtype.Float4      `json:"hdd"`
+	Cdd         pgtype.Float4      `json:"cdd"`
+	Et          pgtype.Float4      `json:"et"`
+	Wdirx       pgtype.Float4      `json:"wdirx"`
+	Timestamp   pgtype.Timestamptz `json:"timestamp"`
+	QcLevel     int32              `json:"qc_level"`
+	StationID   int64              `json:"station_id"`
 }
 
 func (q *Queries) CreateStationMOObservation(ctx context.Context, arg CreateStationMOObservationParams) (ObservationsMoObservation, error) {
@@ -118,6 +152,23 @@ func (q *Queries) CreateStationMOObservation(ctx context.Context, arg CreateStat
 		arg.Srad,
 		arg.Hi,
 		arg.Wchill,
+		arg.Rain,
+		arg.Tx,
+		arg.Tn,
+		arg.TxTimestamp,
+		arg.TnTimestamp,
+		arg.Wrun,
+		arg.Thwi,
+		arg.Thswi,
+		arg.Senergy,
+		arg.Sradx,
+		arg.Uvi,
+		arg.Uvdose,
+		arg.Uvx,
+		arg.Hdd,
+		arg.Cdd,
+		arg.Et,
+		arg.Wdirx,
 		arg.Timestamp,
 		arg.QcLevel,
 		arg.StationID,
@@ -156,6 +207,8 @@ func (q *Queries) CreateStationMOObservation(ctx context.Context, arg CreateStat
 		&i.Wdirx,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TxTimestamp,
+		&i.TnTimestamp,
 	)
 	return i, err
 }
@@ -175,7 +228,7 @@ func (q *Queries) DeleteStationMOObservation(ctx context.Context, arg DeleteStat
 }
 
 const getStationMOObservation = `-- name: GetStationMOObservation :one
-SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at FROM observations_mo_observation
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at, tx_timestamp, tn_timestamp FROM observations_mo_observation
 WHERE station_id = $1 AND id = $2 LIMIT 1
 `
 
@@ -220,12 +273,14 @@ func (q *Queries) GetStationMOObservation(ctx context.Context, arg GetStationMOO
 		&i.Wdirx,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TxTimestamp,
+		&i.TnTimestamp,
 	)
 	return i, err
 }
 
 const listMOObservations = `-- name: ListMOObservations :many
-SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at FROM observations_mo_observation
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at, tx_timestamp, tn_timestamp FROM observations_mo_observation
 WHERE station_id = ANY($1::bigint[])
   AND (CASE WHEN $2::bool THEN timestamp >= $3 ELSE TRUE END)
   AND (CASE WHEN $4::bool THEN timestamp <= $5 ELSE TRUE END)
@@ -294,6 +349,8 @@ func (q *Queries) ListMOObservations(ctx context.Context, arg ListMOObservations
 			&i.Wdirx,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TxTimestamp,
+			&i.TnTimestamp,
 		); err != nil {
 			return nil, err
 		}
@@ -305,8 +362,42 @@ func (q *Queries) ListMOObservations(ctx context.Context, arg ListMOObservations
 	return items, nil
 }
 
+const listStationMOObservationTimestamps = `-- name: ListStationMOObservationTimestamps :many
+SELECT timestamp FROM observations_mo_observation
+WHERE station_id = $1
+  AND timestamp >= $2
+  AND timestamp <= $3
+ORDER BY timestamp
+`
+
+type ListStationMOObservationTimestampsParams struct {
+	StationID int64              `json:"station_id"`
+	StartDate pgtype.Timestamptz `json:"start_date"`
+	EndDate   pgtype.Timestamptz `json:"end_date"`
+}
+
+func (q *Queries) ListStationMOObservationTimestamps(ctx context.Context, arg ListStationMOObservationTimestampsParams) ([]pgtype.Timestamptz, error) {
+	rows, err := q.db.Query(ctx, listStationMOObservationTimestamps, arg.StationID, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Timestamptz{}
+	for rows.Next() {
+		var timestamp pgtype.Timestamptz
+		if err := rows.Scan(&timestamp); err != nil {
+			return nil, err
+		}
+		items = append(items, timestamp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStationMOObservations = `-- name: ListStationMOObservations :many
-SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at FROM observations_mo_observation
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at, tx_timestamp, tn_timestamp FROM observations_mo_observation
 WHERE station_id = $1
   AND (CASE WHEN $2::bool THEN timestamp >= $3 ELSE TRUE END)
   AND (CASE WHEN $4::bool THEN timestamp <= $5 ELSE TRUE END)
@@ -375,6 +466,8 @@ func (q *Queries) ListStationMOObservations(ctx context.Context, arg ListStation
 			&i.Wdirx,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TxTimestamp,
+			&i.TnTimestamp,
 		); err != nil {
 			return nil, err
 		}
@@ -404,7 +497,7 @@ SET
   qc_level = COALESCE($13, qc_level),
   updated_at = now()
 WHERE station_id = $14 AND id = $15
-RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at
+RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, hi, station_id, timestamp, wchill, rain, tx, tn, wrun, thwi, thswi, senergy, sradx, uvi, uvdose, uvx, hdd, cdd, et, qc_level, wdirx, created_at, updated_at, tx_timestamp, tn_timestamp
 `
 
 type UpdateStationMOObservationParams struct {
@@ -477,6 +570,8 @@ func (q *Queries) UpdateStationMOObservation(ctx context.Context, arg UpdateStat
 		&i.Wdirx,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TxTimestamp,
+		&i.TnTimestamp,
 	)
 	return i, err
 }
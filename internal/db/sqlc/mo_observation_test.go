@@ -190,6 +190,40 @@ func (ts *MOObservationTestSuite) TestListStationMOObservations() {
 	}
 }
 
+func (ts *MOObservationTestSuite) TestListStationMOObservationTimestamps() {
+	t := ts.T()
+	n := 5
+	station := createRandomStation(t, false)
+	obsSlice := make([]ObservationsMoObservation, n)
+	for j := range obsSlice {
+		obsSlice[j] = createRandomMOObservation(t, station.ID)
+	}
+
+	timeNow := time.Now()
+	arg := ListStationMOObservationTimestampsParams{
+		StationID: station.ID,
+		StartDate: pgtype.Timestamptz{
+			Time:  timeNow.Add(-time.Hour),
+			Valid: true,
+		},
+		EndDate: pgtype.Timestamptz{
+			Time:  timeNow,
+			Valid: true,
+		},
+	}
+	timestamps, err := testStore.ListStationMOObservationTimestamps(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, timestamps, n)
+	for j := range timestamps {
+		require.WithinDuration(t, obsSlice[j].Timestamp.Time, timestamps[j].Time, time.Millisecond)
+	}
+
+	arg.EndDate.Time = timeNow.Add(-30 * time.Minute)
+	timestamps, err = testStore.ListStationMOObservationTimestamps(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, timestamps)
+}
+
 func (ts *MOObservationTestSuite) TestCountStationMOObservations() {
 	t := ts.T()
 	timeNow := time.Now()
@@ -18,11 +18,15 @@ type GlabsLoad struct {
 	MobileNumber  string             `json:"mobile_number"`
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	CallbackAt    pgtype.Timestamptz `json:"callback_at"`
 }
 
 type MisolStation struct {
-	ID        int64 `json:"id"`
-	StationID int64 `json:"station_id"`
+	ID        int64              `json:"id"`
+	StationID int64              `json:"station_id"`
+	Status    string             `json:"status"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
 type ObservationsCurrent struct {
@@ -46,38 +50,40 @@ type ObservationsCurrent struct {
 }
 
 type ObservationsMoObservation struct {
-	ID        int64              `json:"id"`
-	Pres      pgtype.Float4      `json:"pres"`
-	Rr        pgtype.Float4      `json:"rr"`
-	Rh        pgtype.Float4      `json:"rh"`
-	Temp      pgtype.Float4      `json:"temp"`
-	Td        pgtype.Float4      `json:"td"`
-	Wdir      pgtype.Float4      `json:"wdir"`
-	Wspd      pgtype.Float4      `json:"wspd"`
-	Wspdx     pgtype.Float4      `json:"wspdx"`
-	Srad      pgtype.Float4      `json:"srad"`
-	Hi        pgtype.Float4      `json:"hi"`
-	StationID int64              `json:"station_id"`
-	Timestamp pgtype.Timestamptz `json:"timestamp"`
-	Wchill    pgtype.Float4      `json:"wchill"`
-	Rain      pgtype.Float4      `json:"rain"`
-	Tx        pgtype.Float4      `json:"tx"`
-	Tn        pgtype.Float4      `json:"tn"`
-	Wrun      pgtype.Float4      `json:"wrun"`
-	Thwi      pgtype.Float4      `json:"thwi"`
-	Thswi     pgtype.Float4      `json:"thswi"`
-	Senergy   pgtype.Float4      `json:"senergy"`
-	Sradx     pgtype.Float4      `json:"sradx"`
-	Uvi       pgtype.Float4      `json:"uvi"`
-	Uvdose    pgtype.Float4      `json:"uvdose"`
-	Uvx       pgtype.Float4      `json:"uvx"`
-	Hdd       pgtype.Float4      `json:"hdd"`
-	Cdd       pgtype.Float4      `json:"cdd"`
-	Et        pgtype.Float4      `json:"et"`
-	QcLevel   int32              `json:"qc_level"`
-	Wdirx     pgtype.Float4      `json:"wdirx"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ID          int64              `json:"id"`
+	Pres        pgtype.Float4      `json:"pres"`
+	Rr          pgtype.Float4      `json:"rr"`
+	Rh          pgtype.Float4      `json:"rh"`
+	Temp        pgtype.Float4      `json:"temp"`
+	Td          pgtype.Float4      `json:"td"`
+	Wdir        pgtype.Float4      `json:"wdir"`
+	Wspd        pgtype.Float4      `json:"wspd"`
+	Wspdx       pgtype.Float4      `json:"wspdx"`
+	Srad        pgtype.Float4      `json:"srad"`
+	Hi          pgtype.Float4      `json:"hi"`
+	StationID   int64              `json:"station_id"`
+	Timestamp   pgtype.Timestamptz `json:"timestamp"`
+	Wchill      pgtype.Float4      `json:"wchill"`
+	Rain        pgtype.Float4      `json:"rain"`
+	Tx          pgtype.Float4      `json:"tx"`
+	Tn          pgtype.Float4      `json:"tn"`
+	Wrun        pgtype.Float4      `json:"wrun"`
+	Thwi        pgtype.Float4      `json:"thwi"`
+	Thswi       pgtype.Float4      `json:"thswi"`
+	Senergy     pgtype.Float4      `json:"senergy"`
+	Sradx       pgtype.Float4      `json:"sradx"`
+	Uvi         pgtype.Float4      `json:"uvi"`
+	Uvdose      pgtype.Float4      `json:"uvdose"`
+	Uvx         pgtype.Float4      `json:"uvx"`
+	Hdd         pgtype.Float4      `json:"hdd"`
+	Cdd         pgtype.Float4      `json:"cdd"`
+	Et          pgtype.Float4      `json:"et"`
+	QcLevel     int32              `json:"qc_level"`
+	Wdirx       pgtype.Float4      `json:"wdirx"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	TxTimestamp pgtype.Timestamptz `json:"tx_timestamp"`
+	TnTimestamp pgtype.Timestamptz `json:"tn_timestamp"`
 }
 
 type ObservationsObservation struct {
@@ -101,32 +107,34 @@ type ObservationsObservation struct {
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
 	RainTips           pgtype.Int4        `json:"rain_tips"`
 	RainCumulativeTips pgtype.Int4        `json:"rain_cumulative_tips"`
+	RainAccum          pgtype.Float4      `json:"rain_accum"`
 }
 
 type ObservationsStation struct {
-	ID            int64              `json:"id"`
-	Name          string             `json:"name"`
-	Lat           pgtype.Float4      `json:"lat"`
-	Lon           pgtype.Float4      `json:"lon"`
-	Elevation     pgtype.Float4      `json:"elevation"`
-	DateInstalled pgtype.Date        `json:"date_installed"`
-	MoStationID   pgtype.Text        `json:"mo_station_id"`
-	SmsSystemType pgtype.Text        `json:"sms_system_type"`
-	MobileNumber  pgtype.Text        `json:"mobile_number"`
-	StationType   pgtype.Text        `json:"station_type"`
-	StationType2  pgtype.Text        `json:"station_type2"`
-	StationUrl    pgtype.Text        `json:"station_url"`
-	Status        pgtype.Text        `json:"status"`
-	LoggerVersion pgtype.Text        `json:"logger_version"`
-	PriorityLevel pgtype.Text        `json:"priority_level"`
-	ProviderID    pgtype.Text        `json:"provider_id"`
-	Province      pgtype.Text        `json:"province"`
-	Region        pgtype.Text        `json:"region"`
-	Address       pgtype.Text        `json:"address"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
-	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
-	Geom          util.Point         `json:"geom"`
+	ID                int64              `json:"id"`
+	Name              string             `json:"name"`
+	Lat               pgtype.Float4      `json:"lat"`
+	Lon               pgtype.Float4      `json:"lon"`
+	Elevation         pgtype.Float4      `json:"elevation"`
+	DateInstalled     pgtype.Date        `json:"date_installed"`
+	MoStationID       pgtype.Text        `json:"mo_station_id"`
+	SmsSystemType     pgtype.Text        `json:"sms_system_type"`
+	MobileNumber      pgtype.Text        `json:"mobile_number"`
+	StationType       pgtype.Text        `json:"station_type"`
+	StationType2      pgtype.Text        `json:"station_type2"`
+	StationUrl        pgtype.Text        `json:"station_url"`
+	Status            pgtype.Text        `json:"status"`
+	LoggerVersion     pgtype.Text        `json:"logger_version"`
+	PriorityLevel     pgtype.Text        `json:"priority_level"`
+	ProviderID        pgtype.Text        `json:"provider_id"`
+	Province          pgtype.Text        `json:"province"`
+	Region            pgtype.Text        `json:"region"`
+	Address           pgtype.Text        `json:"address"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt         pgtype.Timestamptz `json:"deleted_at"`
+	Geom              util.Point         `json:"geom"`
+	ReportingInterval pgtype.Int4        `json:"reporting_interval"`
 }
 
 type ObservationsStationhealth struct {
@@ -152,6 +160,21 @@ type ObservationsStationhealth struct {
 	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
 }
 
+type PendingStation struct {
+	ID           int64              `json:"id"`
+	MobileNumber string             `json:"mobile_number"`
+	MessageCount int32              `json:"message_count"`
+	FirstSeenAt  pgtype.Timestamptz `json:"first_seen_at"`
+	LastSeenAt   pgtype.Timestamptz `json:"last_seen_at"`
+}
+
+type PendingStationMessage struct {
+	ID               int64              `json:"id"`
+	PendingStationID int64              `json:"pending_station_id"`
+	Msg              string             `json:"msg"`
+	ReceivedAt       pgtype.Timestamptz `json:"received_at"`
+}
+
 type Role struct {
 	ID          int64              `json:"id"`
 	Name        string             `json:"name"`
@@ -187,10 +210,131 @@ type SimAccessToken struct {
 }
 
 type SimCard struct {
+	MobileNumber     string             `json:"mobile_number"`
+	Type             pgtype.Text        `json:"type"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	LoadPromo        pgtype.Text        `json:"load_promo"`
+	LoadValidityDays pgtype.Int4        `json:"load_validity_days"`
+	LoadExpiresAt    pgtype.Timestamptz `json:"load_expires_at"`
+	LoadRequestedAt  pgtype.Timestamptz `json:"load_requested_at"`
+	Iccid            pgtype.Text        `json:"iccid"`
+	Status           string             `json:"status"`
+}
+
+type SimCardAssignment struct {
+	ID           int64              `json:"id"`
 	MobileNumber string             `json:"mobile_number"`
-	Type         pgtype.Text        `json:"type"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	StationID    int64              `json:"station_id"`
+	AssignedAt   pgtype.Timestamptz `json:"assigned_at"`
+	UnassignedAt pgtype.Timestamptz `json:"unassigned_at"`
+}
+
+type SmsOutbox struct {
+	ID             int64              `json:"id"`
+	MobileNumber   string             `json:"mobile_number"`
+	Message        string             `json:"message"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	LastError      pgtype.Text        `json:"last_error"`
+	DeliveryStatus pgtype.Text        `json:"delivery_status"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	SentAt         pgtype.Timestamptz `json:"sent_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+}
+
+type SmsPart struct {
+	ID         int64              `json:"id"`
+	Sender     string             `json:"sender"`
+	RefID      string             `json:"ref_id"`
+	SeqNum     int32              `json:"seq_num"`
+	Msg        string             `json:"msg"`
+	ReceivedAt pgtype.Timestamptz `json:"received_at"`
+}
+
+type StationClock struct {
+	ID          int64              `json:"id"`
+	StationID   int64              `json:"station_id"`
+	Timezone    string             `json:"timezone"`
+	ClockOffset int32              `json:"clock_offset"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type StationCommand struct {
+	ID             int64              `json:"id"`
+	StationID      int64              `json:"station_id"`
+	Command        string             `json:"command"`
+	Interval       pgtype.Int4        `json:"interval"`
+	Message        string             `json:"message"`
+	Status         string             `json:"status"`
+	SmsOutboxID    pgtype.Int8        `json:"sms_outbox_id"`
+	AckMessage     pgtype.Text        `json:"ack_message"`
+	AcknowledgedAt pgtype.Timestamptz `json:"acknowledged_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type StationMaintenance struct {
+	ID                int64              `json:"id"`
+	StationID         int64              `json:"station_id"`
+	TechnicianID      pgtype.Int8        `json:"technician_id"`
+	VisitDate         pgtype.Date        `json:"visit_date"`
+	Actions           string             `json:"actions"`
+	PartsReplaced     []string           `json:"parts_replaced"`
+	SensorsCalibrated []string           `json:"sensors_calibrated"`
+	Photos            []string           `json:"photos"`
+	WindowStart       pgtype.Timestamptz `json:"window_start"`
+	WindowEnd         pgtype.Timestamptz `json:"window_end"`
+	WindowStatus      pgtype.Text        `json:"window_status"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type StationMetadataHistory struct {
+	ID            int64              `json:"id"`
+	StationID     int64              `json:"station_id"`
+	Lat           pgtype.Float4      `json:"lat"`
+	Lon           pgtype.Float4      `json:"lon"`
+	Elevation     pgtype.Float4      `json:"elevation"`
+	StationType   pgtype.Text        `json:"station_type"`
+	StationType2  pgtype.Text        `json:"station_type2"`
+	LoggerVersion pgtype.Text        `json:"logger_version"`
+	LoggerSerial  pgtype.Text        `json:"logger_serial"`
+	TempHeight    pgtype.Float4      `json:"temp_height"`
+	TempSerial    pgtype.Text        `json:"temp_serial"`
+	WindHeight    pgtype.Float4      `json:"wind_height"`
+	WindSerial    pgtype.Text        `json:"wind_serial"`
+	RainHeight    pgtype.Float4      `json:"rain_height"`
+	RainSerial    pgtype.Text        `json:"rain_serial"`
+	PresHeight    pgtype.Float4      `json:"pres_height"`
+	PresSerial    pgtype.Text        `json:"pres_serial"`
+	Reason        string             `json:"reason"`
+	EffectiveFrom pgtype.Timestamptz `json:"effective_from"`
+	EffectiveTo   pgtype.Timestamptz `json:"effective_to"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type StationStatusHistory struct {
+	ID             int64              `json:"id"`
+	StationID      int64              `json:"station_id"`
+	FromStatus     pgtype.Text        `json:"from_status"`
+	ToStatus       string             `json:"to_status"`
+	Reason         string             `json:"reason"`
+	LastObservedAt pgtype.Timestamptz `json:"last_observed_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type UploadStation struct {
+	ID        int64              `json:"id"`
+	StationID int64              `json:"station_id"`
+	Protocol  string             `json:"protocol"`
+	UploadID  string             `json:"upload_id"`
+	UploadKey string             `json:"upload_key"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
 type User struct {
@@ -206,12 +350,34 @@ type User struct {
 }
 
 type Weatherlink struct {
-	ID        int64              `json:"id"`
-	StationID int64              `json:"station_id"`
-	Uuid      pgtype.Text        `json:"uuid"`
-	ApiKey    pgtype.Text        `json:"api_key"`
-	ApiSecret pgtype.Text        `json:"api_secret"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
-	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	ID                int64              `json:"id"`
+	StationID         int64              `json:"station_id"`
+	Uuid              pgtype.Text        `json:"uuid"`
+	ApiKey            pgtype.Text        `json:"api_key"`
+	ApiSecret         pgtype.Text        `json:"api_secret"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt         pgtype.Timestamptz `json:"deleted_at"`
+	WlStationID       pgtype.Int4        `json:"wl_station_id"`
+	Lat               pgtype.Float4      `json:"lat"`
+	Lon               pgtype.Float4      `json:"lon"`
+	Elevation         pgtype.Float4      `json:"elevation"`
+	FirmwareVersion   pgtype.Text        `json:"firmware_version"`
+	RecordingInterval pgtype.Int4        `json:"recording_interval"`
+	SyncedAt          pgtype.Timestamptz `json:"synced_at"`
+	V1User            pgtype.Text        `json:"v1_user"`
+	V1Pass            pgtype.Text        `json:"v1_pass"`
+	V1ApiToken        pgtype.Text        `json:"v1_api_token"`
+}
+
+type WeatherlinkChange struct {
+	ID         int64              `json:"id"`
+	StationID  int64              `json:"station_id"`
+	Field      string             `json:"field"`
+	OldValue   pgtype.Text        `json:"old_value"`
+	NewValue   pgtype.Text        `json:"new_value"`
+	Status     string             `json:"status"`
+	ReviewedAt pgtype.Timestamptz `json:"reviewed_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
 }
@@ -73,6 +73,7 @@ INSERT INTO observations_observation (
   rr,
   rain_tips,
   rain_cumulative_tips,
+  rain_accum,
   rh,
   temp,
   td,
@@ -87,8 +88,8 @@ INSERT INTO observations_observation (
   qc_level,
   station_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
-) RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+) RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips, rain_accum
 `
 
 type CreateStationObservationParams struct {
@@ -96,6 +97,7 @@ type CreateStationObservationParams struct {
 	Rr                 pgtype.Float4      `json:"rr"`
 	RainTips           pgtype.Int4        `json:"rain_tips"`
 	RainCumulativeTips pgtype.Int4        `json:"rain_cumulative_tips"`
+	RainAccum          pgtype.Float4      `json:"rain_accum"`
 	Rh                 pgtype.Float4      `json:"rh"`
 	Temp               pgtype.Float4      `json:"temp"`
 	Td                 pgtype.Float4      `json:"td"`
@@ -117,6 +119,7 @@ func (q *Queries) CreateStationObservation(ctx context.Context, arg CreateStatio
 		arg.Rr,
 		arg.RainTips,
 		arg.RainCumulativeTips,
+		arg.RainAccum,
 		arg.Rh,
 		arg.Temp,
 		arg.Td,
@@ -153,6 +156,7 @@ func (q *Queries) CreateStationObservation(ctx context.Context, arg CreateStatio
 		&i.UpdatedAt,
 		&i.RainTips,
 		&i.RainCumulativeTips,
+		&i.RainAccum,
 	)
 	return i, err
 }
@@ -172,7 +176,7 @@ func (q *Queries) DeleteStationObservation(ctx context.Context, arg DeleteStatio
 }
 
 const getStationObservation = `-- name: GetStationObservation :one
-SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips FROM observations_observation
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips, rain_accum FROM observations_observation
 WHERE station_id = $1 AND id = $2 LIMIT 1
 `
 
@@ -205,12 +209,13 @@ func (q *Queries) GetStationObservation(ctx context.Context, arg GetStationObser
 		&i.UpdatedAt,
 		&i.RainTips,
 		&i.RainCumulativeTips,
+		&i.RainAccum,
 	)
 	return i, err
 }
 
 const listObservations = `-- name: ListObservations :many
-SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips FROM observations_observation
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips, rain_accum FROM observations_observation
 WHERE station_id = ANY($1::bigint[])
   AND (CASE WHEN $2::bool THEN timestamp >= $3 ELSE TRUE END)
   AND (CASE WHEN $4::bool THEN timestamp <= $5 ELSE TRUE END)
@@ -267,6 +272,7 @@ func (q *Queries) ListObservations(ctx context.Context, arg ListObservationsPara
 			&i.UpdatedAt,
 			&i.RainTips,
 			&i.RainCumulativeTips,
+			&i.RainAccum,
 		); err != nil {
 			return nil, err
 		}
@@ -279,7 +285,7 @@ func (q *Queries) ListObservations(ctx context.Context, arg ListObservationsPara
 }
 
 const listStationObservations = `-- name: ListStationObservations :many
-SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips FROM observations_observation
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips, rain_accum FROM observations_observation
 WHERE station_id = $1
   AND (CASE WHEN $2::bool THEN timestamp >= $3 ELSE TRUE END)
   AND (CASE WHEN $4::bool THEN timestamp <= $5 ELSE TRUE END)
@@ -336,6 +342,7 @@ func (q *Queries) ListStationObservations(ctx context.Context, arg ListStationOb
 			&i.UpdatedAt,
 			&i.RainTips,
 			&i.RainCumulativeTips,
+			&i.RainAccum,
 		); err != nil {
 			return nil, err
 		}
@@ -368,7 +375,7 @@ SET
   qc_level = COALESCE($16, qc_level),
   updated_at = now()
 WHERE station_id = $17 AND id = $18
-RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips
+RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at, rain_tips, rain_cumulative_tips, rain_accum
 `
 
 type UpdateStationObservationParams struct {
@@ -435,6 +442,7 @@ func (q *Queries) UpdateStationObservation(ctx context.Context, arg UpdateStatio
 		&i.UpdatedAt,
 		&i.RainTips,
 		&i.RainCumulativeTips,
+		&i.RainAccum,
 	)
 	return i, err
 }
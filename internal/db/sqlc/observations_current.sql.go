@@ -91,7 +91,7 @@ SELECT
 FROM observations_station stn 
   JOIN observations_current obs 
   ON stn.id = obs.station_id
-WHERE stn.id = $1
+WHERE stn.id = $1 AND stn.deleted_at = '0001-01-01 00:00:00Z'
 ORDER BY obs.timestamp DESC
 LIMIT 1
 `
@@ -142,6 +142,7 @@ WITH NearestStation AS (
   SELECT
 	id, name, lat, lon, elevation, address
   FROM observations_station
+  WHERE deleted_at = '0001-01-01 00:00:00Z'
   ORDER BY geom <-> ST_Point($1::real, $2::real, 4326)
   LIMIT 1
 )
@@ -402,12 +403,41 @@ WITH RankedRows AS (
     JOIN observations_current obs 
     ON stn.id = obs.station_id
   WHERE obs.timestamp > NOW() - INTERVAL '1 hour'
+    AND station_matches(stn,
+      $1::text, $2::text,
+      $3::text, $4::text,
+      $5::text, $6::text,
+      $7::text, $8::text,
+      $9::date, $10::date,
+      $11::real, $12::real, $13::real,
+      $14::real, $15::real, $16::real, $17::real
+    )
 )
 SELECT id, name, lat, lon, elevation, address, rain, temp, rh, wdir, wspd, srad, mslp, tn, tx, gust, rain_accum, tn_timestamp, tx_timestamp, gust_timestamp, timestamp, rn
 FROM RankedRows
 WHERE rn = 1
 `
 
+type ListLatestObservationsParams struct {
+	Status        pgtype.Text   `json:"status"`
+	Q             pgtype.Text   `json:"q"`
+	StationType   pgtype.Text   `json:"station_type"`
+	StationType2  pgtype.Text   `json:"station_type2"`
+	Province      pgtype.Text   `json:"province"`
+	Region        pgtype.Text   `json:"region"`
+	ProviderID    pgtype.Text   `json:"provider_id"`
+	PriorityLevel pgtype.Text   `json:"priority_level"`
+	InstalledFrom pgtype.Date   `json:"installed_from"`
+	InstalledTo   pgtype.Date   `json:"installed_to"`
+	Cx            pgtype.Float4 `json:"cx"`
+	Cy            pgtype.Float4 `json:"cy"`
+	R             pgtype.Float4 `json:"r"`
+	Xmin          pgtype.Float4 `json:"xmin"`
+	Ymin          pgtype.Float4 `json:"ymin"`
+	Xmax          pgtype.Float4 `json:"xmax"`
+	Ymax          pgtype.Float4 `json:"ymax"`
+}
+
 type ListLatestObservationsRow struct {
 	ID            int64              `json:"id"`
 	Name          string             `json:"name"`
@@ -433,8 +463,26 @@ type ListLatestObservationsRow struct {
 	Rn            int64              `json:"rn"`
 }
 
-func (q *Queries) ListLatestObservations(ctx context.Context) ([]ListLatestObservationsRow, error) {
-	rows, err := q.db.Query(ctx, listLatestObservations)
+func (q *Queries) ListLatestObservations(ctx context.Context, arg ListLatestObservationsParams) ([]ListLatestObservationsRow, error) {
+	rows, err := q.db.Query(ctx, listLatestObservations,
+		arg.Status,
+		arg.Q,
+		arg.StationType,
+		arg.StationType2,
+		arg.Province,
+		arg.Region,
+		arg.ProviderID,
+		arg.PriorityLevel,
+		arg.InstalledFrom,
+		arg.InstalledTo,
+		arg.Cx,
+		arg.Cy,
+		arg.R,
+		arg.Xmin,
+		arg.Ymin,
+		arg.Xmax,
+		arg.Ymax,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type PendingStationTestSuite struct {
+	suite.Suite
+}
+
+func TestPendingStationTestSuite(t *testing.T) {
+	suite.Run(t, new(PendingStationTestSuite))
+}
+
+func (ts *PendingStationTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *PendingStationTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *PendingStationTestSuite) TestBufferPendingStationMessageTx() {
+	t := ts.T()
+	mobileNum := gofakeit.Regex("639[0-9]{9}")
+
+	n := 3
+	for i := range n {
+		result, err := testStore.BufferPendingStationMessageTx(context.Background(), BufferPendingStationMessageTxParams{
+			MobileNumber: mobileNum,
+			Msg:          util.RandomString(32),
+		})
+		require.NoError(t, err)
+		require.Equal(t, mobileNum, result.PendingStation.MobileNumber)
+		require.Equal(t, int32(i+1), result.PendingStation.MessageCount)
+		require.Equal(t, result.PendingStation.ID, result.Message.PendingStationID)
+	}
+
+	pending, err := testStore.GetPendingStation(context.Background(), mobileNum)
+	require.NoError(t, err)
+
+	msgs, err := testStore.ListPendingStationMessages(context.Background(), pending.ID)
+	require.NoError(t, err)
+	require.Len(t, msgs, n)
+
+	count, err := testStore.CountPendingStations(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func (ts *PendingStationTestSuite) TestListPendingStations() {
+	t := ts.T()
+	n := 5
+	for range n {
+		createRandomPendingStation(t, 1)
+	}
+
+	gotPending, err := testStore.ListPendingStations(context.Background(), ListPendingStationsParams{
+		Limit:  pgtype.Int4{Int32: 3, Valid: true},
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, gotPending, 3)
+}
+
+func (ts *PendingStationTestSuite) TestClaimPendingStationTxNew() {
+	t := ts.T()
+	pending := createRandomPendingStation(t, 2)
+
+	result, err := testStore.ClaimPendingStationTx(context.Background(), ClaimPendingStationTxParams{
+		MobileNumber: pending.MobileNumber,
+		Station:      CreateStationParams{Name: util.RandomString(12)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, pending.MobileNumber, result.Station.MobileNumber.String)
+	require.Len(t, result.Messages, 2)
+
+	// the messages stay queued until they are ingested
+	err = testStore.DeletePendingStationMessage(context.Background(), result.Messages[0].ID)
+	require.NoError(t, err)
+
+	gotPending, err := testStore.GetPendingStation(context.Background(), pending.MobileNumber)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), gotPending.MessageCount)
+
+	gotMsgs, err := testStore.ListPendingStationMessages(context.Background(), pending.ID)
+	require.NoError(t, err)
+	require.Len(t, gotMsgs, 1)
+	require.Equal(t, result.Messages[1].ID, gotMsgs[0].ID)
+}
+
+func (ts *PendingStationTestSuite) TestClaimPendingStationTxExisting() {
+	t := ts.T()
+	pending := createRandomPendingStation(t, 1)
+	station := createRandomStation(t, false)
+
+	result, err := testStore.ClaimPendingStationTx(context.Background(), ClaimPendingStationTxParams{
+		MobileNumber: pending.MobileNumber,
+		StationID:    station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, station.ID, result.Station.ID)
+	require.Equal(t, pending.MobileNumber, result.Station.MobileNumber.String)
+	require.Len(t, result.Messages, 1)
+	require.Equal(t, pending.ID, result.PendingStation.ID)
+
+	err = testStore.DeletePendingStation(context.Background(), pending.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.ClaimPendingStationTx(context.Background(), ClaimPendingStationTxParams{
+		MobileNumber: pending.MobileNumber,
+		StationID:    station.ID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func createRandomPendingStation(t *testing.T, nMsgs int) PendingStation {
+	mobileNum := gofakeit.Regex("639[0-9]{9}")
+	var pending PendingStation
+	for range nMsgs {
+		result, err := testStore.BufferPendingStationMessageTx(context.Background(), BufferPendingStationMessageTxParams{
+			MobileNumber: mobileNum,
+			Msg:          util.RandomString(32),
+		})
+		require.NoError(t, err)
+		pending = result.PendingStation
+	}
+	return pending
+}
@@ -12,78 +12,170 @@ import (
 )
 
 type Querier interface {
+	AcknowledgeStationCommand(ctx context.Context, arg AcknowledgeStationCommandParams) (StationCommand, error)
 	BatchCreateUserRoles(ctx context.Context, arg []BatchCreateUserRolesParams) *BatchCreateUserRolesBatchResults
 	BatchDeleteUserRoles(ctx context.Context, arg []BatchDeleteUserRolesParams) *BatchDeleteUserRolesBatchResults
+	ClearStationMobileNumber(ctx context.Context, mobileNumber pgtype.Text) error
+	CloseSimCardAssignments(ctx context.Context, arg CloseSimCardAssignmentsParams) error
+	CloseStationMetadataHistory(ctx context.Context, arg CloseStationMetadataHistoryParams) error
+	// Claimed messages stay SENDING until the send result is stored.
+	// The claim expires so that the messages of an interrupted dispatch are sent again.
+	ClaimDueSmsOutbox(ctx context.Context, limit int32) ([]SmsOutbox, error)
+	CompleteGLabsLoad(ctx context.Context, arg CompleteGLabsLoadParams) (GlabsLoad, error)
 	CountLufftStationMsg(ctx context.Context, stationID int64) (int64, error)
 	CountMOObservations(ctx context.Context, arg CountMOObservationsParams) (int64, error)
+	CountMisolStations(ctx context.Context, status pgtype.Text) (int64, error)
 	CountObservations(ctx context.Context, arg CountObservationsParams) (int64, error)
+	CountOpenStationMaintenanceWindows(ctx context.Context, stationID int64) (int64, error)
+	CountPendingStations(ctx context.Context) (int64, error)
 	CountRoles(ctx context.Context) (int64, error)
+	CountSimCards(ctx context.Context, arg CountSimCardsParams) (int64, error)
+	CountSmsOutbox(ctx context.Context, arg CountSmsOutboxParams) (int64, error)
+	CountStationClockDrift(ctx context.Context, arg CountStationClockDriftParams) (int64, error)
+	CountStationCommands(ctx context.Context, arg CountStationCommandsParams) (int64, error)
 	CountStationMOObservations(ctx context.Context, arg CountStationMOObservationsParams) (int64, error)
+	CountStationMaintenance(ctx context.Context, arg CountStationMaintenanceParams) (int64, error)
+	CountStationMetadataHistory(ctx context.Context, stationID int64) (int64, error)
 	CountStationObservations(ctx context.Context, arg CountStationObservationsParams) (int64, error)
-	CountStations(ctx context.Context, status pgtype.Text) (int64, error)
-	CountStationsWithinBBox(ctx context.Context, arg CountStationsWithinBBoxParams) (int64, error)
-	CountStationsWithinRadius(ctx context.Context, arg CountStationsWithinRadiusParams) (int64, error)
+	CountStationStatusHistory(ctx context.Context, arg CountStationStatusHistoryParams) (int64, error)
+	CountStations(ctx context.Context, arg CountStationsParams) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
+	CountWeatherlinkChanges(ctx context.Context, status pgtype.Text) (int64, error)
 	CreateCurrentObservation(ctx context.Context, arg CreateCurrentObservationParams) (ObservationsCurrent, error)
 	CreateGLabsLoad(ctx context.Context, arg CreateGLabsLoadParams) (GlabsLoad, error)
+	CreateGLabsLoadCallback(ctx context.Context, arg CreateGLabsLoadCallbackParams) (GlabsLoad, error)
 	CreateMisolStation(ctx context.Context, arg CreateMisolStationParams) (MisolStation, error)
+	CreatePendingStationMessage(ctx context.Context, arg CreatePendingStationMessageParams) (PendingStationMessage, error)
 	CreateRole(ctx context.Context, arg CreateRoleParams) (Role, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateSimAccessToken(ctx context.Context, arg CreateSimAccessTokenParams) (SimAccessToken, error)
 	CreateSimCard(ctx context.Context, arg CreateSimCardParams) (SimCard, error)
+	CreateSimCardAssignment(ctx context.Context, arg CreateSimCardAssignmentParams) (SimCardAssignment, error)
+	CreateSmsOutbox(ctx context.Context, arg CreateSmsOutboxParams) (SmsOutbox, error)
+	CreateSmsPart(ctx context.Context, arg CreateSmsPartParams) error
 	CreateStation(ctx context.Context, arg CreateStationParams) (ObservationsStation, error)
+	CreateStationCommand(ctx context.Context, arg CreateStationCommandParams) (StationCommand, error)
 	CreateStationHealth(ctx context.Context, arg CreateStationHealthParams) (ObservationsStationhealth, error)
 	CreateStationMOObservation(ctx context.Context, arg CreateStationMOObservationParams) (ObservationsMoObservation, error)
+	CreateStationMaintenance(ctx context.Context, arg CreateStationMaintenanceParams) (StationMaintenance, error)
+	CreateStationMetadataHistory(ctx context.Context, arg CreateStationMetadataHistoryParams) (StationMetadataHistory, error)
 	CreateStationObservation(ctx context.Context, arg CreateStationObservationParams) (ObservationsObservation, error)
+	CreateStationStatusHistory(ctx context.Context, arg CreateStationStatusHistoryParams) (StationStatusHistory, error)
+	CreateUploadStation(ctx context.Context, arg CreateUploadStationParams) (UploadStation, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWeatherlinkChange(ctx context.Context, arg CreateWeatherlinkChangeParams) (WeatherlinkChange, error)
 	CreateWeatherlinkStation(ctx context.Context, arg CreateWeatherlinkStationParams) (Weatherlink, error)
 	DeleteMisolStation(ctx context.Context, id int64) error
+	DeletePendingStation(ctx context.Context, id int64) error
+	DeletePendingStationMessage(ctx context.Context, id int64) error
 	DeleteRole(ctx context.Context, id int64) error
 	DeleteSession(ctx context.Context, id uuid.UUID) error
 	DeleteSimAccessToken(ctx context.Context, accessToken string) error
-	DeleteStation(ctx context.Context, id int64) error
+	DeleteSimCard(ctx context.Context, mobileNumber string) error
+	DeleteSmsParts(ctx context.Context, arg DeleteSmsPartsParams) (int64, error)
+	DeleteStaleSmsParts(ctx context.Context, receivedAt pgtype.Timestamptz) error
+	DeleteStation(ctx context.Context, id int64) (int64, error)
+	DeleteStationClock(ctx context.Context, stationID int64) error
 	DeleteStationHealth(ctx context.Context, arg DeleteStationHealthParams) error
 	DeleteStationMOObservation(ctx context.Context, arg DeleteStationMOObservationParams) error
+	DeleteStationMaintenance(ctx context.Context, arg DeleteStationMaintenanceParams) error
 	DeleteStationObservation(ctx context.Context, arg DeleteStationObservationParams) error
+	DeleteUploadStation(ctx context.Context, arg DeleteUploadStationParams) error
 	DeleteUser(ctx context.Context, id int64) error
+	DeleteWeatherlinkStation(ctx context.Context, stationID int64) error
+	ExtendSimCardLoad(ctx context.Context, mobileNumber string) error
+	GetActiveSimCardAssignment(ctx context.Context, mobileNumber string) (SimCardAssignment, error)
+	GetCurrentStationMetadataForUpdate(ctx context.Context, stationID int64) (StationMetadataHistory, error)
+	GetDeletedStation(ctx context.Context, id int64) (ObservationsStation, error)
+	GetLatestSimAccessToken(ctx context.Context, arg GetLatestSimAccessTokenParams) (SimAccessToken, error)
 	GetLatestStationObservation(ctx context.Context, id int64) (GetLatestStationObservationRow, error)
 	GetMisolStation(ctx context.Context, id int64) (MisolStation, error)
 	GetNearestLatestStationObservation(ctx context.Context, arg GetNearestLatestStationObservationParams) (GetNearestLatestStationObservationRow, error)
+	GetOutstandingStationCommand(ctx context.Context, arg GetOutstandingStationCommandParams) (StationCommand, error)
+	GetPendingStation(ctx context.Context, mobileNumber string) (PendingStation, error)
 	GetRole(ctx context.Context, id int64) (Role, error)
 	GetRoleByName(ctx context.Context, name string) (Role, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetSimAccessToken(ctx context.Context, accessToken string) (SimAccessToken, error)
 	GetSimCard(ctx context.Context, mobileNumber string) (SimCard, error)
+	GetSmsOutbox(ctx context.Context, id int64) (SmsOutbox, error)
 	GetStation(ctx context.Context, id int64) (ObservationsStation, error)
 	GetStationByMobileNumber(ctx context.Context, mobileNumber pgtype.Text) (ObservationsStation, error)
+	GetStationClock(ctx context.Context, stationID int64) (StationClock, error)
+	GetStationCommand(ctx context.Context, id int64) (StationCommand, error)
 	GetStationHealth(ctx context.Context, arg GetStationHealthParams) (ObservationsStationhealth, error)
+	GetStationHealthSummary(ctx context.Context, arg GetStationHealthSummaryParams) (GetStationHealthSummaryRow, error)
 	GetStationMOObservation(ctx context.Context, arg GetStationMOObservationParams) (ObservationsMoObservation, error)
+	GetStationMaintenance(ctx context.Context, arg GetStationMaintenanceParams) (StationMaintenance, error)
 	GetStationObservation(ctx context.Context, arg GetStationObservationParams) (ObservationsObservation, error)
+	GetStationStatusForUpdate(ctx context.Context, id int64) (pgtype.Text, error)
+	GetUploadStation(ctx context.Context, arg GetUploadStationParams) (UploadStation, error)
 	GetUser(ctx context.Context, id int64) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
+	GetWeatherlinkChange(ctx context.Context, id int64) (WeatherlinkChange, error)
+	GetWeatherlinkStation(ctx context.Context, stationID int64) (Weatherlink, error)
+	GetWeatherlinkStationByWLID(ctx context.Context, arg GetWeatherlinkStationByWLIDParams) (Weatherlink, error)
 	InsertCurrentMOObservations(ctx context.Context) ([]ObservationsCurrent, error)
 	InsertCurrentObservations(ctx context.Context) ([]ObservationsCurrent, error)
-	ListLatestObservations(ctx context.Context) ([]ListLatestObservationsRow, error)
+	ListLatestObservations(ctx context.Context, arg ListLatestObservationsParams) ([]ListLatestObservationsRow, error)
 	ListLufftStationMsg(ctx context.Context, arg ListLufftStationMsgParams) ([]ListLufftStationMsgRow, error)
 	ListMOObservations(ctx context.Context, arg ListMOObservationsParams) ([]ObservationsMoObservation, error)
+	ListMisolStations(ctx context.Context, arg ListMisolStationsParams) ([]MisolStation, error)
 	ListObservations(ctx context.Context, arg ListObservationsParams) ([]ObservationsObservation, error)
+	ListPendingStationMessages(ctx context.Context, pendingStationID int64) ([]PendingStationMessage, error)
+	ListPendingStations(ctx context.Context, arg ListPendingStationsParams) ([]PendingStation, error)
 	ListRoles(ctx context.Context, arg ListRolesParams) ([]Role, error)
+	ListSimCardAssignments(ctx context.Context, arg ListSimCardAssignmentsParams) ([]SimCardAssignment, error)
+	ListSimCards(ctx context.Context, arg ListSimCardsParams) ([]SimCard, error)
+	ListSimCardsDueForLoad(ctx context.Context, arg ListSimCardsDueForLoadParams) ([]SimCard, error)
+	ListSmsOutbox(ctx context.Context, arg ListSmsOutboxParams) ([]SmsOutbox, error)
+	ListSmsParts(ctx context.Context, arg ListSmsPartsParams) ([]SmsPart, error)
+	ListStationBatteryDaily(ctx context.Context, arg ListStationBatteryDailyParams) ([]ListStationBatteryDailyRow, error)
+	ListStationClockDrift(ctx context.Context, arg ListStationClockDriftParams) ([]ListStationClockDriftRow, error)
+	ListStationCommands(ctx context.Context, arg ListStationCommandsParams) ([]ListStationCommandsRow, error)
+	ListStationDailyDataStatus(ctx context.Context, arg ListStationDailyDataStatusParams) ([]ListStationDailyDataStatusRow, error)
+	ListStationDailyRecordCounts(ctx context.Context, arg ListStationDailyRecordCountsParams) ([]ListStationDailyRecordCountsRow, error)
 	ListStationHealths(ctx context.Context, arg ListStationHealthsParams) ([]ObservationsStationhealth, error)
+	ListStationMOObservationTimestamps(ctx context.Context, arg ListStationMOObservationTimestampsParams) ([]pgtype.Timestamptz, error)
 	ListStationMOObservations(ctx context.Context, arg ListStationMOObservationsParams) ([]ObservationsMoObservation, error)
+	ListStationMaintenance(ctx context.Context, arg ListStationMaintenanceParams) ([]StationMaintenance, error)
+	ListStationMaintenanceWindowsInRange(ctx context.Context, arg ListStationMaintenanceWindowsInRangeParams) ([]StationMaintenance, error)
+	ListStationMaintenanceWindowsToClose(ctx context.Context, now pgtype.Timestamptz) ([]StationMaintenance, error)
+	ListStationMaintenanceWindowsToOpen(ctx context.Context, now pgtype.Timestamptz) ([]StationMaintenance, error)
+	ListStationMetadataHistory(ctx context.Context, arg ListStationMetadataHistoryParams) ([]StationMetadataHistory, error)
+	ListStationMetadataInRange(ctx context.Context, arg ListStationMetadataInRangeParams) ([]StationMetadataHistory, error)
 	ListStationObservations(ctx context.Context, arg ListStationObservationsParams) ([]ObservationsObservation, error)
+	ListStationStatusHistory(ctx context.Context, arg ListStationStatusHistoryParams) ([]StationStatusHistory, error)
 	ListStations(ctx context.Context, arg ListStationsParams) ([]ObservationsStation, error)
-	ListStationsWithinBBox(ctx context.Context, arg ListStationsWithinBBoxParams) ([]ObservationsStation, error)
-	ListStationsWithinRadius(ctx context.Context, arg ListStationsWithinRadiusParams) ([]ObservationsStation, error)
+	ListStationsByMobileNumbers(ctx context.Context, mobileNumbers []string) ([]ObservationsStation, error)
+	ListStationsLastObserved(ctx context.Context) ([]ListStationsLastObservedRow, error)
+	ListUploadStations(ctx context.Context, stationID int64) ([]UploadStation, error)
 	ListUserRoles(ctx context.Context, userID int64) ([]string, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListWeatherlinkChanges(ctx context.Context, arg ListWeatherlinkChangesParams) ([]WeatherlinkChange, error)
 	ListWeatherlinkStations(ctx context.Context, arg ListWeatherlinkStationsParams) ([]Weatherlink, error)
+	MarkSimCardLoadRequested(ctx context.Context, mobileNumber string) error
+	PurgeStation(ctx context.Context, id int64) error
+	RestoreStation(ctx context.Context, id int64) (ObservationsStation, error)
+	UpdateMisolStation(ctx context.Context, arg UpdateMisolStationParams) (MisolStation, error)
 	UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error)
+	UpdateSimCard(ctx context.Context, arg UpdateSimCardParams) (SimCard, error)
+	UpdateSimCardLoad(ctx context.Context, arg UpdateSimCardLoadParams) (SimCard, error)
+	UpdateSmsOutboxStatus(ctx context.Context, arg UpdateSmsOutboxStatusParams) (SmsOutbox, error)
 	UpdateStation(ctx context.Context, arg UpdateStationParams) (ObservationsStation, error)
+	UpdateStationCommandMessage(ctx context.Context, arg UpdateStationCommandMessageParams) (StationCommand, error)
 	UpdateStationHealth(ctx context.Context, arg UpdateStationHealthParams) (ObservationsStationhealth, error)
 	UpdateStationMOObservation(ctx context.Context, arg UpdateStationMOObservationParams) (ObservationsMoObservation, error)
+	UpdateStationMaintenance(ctx context.Context, arg UpdateStationMaintenanceParams) (StationMaintenance, error)
+	UpdateStationMaintenanceWindowStatus(ctx context.Context, arg UpdateStationMaintenanceWindowStatusParams) error
 	UpdateStationObservation(ctx context.Context, arg UpdateStationObservationParams) (ObservationsObservation, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateWeatherlinkChangeStatus(ctx context.Context, arg UpdateWeatherlinkChangeStatusParams) (WeatherlinkChange, error)
+	UpdateWeatherlinkStation(ctx context.Context, arg UpdateWeatherlinkStationParams) (Weatherlink, error)
+	UpdateWeatherlinkStationMetadata(ctx context.Context, arg UpdateWeatherlinkStationMetadataParams) (Weatherlink, error)
+	UpsertPendingStation(ctx context.Context, mobileNumber string) (PendingStation, error)
+	UpsertStationClock(ctx context.Context, arg UpsertStationClockParams) (StationClock, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -33,11 +34,169 @@ func (ts *SimCardTestSuite) TestCreateSimCard() {
 	createRandomSimCard(ts.T())
 }
 
+func (ts *SimCardTestSuite) TestListSimCards() {
+	t := ts.T()
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomSimCard(t)
+	}
+
+	simCards, err := testStore.ListSimCards(context.Background(), ListSimCardsParams{
+		Limit: pgtype.Int4{Int32: 3, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, simCards, 3)
+
+	count, err := testStore.CountSimCards(context.Background(), CountSimCardsParams{
+		Status: util.ToPgText("ACTIVE"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(n), count)
+}
+
+func (ts *SimCardTestSuite) TestUpdateSimCard() {
+	t := ts.T()
+	simCard := createRandomSimCard(t)
+
+	updated, err := testStore.UpdateSimCard(context.Background(), UpdateSimCardParams{
+		Status:       util.ToPgText("LOST"),
+		MobileNumber: simCard.MobileNumber,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "LOST", updated.Status)
+	require.Equal(t, simCard.Type, updated.Type)
+	require.True(t, updated.UpdatedAt.Valid)
+}
+
+func (ts *SimCardTestSuite) TestDeleteSimCard() {
+	t := ts.T()
+	simCard := createRandomSimCard(t)
+
+	err := testStore.DeleteSimCard(context.Background(), simCard.MobileNumber)
+	require.NoError(t, err)
+
+	_, err = testStore.GetSimCard(context.Background(), simCard.MobileNumber)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *SimCardTestSuite) TestSimCardLoad() {
+	t := ts.T()
+	simCard := createRandomSimCard(t)
+	expiresAt := time.Now().Add(time.Hour)
+
+	updated, err := testStore.UpdateSimCardLoad(context.Background(), UpdateSimCardLoadParams{
+		LoadPromo:        util.ToPgText("LOAD50"),
+		LoadValidityDays: pgtype.Int4{Int32: 30, Valid: true},
+		LoadExpiresAt:    pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		MobileNumber:     simCard.MobileNumber,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "LOAD50", updated.LoadPromo.String)
+
+	due, err := testStore.ListSimCardsDueForLoad(context.Background(), ListSimCardsDueForLoadParams{
+		RequestedBefore: pgtype.Timestamptz{Time: time.Now().Add(-24 * time.Hour), Valid: true},
+		ExpiresBefore:   pgtype.Timestamptz{Time: time.Now().Add(72 * time.Hour), Valid: true},
+		SilentSince:     pgtype.Timestamptz{Time: time.Now().Add(-6 * time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, simCard.MobileNumber, due[0].MobileNumber)
+
+	err = testStore.MarkSimCardLoadRequested(context.Background(), simCard.MobileNumber)
+	require.NoError(t, err)
+
+	due, err = testStore.ListSimCardsDueForLoad(context.Background(), ListSimCardsDueForLoadParams{
+		RequestedBefore: pgtype.Timestamptz{Time: time.Now().Add(-24 * time.Hour), Valid: true},
+		ExpiresBefore:   pgtype.Timestamptz{Time: time.Now().Add(72 * time.Hour), Valid: true},
+		SilentSince:     pgtype.Timestamptz{Time: time.Now().Add(-6 * time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	err = testStore.ExtendSimCardLoad(context.Background(), simCard.MobileNumber)
+	require.NoError(t, err)
+
+	extended, err := testStore.GetSimCard(context.Background(), simCard.MobileNumber)
+	require.NoError(t, err)
+	require.WithinDuration(t, expiresAt.Add(30*24*time.Hour), extended.LoadExpiresAt.Time, time.Second)
+	require.True(t, extended.LoadRequestedAt.Valid)
+}
+
+func (ts *SimCardTestSuite) TestSimCardLoadSilentStation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	simCard, err := testStore.CreateSimCard(context.Background(), CreateSimCardParams{
+		MobileNumber: station.MobileNumber.String,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.UpdateSimCardLoad(context.Background(), UpdateSimCardLoadParams{
+		LoadPromo:        util.ToPgText("LOAD50"),
+		LoadValidityDays: pgtype.Int4{Int32: 30, Valid: true},
+		LoadExpiresAt:    pgtype.Timestamptz{Time: time.Now().Add(30 * 24 * time.Hour), Valid: true},
+		MobileNumber:     simCard.MobileNumber,
+	})
+	require.NoError(t, err)
+
+	createObservationAt := func(ts time.Time) {
+		_, err := testStore.CreateStationObservation(context.Background(), CreateStationObservationParams{
+			Timestamp: pgtype.Timestamptz{Time: ts, Valid: true},
+			StationID: station.ID,
+		})
+		require.NoError(t, err)
+	}
+	isDue := func(silentSince time.Time) bool {
+		due, err := testStore.ListSimCardsDueForLoad(context.Background(), ListSimCardsDueForLoadParams{
+			RequestedBefore: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+			ExpiresBefore:   pgtype.Timestamptz{Time: time.Now().Add(72 * time.Hour), Valid: true},
+			SilentSince:     pgtype.Timestamptz{Time: silentSince, Valid: true},
+		})
+		require.NoError(t, err)
+		for _, d := range due {
+			if d.MobileNumber == simCard.MobileNumber {
+				return true
+			}
+		}
+		return false
+	}
+
+	createObservationAt(time.Now().Add(-12 * time.Hour))
+	require.True(t, isDue(time.Now().Add(-6*time.Hour)))
+
+	// one top-up per silence episode
+	err = testStore.MarkSimCardLoadRequested(context.Background(), simCard.MobileNumber)
+	require.NoError(t, err)
+	require.False(t, isDue(time.Now().Add(-6*time.Hour)))
+
+	// the station reported after the request and went silent again
+	createObservationAt(time.Now().Add(time.Minute))
+	require.True(t, isDue(time.Now().Add(time.Hour)))
+
+	_, err = testStore.UpdateStation(context.Background(), UpdateStationParams{
+		ID:     station.ID,
+		Status: util.ToPgText("MAINTENANCE"),
+	})
+	require.NoError(t, err)
+	require.False(t, isDue(time.Now().Add(time.Hour)))
+
+	_, err = testStore.UpdateStation(context.Background(), UpdateStationParams{
+		ID:     station.ID,
+		Status: util.ToPgText("OFFLINE"),
+	})
+	require.NoError(t, err)
+	require.True(t, isDue(time.Now().Add(time.Hour)))
+
+	_, err = testStore.DeleteStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.False(t, isDue(time.Now().Add(time.Hour)))
+}
+
 func createRandomSimCard(t *testing.T) SimCard {
 	simCard := randomSimCard()
 	arg := CreateSimCardParams{
 		MobileNumber: simCard.MobileNumber,
 		Type:         simCard.Type,
+		Iccid:        simCard.Iccid,
 	}
 
 	simCard, err := testStore.CreateSimCard(context.Background(), arg)
@@ -46,6 +205,8 @@ func createRandomSimCard(t *testing.T) SimCard {
 
 	require.Equal(t, arg.MobileNumber, simCard.MobileNumber)
 	require.Equal(t, arg.Type, simCard.Type)
+	require.Equal(t, arg.Iccid, simCard.Iccid)
+	require.Equal(t, "ACTIVE", simCard.Status)
 	require.True(t, simCard.UpdatedAt.Time.IsZero())
 	require.True(t, simCard.CreatedAt.Valid)
 	require.NotZero(t, simCard.CreatedAt.Time)
@@ -60,6 +221,10 @@ func randomSimCard() SimCard {
 			String: util.RandomString(6),
 			Valid:  true,
 		},
+		Iccid: pgtype.Text{
+			String: fmt.Sprintf("8963%015d", util.RandomInt(0, int64(999999999999999))),
+			Valid:  true,
+		},
 		CreatedAt: pgtype.Timestamptz{
 			Time:  time.Now(),
 			Valid: true,
@@ -11,34 +11,83 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countSimCards = `-- name: CountSimCards :one
+SELECT count(*) FROM sim_cards
+WHERE
+  (CASE WHEN $1::text IS NOT NULL THEN status = $1 ELSE TRUE END)
+  AND (CASE WHEN $2::text IS NOT NULL THEN type = $2 ELSE TRUE END)
+`
+
+type CountSimCardsParams struct {
+	Status pgtype.Text `json:"status"`
+	Type   pgtype.Text `json:"type"`
+}
+
+func (q *Queries) CountSimCards(ctx context.Context, arg CountSimCardsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSimCards, arg.Status, arg.Type)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createSimCard = `-- name: CreateSimCard :one
 INSERT INTO sim_cards (
   mobile_number,
-  type
+  type,
+  iccid
 ) VALUES (
-  $1, $2
-) RETURNING mobile_number, type, created_at, updated_at
+  $1, $2, $3
+) RETURNING mobile_number, type, created_at, updated_at, load_promo, load_validity_days, load_expires_at, load_requested_at, iccid, status
 `
 
 type CreateSimCardParams struct {
 	MobileNumber string      `json:"mobile_number"`
 	Type         pgtype.Text `json:"type"`
+	Iccid        pgtype.Text `json:"iccid"`
 }
 
 func (q *Queries) CreateSimCard(ctx context.Context, arg CreateSimCardParams) (SimCard, error) {
-	row := q.db.QueryRow(ctx, createSimCard, arg.MobileNumber, arg.Type)
+	row := q.db.QueryRow(ctx, createSimCard, arg.MobileNumber, arg.Type, arg.Iccid)
 	var i SimCard
 	err := row.Scan(
 		&i.MobileNumber,
 		&i.Type,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LoadPromo,
+		&i.LoadValidityDays,
+		&i.LoadExpiresAt,
+		&i.LoadRequestedAt,
+		&i.Iccid,
+		&i.Status,
 	)
 	return i, err
 }
 
+const deleteSimCard = `-- name: DeleteSimCard :exec
+DELETE FROM sim_cards WHERE mobile_number = $1
+`
+
+func (q *Queries) DeleteSimCard(ctx context.Context, mobileNumber string) error {
+	_, err := q.db.Exec(ctx, deleteSimCard, mobileNumber)
+	return err
+}
+
+const extendSimCardLoad = `-- name: ExtendSimCardLoad :exec
+UPDATE sim_cards
+SET
+  load_expires_at = GREATEST(COALESCE(load_expires_at, now()), now()) + make_interval(days => load_validity_days),
+  updated_at = now()
+WHERE mobile_number = $1 AND load_validity_days IS NOT NULL
+`
+
+func (q *Queries) ExtendSimCardLoad(ctx context.Context, mobileNumber string) error {
+	_, err := q.db.Exec(ctx, extendSimCardLoad, mobileNumber)
+	return err
+}
+
 const getSimCard = `-- name: GetSimCard :one
-SELECT mobile_number, type, created_at, updated_at FROM sim_cards
+SELECT mobile_number, type, created_at, updated_at, load_promo, load_validity_days, load_expires_at, load_requested_at, iccid, status FROM sim_cards
 WHERE mobile_number = $1 LIMIT 1
 `
 
@@ -50,6 +99,221 @@ func (q *Queries) GetSimCard(ctx context.Context, mobileNumber string) (SimCard,
 		&i.Type,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LoadPromo,
+		&i.LoadValidityDays,
+		&i.LoadExpiresAt,
+		&i.LoadRequestedAt,
+		&i.Iccid,
+		&i.Status,
+	)
+	return i, err
+}
+
+const listSimCards = `-- name: ListSimCards :many
+SELECT mobile_number, type, created_at, updated_at, load_promo, load_validity_days, load_expires_at, load_requested_at, iccid, status FROM sim_cards
+WHERE
+  (CASE WHEN $1::text IS NOT NULL THEN status = $1 ELSE TRUE END)
+  AND (CASE WHEN $2::text IS NOT NULL THEN type = $2 ELSE TRUE END)
+ORDER BY mobile_number
+LIMIT $4
+OFFSET $3
+`
+
+type ListSimCardsParams struct {
+	Status pgtype.Text `json:"status"`
+	Type   pgtype.Text `json:"type"`
+	Offset int32       `json:"offset"`
+	Limit  pgtype.Int4 `json:"limit"`
+}
+
+func (q *Queries) ListSimCards(ctx context.Context, arg ListSimCardsParams) ([]SimCard, error) {
+	rows, err := q.db.Query(ctx, listSimCards,
+		arg.Status,
+		arg.Type,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SimCard{}
+	for rows.Next() {
+		var i SimCard
+		if err := rows.Scan(
+			&i.MobileNumber,
+			&i.Type,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LoadPromo,
+			&i.LoadValidityDays,
+			&i.LoadExpiresAt,
+			&i.LoadRequestedAt,
+			&i.Iccid,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSimCardsDueForLoad = `-- name: ListSimCardsDueForLoad :many
+SELECT c.mobile_number, c.type, c.created_at, c.updated_at, c.load_promo, c.load_validity_days, c.load_expires_at, c.load_requested_at, c.iccid, c.status FROM sim_cards c
+WHERE c.load_promo IS NOT NULL
+  AND (c.load_requested_at IS NULL OR c.load_requested_at < $1)
+  AND (
+    c.load_expires_at < $2
+    OR EXISTS (
+      SELECT 1 FROM observations_station s
+      WHERE s.mobile_number = c.mobile_number
+        AND s.deleted_at = '0001-01-01 00:00:00Z'
+        AND COALESCE(s.status, '') NOT IN ('INACTIVE', 'MAINTENANCE')
+        AND NOT EXISTS (
+          SELECT 1 FROM observations_observation o
+          WHERE o.station_id = s.id AND o.timestamp >= $3
+        )
+        -- top up once per silence episode: nothing requested since the last observation
+        AND (c.load_requested_at IS NULL OR c.load_requested_at < (
+          SELECT max(o.timestamp) FROM observations_observation o WHERE o.station_id = s.id
+        ))
+    )
+  )
+ORDER BY c.load_expires_at NULLS FIRST
+`
+
+type ListSimCardsDueForLoadParams struct {
+	RequestedBefore pgtype.Timestamptz `json:"requested_before"`
+	ExpiresBefore   pgtype.Timestamptz `json:"expires_before"`
+	SilentSince     pgtype.Timestamptz `json:"silent_since"`
+}
+
+func (q *Queries) ListSimCardsDueForLoad(ctx context.Context, arg ListSimCardsDueForLoadParams) ([]SimCard, error) {
+	rows, err := q.db.Query(ctx, listSimCardsDueForLoad, arg.RequestedBefore, arg.ExpiresBefore, arg.SilentSince)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SimCard{}
+	for rows.Next() {
+		var i SimCard
+		if err := rows.Scan(
+			&i.MobileNumber,
+			&i.Type,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LoadPromo,
+			&i.LoadValidityDays,
+			&i.LoadExpiresAt,
+			&i.LoadRequestedAt,
+			&i.Iccid,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markSimCardLoadRequested = `-- name: MarkSimCardLoadRequested :exec
+UPDATE sim_cards
+SET
+  load_requested_at = now(),
+  updated_at = now()
+WHERE mobile_number = $1
+`
+
+func (q *Queries) MarkSimCardLoadRequested(ctx context.Context, mobileNumber string) error {
+	_, err := q.db.Exec(ctx, markSimCardLoadRequested, mobileNumber)
+	return err
+}
+
+const updateSimCard = `-- name: UpdateSimCard :one
+UPDATE sim_cards
+SET
+  type = COALESCE($1, type),
+  iccid = COALESCE($2, iccid),
+  status = COALESCE($3, status),
+  updated_at = now()
+WHERE mobile_number = $4
+RETURNING mobile_number, type, created_at, updated_at, load_promo, load_validity_days, load_expires_at, load_requested_at, iccid, status
+`
+
+type UpdateSimCardParams struct {
+	Type         pgtype.Text `json:"type"`
+	Iccid        pgtype.Text `json:"iccid"`
+	Status       pgtype.Text `json:"status"`
+	MobileNumber string      `json:"mobile_number"`
+}
+
+func (q *Queries) UpdateSimCard(ctx context.Context, arg UpdateSimCardParams) (SimCard, error) {
+	row := q.db.QueryRow(ctx, updateSimCard,
+		arg.Type,
+		arg.Iccid,
+		arg.Status,
+		arg.MobileNumber,
+	)
+	var i SimCard
+	err := row.Scan(
+		&i.MobileNumber,
+		&i.Type,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LoadPromo,
+		&i.LoadValidityDays,
+		&i.LoadExpiresAt,
+		&i.LoadRequestedAt,
+		&i.Iccid,
+		&i.Status,
+	)
+	return i, err
+}
+
+const updateSimCardLoad = `-- name: UpdateSimCardLoad :one
+UPDATE sim_cards
+SET
+  load_promo = $1,
+  load_validity_days = $2,
+  load_expires_at = $3,
+  updated_at = now()
+WHERE mobile_number = $4
+RETURNING mobile_number, type, created_at, updated_at, load_promo, load_validity_days, load_expires_at, load_requested_at, iccid, status
+`
+
+type UpdateSimCardLoadParams struct {
+	LoadPromo        pgtype.Text        `json:"load_promo"`
+	LoadValidityDays pgtype.Int4        `json:"load_validity_days"`
+	LoadExpiresAt    pgtype.Timestamptz `json:"load_expires_at"`
+	MobileNumber     string             `json:"mobile_number"`
+}
+
+func (q *Queries) UpdateSimCardLoad(ctx context.Context, arg UpdateSimCardLoadParams) (SimCard, error) {
+	row := q.db.QueryRow(ctx, updateSimCardLoad,
+		arg.LoadPromo,
+		arg.LoadValidityDays,
+		arg.LoadExpiresAt,
+		arg.MobileNumber,
+	)
+	var i SimCard
+	err := row.Scan(
+		&i.MobileNumber,
+		&i.Type,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LoadPromo,
+		&i.LoadValidityDays,
+		&i.LoadExpiresAt,
+		&i.LoadRequestedAt,
+		&i.Iccid,
+		&i.Status,
 	)
 	return i, err
 }
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SmsOutboxTestSuite struct {
+	suite.Suite
+}
+
+func TestSmsOutboxTestSuite(t *testing.T) {
+	suite.Run(t, new(SmsOutboxTestSuite))
+}
+
+func (ts *SmsOutboxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *SmsOutboxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *SmsOutboxTestSuite) TestCreateSmsOutbox() {
+	createRandomSmsOutbox(ts.T())
+}
+
+func (ts *SmsOutboxTestSuite) TestClaimDueSmsOutbox() {
+	t := ts.T()
+	due := createRandomSmsOutbox(t)
+	later := createRandomSmsOutbox(t)
+
+	_, err := testStore.UpdateSmsOutboxStatus(context.Background(), UpdateSmsOutboxStatusParams{
+		ID:            later.ID,
+		Status:        later.Status,
+		Attempts:      1,
+		NextAttemptAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	msgs, err := testStore.ClaimDueSmsOutbox(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, due.ID, msgs[0].ID)
+	require.Equal(t, "SENDING", msgs[0].Status)
+	require.True(t, msgs[0].NextAttemptAt.Time.After(time.Now()))
+
+	// a claimed message is not handed to another dispatch
+	msgs, err = testStore.ClaimDueSmsOutbox(context.Background(), 10)
+	require.NoError(t, err)
+	require.Empty(t, msgs)
+}
+
+func (ts *SmsOutboxTestSuite) TestUpdateSmsOutboxStatus() {
+	t := ts.T()
+	msg := createRandomSmsOutbox(t)
+	sentAt := time.Now()
+
+	updated, err := testStore.UpdateSmsOutboxStatus(context.Background(), UpdateSmsOutboxStatusParams{
+		ID:             msg.ID,
+		Status:         "SENT",
+		Attempts:       1,
+		DeliveryStatus: util.ToPgText("DeliveredToNetwork"),
+		SentAt:         pgtype.Timestamptz{Time: sentAt, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "SENT", updated.Status)
+	require.Equal(t, int32(1), updated.Attempts)
+	require.Equal(t, "DeliveredToNetwork", updated.DeliveryStatus.String)
+	require.WithinDuration(t, sentAt, updated.SentAt.Time, time.Second)
+	require.Equal(t, msg.NextAttemptAt.Time.Unix(), updated.NextAttemptAt.Time.Unix())
+
+	count, err := testStore.CountSmsOutbox(context.Background(), CountSmsOutboxParams{
+		Status: util.ToPgText("SENT"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func createRandomSmsOutbox(t *testing.T) SmsOutbox {
+	arg := CreateSmsOutboxParams{
+		MobileNumber: gofakeit.Regex("639[0-9]{9}"),
+		Message:      util.RandomString(40),
+	}
+
+	msg, err := testStore.CreateSmsOutbox(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, msg)
+	require.Equal(t, arg.MobileNumber, msg.MobileNumber)
+	require.Equal(t, arg.Message, msg.Message)
+	require.Equal(t, "QUEUED", msg.Status)
+	require.Zero(t, msg.Attempts)
+
+	return msg
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SmsPartTestSuite struct {
+	suite.Suite
+}
+
+func TestSmsPartTestSuite(t *testing.T) {
+	suite.Run(t, new(SmsPartTestSuite))
+}
+
+func (ts *SmsPartTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *SmsPartTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *SmsPartTestSuite) TestSmsParts() {
+	t := ts.T()
+	sender := gofakeit.Regex("639[0-9]{9}")
+	refID := util.RandomString(12)
+
+	for _, seqNum := range []int32{2, 1, 2} {
+		err := testStore.CreateSmsPart(context.Background(), CreateSmsPartParams{
+			Sender: sender,
+			RefID:  refID,
+			SeqNum: seqNum,
+			Msg:    util.RandomString(16),
+		})
+		require.NoError(t, err)
+	}
+
+	parts, err := testStore.ListSmsParts(context.Background(), ListSmsPartsParams{Sender: sender, RefID: refID})
+	require.NoError(t, err)
+	require.Len(t, parts, 2)
+	require.Equal(t, int32(1), parts[0].SeqNum)
+	require.Equal(t, int32(2), parts[1].SeqNum)
+
+	n, err := testStore.DeleteSmsParts(context.Background(), DeleteSmsPartsParams{Sender: sender, RefID: refID})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	n, err = testStore.DeleteSmsParts(context.Background(), DeleteSmsPartsParams{Sender: sender, RefID: refID})
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func (ts *SmsPartTestSuite) TestDeleteStaleSmsParts() {
+	t := ts.T()
+	sender := gofakeit.Regex("639[0-9]{9}")
+	refID := util.RandomString(12)
+
+	err := testStore.CreateSmsPart(context.Background(), CreateSmsPartParams{
+		Sender: sender,
+		RefID:  refID,
+		SeqNum: 1,
+		Msg:    util.RandomString(16),
+	})
+	require.NoError(t, err)
+
+	err = testStore.DeleteStaleSmsParts(context.Background(), pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true})
+	require.NoError(t, err)
+
+	parts, err := testStore.ListSmsParts(context.Background(), ListSmsPartsParams{Sender: sender, RefID: refID})
+	require.NoError(t, err)
+	require.Empty(t, parts)
+}
@@ -11,64 +11,71 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const countStations = `-- name: CountStations :one
-SELECT count(*) FROM observations_station
-WHERE (CASE WHEN $1::text IS NOT NULL THEN status = $1 ELSE TRUE END)
+const clearStationMobileNumber = `-- name: ClearStationMobileNumber :exec
+UPDATE observations_station
+SET
+  mobile_number = NULL,
+  updated_at = now()
+WHERE mobile_number = $1
 `
 
-func (q *Queries) CountStations(ctx context.Context, status pgtype.Text) (int64, error) {
-	row := q.db.QueryRow(ctx, countStations, status)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+func (q *Queries) ClearStationMobileNumber(ctx context.Context, mobileNumber pgtype.Text) error {
+	_, err := q.db.Exec(ctx, clearStationMobileNumber, mobileNumber)
+	return err
 }
 
-const countStationsWithinBBox = `-- name: CountStationsWithinBBox :one
-SELECT count(*) FROM observations_station
-WHERE geom && ST_MakeEnvelope($1::real, $2::real, $3::real, $4::real, 4326)
-  AND (CASE WHEN $5::text IS NOT NULL THEN status = $5 ELSE TRUE END)
+const countStations = `-- name: CountStations :one
+SELECT count(*) FROM observations_station stn
+WHERE station_matches(stn,
+  $1::text, $2::text,
+  $3::text, $4::text,
+  $5::text, $6::text,
+  $7::text, $8::text,
+  $9::date, $10::date,
+  $11::real, $12::real, $13::real,
+  $14::real, $15::real, $16::real, $17::real
+)
 `
 
-type CountStationsWithinBBoxParams struct {
-	Xmin   float32     `json:"xmin"`
-	Ymin   float32     `json:"ymin"`
-	Xmax   float32     `json:"xmax"`
-	Ymax   float32     `json:"ymax"`
-	Status pgtype.Text `json:"status"`
+type CountStationsParams struct {
+	Status        pgtype.Text   `json:"status"`
+	Q             pgtype.Text   `json:"q"`
+	StationType   pgtype.Text   `json:"station_type"`
+	StationType2  pgtype.Text   `json:"station_type2"`
+	Province      pgtype.Text   `json:"province"`
+	Region        pgtype.Text   `json:"region"`
+	ProviderID    pgtype.Text   `json:"provider_id"`
+	PriorityLevel pgtype.Text   `json:"priority_level"`
+	InstalledFrom pgtype.Date   `json:"installed_from"`
+	InstalledTo   pgtype.Date   `json:"installed_to"`
+	Cx            pgtype.Float4 `json:"cx"`
+	Cy            pgtype.Float4 `json:"cy"`
+	R             pgtype.Float4 `json:"r"`
+	Xmin          pgtype.Float4 `json:"xmin"`
+	Ymin          pgtype.Float4 `json:"ymin"`
+	Xmax          pgtype.Float4 `json:"xmax"`
+	Ymax          pgtype.Float4 `json:"ymax"`
 }
 
-func (q *Queries) CountStationsWithinBBox(ctx context.Context, arg CountStationsWithinBBoxParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countStationsWithinBBox,
-		arg.Xmin,
-		arg.Ymin,
-		arg.Xmax,
-		arg.Ymax,
+func (q *Queries) CountStations(ctx context.Context, arg CountStationsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countStations,
 		arg.Status,
-	)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
-}
-
-const countStationsWithinRadius = `-- name: CountStationsWithinRadius :one
-SELECT count(*) FROM observations_station
-WHERE ST_DWithin(geom, ST_Point($1::real, $2::real, 4326), $3::real)
-  AND (CASE WHEN $4::text IS NOT NULL THEN status = $4 ELSE TRUE END)
-`
-
-type CountStationsWithinRadiusParams struct {
-	Cx     float32     `json:"cx"`
-	Cy     float32     `json:"cy"`
-	R      float32     `json:"r"`
-	Status pgtype.Text `json:"status"`
-}
-
-func (q *Queries) CountStationsWithinRadius(ctx context.Context, arg CountStationsWithinRadiusParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countStationsWithinRadius,
+		arg.Q,
+		arg.StationType,
+		arg.StationType2,
+		arg.Province,
+		arg.Region,
+		arg.ProviderID,
+		arg.PriorityLevel,
+		arg.InstalledFrom,
+		arg.InstalledTo,
 		arg.Cx,
 		arg.Cy,
 		arg.R,
-		arg.Status,
+		arg.Xmin,
+		arg.Ymin,
+		arg.Xmax,
+		arg.Ymax,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -95,35 +102,37 @@ INSERT INTO observations_station (
   province,
   region,
   address,
+  reporting_interval,
   geom
 ) VALUES (
-  $1, $17, $18, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, 
+  $1, $18, $19, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
   CASE
-    WHEN $18::real IS NOT NULL AND $17::real IS NOT NULL THEN ST_Point($18::real, $17::real, 4326)
+    WHEN $19::real IS NOT NULL AND $18::real IS NOT NULL THEN ST_Point($19::real, $18::real, 4326)
     ELSE ST_GeomFromEWKT('POINT EMPTY')
   END
-) RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom
+) RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, reporting_interval
 `
 
 type CreateStationParams struct {
-	Name          string        `json:"name"`
-	Elevation     pgtype.Float4 `json:"elevation"`
-	DateInstalled pgtype.Date   `json:"date_installed"`
-	MoStationID   pgtype.Text   `json:"mo_station_id"`
-	SmsSystemType pgtype.Text   `json:"sms_system_type"`
-	MobileNumber  pgtype.Text   `json:"mobile_number"`
-	StationType   pgtype.Text   `json:"station_type"`
-	StationType2  pgtype.Text   `json:"station_type2"`
-	StationUrl    pgtype.Text   `json:"station_url"`
-	Status        pgtype.Text   `json:"status"`
-	LoggerVersion pgtype.Text   `json:"logger_version"`
-	PriorityLevel pgtype.Text   `json:"priority_level"`
-	ProviderID    pgtype.Text   `json:"provider_id"`
-	Province      pgtype.Text   `json:"province"`
-	Region        pgtype.Text   `json:"region"`
-	Address       pgtype.Text   `json:"address"`
-	Lat           pgtype.Float4 `json:"lat"`
-	Lon           pgtype.Float4 `json:"lon"`
+	Name              string        `json:"name"`
+	Elevation         pgtype.Float4 `json:"elevation"`
+	DateInstalled     pgtype.Date   `json:"date_installed"`
+	MoStationID       pgtype.Text   `json:"mo_station_id"`
+	SmsSystemType     pgtype.Text   `json:"sms_system_type"`
+	MobileNumber      pgtype.Text   `json:"mobile_number"`
+	StationType       pgtype.Text   `json:"station_type"`
+	StationType2      pgtype.Text   `json:"station_type2"`
+	StationUrl        pgtype.Text   `json:"station_url"`
+	Status            pgtype.Text   `json:"status"`
+	LoggerVersion     pgtype.Text   `json:"logger_version"`
+	PriorityLevel     pgtype.Text   `json:"priority_level"`
+	ProviderID        pgtype.Text   `json:"provider_id"`
+	Province          pgtype.Text   `json:"province"`
+	Region            pgtype.Text   `json:"region"`
+	Address           pgtype.Text   `json:"address"`
+	ReportingInterval pgtype.Int4   `json:"reporting_interval"`
+	Lat               pgtype.Float4 `json:"lat"`
+	Lon               pgtype.Float4 `json:"lon"`
 }
 
 func (q *Queries) CreateStation(ctx context.Context, arg CreateStationParams) (ObservationsStation, error) {
@@ -144,6 +153,7 @@ func (q *Queries) CreateStation(ctx context.Context, arg CreateStationParams) (O
 		arg.Province,
 		arg.Region,
 		arg.Address,
+		arg.ReportingInterval,
 		arg.Lat,
 		arg.Lon,
 	)
@@ -172,22 +182,67 @@ func (q *Queries) CreateStation(ctx context.Context, arg CreateStationParams) (O
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Geom,
+		&i.ReportingInterval,
 	)
 	return i, err
 }
 
-const deleteStation = `-- name: DeleteStation :exec
-DELETE FROM observations_station WHERE id = $1
+const deleteStation = `-- name: DeleteStation :execrows
+UPDATE observations_station
+SET
+  deleted_at = now(),
+  updated_at = now()
+WHERE id = $1 AND deleted_at = '0001-01-01 00:00:00Z'
 `
 
-func (q *Queries) DeleteStation(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, deleteStation, id)
-	return err
+func (q *Queries) DeleteStation(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteStation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getDeletedStation = `-- name: GetDeletedStation :one
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, reporting_interval FROM observations_station
+WHERE id = $1 AND deleted_at <> '0001-01-01 00:00:00Z' LIMIT 1
+`
+
+func (q *Queries) GetDeletedStation(ctx context.Context, id int64) (ObservationsStation, error) {
+	row := q.db.QueryRow(ctx, getDeletedStation, id)
+	var i ObservationsStation
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Lat,
+		&i.Lon,
+		&i.Elevation,
+		&i.DateInstalled,
+		&i.MoStationID,
+		&i.SmsSystemType,
+		&i.MobileNumber,
+		&i.StationType,
+		&i.StationType2,
+		&i.StationUrl,
+		&i.Status,
+		&i.LoggerVersion,
+		&i.PriorityLevel,
+		&i.ProviderID,
+		&i.Province,
+		&i.Region,
+		&i.Address,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Geom,
+		&i.ReportingInterval,
+	)
+	return i, err
 }
 
 const getStation = `-- name: GetStation :one
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
-WHERE id = $1 LIMIT 1
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, reporting_interval FROM observations_station
+WHERE id = $1 AND deleted_at = '0001-01-01 00:00:00Z' LIMIT 1
 `
 
 func (q *Queries) GetStation(ctx context.Context, id int64) (ObservationsStation, error) {
@@ -217,13 +272,14 @@ func (q *Queries) GetStation(ctx context.Context, id int64) (ObservationsStation
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Geom,
+		&i.ReportingInterval,
 	)
 	return i, err
 }
 
 const getStationByMobileNumber = `-- name: GetStationByMobileNumber :one
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
-WHERE mobile_number = $1 LIMIT 1
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, reporting_interval FROM observations_station
+WHERE mobile_number = $1 AND deleted_at = '0001-01-01 00:00:00Z' LIMIT 1
 `
 
 func (q *Queries) GetStationByMobileNumber(ctx context.Context, mobileNumber pgtype.Text) (ObservationsStation, error) {
@@ -253,27 +309,95 @@ func (q *Queries) GetStationByMobileNumber(ctx context.Context, mobileNumber pgt
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Geom,
+		&i.ReportingInterval,
 	)
 	return i, err
 }
 
+const getStationStatusForUpdate = `-- name: GetStationStatusForUpdate :one
+SELECT status FROM observations_station
+WHERE id = $1 AND deleted_at = '0001-01-01 00:00:00Z'
+FOR UPDATE
+`
+
+func (q *Queries) GetStationStatusForUpdate(ctx context.Context, id int64) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getStationStatusForUpdate, id)
+	var status pgtype.Text
+	err := row.Scan(&status)
+	return status, err
+}
+
 const listStations = `-- name: ListStations :many
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
-WHERE
-  (CASE WHEN $1::text IS NOT NULL THEN status = $1 ELSE TRUE END)
-ORDER BY id
-LIMIT $3
-OFFSET $2
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, reporting_interval FROM observations_station stn
+WHERE station_matches(stn,
+  $1::text, $2::text,
+  $3::text, $4::text,
+  $5::text, $6::text,
+  $7::text, $8::text,
+  $9::date, $10::date,
+  $11::real, $12::real, $13::real,
+  $14::real, $15::real, $16::real, $17::real
+)
+ORDER BY
+  CASE WHEN $18::text = 'relevance' THEN similarity(name, $2) END DESC,
+  CASE WHEN $18 = 'name' THEN name END,
+  CASE WHEN $18 = '-name' THEN name END DESC NULLS LAST,
+  CASE WHEN $18 = 'date_installed' THEN date_installed END,
+  CASE WHEN $18 = '-date_installed' THEN date_installed END DESC NULLS LAST,
+  CASE WHEN $18 = 'priority_level' THEN priority_level END,
+  CASE WHEN $18 = '-priority_level' THEN priority_level END DESC NULLS LAST,
+  CASE WHEN $18 = '-id' THEN id END DESC,
+  id
+LIMIT $20
+OFFSET $19
 `
 
 type ListStationsParams struct {
-	Status pgtype.Text `json:"status"`
-	Offset int32       `json:"offset"`
-	Limit  pgtype.Int4 `json:"limit"`
+	Status        pgtype.Text   `json:"status"`
+	Q             pgtype.Text   `json:"q"`
+	StationType   pgtype.Text   `json:"station_type"`
+	StationType2  pgtype.Text   `json:"station_type2"`
+	Province      pgtype.Text   `json:"province"`
+	Region        pgtype.Text   `json:"region"`
+	ProviderID    pgtype.Text   `json:"provider_id"`
+	PriorityLevel pgtype.Text   `json:"priority_level"`
+	InstalledFrom pgtype.Date   `json:"installed_from"`
+	InstalledTo   pgtype.Date   `json:"installed_to"`
+	Cx            pgtype.Float4 `json:"cx"`
+	Cy            pgtype.Float4 `json:"cy"`
+	R             pgtype.Float4 `json:"r"`
+	Xmin          pgtype.Float4 `json:"xmin"`
+	Ymin          pgtype.Float4 `json:"ymin"`
+	Xmax          pgtype.Float4 `json:"xmax"`
+	Ymax          pgtype.Float4 `json:"ymax"`
+	Sort          pgtype.Text   `json:"sort"`
+	Offset        int32         `json:"offset"`
+	Limit         pgtype.Int4   `json:"limit"`
 }
 
 func (q *Queries) ListStations(ctx context.Context, arg ListStationsParams) ([]ObservationsStation, error) {
-	rows, err := q.db.Query(ctx, listStations, arg.Status, arg.Offset, arg.Limit)
+	rows, err := q.db.Query(ctx, listStations,
+		arg.Status,
+		arg.Q,
+		arg.StationType,
+		arg.StationType2,
+		arg.Province,
+		arg.Region,
+		arg.ProviderID,
+		arg.PriorityLevel,
+		arg.InstalledFrom,
+		arg.InstalledTo,
+		arg.Cx,
+		arg.Cy,
+		arg.R,
+		arg.Xmin,
+		arg.Ymin,
+		arg.Xmax,
+		arg.Ymax,
+		arg.Sort,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -305,6 +429,7 @@ func (q *Queries) ListStations(ctx context.Context, arg ListStationsParams) ([]O
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.Geom,
+			&i.ReportingInterval,
 		); err != nil {
 			return nil, err
 		}
@@ -316,35 +441,15 @@ func (q *Queries) ListStations(ctx context.Context, arg ListStationsParams) ([]O
 	return items, nil
 }
 
-const listStationsWithinBBox = `-- name: ListStationsWithinBBox :many
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
-WHERE geom && ST_MakeEnvelope($1::real, $2::real, $3::real, $4::real, 4326)
-  AND (CASE WHEN $5::text IS NOT NULL THEN status = $5 ELSE TRUE END)
+const listStationsByMobileNumbers = `-- name: ListStationsByMobileNumbers :many
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, reporting_interval FROM observations_station
+WHERE mobile_number = ANY($1::text[])
+  AND deleted_at = '0001-01-01 00:00:00Z'
 ORDER BY id
-LIMIT $7
-OFFSET $6
 `
 
-type ListStationsWithinBBoxParams struct {
-	Xmin   float32     `json:"xmin"`
-	Ymin   float32     `json:"ymin"`
-	Xmax   float32     `json:"xmax"`
-	Ymax   float32     `json:"ymax"`
-	Status pgtype.Text `json:"status"`
-	Offset int32       `json:"offset"`
-	Limit  pgtype.Int4 `json:"limit"`
-}
-
-func (q *Queries) ListStationsWithinBBox(ctx context.Context, arg ListStationsWithinBBoxParams) ([]ObservationsStation, error) {
-	rows, err := q.db.Query(ctx, listStationsWithinBBox,
-		arg.Xmin,
-		arg.Ymin,
-		arg.Xmax,
-		arg.Ymax,
-		arg.Status,
-		arg.Offset,
-		arg.Limit,
-	)
+func (q *Queries) ListStationsByMobileNumbers(ctx context.Context, mobileNumbers []string) ([]ObservationsStation, error) {
+	rows, err := q.db.Query(ctx, listStationsByMobileNumbers, mobileNumbers)
 	if err != nil {
 		return nil, err
 	}
@@ -376,6 +481,7 @@ func (q *Queries) ListStationsWithinBBox(ctx context.Context, arg ListStationsWi
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.Geom,
+			&i.ReportingInterval,
 		); err != nil {
 			return nil, err
 		}
@@ -387,64 +493,40 @@ func (q *Queries) ListStationsWithinBBox(ctx context.Context, arg ListStationsWi
 	return items, nil
 }
 
-const listStationsWithinRadius = `-- name: ListStationsWithinRadius :many
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
-WHERE ST_DWithin(geom, ST_Point($1::real, $2::real, 4326), $3::real)
-  AND (CASE WHEN $4::text IS NOT NULL THEN status = $4 ELSE TRUE END)
-ORDER BY id
-LIMIT $6
-OFFSET $5
+const listStationsLastObserved = `-- name: ListStationsLastObserved :many
+SELECT
+  s.id,
+  s.station_type,
+  s.status,
+  max(c.timestamp) AS last_observed_at
+FROM observations_station s
+LEFT JOIN observations_current c ON c.station_id = s.id
+WHERE s.deleted_at = '0001-01-01 00:00:00Z'
+GROUP BY s.id
+ORDER BY s.id
 `
 
-type ListStationsWithinRadiusParams struct {
-	Cx     float32     `json:"cx"`
-	Cy     float32     `json:"cy"`
-	R      float32     `json:"r"`
-	Status pgtype.Text `json:"status"`
-	Offset int32       `json:"offset"`
-	Limit  pgtype.Int4 `json:"limit"`
+type ListStationsLastObservedRow struct {
+	ID             int64              `json:"id"`
+	StationType    pgtype.Text        `json:"station_type"`
+	Status         pgtype.Text        `json:"status"`
+	LastObservedAt pgtype.Timestamptz `json:"last_observed_at"`
 }
 
-func (q *Queries) ListStationsWithinRadius(ctx context.Context, arg ListStationsWithinRadiusParams) ([]ObservationsStation, error) {
-	rows, err := q.db.Query(ctx, listStationsWithinRadius,
-		arg.Cx,
-		arg.Cy,
-		arg.R,
-		arg.Status,
-		arg.Offset,
-		arg.Limit,
-	)
+func (q *Queries) ListStationsLastObserved(ctx context.Context) ([]ListStationsLastObservedRow, error) {
+	rows, err := q.db.Query(ctx, listStationsLastObserved)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ObservationsStation{}
+	items := []ListStationsLastObservedRow{}
 	for rows.Next() {
-		var i ObservationsStation
+		var i ListStationsLastObservedRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Lat,
-			&i.Lon,
-			&i.Elevation,
-			&i.DateInstalled,
-			&i.MoStationID,
-			&i.SmsSystemType,
-			&i.MobileNumber,
 			&i.StationType,
-			&i.StationType2,
-			&i.StationUrl,
 			&i.Status,
-			&i.LoggerVersion,
-			&i.PriorityLevel,
-			&i.ProviderID,
-			&i.Province,
-			&i.Region,
-			&i.Address,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.DeletedAt,
-			&i.Geom,
+			&i.LastObservedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -456,6 +538,56 @@ func (q *Queries) ListStationsWithinRadius(ctx context.Context, arg ListStations
 	return items, nil
 }
 
+const purgeStation = `-- name: PurgeStation :exec
+DELETE FROM observations_station WHERE id = $1 AND deleted_at <> '0001-01-01 00:00:00Z'
+`
+
+func (q *Queries) PurgeStation(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, purgeStation, id)
+	return err
+}
+
+const restoreStation = `-- name: RestoreStation :one
+UPDATE observations_station
+SET
+  deleted_at = '0001-01-01 00:00:00Z',
+  updated_at = now()
+WHERE id = $1 AND deleted_at <> '0001-01-01 00:00:00Z'
+RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, reporting_interval
+`
+
+func (q *Queries) RestoreStation(ctx context.Context, id int64) (ObservationsStation, error) {
+	row := q.db.QueryRow(ctx, restoreStation, id)
+	var i ObservationsStation
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Lat,
+		&i.Lon,
+		&i.Elevation,
+		&i.DateInstalled,
+		&i.MoStationID,
+		&i.SmsSystemType,
+		&i.MobileNumber,
+		&i.StationType,
+		&i.StationType2,
+		&i.StationUrl,
+		&i.Status,
+		&i.LoggerVersion,
+		&i.PriorityLevel,
+		&i.ProviderID,
+		&i.Province,
+		&i.Region,
+		&i.Address,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Geom,
+		&i.ReportingInterval,
+	)
+	return i, err
+}
+
 const updateStation = `-- name: UpdateStation :one
 UPDATE observations_station
 SET
@@ -477,32 +609,34 @@ SET
   province = COALESCE($16, province),
   region = COALESCE($17, region),
   address = COALESCE($18, address),
+  reporting_interval = COALESCE($19, reporting_interval),
   geom = COALESCE(ST_POINT($3, $2, 4326), geom),
   updated_at = now()
-WHERE id = $19
-RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom
+WHERE id = $20 AND deleted_at = '0001-01-01 00:00:00Z'
+RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, reporting_interval
 `
 
 type UpdateStationParams struct {
-	Name          pgtype.Text   `json:"name"`
-	Lat           pgtype.Float4 `json:"lat"`
-	Lon           pgtype.Float4 `json:"lon"`
-	Elevation     pgtype.Float4 `json:"elevation"`
-	DateInstalled pgtype.Date   `json:"date_installed"`
-	MoStationID   pgtype.Text   `json:"mo_station_id"`
-	SmsSystemType pgtype.Text   `json:"sms_system_type"`
-	MobileNumber  pgtype.Text   `json:"mobile_number"`
-	StationType   pgtype.Text   `json:"station_type"`
-	StationType2  pgtype.Text   `json:"station_type2"`
-	StationUrl    pgtype.Text   `json:"station_url"`
-	Status        pgtype.Text   `json:"status"`
-	LoggerVersion pgtype.Text   `json:"logger_version"`
-	PriorityLevel pgtype.Text   `json:"priority_level"`
-	ProviderID    pgtype.Text   `json:"provider_id"`
-	Province      pgtype.Text   `json:"province"`
-	Region        pgtype.Text   `json:"region"`
-	Address       pgtype.Text   `json:"address"`
-	ID            int64         `json:"id"`
+	Name              pgtype.Text   `json:"name"`
+	Lat               pgtype.Float4 `json:"lat"`
+	Lon               pgtype.Float4 `json:"lon"`
+	Elevation         pgtype.Float4 `json:"elevation"`
+	DateInstalled     pgtype.Date   `json:"date_installed"`
+	MoStationID       pgtype.Text   `json:"mo_station_id"`
+	SmsSystemType     pgtype.Text   `json:"sms_system_type"`
+	MobileNumber      pgtype.Text   `json:"mobile_number"`
+	StationType       pgtype.Text   `json:"station_type"`
+	StationType2      pgtype.Text   `json:"station_type2"`
+	StationUrl        pgtype.Text   `json:"station_url"`
+	Status            pgtype.Text   `json:"status"`
+	LoggerVersion     pgtype.Text   `json:"logger_version"`
+	PriorityLevel     pgtype.Text   `json:"priority_level"`
+	ProviderID        pgtype.Text   `json:"provider_id"`
+	Province          pgtype.Text   `json:"province"`
+	Region            pgtype.Text   `json:"region"`
+	Address           pgtype.Text   `json:"address"`
+	ReportingInterval pgtype.Int4   `json:"reporting_interval"`
+	ID                int64         `json:"id"`
 }
 
 func (q *Queries) UpdateStation(ctx context.Context, arg UpdateStationParams) (ObservationsStation, error) {
@@ -525,6 +659,7 @@ func (q *Queries) UpdateStation(ctx context.Context, arg UpdateStationParams) (O
 		arg.Province,
 		arg.Region,
 		arg.Address,
+		arg.ReportingInterval,
 		arg.ID,
 	)
 	var i ObservationsStation
@@ -552,6 +687,7 @@ func (q *Queries) UpdateStation(ctx context.Context, arg UpdateStationParams) (O
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Geom,
+		&i.ReportingInterval,
 	)
 	return i, err
 }
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationClockTestSuite struct {
+	suite.Suite
+}
+
+func TestStationClockTestSuite(t *testing.T) {
+	suite.Run(t, new(StationClockTestSuite))
+}
+
+func (ts *StationClockTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationClockTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationClockTestSuite) TestUpsertStationClock() {
+	t := ts.T()
+	clock := createRandomStationClock(t)
+
+	arg := UpsertStationClockParams{
+		StationID:   clock.StationID,
+		Timezone:    "UTC",
+		ClockOffset: clock.ClockOffset + 1,
+	}
+	gotClock, err := testStore.UpsertStationClock(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, clock.ID, gotClock.ID)
+	require.Equal(t, arg.Timezone, gotClock.Timezone)
+	require.Equal(t, arg.ClockOffset, gotClock.ClockOffset)
+	require.False(t, gotClock.UpdatedAt.Time.IsZero())
+}
+
+func (ts *StationClockTestSuite) TestGetStationClock() {
+	t := ts.T()
+	clock := createRandomStationClock(t)
+
+	gotClock, err := testStore.GetStationClock(context.Background(), clock.StationID)
+	require.NoError(t, err)
+	require.Equal(t, clock, gotClock)
+}
+
+func (ts *StationClockTestSuite) TestDeleteStationClock() {
+	t := ts.T()
+	clock := createRandomStationClock(t)
+
+	err := testStore.DeleteStationClock(context.Background(), clock.StationID)
+	require.NoError(t, err)
+
+	gotClock, err := testStore.GetStationClock(context.Background(), clock.StationID)
+	require.Error(t, err)
+	require.Empty(t, gotClock)
+}
+
+func (ts *StationClockTestSuite) TestListStationClockDrift() {
+	t := ts.T()
+	stn := createRandomStation(t, false)
+	otherStn := createRandomStation(t, false)
+
+	now := time.Now()
+	for i, diff := range []int32{118, 120, 122} {
+		createStationHealthWithDrift(t, stn.ID, now.Add(-time.Duration(i)*time.Hour), diff)
+		createStationHealthWithDrift(t, otherStn.ID, now.Add(-time.Duration(i)*time.Hour), 1)
+	}
+
+	arg := ListStationClockDriftParams{
+		MinDrift: 10,
+		Limit:    pgtype.Int4{Int32: 10, Valid: true},
+	}
+	rows, err := testStore.ListStationClockDrift(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, stn.ID, rows[0].StationID)
+	require.Equal(t, int64(3), rows[0].SampleCount)
+	require.InDelta(t, 120, rows[0].MedianMinutesDifference, 0.01)
+	require.Equal(t, int32(118), rows[0].MinMinutesDifference)
+	require.Equal(t, int32(122), rows[0].MaxMinutesDifference)
+
+	count, err := testStore.CountStationClockDrift(context.Background(), CountStationClockDriftParams{MinDrift: 10})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	_, err = testStore.UpsertStationClock(context.Background(), UpsertStationClockParams{
+		StationID:   stn.ID,
+		Timezone:    "Asia/Manila",
+		ClockOffset: 120,
+	})
+	require.NoError(t, err)
+
+	rows, err = testStore.ListStationClockDrift(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	// deleted stations are left out
+	_, err = testStore.UpsertStationClock(context.Background(), UpsertStationClockParams{
+		StationID: stn.ID,
+		Timezone:  "Asia/Manila",
+	})
+	require.NoError(t, err)
+	_, err = testStore.DeleteStation(context.Background(), stn.ID)
+	require.NoError(t, err)
+
+	rows, err = testStore.ListStationClockDrift(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	count, err = testStore.CountStationClockDrift(context.Background(), CountStationClockDriftParams{MinDrift: 10})
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func createRandomStationClock(t *testing.T) StationClock {
+	stn := createRandomStation(t, false)
+
+	arg := UpsertStationClockParams{
+		StationID:   stn.ID,
+		Timezone:    "Asia/Manila",
+		ClockOffset: util.RandomInt[int32](-60, 60),
+	}
+
+	clock, err := testStore.UpsertStationClock(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, clock)
+
+	require.Equal(t, arg.StationID, clock.StationID)
+	require.Equal(t, arg.Timezone, clock.Timezone)
+	require.Equal(t, arg.ClockOffset, clock.ClockOffset)
+
+	return clock
+}
+
+func createStationHealthWithDrift(t *testing.T, stationID int64, timestamp time.Time, minutesDiff int32) {
+	_, err := testStore.CreateStationHealth(context.Background(), CreateStationHealthParams{
+		StationID:         stationID,
+		MinutesDifference: pgtype.Int4{Int32: minutesDiff, Valid: true},
+		Timestamp:         pgtype.Timestamptz{Time: timestamp, Valid: true},
+	})
+	require.NoError(t, err)
+}
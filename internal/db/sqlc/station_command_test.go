@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationCommandTestSuite struct {
+	suite.Suite
+}
+
+func TestStationCommandTestSuite(t *testing.T) {
+	suite.Run(t, new(StationCommandTestSuite))
+}
+
+func (ts *StationCommandTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationCommandTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationCommandTestSuite) TestCreateStationCommandTx() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	mobileNumber := gofakeit.Regex("639[0-9]{9}")
+
+	result, err := testStore.CreateStationCommandTx(context.Background(), CreateStationCommandTxParams{
+		CreateStationCommandParams: CreateStationCommandParams{
+			StationID: station.ID,
+			Command:   "INTERVAL",
+			Interval:  pgtype.Int4{Int32: 10, Valid: true},
+		},
+		MobileNumber: mobileNumber,
+		FormatMessage: func(cmd StationCommand) (string, error) {
+			return fmt.Sprintf("CMD,%d,%s,%d", cmd.ID, cmd.Command, cmd.Interval.Int32), nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("CMD,%d,INTERVAL,10", result.Command.ID), result.Command.Message)
+	require.Equal(t, "PENDING", result.Command.Status)
+	require.Equal(t, result.Sms.ID, result.Command.SmsOutboxID.Int64)
+	require.Equal(t, mobileNumber, result.Sms.MobileNumber)
+	require.Equal(t, result.Command.Message, result.Sms.Message)
+
+	cmds, err := testStore.ListStationCommands(context.Background(), ListStationCommandsParams{StationID: station.ID})
+	require.NoError(t, err)
+	require.Len(t, cmds, 1)
+	require.Equal(t, "QUEUED", cmds[0].SmsStatus.String)
+}
+
+func (ts *StationCommandTestSuite) TestCreateStationCommandTxFormatError() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+
+	_, err := testStore.CreateStationCommandTx(context.Background(), CreateStationCommandTxParams{
+		CreateStationCommandParams: CreateStationCommandParams{StationID: station.ID, Command: "FORMAT"},
+		MobileNumber:               gofakeit.Regex("639[0-9]{9}"),
+		FormatMessage: func(cmd StationCommand) (string, error) {
+			return "", fmt.Errorf("unknown command: %s", cmd.Command)
+		},
+	})
+	require.Error(t, err)
+
+	count, err := testStore.CountStationCommands(context.Background(), CountStationCommandsParams{StationID: station.ID})
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func (ts *StationCommandTestSuite) TestAcknowledgeStationCommand() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+
+	var cmds []StationCommand
+	for range 2 {
+		cmd, err := testStore.CreateStationCommand(context.Background(), CreateStationCommandParams{
+			StationID: station.ID,
+			Command:   "REBOOT",
+		})
+		require.NoError(t, err)
+		cmds = append(cmds, cmd)
+	}
+
+	outstanding, err := testStore.GetOutstandingStationCommand(context.Background(), GetOutstandingStationCommandParams{
+		StationID: station.ID,
+		Command:   "REBOOT",
+	})
+	require.NoError(t, err)
+	require.Equal(t, cmds[0].ID, outstanding.ID)
+
+	acked, err := testStore.AcknowledgeStationCommand(context.Background(), AcknowledgeStationCommandParams{
+		Status:     "REJECTED",
+		AckMessage: util.ToPgText("busy"),
+		ID:         outstanding.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "REJECTED", acked.Status)
+	require.Equal(t, "busy", acked.AckMessage.String)
+	require.True(t, acked.AcknowledgedAt.Valid)
+
+	_, err = testStore.AcknowledgeStationCommand(context.Background(), AcknowledgeStationCommandParams{
+		Status: "ACKNOWLEDGED",
+		ID:     outstanding.ID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	outstanding, err = testStore.GetOutstandingStationCommand(context.Background(), GetOutstandingStationCommandParams{
+		StationID: station.ID,
+		Command:   "REBOOT",
+	})
+	require.NoError(t, err)
+	require.Equal(t, cmds[1].ID, outstanding.ID)
+}
@@ -153,22 +153,216 @@ func (q *Queries) GetStationHealth(ctx context.Context, arg GetStationHealthPara
 	return i, err
 }
 
+const getStationHealthSummary = `-- name: GetStationHealthSummary :one
+SELECT
+  count(*) AS count,
+  min(timestamp) AS first_timestamp,
+  max(timestamp) AS last_timestamp,
+  min(vb1) AS vb1_min,
+  max(vb1) AS vb1_max,
+  avg(vb1) AS vb1_avg,
+  min(vb2) AS vb2_min,
+  max(vb2) AS vb2_max,
+  avg(vb2) AS vb2_avg,
+  min(curr) AS curr_min,
+  max(curr) AS curr_max,
+  avg(curr) AS curr_avg,
+  min(bp1) AS bp1_min,
+  max(bp1) AS bp1_max,
+  avg(bp1) AS bp1_avg,
+  min(bp2) AS bp2_min,
+  max(bp2) AS bp2_max,
+  avg(bp2) AS bp2_avg,
+  min(ss) AS ss_min,
+  max(ss) AS ss_max,
+  avg(ss::real) AS ss_avg,
+  min(temp_arq) AS temp_arq_min,
+  max(temp_arq) AS temp_arq_max,
+  avg(temp_arq) AS temp_arq_avg,
+  min(rh_arq) AS rh_arq_min,
+  max(rh_arq) AS rh_arq_max,
+  avg(rh_arq) AS rh_arq_avg,
+  COALESCE((array_agg(data_status ORDER BY timestamp DESC))[1], '')::text AS last_data_status
+FROM observations_stationhealth
+WHERE station_id = $1
+  AND (CASE WHEN $2::bool THEN timestamp >= $3 ELSE TRUE END)
+  AND (CASE WHEN $4::bool THEN timestamp <= $5 ELSE TRUE END)
+`
+
+type GetStationHealthSummaryParams struct {
+	StationID   int64              `json:"station_id"`
+	IsStartDate bool               `json:"is_start_date"`
+	StartDate   pgtype.Timestamptz `json:"start_date"`
+	IsEndDate   bool               `json:"is_end_date"`
+	EndDate     pgtype.Timestamptz `json:"end_date"`
+}
+
+type GetStationHealthSummaryRow struct {
+	Count          int64              `json:"count"`
+	FirstTimestamp pgtype.Timestamptz `json:"first_timestamp"`
+	LastTimestamp  pgtype.Timestamptz `json:"last_timestamp"`
+	Vb1Min         pgtype.Float4      `json:"vb1_min"`
+	Vb1Max         pgtype.Float4      `json:"vb1_max"`
+	Vb1Avg         pgtype.Float8      `json:"vb1_avg"`
+	Vb2Min         pgtype.Float4      `json:"vb2_min"`
+	Vb2Max         pgtype.Float4      `json:"vb2_max"`
+	Vb2Avg         pgtype.Float8      `json:"vb2_avg"`
+	CurrMin        pgtype.Float4      `json:"curr_min"`
+	CurrMax        pgtype.Float4      `json:"curr_max"`
+	CurrAvg        pgtype.Float8      `json:"curr_avg"`
+	Bp1Min         pgtype.Float4      `json:"bp1_min"`
+	Bp1Max         pgtype.Float4      `json:"bp1_max"`
+	Bp1Avg         pgtype.Float8      `json:"bp1_avg"`
+	Bp2Min         pgtype.Float4      `json:"bp2_min"`
+	Bp2Max         pgtype.Float4      `json:"bp2_max"`
+	Bp2Avg         pgtype.Float8      `json:"bp2_avg"`
+	SsMin          pgtype.Int4        `json:"ss_min"`
+	SsMax          pgtype.Int4        `json:"ss_max"`
+	SsAvg          pgtype.Float8      `json:"ss_avg"`
+	TempArqMin     pgtype.Float4      `json:"temp_arq_min"`
+	TempArqMax     pgtype.Float4      `json:"temp_arq_max"`
+	TempArqAvg     pgtype.Float8      `json:"temp_arq_avg"`
+	RhArqMin       pgtype.Float4      `json:"rh_arq_min"`
+	RhArqMax       pgtype.Float4      `json:"rh_arq_max"`
+	RhArqAvg       pgtype.Float8      `json:"rh_arq_avg"`
+	LastDataStatus string             `json:"last_data_status"`
+}
+
+func (q *Queries) GetStationHealthSummary(ctx context.Context, arg GetStationHealthSummaryParams) (GetStationHealthSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getStationHealthSummary,
+		arg.StationID,
+		arg.IsStartDate,
+		arg.StartDate,
+		arg.IsEndDate,
+		arg.EndDate,
+	)
+	var i GetStationHealthSummaryRow
+	err := row.Scan(
+		&i.Count,
+		&i.FirstTimestamp,
+		&i.LastTimestamp,
+		&i.Vb1Min,
+		&i.Vb1Max,
+		&i.Vb1Avg,
+		&i.Vb2Min,
+		&i.Vb2Max,
+		&i.Vb2Avg,
+		&i.CurrMin,
+		&i.CurrMax,
+		&i.CurrAvg,
+		&i.Bp1Min,
+		&i.Bp1Max,
+		&i.Bp1Avg,
+		&i.Bp2Min,
+		&i.Bp2Max,
+		&i.Bp2Avg,
+		&i.SsMin,
+		&i.SsMax,
+		&i.SsAvg,
+		&i.TempArqMin,
+		&i.TempArqMax,
+		&i.TempArqAvg,
+		&i.RhArqMin,
+		&i.RhArqMax,
+		&i.RhArqAvg,
+		&i.LastDataStatus,
+	)
+	return i, err
+}
+
+const listStationBatteryDaily = `-- name: ListStationBatteryDaily :many
+SELECT
+  h.station_id,
+  s.name,
+  (h.timestamp AT TIME ZONE COALESCE(c.timezone, 'Asia/Manila'))::date AS day,
+  min(h.vb1)::real AS vb1_min,
+  max(h.vb1)::real AS vb1_max,
+  max(h.bp1) AS bp1_max,
+  count(h.id) AS sample_count
+FROM observations_stationhealth h
+JOIN observations_station s ON s.id = h.station_id
+LEFT JOIN station_clock c ON c.station_id = s.id
+WHERE h.vb1 > 0
+  AND s.deleted_at = '0001-01-01 00:00:00Z'
+  AND h.timestamp >= $1
+  AND ($2::bigint IS NULL OR h.station_id = $2)
+GROUP BY h.station_id, s.name, day
+ORDER BY h.station_id, day
+`
+
+type ListStationBatteryDailyParams struct {
+	Since     pgtype.Timestamptz `json:"since"`
+	StationID pgtype.Int8        `json:"station_id"`
+}
+
+type ListStationBatteryDailyRow struct {
+	StationID   int64         `json:"station_id"`
+	Name        string        `json:"name"`
+	Day         pgtype.Date   `json:"day"`
+	Vb1Min      float32       `json:"vb1_min"`
+	Vb1Max      float32       `json:"vb1_max"`
+	Bp1Max      pgtype.Float4 `json:"bp1_max"`
+	SampleCount int64         `json:"sample_count"`
+}
+
+func (q *Queries) ListStationBatteryDaily(ctx context.Context, arg ListStationBatteryDailyParams) ([]ListStationBatteryDailyRow, error) {
+	rows, err := q.db.Query(ctx, listStationBatteryDaily, arg.Since, arg.StationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStationBatteryDailyRow{}
+	for rows.Next() {
+		var i ListStationBatteryDailyRow
+		if err := rows.Scan(
+			&i.StationID,
+			&i.Name,
+			&i.Day,
+			&i.Vb1Min,
+			&i.Vb1Max,
+			&i.Bp1Max,
+			&i.SampleCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStationHealths = `-- name: ListStationHealths :many
 SELECT id, vb1, vb2, curr, bp1, bp2, cm, ss, temp_arq, rh_arq, fpm, error_msg, message, data_count, data_status, timestamp, station_id, minutes_difference, created_at, updated_at FROM observations_stationhealth
 WHERE station_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3
+  AND (CASE WHEN $2::bool THEN timestamp >= $3 ELSE TRUE END)
+  AND (CASE WHEN $4::bool THEN timestamp <= $5 ELSE TRUE END)
+ORDER BY timestamp DESC
+LIMIT $6
+OFFSET $7
 `
 
 type ListStationHealthsParams struct {
-	StationID int64 `json:"station_id"`
-	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
+	StationID   int64              `json:"station_id"`
+	IsStartDate bool               `json:"is_start_date"`
+	StartDate   pgtype.Timestamptz `json:"start_date"`
+	IsEndDate   bool               `json:"is_end_date"`
+	EndDate     pgtype.Timestamptz `json:"end_date"`
+	Limit       pgtype.Int4        `json:"limit"`
+	Offset      int32              `json:"offset"`
 }
 
 func (q *Queries) ListStationHealths(ctx context.Context, arg ListStationHealthsParams) ([]ObservationsStationhealth, error) {
-	rows, err := q.db.Query(ctx, listStationHealths, arg.StationID, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listStationHealths,
+		arg.StationID,
+		arg.IsStartDate,
+		arg.StartDate,
+		arg.IsEndDate,
+		arg.EndDate,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
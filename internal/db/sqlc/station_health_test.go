@@ -63,7 +63,7 @@ func (ts *StationHealthTestSuite) TestListStationHealths() {
 
 	arg := ListStationHealthsParams{
 		StationID: station.ID,
-		Limit:     5,
+		Limit:     pgtype.Int4{Int32: 5, Valid: true},
 		Offset:    5,
 	}
 
@@ -76,6 +76,75 @@ func (ts *StationHealthTestSuite) TestListStationHealths() {
 	}
 }
 
+func (ts *StationHealthTestSuite) TestGetStationHealthSummary() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomStationHealth(t, station.ID)
+	}
+
+	summary, err := testStore.GetStationHealthSummary(context.Background(), GetStationHealthSummaryParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(n), summary.Count)
+	require.True(t, summary.LastTimestamp.Valid)
+	require.True(t, summary.Vb1Avg.Valid)
+	require.LessOrEqual(t, summary.Vb1Min.Float32, summary.Vb1Max.Float32)
+
+	empty, err := testStore.GetStationHealthSummary(context.Background(), GetStationHealthSummaryParams{
+		StationID: station.ID + 1,
+	})
+	require.NoError(t, err)
+	require.Zero(t, empty.Count)
+	require.Empty(t, empty.LastDataStatus)
+}
+
+func (ts *StationHealthTestSuite) TestListStationBatteryDaily() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	otherStation := createRandomStation(t, false)
+	healths := make([]ObservationsStationhealth, 4)
+	for i := range healths {
+		healths[i] = createRandomStationHealth(t, station.ID)
+	}
+	createRandomStationHealth(t, otherStation.ID)
+
+	rows, err := testStore.ListStationBatteryDaily(context.Background(), ListStationBatteryDailyParams{
+		Since:     pgtype.Timestamptz{Time: time.Now().AddDate(0, 0, -1), Valid: true},
+		StationID: pgtype.Int8{Int64: station.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, rows)
+
+	var samples int64
+	for _, row := range rows {
+		require.Equal(t, station.ID, row.StationID)
+		require.Equal(t, station.Name, row.Name)
+		require.LessOrEqual(t, row.Vb1Min, row.Vb1Max)
+		samples += row.SampleCount
+	}
+	require.Equal(t, int64(len(healths)), samples)
+
+	rows, err = testStore.ListStationBatteryDaily(context.Background(), ListStationBatteryDailyParams{
+		Since: pgtype.Timestamptz{Time: time.Now().AddDate(0, 0, -1), Valid: true},
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(rows), 2)
+
+	// deleted stations are left out
+	_, err = testStore.DeleteStation(context.Background(), station.ID)
+	require.NoError(t, err)
+
+	rows, err = testStore.ListStationBatteryDaily(context.Background(), ListStationBatteryDailyParams{
+		Since:     pgtype.Timestamptz{Time: time.Now().AddDate(0, 0, -1), Valid: true},
+		StationID: pgtype.Int8{Int64: station.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
+
 func (ts *StationHealthTestSuite) TestUpdateStationHealth() {
 	var (
 		oldHealth ObservationsStationhealth
@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationMaintenanceTestSuite struct {
+	suite.Suite
+}
+
+func TestStationMaintenanceTestSuite(t *testing.T) {
+	suite.Run(t, new(StationMaintenanceTestSuite))
+}
+
+func (ts *StationMaintenanceTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationMaintenanceTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationMaintenanceTestSuite) TestCreateStationMaintenance() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	user := createRandomUser(t)
+
+	m, err := testStore.CreateStationMaintenance(context.Background(), CreateStationMaintenanceParams{
+		StationID:         station.ID,
+		TechnicianID:      pgtype.Int8{Int64: user.ID, Valid: true},
+		VisitDate:         pgtype.Date{Time: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		Actions:           "cleaned the rain gauge",
+		PartsReplaced:     []string{"battery"},
+		SensorsCalibrated: []string{},
+		Photos:            []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, m.TechnicianID.Int64)
+	require.Equal(t, []string{"battery"}, m.PartsReplaced)
+	require.Empty(t, m.SensorsCalibrated)
+	require.False(t, m.WindowStatus.Valid)
+
+	got, err := testStore.GetStationMaintenance(context.Background(), GetStationMaintenanceParams{
+		StationID: station.ID,
+		ID:        m.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, m, got)
+}
+
+func (ts *StationMaintenanceTestSuite) TestCreateStationMaintenanceInvalidWindow() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	start := time.Now()
+
+	_, err := testStore.CreateStationMaintenance(context.Background(), CreateStationMaintenanceParams{
+		StationID:         station.ID,
+		VisitDate:         pgtype.Date{Time: start, Valid: true},
+		PartsReplaced:     []string{},
+		SensorsCalibrated: []string{},
+		Photos:            []string{},
+		WindowStart:       pgtype.Timestamptz{Time: start, Valid: true},
+		WindowEnd:         pgtype.Timestamptz{Time: start.Add(-time.Hour), Valid: true},
+		WindowStatus:      pgtype.Text{String: "PLANNED", Valid: true},
+	})
+	require.Error(t, err)
+}
+
+func (ts *StationMaintenanceTestSuite) TestListStationMaintenance() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	n := 5
+	for i := range n {
+		createRandomStationMaintenance(t, station.ID, time.Date(2024, 3, i+1, 0, 0, 0, 0, time.UTC), time.Time{})
+	}
+
+	arg := ListStationMaintenanceParams{
+		StationID:   station.ID,
+		IsStartDate: true,
+		StartDate:   pgtype.Date{Time: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+		Limit:       pgtype.Int4{Int32: 3, Valid: true},
+	}
+	visits, err := testStore.ListStationMaintenance(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, visits, 3)
+	require.True(t, visits[0].VisitDate.Time.After(visits[1].VisitDate.Time))
+
+	count, err := testStore.CountStationMaintenance(context.Background(), CountStationMaintenanceParams{
+		StationID:   arg.StationID,
+		IsStartDate: arg.IsStartDate,
+		StartDate:   arg.StartDate,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(n-1), count)
+}
+
+func (ts *StationMaintenanceTestSuite) TestUpdateStationMaintenance() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	m := createRandomStationMaintenance(t, station.ID, time.Now(), time.Time{})
+
+	windowStart := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+	updated, err := testStore.UpdateStationMaintenance(context.Background(), UpdateStationMaintenanceParams{
+		StationID:    station.ID,
+		ID:           m.ID,
+		Photos:       []string{"https://example.com/visit.jpg"},
+		WindowStart:  pgtype.Timestamptz{Time: windowStart, Valid: true},
+		WindowEnd:    pgtype.Timestamptz{Time: windowStart.Add(2 * time.Hour), Valid: true},
+		WindowStatus: pgtype.Text{String: "PLANNED", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, m.Actions, updated.Actions)
+	require.Equal(t, m.PartsReplaced, updated.PartsReplaced)
+	require.Equal(t, []string{"https://example.com/visit.jpg"}, updated.Photos)
+	require.WithinDuration(t, windowStart, updated.WindowStart.Time, time.Microsecond)
+	require.Equal(t, "PLANNED", updated.WindowStatus.String)
+}
+
+func (ts *StationMaintenanceTestSuite) TestDeleteStationMaintenance() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	m := createRandomStationMaintenance(t, station.ID, time.Now(), time.Time{})
+
+	err := testStore.DeleteStationMaintenance(context.Background(), DeleteStationMaintenanceParams{
+		StationID: station.ID,
+		ID:        m.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.GetStationMaintenance(context.Background(), GetStationMaintenanceParams{
+		StationID: station.ID,
+		ID:        m.ID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *StationMaintenanceTestSuite) TestMaintenanceWindows() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	now := time.Now()
+	due := createRandomStationMaintenance(t, station.ID, now, now.Add(-time.Hour))
+	createRandomStationMaintenance(t, station.ID, now, now.Add(time.Hour))
+
+	toOpen, err := testStore.ListStationMaintenanceWindowsToOpen(context.Background(), pgtype.Timestamptz{Time: now, Valid: true})
+	require.NoError(t, err)
+	require.Len(t, toOpen, 1)
+	require.Equal(t, due.ID, toOpen[0].ID)
+
+	err = testStore.UpdateStationMaintenanceWindowStatus(context.Background(), UpdateStationMaintenanceWindowStatusParams{
+		ID:           due.ID,
+		WindowStatus: pgtype.Text{String: "OPEN", Valid: true},
+	})
+	require.NoError(t, err)
+
+	count, err := testStore.CountOpenStationMaintenanceWindows(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// the window ends after an hour
+	toClose, err := testStore.ListStationMaintenanceWindowsToClose(context.Background(), pgtype.Timestamptz{Time: now.Add(2 * time.Hour), Valid: true})
+	require.NoError(t, err)
+	require.Len(t, toClose, 1)
+	require.Equal(t, due.ID, toClose[0].ID)
+
+	windows, err := testStore.ListStationMaintenanceWindowsInRange(context.Background(), ListStationMaintenanceWindowsInRangeParams{
+		StationIds: []int64{station.ID},
+		StartDate:  pgtype.Timestamptz{Time: now.Add(-30 * time.Minute), Valid: true},
+		EndDate:    pgtype.Timestamptz{Time: now.Add(30 * time.Minute), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+	require.Equal(t, due.ID, windows[0].ID)
+}
+
+// createRandomStationMaintenance creates a maintenance visit, a non-zero windowStart plans a 2-hour maintenance window
+func createRandomStationMaintenance(t *testing.T, stationID int64, visitDate, windowStart time.Time) StationMaintenance {
+	arg := CreateStationMaintenanceParams{
+		StationID:         stationID,
+		VisitDate:         pgtype.Date{Time: visitDate, Valid: true},
+		Actions:           gofakeit.Sentence(5),
+		PartsReplaced:     []string{gofakeit.Word()},
+		SensorsCalibrated: []string{},
+		Photos:            []string{gofakeit.URL()},
+	}
+	if !windowStart.IsZero() {
+		arg.WindowStart = pgtype.Timestamptz{Time: windowStart, Valid: true}
+		arg.WindowEnd = pgtype.Timestamptz{Time: windowStart.Add(2 * time.Hour), Valid: true}
+		arg.WindowStatus = pgtype.Text{String: "PLANNED", Valid: true}
+	}
+
+	m, err := testStore.CreateStationMaintenance(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, m.ID)
+	return m
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -95,6 +96,66 @@ func (ts *StationTestSuite) TestListStations() {
 	}
 }
 
+func (ts *StationTestSuite) TestSearchStations() {
+	t := ts.T()
+	ctx := context.Background()
+	names := []string{"Los Banos", "Bay", "Los Angeles Street"}
+	stations := make([]ObservationsStation, len(names))
+	for i, name := range names {
+		station := createRandomStation(t, false)
+		station, err := testStore.UpdateStation(ctx, UpdateStationParams{
+			ID:            station.ID,
+			Name:          pgtype.Text{String: name, Valid: true},
+			Province:      pgtype.Text{String: "Laguna", Valid: i < 2},
+			PriorityLevel: pgtype.Text{String: fmt.Sprintf("%d", i+1), Valid: true},
+			DateInstalled: pgtype.Date{Time: time.Date(2024, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		})
+		require.NoError(t, err)
+		stations[i] = station
+	}
+	createRandomStation(t, false)
+
+	gotStations, err := testStore.ListStations(ctx, ListStationsParams{
+		Q:    pgtype.Text{String: "los", Valid: true},
+		Sort: pgtype.Text{String: "-name", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, gotStations, 2)
+	require.Equal(t, stations[0].ID, gotStations[0].ID)
+	require.Equal(t, stations[2].ID, gotStations[1].ID)
+
+	gotStations, err = testStore.ListStations(ctx, ListStationsParams{
+		Province:      pgtype.Text{String: "Laguna", Valid: true},
+		InstalledFrom: pgtype.Date{Time: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, gotStations, 1)
+	require.Equal(t, stations[1].ID, gotStations[0].ID)
+
+	gotStations, err = testStore.ListStations(ctx, ListStationsParams{
+		InstalledFrom: pgtype.Date{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		InstalledTo:   pgtype.Date{Time: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), Valid: true},
+		Sort:          pgtype.Text{String: "-priority_level", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, gotStations, 3)
+	require.Equal(t, stations[2].ID, gotStations[0].ID)
+
+	numStations, err := testStore.CountStations(ctx, CountStationsParams{
+		Q:             pgtype.Text{String: "banos", Valid: true},
+		PriorityLevel: pgtype.Text{String: "1", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), numStations)
+
+	// LIKE wildcards in the search text are matched literally
+	numStations, err = testStore.CountStations(ctx, CountStationsParams{
+		Q: pgtype.Text{String: "%", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Zero(t, numStations)
+}
+
 func (ts *StationTestSuite) TestListStationsWithinRadius() {
 	t := ts.T()
 	cLat := getRandomLat()
@@ -122,14 +183,14 @@ func (ts *StationTestSuite) TestListStationsWithinRadius() {
 		createRandomStation(t, util.Point{Point: p})
 	}
 
-	arg := ListStationsWithinRadiusParams{
-		Cx:     cLon,
-		Cy:     cLat,
-		R:      cR,
+	arg := ListStationsParams{
+		Cx:     pgtype.Float4{Float32: cLon, Valid: true},
+		Cy:     pgtype.Float4{Float32: cLat, Valid: true},
+		R:      pgtype.Float4{Float32: cR, Valid: true},
 		Limit:  pgtype.Int4{Int32: int32(n), Valid: true},
 		Offset: 0,
 	}
-	gotStations, err := testStore.ListStationsWithinRadius(context.Background(), arg)
+	gotStations, err := testStore.ListStations(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, gotStations, 5)
 
@@ -155,15 +216,15 @@ func (ts *StationTestSuite) TestListStationsWithinBBox() {
 		createRandomStation(t, util.Point{Point: p})
 	}
 
-	arg := ListStationsWithinBBoxParams{
-		Xmin:   float32(xMin),
-		Ymin:   float32(yMin),
-		Xmax:   float32(xMax),
-		Ymax:   float32(yMax),
+	arg := ListStationsParams{
+		Xmin:   pgtype.Float4{Float32: float32(xMin), Valid: true},
+		Ymin:   pgtype.Float4{Float32: float32(yMin), Valid: true},
+		Xmax:   pgtype.Float4{Float32: float32(xMax), Valid: true},
+		Ymax:   pgtype.Float4{Float32: float32(yMax), Valid: true},
 		Limit:  pgtype.Int4{Int32: int32(n), Valid: true},
 		Offset: 0,
 	}
-	gotStations, err := testStore.ListStationsWithinBBox(context.Background(), arg)
+	gotStations, err := testStore.ListStations(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, gotStations, 5)
 
@@ -195,11 +256,13 @@ func (ts *StationTestSuite) TestCountStations() {
 		}
 	}
 
-	numStations, err := testStore.CountStations(ctx, pgtype.Text{})
+	numStations, err := testStore.CountStations(ctx, CountStationsParams{})
 	require.NoError(t, err)
 	require.Equal(t, int64(n), numStations)
 
-	numStations, err = testStore.CountStations(ctx, pgtype.Text{String: "ONLINE", Valid: true})
+	numStations, err = testStore.CountStations(ctx, CountStationsParams{
+		Status: pgtype.Text{String: "ONLINE", Valid: true},
+	})
 	require.NoError(t, err)
 	require.Equal(t, int64(4), numStations)
 }
@@ -230,12 +293,12 @@ func (ts *StationTestSuite) TestCountStationsWithinRadius() {
 		p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{float64(lon), float64(lat)}).SetSRID(4326)
 		createRandomStation(t, util.Point{Point: p})
 	}
-	arg := CountStationsWithinRadiusParams{
-		Cx: cLon,
-		Cy: cLat,
-		R:  cR,
+	arg := CountStationsParams{
+		Cx: pgtype.Float4{Float32: cLon, Valid: true},
+		Cy: pgtype.Float4{Float32: cLat, Valid: true},
+		R:  pgtype.Float4{Float32: cR, Valid: true},
 	}
-	numStations, err := testStore.CountStationsWithinRadius(context.Background(), arg)
+	numStations, err := testStore.CountStations(context.Background(), arg)
 	require.NoError(t, err)
 	require.Equal(t, numStations, int64(5))
 }
@@ -257,13 +320,13 @@ func (ts *StationTestSuite) TestCountStationsWithinBBox() {
 		createRandomStation(t, util.Point{Point: p})
 	}
 
-	arg := CountStationsWithinBBoxParams{
-		Xmin: float32(xMin),
-		Ymin: float32(yMin),
-		Xmax: float32(xMax),
-		Ymax: float32(yMax),
+	arg := CountStationsParams{
+		Xmin: pgtype.Float4{Float32: float32(xMin), Valid: true},
+		Ymin: pgtype.Float4{Float32: float32(yMin), Valid: true},
+		Xmax: pgtype.Float4{Float32: float32(xMax), Valid: true},
+		Ymax: pgtype.Float4{Float32: float32(yMax), Valid: true},
 	}
-	numStations, err := testStore.CountStationsWithinBBox(context.Background(), arg)
+	numStations, err := testStore.CountStations(context.Background(), arg)
 	require.NoError(t, err)
 	require.Equal(t, numStations, int64(5))
 }
@@ -373,12 +436,84 @@ func (ts *StationTestSuite) TestDeleteStation() {
 	t := ts.T()
 	station := createRandomStation(t, false)
 
-	err := testStore.DeleteStation(context.Background(), station.ID)
+	n, err := testStore.DeleteStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	// deleting again has no effect
+	n, err = testStore.DeleteStation(context.Background(), station.ID)
 	require.NoError(t, err)
+	require.Zero(t, n)
 
 	gotStation, err := testStore.GetStation(context.Background(), station.ID)
 	require.Error(t, err)
 	require.Empty(t, gotStation)
+
+	stations, err := testStore.ListStations(context.Background(), ListStationsParams{})
+	require.NoError(t, err)
+	for _, stn := range stations {
+		require.NotEqual(t, station.ID, stn.ID)
+	}
+
+	// the data is kept
+	deleted, err := testStore.GetDeletedStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, station.Name, deleted.Name)
+	require.False(t, deleted.DeletedAt.Time.IsZero())
+
+	// the mobile number can be used by a new station
+	newStation, err := testStore.CreateStation(context.Background(), CreateStationParams{
+		Name:         util.RandomString(16),
+		MobileNumber: station.MobileNumber,
+	})
+	require.NoError(t, err)
+	require.Equal(t, station.MobileNumber, newStation.MobileNumber)
+
+	// and the old station cannot be restored while it is in use
+	_, err = testStore.RestoreStation(context.Background(), station.ID)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
+
+func (ts *StationTestSuite) TestRestoreStation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	_, err := testStore.RestoreStation(context.Background(), station.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.DeleteStation(context.Background(), station.ID)
+	require.NoError(t, err)
+
+	restored, err := testStore.RestoreStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.True(t, restored.DeletedAt.Time.IsZero())
+
+	gotStation, err := testStore.GetStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, station.ID, gotStation.ID)
+}
+
+func (ts *StationTestSuite) TestPurgeStation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	createRandomObservation(t, station.ID)
+
+	// only deleted stations are purged
+	err := testStore.PurgeStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	_, err = testStore.GetStation(context.Background(), station.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.DeleteStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	err = testStore.PurgeStation(context.Background(), station.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetDeletedStation(context.Background(), station.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	count, err := testStore.CountStationObservations(context.Background(), CountStationObservationsParams{StationID: station.ID})
+	require.NoError(t, err)
+	require.Zero(t, count)
 }
 
 func createRandomStation(t *testing.T, geom any) ObservationsStation {
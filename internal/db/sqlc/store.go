@@ -11,8 +11,19 @@ type Store interface {
 	Querier
 	BulkCreateUserRoles(ctx context.Context, arg []UserRolesParams) (ret []UserRolesParams, errs []error)
 	BulkDeleteUserRoles(ctx context.Context, arg []UserRolesParams) []error
+	AssignSimCardTx(ctx context.Context, arg AssignSimCardTxParams) (AssignSimCardTxResult, error)
+	BufferPendingStationMessageTx(ctx context.Context, arg BufferPendingStationMessageTxParams) (BufferPendingStationMessageTxResult, error)
+	ClaimPendingStationTx(ctx context.Context, arg ClaimPendingStationTxParams) (ClaimPendingStationTxResult, error)
 	CreateMisolStationTx(ctx context.Context, arg CreateMisolStationTxParams) (CreateMisolStationTxResult, error)
+	CreateStationCommandTx(ctx context.Context, arg CreateStationCommandTxParams) (CreateStationCommandTxResult, error)
+	CreateWeatherlinkStationTx(ctx context.Context, arg CreateWeatherlinkStationTxParams) (CreateWeatherlinkStationTxResult, error)
+	DeleteMisolStationTx(ctx context.Context, arg DeleteMisolStationTxParams) error
 	FirstOrCreateSimAccessTokenTx(ctx context.Context, arg FirstOrCreateSimAccessTokenTxParams) (FirstOrCreateSimAccessTokenTxResult, error)
+	ImportStationsTx(ctx context.Context, arg ImportStationsTxParams) (ImportStationsTxResult, error)
+	UnassignSimCardTx(ctx context.Context, mobileNumber string) error
+	UpdateStationMetadataTx(ctx context.Context, arg UpdateStationMetadataTxParams) (UpdateStationMetadataTxResult, error)
+	UpdateStationStatusTx(ctx context.Context, arg UpdateStationStatusTxParams) (UpdateStationStatusTxResult, error)
+	UpdateStationTx(ctx context.Context, arg UpdateStationTxParams) (UpdateStationTxResult, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
@@ -15,11 +15,15 @@ type CreateMisolStationTxParams struct {
 	Province  pgtype.Text   `json:"province"`
 	Region    pgtype.Text   `json:"region"`
 	Address   pgtype.Text   `json:"address"`
+	// Status is the station status, MisolStatus the status of the misol registration
+	Status      pgtype.Text `json:"status"`
+	MisolStatus pgtype.Text `json:"misol_status"`
 }
 
 type CreateMisolStationTxResult struct {
-	ID   int64
-	Info ObservationsStation
+	ID     int64
+	Status string
+	Info   ObservationsStation
 }
 
 func (store *SQLStore) CreateMisolStationTx(ctx context.Context, arg CreateMisolStationTxParams) (CreateMisolStationTxResult, error) {
@@ -36,6 +40,7 @@ func (store *SQLStore) CreateMisolStationTx(ctx context.Context, arg CreateMisol
 			Province:  arg.Province,
 			Region:    arg.Region,
 			Address:   arg.Address,
+			Status:    arg.Status,
 			StationType: pgtype.Text{
 				String: "MISOL",
 				Valid:  true,
@@ -48,14 +53,33 @@ func (store *SQLStore) CreateMisolStationTx(ctx context.Context, arg CreateMisol
 		mStn, err := q.CreateMisolStation(ctx, CreateMisolStationParams{
 			ID:        arg.ID,
 			StationID: result.Info.ID,
+			Status:    arg.MisolStatus,
 		})
 		if err != nil {
 			return err
 		}
 		result.ID = mStn.ID
+		result.Status = mStn.Status
 
 		return nil
 	})
 
 	return result, err
 }
+
+type DeleteMisolStationTxParams struct {
+	ID        int64 `json:"id"`
+	StationID int64 `json:"station_id"`
+}
+
+// DeleteMisolStationTx removes a misol mapping together with its station.
+// Stations are only soft deleted so the mapping has to be removed explicitly.
+func (store *SQLStore) DeleteMisolStationTx(ctx context.Context, arg DeleteMisolStationTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if err := q.DeleteMisolStation(ctx, arg.ID); err != nil {
+			return err
+		}
+		_, err := q.DeleteStation(ctx, arg.StationID)
+		return err
+	})
+}
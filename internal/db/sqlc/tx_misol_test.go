@@ -32,6 +32,38 @@ func (ts *MisolStationTxTestSuite) TestCreateStation() {
 	createRandomMisolStationTx(ts.T())
 }
 
+func (ts *MisolStationTxTestSuite) TestCreatePendingStation() {
+	t := ts.T()
+	arg := CreateMisolStationTxParams{
+		ID:          util.RandomInt[int64](1, 1000),
+		Name:        util.RandomString(15),
+		Status:      pgtype.Text{String: "INACTIVE", Valid: true},
+		MisolStatus: pgtype.Text{String: "PENDING", Valid: true},
+	}
+
+	mStn, err := testStore.CreateMisolStationTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, "PENDING", mStn.Status)
+	require.Equal(t, "INACTIVE", mStn.Info.Status.String)
+}
+
+func (ts *MisolStationTxTestSuite) TestDeleteMisolStationTx() {
+	t := ts.T()
+	mStn := createRandomMisolStationTx(t)
+
+	err := testStore.DeleteMisolStationTx(context.Background(), DeleteMisolStationTxParams{
+		ID:        mStn.ID,
+		StationID: mStn.Info.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.GetMisolStation(context.Background(), mStn.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.GetStation(context.Background(), mStn.Info.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
 func createRandomMisolStationTx(t *testing.T) CreateMisolStationTxResult {
 	arg := CreateMisolStationTxParams{
 		ID:   util.RandomInt[int64](1, 1000),
@@ -56,6 +88,7 @@ func createRandomMisolStationTx(t *testing.T) CreateMisolStationTxResult {
 
 	require.Equal(t, arg.ID, mStn.ID)
 	require.Equal(t, arg.Name, mStn.Info.Name)
+	require.Equal(t, "APPROVED", mStn.Status)
 
 	return mStn
 }
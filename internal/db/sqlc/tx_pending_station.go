@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type BufferPendingStationMessageTxParams struct {
+	MobileNumber string `json:"mobile_number"`
+	Msg          string `json:"msg"`
+}
+
+type BufferPendingStationMessageTxResult struct {
+	PendingStation PendingStation
+	Message        PendingStationMessage
+}
+
+// BufferPendingStationMessageTx queues a message from an unknown sender until the number is claimed
+func (store *SQLStore) BufferPendingStationMessageTx(ctx context.Context, arg BufferPendingStationMessageTxParams) (BufferPendingStationMessageTxResult, error) {
+	var result BufferPendingStationMessageTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.PendingStation, err = q.UpsertPendingStation(ctx, arg.MobileNumber)
+		if err != nil {
+			return err
+		}
+
+		result.Message, err = q.CreatePendingStationMessage(ctx, CreatePendingStationMessageParams{
+			PendingStationID: result.PendingStation.ID,
+			Msg:              arg.Msg,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+type ClaimPendingStationTxParams struct {
+	MobileNumber string `json:"mobile_number"`
+	// StationID assigns the number to an existing station, when zero Station is created instead
+	StationID int64               `json:"station_id"`
+	Station   CreateStationParams `json:"station"`
+}
+
+type ClaimPendingStationTxResult struct {
+	PendingStation PendingStation
+	Station        ObservationsStation
+	Messages       []PendingStationMessage
+}
+
+// ClaimPendingStationTx assigns a pending mobile number to a station.
+// The buffered messages are returned for ingestion and stay queued until they are stored.
+func (store *SQLStore) ClaimPendingStationTx(ctx context.Context, arg ClaimPendingStationTxParams) (ClaimPendingStationTxResult, error) {
+	var result ClaimPendingStationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.PendingStation, err = q.GetPendingStation(ctx, arg.MobileNumber)
+		if err != nil {
+			return err
+		}
+
+		mobileNumber := pgtype.Text{String: arg.MobileNumber, Valid: true}
+		if arg.StationID > 0 {
+			result.Station, err = q.UpdateStation(ctx, UpdateStationParams{
+				ID:           arg.StationID,
+				MobileNumber: mobileNumber,
+			})
+		} else {
+			stnArg := arg.Station
+			stnArg.MobileNumber = mobileNumber
+			result.Station, err = q.CreateStation(ctx, stnArg)
+		}
+		if err != nil {
+			return err
+		}
+
+		result.Messages, err = q.ListPendingStationMessages(ctx, result.PendingStation.ID)
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AssignSimCardTxParams struct {
+	MobileNumber string `json:"mobile_number"`
+	StationID    int64  `json:"station_id"`
+}
+
+type AssignSimCardTxResult struct {
+	Station    ObservationsStation
+	Assignment SimCardAssignment
+}
+
+// AssignSimCardTx puts a SIM in a station, replacing the station's previous SIM.
+// The number is also removed from the station that held it, so GetStationByMobileNumber resolves to the new station.
+func (store *SQLStore) AssignSimCardTx(ctx context.Context, arg AssignSimCardTxParams) (AssignSimCardTxResult, error) {
+	var result AssignSimCardTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.CloseSimCardAssignments(ctx, CloseSimCardAssignmentsParams{
+			MobileNumber: arg.MobileNumber,
+			StationID:    arg.StationID,
+		})
+		if err != nil {
+			return err
+		}
+
+		mobileNumber := pgtype.Text{String: arg.MobileNumber, Valid: true}
+		if err = q.ClearStationMobileNumber(ctx, mobileNumber); err != nil {
+			return err
+		}
+
+		result.Station, err = q.UpdateStation(ctx, UpdateStationParams{
+			ID:           arg.StationID,
+			MobileNumber: mobileNumber,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Assignment, err = q.CreateSimCardAssignment(ctx, CreateSimCardAssignmentParams{
+			MobileNumber: arg.MobileNumber,
+			StationID:    arg.StationID,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// UnassignSimCardTx takes a SIM out of its station and clears the station mobile number
+func (store *SQLStore) UnassignSimCardTx(ctx context.Context, mobileNumber string) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		assignment, err := q.GetActiveSimCardAssignment(ctx, mobileNumber)
+		if err != nil {
+			return err
+		}
+
+		err = q.CloseSimCardAssignments(ctx, CloseSimCardAssignmentsParams{
+			MobileNumber: mobileNumber,
+			StationID:    assignment.StationID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.ClearStationMobileNumber(ctx, pgtype.Text{String: mobileNumber, Valid: true})
+	})
+}
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SimCardTxTestSuite struct {
+	suite.Suite
+}
+
+func TestSimCardTxTestSuite(t *testing.T) {
+	suite.Run(t, new(SimCardTxTestSuite))
+}
+
+func (ts *SimCardTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *SimCardTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *SimCardTxTestSuite) TestAssignSimCardTx() {
+	t := ts.T()
+	simCard := createRandomSimCard(t)
+	station1 := createRandomStation(t, nil)
+	station2 := createRandomStation(t, nil)
+
+	result, err := testStore.AssignSimCardTx(context.Background(), AssignSimCardTxParams{
+		MobileNumber: simCard.MobileNumber,
+		StationID:    station1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, station1.ID, result.Station.ID)
+	require.Equal(t, simCard.MobileNumber, result.Station.MobileNumber.String)
+	require.False(t, result.Assignment.UnassignedAt.Valid)
+
+	// swap the SIM to the second station
+	result, err = testStore.AssignSimCardTx(context.Background(), AssignSimCardTxParams{
+		MobileNumber: simCard.MobileNumber,
+		StationID:    station2.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, station2.ID, result.Station.ID)
+
+	station, err := testStore.GetStationByMobileNumber(context.Background(), pgtype.Text{String: simCard.MobileNumber, Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, station2.ID, station.ID)
+
+	gotStation1, err := testStore.GetStation(context.Background(), station1.ID)
+	require.NoError(t, err)
+	require.False(t, gotStation1.MobileNumber.Valid)
+
+	assignments, err := testStore.ListSimCardAssignments(context.Background(), ListSimCardAssignmentsParams{
+		MobileNumber: pgtype.Text{String: simCard.MobileNumber, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, assignments, 2)
+
+	active, err := testStore.GetActiveSimCardAssignment(context.Background(), simCard.MobileNumber)
+	require.NoError(t, err)
+	require.Equal(t, station2.ID, active.StationID)
+
+	_, err = testStore.AssignSimCardTx(context.Background(), AssignSimCardTxParams{
+		MobileNumber: simCard.MobileNumber,
+		StationID:    station2.ID + 100,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *SimCardTxTestSuite) TestUnassignSimCardTx() {
+	t := ts.T()
+	simCard := createRandomSimCard(t)
+	station := createRandomStation(t, nil)
+
+	_, err := testStore.AssignSimCardTx(context.Background(), AssignSimCardTxParams{
+		MobileNumber: simCard.MobileNumber,
+		StationID:    station.ID,
+	})
+	require.NoError(t, err)
+
+	err = testStore.UnassignSimCardTx(context.Background(), simCard.MobileNumber)
+	require.NoError(t, err)
+
+	_, err = testStore.GetStationByMobileNumber(context.Background(), pgtype.Text{String: simCard.MobileNumber, Valid: true})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	err = testStore.UnassignSimCardTx(context.Background(), simCard.MobileNumber)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
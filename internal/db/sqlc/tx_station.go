@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UpdateStationTxParams holds the station changes, unset fields are left unchanged
+type UpdateStationTxParams struct {
+	UpdateStationParams
+	// Reason is recorded with the status transition and the metadata version
+	Reason string
+	// CanTransition is checked when the status changes, see UpdateStationStatusTxParams
+	CanTransition func(from, to string) bool
+}
+
+type UpdateStationTxResult struct {
+	Station ObservationsStation
+}
+
+// UpdateStationTx updates the station in a single transaction.
+// A status change goes through the status state machine and is recorded in the status history.
+// A location or type change starts a new station metadata version.
+func (store *SQLStore) UpdateStationTx(ctx context.Context, arg UpdateStationTxParams) (UpdateStationTxResult, error) {
+	var result UpdateStationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		stnArg := arg.UpdateStationParams
+
+		if stnArg.Status.Valid {
+			_, err := updateStationStatus(ctx, q, UpdateStationStatusTxParams{
+				StationID:     arg.ID,
+				Status:        stnArg.Status.String,
+				Reason:        arg.Reason,
+				CanTransition: arg.CanTransition,
+			})
+			if err != nil {
+				return err
+			}
+			stnArg.Status = pgtype.Text{}
+		}
+
+		if stnArg.Lat.Valid || stnArg.Lon.Valid || stnArg.Elevation.Valid || stnArg.StationType.Valid || stnArg.StationType2.Valid {
+			_, err := updateStationMetadata(ctx, q, UpdateStationMetadataTxParams{
+				CreateStationMetadataHistoryParams: CreateStationMetadataHistoryParams{
+					StationID:    arg.ID,
+					Lat:          stnArg.Lat,
+					Lon:          stnArg.Lon,
+					Elevation:    stnArg.Elevation,
+					StationType:  stnArg.StationType,
+					StationType2: stnArg.StationType2,
+					Reason:       arg.Reason,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			stnArg.Lat, stnArg.Lon, stnArg.Elevation = pgtype.Float4{}, pgtype.Float4{}, pgtype.Float4{}
+			stnArg.StationType, stnArg.StationType2 = pgtype.Text{}, pgtype.Text{}
+		}
+
+		var err error
+		result.Station, err = q.UpdateStation(ctx, stnArg)
+		return err
+	})
+
+	return result, err
+}
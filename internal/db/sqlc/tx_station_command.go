@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateStationCommandTxParams struct {
+	CreateStationCommandParams
+	MobileNumber string `json:"mobile_number"`
+	// ExpiresAt is set for commands that must not be sent late
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	// FormatMessage renders the SMS text once the command id is known
+	FormatMessage func(cmd StationCommand) (string, error)
+}
+
+type CreateStationCommandTxResult struct {
+	Command StationCommand
+	Sms     SmsOutbox
+}
+
+// CreateStationCommandTx records a station command and queues its SMS
+func (store *SQLStore) CreateStationCommandTx(ctx context.Context, arg CreateStationCommandTxParams) (CreateStationCommandTxResult, error) {
+	var result CreateStationCommandTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		cmd, err := q.CreateStationCommand(ctx, arg.CreateStationCommandParams)
+		if err != nil {
+			return err
+		}
+
+		msg, err := arg.FormatMessage(cmd)
+		if err != nil {
+			return err
+		}
+
+		result.Sms, err = q.CreateSmsOutbox(ctx, CreateSmsOutboxParams{
+			MobileNumber: arg.MobileNumber,
+			Message:      msg,
+			ExpiresAt:    arg.ExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Command, err = q.UpdateStationCommandMessage(ctx, UpdateStationCommandMessageParams{
+			Message:     msg,
+			SmsOutboxID: pgtype.Int8{Int64: result.Sms.ID, Valid: true},
+			ID:          cmd.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
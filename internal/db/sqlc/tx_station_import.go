@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+)
+
+type ImportStationsTxParams struct {
+	Stations []CreateStationParams
+}
+
+type ImportStationsTxResult struct {
+	Stations []ObservationsStation
+}
+
+// ImportStationsTx creates all the stations or none of them.
+func (store *SQLStore) ImportStationsTx(ctx context.Context, arg ImportStationsTxParams) (ImportStationsTxResult, error) {
+	var result ImportStationsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result.Stations = make([]ObservationsStation, 0, len(arg.Stations))
+		for _, stn := range arg.Stations {
+			created, err := q.CreateStation(ctx, stn)
+			if err != nil {
+				return err
+			}
+			result.Stations = append(result.Stations, created)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImportStationsTxTestSuite struct {
+	suite.Suite
+}
+
+func TestImportStationsTxTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportStationsTxTestSuite))
+}
+
+func (ts *ImportStationsTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *ImportStationsTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *ImportStationsTxTestSuite) TestImportStationsTx() {
+	t := ts.T()
+	arg := ImportStationsTxParams{
+		Stations: []CreateStationParams{
+			{Name: util.RandomString(12), MobileNumber: util.ToPgText(util.RandomMobileNumber())},
+			{Name: util.RandomString(12), Lat: pgtype.Float4{Float32: 14.5, Valid: true}, Lon: pgtype.Float4{Float32: 121, Valid: true}},
+		},
+	}
+
+	result, err := testStore.ImportStationsTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, result.Stations, 2)
+	require.Equal(t, arg.Stations[0].MobileNumber, result.Stations[0].MobileNumber)
+
+	stations, err := testStore.ListStationsByMobileNumbers(context.Background(), []string{arg.Stations[0].MobileNumber.String})
+	require.NoError(t, err)
+	require.Len(t, stations, 1)
+	require.Equal(t, result.Stations[0].ID, stations[0].ID)
+}
+
+func (ts *ImportStationsTxTestSuite) TestImportStationsTxRollback() {
+	t := ts.T()
+	mobileNumber := util.ToPgText(util.RandomMobileNumber())
+	arg := ImportStationsTxParams{
+		Stations: []CreateStationParams{
+			{Name: util.RandomString(12), MobileNumber: mobileNumber},
+			{Name: util.RandomString(12), MobileNumber: mobileNumber},
+		},
+	}
+
+	_, err := testStore.ImportStationsTx(context.Background(), arg)
+	require.Error(t, err)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	stations, err := testStore.ListStationsByMobileNumbers(context.Background(), []string{mobileNumber.String})
+	require.NoError(t, err)
+	require.Empty(t, stations)
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// stationMetadataEpoch is the start of the first metadata version of a station
+var stationMetadataEpoch = pgtype.Timestamptz{Time: time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+
+// UpdateStationMetadataTxParams holds the new station metadata, unset fields are carried over from the current version
+type UpdateStationMetadataTxParams struct {
+	CreateStationMetadataHistoryParams
+}
+
+type UpdateStationMetadataTxResult struct {
+	Changed  bool
+	Metadata StationMetadataHistory
+}
+
+// UpdateStationMetadataTx closes the current station metadata version at EffectiveFrom and starts a new one.
+// The station location and type are updated to the new version.
+// Nothing is written when the metadata did not change.
+func (store *SQLStore) UpdateStationMetadataTx(ctx context.Context, arg UpdateStationMetadataTxParams) (UpdateStationMetadataTxResult, error) {
+	var result UpdateStationMetadataTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = updateStationMetadata(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// updateStationMetadata runs UpdateStationMetadataTx within an existing transaction
+func updateStationMetadata(ctx context.Context, q *Queries, arg UpdateStationMetadataTxParams) (UpdateStationMetadataTxResult, error) {
+	var result UpdateStationMetadataTxResult
+
+	stn, err := q.GetStation(ctx, arg.StationID)
+	if err != nil {
+		return result, err
+	}
+
+	cur, err := q.GetCurrentStationMetadataForUpdate(ctx, arg.StationID)
+	if errors.Is(err, ErrRecordNotFound) {
+		// the station has not changed since it was created
+		cur, err = q.CreateStationMetadataHistory(ctx, CreateStationMetadataHistoryParams{
+			StationID:     stn.ID,
+			Lat:           stn.Lat,
+			Lon:           stn.Lon,
+			Elevation:     stn.Elevation,
+			StationType:   stn.StationType,
+			StationType2:  stn.StationType2,
+			LoggerVersion: stn.LoggerVersion,
+			Reason:        "initial",
+			EffectiveFrom: stationMetadataEpoch,
+		})
+	}
+	if err != nil {
+		return result, err
+	}
+
+	next := mergeStationMetadata(cur, arg.CreateStationMetadataHistoryParams)
+	if !next.EffectiveFrom.Valid {
+		next.EffectiveFrom = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	}
+
+	prev := mergeStationMetadata(cur, CreateStationMetadataHistoryParams{})
+	prev.Reason, prev.EffectiveFrom = next.Reason, next.EffectiveFrom
+	if prev == next {
+		result.Metadata = cur
+		return result, nil
+	}
+	if !next.EffectiveFrom.Time.After(cur.EffectiveFrom.Time) {
+		return result, ErrInvalidEffectiveFrom
+	}
+
+	err = q.CloseStationMetadataHistory(ctx, CloseStationMetadataHistoryParams{
+		EffectiveTo: next.EffectiveFrom,
+		ID:          cur.ID,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Metadata, err = q.CreateStationMetadataHistory(ctx, next)
+	if err != nil {
+		return result, err
+	}
+
+	_, err = q.UpdateStation(ctx, UpdateStationParams{
+		ID:            arg.StationID,
+		Lat:           next.Lat,
+		Lon:           next.Lon,
+		Elevation:     next.Elevation,
+		StationType:   next.StationType,
+		StationType2:  next.StationType2,
+		LoggerVersion: next.LoggerVersion,
+	})
+	result.Changed = err == nil
+	return result, err
+}
+
+// mergeStationMetadata fills the unset fields of arg from the metadata version m
+func mergeStationMetadata(m StationMetadataHistory, arg CreateStationMetadataHistoryParams) CreateStationMetadataHistoryParams {
+	arg.StationID = m.StationID
+	arg.Lat = coalesceFloat4(arg.Lat, m.Lat)
+	arg.Lon = coalesceFloat4(arg.Lon, m.Lon)
+	arg.Elevation = coalesceFloat4(arg.Elevation, m.Elevation)
+	arg.StationType = coalesceText(arg.StationType, m.StationType)
+	arg.StationType2 = coalesceText(arg.StationType2, m.StationType2)
+	arg.LoggerVersion = coalesceText(arg.LoggerVersion, m.LoggerVersion)
+	arg.LoggerSerial = coalesceText(arg.LoggerSerial, m.LoggerSerial)
+	arg.TempHeight = coalesceFloat4(arg.TempHeight, m.TempHeight)
+	arg.TempSerial = coalesceText(arg.TempSerial, m.TempSerial)
+	arg.WindHeight = coalesceFloat4(arg.WindHeight, m.WindHeight)
+	arg.WindSerial = coalesceText(arg.WindSerial, m.WindSerial)
+	arg.RainHeight = coalesceFloat4(arg.RainHeight, m.RainHeight)
+	arg.RainSerial = coalesceText(arg.RainSerial, m.RainSerial)
+	arg.PresHeight = coalesceFloat4(arg.PresHeight, m.PresHeight)
+	arg.PresSerial = coalesceText(arg.PresSerial, m.PresSerial)
+	return arg
+}
+
+func coalesceFloat4(v, fallback pgtype.Float4) pgtype.Float4 {
+	if v.Valid {
+		return v
+	}
+	return fallback
+}
+
+func coalesceText(v, fallback pgtype.Text) pgtype.Text {
+	if v.Valid {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationMetadataTxTestSuite struct {
+	suite.Suite
+}
+
+func TestStationMetadataTxTestSuite(t *testing.T) {
+	suite.Run(t, new(StationMetadataTxTestSuite))
+}
+
+func (ts *StationMetadataTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationMetadataTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationMetadataTxTestSuite) TestUpdateStationMetadataTx() {
+	t := ts.T()
+	station := createRandomStation(t, true)
+	relocatedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	newLat := pgtype.Float4{Float32: getRandomLat(), Valid: true}
+
+	result, err := testStore.UpdateStationMetadataTx(context.Background(), UpdateStationMetadataTxParams{
+		CreateStationMetadataHistoryParams: CreateStationMetadataHistoryParams{
+			StationID:     station.ID,
+			Lat:           newLat,
+			WindHeight:    pgtype.Float4{Float32: 10, Valid: true},
+			LoggerSerial:  util.ToPgText("SN12345"),
+			Reason:        "relocated",
+			EffectiveFrom: pgtype.Timestamptz{Time: relocatedAt, Valid: true},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Changed)
+	require.Equal(t, newLat, result.Metadata.Lat)
+	require.Equal(t, station.Lon, result.Metadata.Lon)
+	require.Equal(t, "SN12345", result.Metadata.LoggerSerial.String)
+	require.WithinDuration(t, relocatedAt, result.Metadata.EffectiveFrom.Time, time.Microsecond)
+	require.False(t, result.Metadata.EffectiveTo.Valid)
+
+	gotStation, err := testStore.GetStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, newLat, gotStation.Lat)
+
+	// the same metadata does not start a new version
+	result, err = testStore.UpdateStationMetadataTx(context.Background(), UpdateStationMetadataTxParams{
+		CreateStationMetadataHistoryParams: CreateStationMetadataHistoryParams{
+			StationID: station.ID,
+			Lat:       newLat,
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, result.Changed)
+
+	_, err = testStore.UpdateStationMetadataTx(context.Background(), UpdateStationMetadataTxParams{
+		CreateStationMetadataHistoryParams: CreateStationMetadataHistoryParams{
+			StationID:     station.ID,
+			Elevation:     pgtype.Float4{Float32: 5, Valid: true},
+			EffectiveFrom: pgtype.Timestamptz{Time: relocatedAt.Add(-time.Minute), Valid: true},
+		},
+	})
+	require.ErrorIs(t, err, ErrInvalidEffectiveFrom)
+
+	history, err := testStore.ListStationMetadataHistory(context.Background(), ListStationMetadataHistoryParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, result.Metadata.ID, history[0].ID)
+	require.Equal(t, station.Lat, history[1].Lat)
+	require.Equal(t, history[0].EffectiveFrom, history[1].EffectiveTo)
+
+	count, err := testStore.CountStationMetadataHistory(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	// only the version valid in the range is returned
+	versions, err := testStore.ListStationMetadataInRange(context.Background(), ListStationMetadataInRangeParams{
+		StationIds: []int64{station.ID},
+		StartDate:  pgtype.Timestamptz{Time: relocatedAt.Add(-2 * time.Hour), Valid: true},
+		EndDate:    pgtype.Timestamptz{Time: relocatedAt.Add(-time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.Equal(t, history[1].ID, versions[0].ID)
+
+	_, err = testStore.UpdateStationMetadataTx(context.Background(), UpdateStationMetadataTxParams{
+		CreateStationMetadataHistoryParams: CreateStationMetadataHistoryParams{
+			StationID: station.ID + 1000,
+			Lat:       newLat,
+		},
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
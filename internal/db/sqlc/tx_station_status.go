@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type UpdateStationStatusTxParams struct {
+	StationID      int64
+	Status         string
+	Reason         string
+	LastObservedAt pgtype.Timestamptz
+	// CanTransition is checked against the locked current status, the update fails with ErrInvalidStatusTransition when it returns false
+	CanTransition func(from, to string) bool
+}
+
+type UpdateStationStatusTxResult struct {
+	Changed bool
+	History StationStatusHistory
+}
+
+// UpdateStationStatusTx sets the station status and records the transition.
+// Nothing is written when the station already has the status.
+func (store *SQLStore) UpdateStationStatusTx(ctx context.Context, arg UpdateStationStatusTxParams) (UpdateStationStatusTxResult, error) {
+	var result UpdateStationStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = updateStationStatus(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// updateStationStatus runs UpdateStationStatusTx within an existing transaction
+func updateStationStatus(ctx context.Context, q *Queries, arg UpdateStationStatusTxParams) (UpdateStationStatusTxResult, error) {
+	var result UpdateStationStatusTxResult
+
+	from, err := q.GetStationStatusForUpdate(ctx, arg.StationID)
+	if err != nil {
+		return result, err
+	}
+	if from.Valid && from.String == arg.Status {
+		return result, nil
+	}
+	if arg.CanTransition != nil && !arg.CanTransition(from.String, arg.Status) {
+		return result, ErrInvalidStatusTransition
+	}
+
+	_, err = q.UpdateStation(ctx, UpdateStationParams{
+		ID:     arg.StationID,
+		Status: pgtype.Text{String: arg.Status, Valid: true},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.History, err = q.CreateStationStatusHistory(ctx, CreateStationStatusHistoryParams{
+		StationID:      arg.StationID,
+		FromStatus:     from,
+		ToStatus:       arg.Status,
+		Reason:         arg.Reason,
+		LastObservedAt: arg.LastObservedAt,
+	})
+	result.Changed = err == nil
+	return result, err
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationStatusTxTestSuite struct {
+	suite.Suite
+}
+
+func TestStationStatusTxTestSuite(t *testing.T) {
+	suite.Run(t, new(StationStatusTxTestSuite))
+}
+
+func (ts *StationStatusTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationStatusTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationStatusTxTestSuite) TestUpdateStationStatusTx() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	noMaintenanceExit := func(from, to string) bool {
+		return from != "MAINTENANCE" || to == "ONLINE"
+	}
+
+	result, err := testStore.UpdateStationStatusTx(context.Background(), UpdateStationStatusTxParams{
+		StationID:     station.ID,
+		Status:        "MAINTENANCE",
+		Reason:        "sensor replacement",
+		CanTransition: noMaintenanceExit,
+	})
+	require.NoError(t, err)
+	require.True(t, result.Changed)
+	require.Equal(t, station.Status, result.History.FromStatus)
+	require.Equal(t, "MAINTENANCE", result.History.ToStatus)
+	require.Equal(t, "sensor replacement", result.History.Reason)
+
+	// same status is a no-op
+	result, err = testStore.UpdateStationStatusTx(context.Background(), UpdateStationStatusTxParams{
+		StationID:     station.ID,
+		Status:        "MAINTENANCE",
+		CanTransition: noMaintenanceExit,
+	})
+	require.NoError(t, err)
+	require.False(t, result.Changed)
+
+	_, err = testStore.UpdateStationStatusTx(context.Background(), UpdateStationStatusTxParams{
+		StationID:     station.ID,
+		Status:        "OFFLINE",
+		CanTransition: noMaintenanceExit,
+	})
+	require.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	gotStation, err := testStore.GetStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, "MAINTENANCE", gotStation.Status.String)
+
+	count, err := testStore.CountStationStatusHistory(context.Background(), CountStationStatusHistoryParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	_, err = testStore.UpdateStationStatusTx(context.Background(), UpdateStationStatusTxParams{
+		StationID: station.ID + 1000,
+		Status:    "ONLINE",
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
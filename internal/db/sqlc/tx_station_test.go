@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationTxTestSuite struct {
+	suite.Suite
+}
+
+func TestStationTxTestSuite(t *testing.T) {
+	suite.Run(t, new(StationTxTestSuite))
+}
+
+func (ts *StationTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationTxTestSuite) TestUpdateStationTx() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	otherStation := createRandomStation(t, nil)
+
+	result, err := testStore.UpdateStationTx(context.Background(), UpdateStationTxParams{
+		UpdateStationParams: UpdateStationParams{
+			ID:       station.ID,
+			Name:     util.ToPgText("renamed"),
+			Status:   util.ToPgText("MAINTENANCE"),
+			Province: util.ToPgText("Benguet"),
+		},
+		Reason: "station updated",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "renamed", result.Station.Name)
+	require.Equal(t, "MAINTENANCE", result.Station.Status.String)
+	require.Equal(t, "Benguet", result.Station.Province.String)
+
+	count, err := testStore.CountStationStatusHistory(context.Background(), CountStationStatusHistoryParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	elevation := pgtype.Float4{Float32: 512, Valid: true}
+	result, err = testStore.UpdateStationTx(context.Background(), UpdateStationTxParams{
+		UpdateStationParams: UpdateStationParams{
+			ID:        station.ID,
+			Elevation: elevation,
+		},
+		Reason: "station moved",
+	})
+	require.NoError(t, err)
+	require.Equal(t, elevation, result.Station.Elevation)
+
+	metadata, err := testStore.GetCurrentStationMetadataForUpdate(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, elevation, metadata.Elevation)
+	require.Equal(t, "station moved", metadata.Reason)
+
+	// the status change is rolled back with the failed update
+	_, err = testStore.UpdateStationTx(context.Background(), UpdateStationTxParams{
+		UpdateStationParams: UpdateStationParams{
+			ID:           station.ID,
+			Status:       util.ToPgText("ONLINE"),
+			Elevation:    pgtype.Float4{Float32: 1024, Valid: true},
+			MobileNumber: otherStation.MobileNumber,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	gotStation, err := testStore.GetStation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, "MAINTENANCE", gotStation.Status.String)
+	require.Equal(t, station.MobileNumber, gotStation.MobileNumber)
+	require.Equal(t, elevation, gotStation.Elevation)
+
+	count, err = testStore.CountStationStatusHistory(context.Background(), CountStationStatusHistoryParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	_, err = testStore.UpdateStationTx(context.Background(), UpdateStationTxParams{
+		UpdateStationParams: UpdateStationParams{
+			ID:     station.ID,
+			Status: util.ToPgText("OFFLINE"),
+		},
+		CanTransition: func(from, to string) bool { return from != "MAINTENANCE" },
+	})
+	require.ErrorIs(t, err, ErrInvalidStatusTransition)
+}
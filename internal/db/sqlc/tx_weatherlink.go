@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateWeatherlinkStationTxParams struct {
+	Name              string        `json:"name"`
+	Lat               pgtype.Float4 `json:"lat"`
+	Lon               pgtype.Float4 `json:"lon"`
+	Elevation         pgtype.Float4 `json:"elevation"`
+	Status            pgtype.Text   `json:"status"`
+	ApiKey            pgtype.Text   `json:"api_key"`
+	ApiSecret         pgtype.Text   `json:"api_secret"`
+	WlStationID       pgtype.Int4   `json:"wl_station_id"`
+	FirmwareVersion   pgtype.Text   `json:"firmware_version"`
+	RecordingInterval pgtype.Int4   `json:"recording_interval"`
+}
+
+type CreateWeatherlinkStationTxResult struct {
+	Weatherlink Weatherlink
+	Info        ObservationsStation
+}
+
+func (store *SQLStore) CreateWeatherlinkStationTx(ctx context.Context, arg CreateWeatherlinkStationTxParams) (CreateWeatherlinkStationTxResult, error) {
+	var result CreateWeatherlinkStationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Info, err = q.CreateStation(ctx, CreateStationParams{
+			Name:      arg.Name,
+			Lat:       arg.Lat,
+			Lon:       arg.Lon,
+			Elevation: arg.Elevation,
+			Status:    arg.Status,
+			StationType: pgtype.Text{
+				String: "MO",
+				Valid:  true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		wl, err := q.CreateWeatherlinkStation(ctx, CreateWeatherlinkStationParams{
+			StationID: result.Info.ID,
+			ApiKey:    arg.ApiKey,
+			ApiSecret: arg.ApiSecret,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Weatherlink, err = q.UpdateWeatherlinkStationMetadata(ctx, UpdateWeatherlinkStationMetadataParams{
+			ID:                wl.ID,
+			WlStationID:       arg.WlStationID,
+			Lat:               arg.Lat,
+			Lon:               arg.Lon,
+			Elevation:         arg.Elevation,
+			FirmwareVersion:   arg.FirmwareVersion,
+			RecordingInterval: arg.RecordingInterval,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type UploadStationTestSuite struct {
+	suite.Suite
+}
+
+func TestUploadStationTestSuite(t *testing.T) {
+	suite.Run(t, new(UploadStationTestSuite))
+}
+
+func (ts *UploadStationTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *UploadStationTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *UploadStationTestSuite) TestCreateUploadStation() {
+	t := ts.T()
+	uStn := createRandomUploadStation(t, "WU")
+
+	arg := CreateUploadStationParams{
+		StationID: uStn.StationID,
+		Protocol:  uStn.Protocol,
+		UploadID:  uStn.UploadID,
+		UploadKey: util.RandomString(60),
+	}
+	_, err := testStore.CreateUploadStation(context.Background(), arg)
+	require.Error(t, err)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
+
+func (ts *UploadStationTestSuite) TestGetUploadStation() {
+	t := ts.T()
+	uStn := createRandomUploadStation(t, "ECOWITT")
+
+	gotUStn, err := testStore.GetUploadStation(context.Background(), GetUploadStationParams{
+		Protocol: uStn.Protocol,
+		UploadID: uStn.UploadID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uStn, gotUStn)
+
+	_, err = testStore.GetUploadStation(context.Background(), GetUploadStationParams{
+		Protocol: "WU",
+		UploadID: uStn.UploadID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	// uploads for deleted stations are not accepted
+	_, err = testStore.DeleteStation(context.Background(), uStn.StationID)
+	require.NoError(t, err)
+	_, err = testStore.GetUploadStation(context.Background(), GetUploadStationParams{
+		Protocol: uStn.Protocol,
+		UploadID: uStn.UploadID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *UploadStationTestSuite) TestListUploadStations() {
+	t := ts.T()
+	uStn := createRandomUploadStation(t, "WU")
+
+	arg := CreateUploadStationParams{
+		StationID: uStn.StationID,
+		Protocol:  "ECOWITT",
+		UploadID:  strings.ToUpper(util.RandomString(12)),
+		UploadKey: util.RandomString(60),
+	}
+	_, err := testStore.CreateUploadStation(context.Background(), arg)
+	require.NoError(t, err)
+
+	gotUStns, err := testStore.ListUploadStations(context.Background(), uStn.StationID)
+	require.NoError(t, err)
+	require.Len(t, gotUStns, 2)
+	require.Equal(t, uStn, gotUStns[0])
+}
+
+func (ts *UploadStationTestSuite) TestDeleteUploadStation() {
+	t := ts.T()
+	uStn := createRandomUploadStation(t, "WU")
+
+	err := testStore.DeleteUploadStation(context.Background(), DeleteUploadStationParams{
+		StationID: uStn.StationID,
+		ID:        uStn.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.GetUploadStation(context.Background(), GetUploadStationParams{
+		Protocol: uStn.Protocol,
+		UploadID: uStn.UploadID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func createRandomUploadStation(t *testing.T, protocol string) UploadStation {
+	station := createRandomStation(t, false)
+
+	arg := CreateUploadStationParams{
+		StationID: station.ID,
+		Protocol:  protocol,
+		UploadID:  strings.ToUpper(util.RandomString(12)),
+		UploadKey: util.RandomString(60),
+	}
+
+	uStn, err := testStore.CreateUploadStation(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, uStn.ID)
+	require.Equal(t, arg.StationID, uStn.StationID)
+	require.Equal(t, arg.Protocol, uStn.Protocol)
+	require.Equal(t, arg.UploadID, uStn.UploadID)
+	require.Equal(t, arg.UploadKey, uStn.UploadKey)
+
+	return uStn
+}
@@ -16,17 +16,23 @@ INSERT INTO weatherlink (
   station_id,
   uuid,
   api_key,
-  api_secret
+  api_secret,
+  v1_user,
+  v1_pass,
+  v1_api_token
 ) VALUES (
-  $1, $2, $3, $4
-) RETURNING id, station_id, uuid, api_key, api_secret, created_at, updated_at, deleted_at
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, station_id, uuid, api_key, api_secret, created_at, updated_at, deleted_at, wl_station_id, lat, lon, elevation, firmware_version, recording_interval, synced_at, v1_user, v1_pass, v1_api_token
 `
 
 type CreateWeatherlinkStationParams struct {
-	StationID int64       `json:"station_id"`
-	Uuid      pgtype.Text `json:"uuid"`
-	ApiKey    pgtype.Text `json:"api_key"`
-	ApiSecret pgtype.Text `json:"api_secret"`
+	StationID  int64       `json:"station_id"`
+	Uuid       pgtype.Text `json:"uuid"`
+	ApiKey     pgtype.Text `json:"api_key"`
+	ApiSecret  pgtype.Text `json:"api_secret"`
+	V1User     pgtype.Text `json:"v1_user"`
+	V1Pass     pgtype.Text `json:"v1_pass"`
+	V1ApiToken pgtype.Text `json:"v1_api_token"`
 }
 
 func (q *Queries) CreateWeatherlinkStation(ctx context.Context, arg CreateWeatherlinkStationParams) (Weatherlink, error) {
@@ -35,6 +41,9 @@ func (q *Queries) CreateWeatherlinkStation(ctx context.Context, arg CreateWeathe
 		arg.Uuid,
 		arg.ApiKey,
 		arg.ApiSecret,
+		arg.V1User,
+		arg.V1Pass,
+		arg.V1ApiToken,
 	)
 	var i Weatherlink
 	err := row.Scan(
@@ -46,14 +55,105 @@ func (q *Queries) CreateWeatherlinkStation(ctx context.Context, arg CreateWeathe
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.WlStationID,
+		&i.Lat,
+		&i.Lon,
+		&i.Elevation,
+		&i.FirmwareVersion,
+		&i.RecordingInterval,
+		&i.SyncedAt,
+		&i.V1User,
+		&i.V1Pass,
+		&i.V1ApiToken,
+	)
+	return i, err
+}
+
+const deleteWeatherlinkStation = `-- name: DeleteWeatherlinkStation :exec
+DELETE FROM weatherlink WHERE station_id = $1
+`
+
+func (q *Queries) DeleteWeatherlinkStation(ctx context.Context, stationID int64) error {
+	_, err := q.db.Exec(ctx, deleteWeatherlinkStation, stationID)
+	return err
+}
+
+const getWeatherlinkStation = `-- name: GetWeatherlinkStation :one
+SELECT id, station_id, uuid, api_key, api_secret, created_at, updated_at, deleted_at, wl_station_id, lat, lon, elevation, firmware_version, recording_interval, synced_at, v1_user, v1_pass, v1_api_token FROM weatherlink
+WHERE station_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetWeatherlinkStation(ctx context.Context, stationID int64) (Weatherlink, error) {
+	row := q.db.QueryRow(ctx, getWeatherlinkStation, stationID)
+	var i Weatherlink
+	err := row.Scan(
+		&i.ID,
+		&i.StationID,
+		&i.Uuid,
+		&i.ApiKey,
+		&i.ApiSecret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.WlStationID,
+		&i.Lat,
+		&i.Lon,
+		&i.Elevation,
+		&i.FirmwareVersion,
+		&i.RecordingInterval,
+		&i.SyncedAt,
+		&i.V1User,
+		&i.V1Pass,
+		&i.V1ApiToken,
+	)
+	return i, err
+}
+
+const getWeatherlinkStationByWLID = `-- name: GetWeatherlinkStationByWLID :one
+SELECT id, station_id, uuid, api_key, api_secret, created_at, updated_at, deleted_at, wl_station_id, lat, lon, elevation, firmware_version, recording_interval, synced_at, v1_user, v1_pass, v1_api_token FROM weatherlink
+WHERE api_key = $1 AND wl_station_id = $2 LIMIT 1
+`
+
+type GetWeatherlinkStationByWLIDParams struct {
+	ApiKey      pgtype.Text `json:"api_key"`
+	WlStationID pgtype.Int4 `json:"wl_station_id"`
+}
+
+func (q *Queries) GetWeatherlinkStationByWLID(ctx context.Context, arg GetWeatherlinkStationByWLIDParams) (Weatherlink, error) {
+	row := q.db.QueryRow(ctx, getWeatherlinkStationByWLID, arg.ApiKey, arg.WlStationID)
+	var i Weatherlink
+	err := row.Scan(
+		&i.ID,
+		&i.StationID,
+		&i.Uuid,
+		&i.ApiKey,
+		&i.ApiSecret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.WlStationID,
+		&i.Lat,
+		&i.Lon,
+		&i.Elevation,
+		&i.FirmwareVersion,
+		&i.RecordingInterval,
+		&i.SyncedAt,
+		&i.V1User,
+		&i.V1Pass,
+		&i.V1ApiToken,
 	)
 	return i, err
 }
 
 const listWeatherlinkStations = `-- name: ListWeatherlinkStations :many
-SELECT id, station_id, uuid, api_key, api_secret, created_at, updated_at, deleted_at FROM weatherlink
+SELECT id, station_id, uuid, api_key, api_secret, created_at, updated_at, deleted_at, wl_station_id, lat, lon, elevation, firmware_version, recording_interval, synced_at, v1_user, v1_pass, v1_api_token FROM weatherlink
 WHERE
-  uuid IS NOT NULL OR (api_key IS NOT NULL AND api_secret IS NOT NULL)
+  (
+    uuid IS NOT NULL
+    OR (api_key IS NOT NULL AND api_secret IS NOT NULL)
+    OR (v1_user IS NOT NULL AND v1_pass IS NOT NULL AND v1_api_token IS NOT NULL)
+  )
+  AND station_id IN (SELECT id FROM observations_station WHERE deleted_at = '0001-01-01 00:00:00Z')
 ORDER BY id
 LIMIT $2
 OFFSET $1
@@ -82,6 +182,16 @@ func (q *Queries) ListWeatherlinkStations(ctx context.Context, arg ListWeatherli
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.WlStationID,
+			&i.Lat,
+			&i.Lon,
+			&i.Elevation,
+			&i.FirmwareVersion,
+			&i.RecordingInterval,
+			&i.SyncedAt,
+			&i.V1User,
+			&i.V1Pass,
+			&i.V1ApiToken,
 		); err != nil {
 			return nil, err
 		}
@@ -92,3 +202,120 @@ func (q *Queries) ListWeatherlinkStations(ctx context.Context, arg ListWeatherli
 	}
 	return items, nil
 }
+
+const updateWeatherlinkStation = `-- name: UpdateWeatherlinkStation :one
+UPDATE weatherlink
+SET
+  uuid = COALESCE($1, uuid),
+  api_key = COALESCE($2, api_key),
+  api_secret = COALESCE($3, api_secret),
+  v1_user = COALESCE($4, v1_user),
+  v1_pass = COALESCE($5, v1_pass),
+  v1_api_token = COALESCE($6, v1_api_token),
+  updated_at = now()
+WHERE station_id = $7
+RETURNING id, station_id, uuid, api_key, api_secret, created_at, updated_at, deleted_at, wl_station_id, lat, lon, elevation, firmware_version, recording_interval, synced_at, v1_user, v1_pass, v1_api_token
+`
+
+type UpdateWeatherlinkStationParams struct {
+	Uuid       pgtype.Text `json:"uuid"`
+	ApiKey     pgtype.Text `json:"api_key"`
+	ApiSecret  pgtype.Text `json:"api_secret"`
+	V1User     pgtype.Text `json:"v1_user"`
+	V1Pass     pgtype.Text `json:"v1_pass"`
+	V1ApiToken pgtype.Text `json:"v1_api_token"`
+	StationID  int64       `json:"station_id"`
+}
+
+func (q *Queries) UpdateWeatherlinkStation(ctx context.Context, arg UpdateWeatherlinkStationParams) (Weatherlink, error) {
+	row := q.db.QueryRow(ctx, updateWeatherlinkStation,
+		arg.Uuid,
+		arg.ApiKey,
+		arg.ApiSecret,
+		arg.V1User,
+		arg.V1Pass,
+		arg.V1ApiToken,
+		arg.StationID,
+	)
+	var i Weatherlink
+	err := row.Scan(
+		&i.ID,
+		&i.StationID,
+		&i.Uuid,
+		&i.ApiKey,
+		&i.ApiSecret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.WlStationID,
+		&i.Lat,
+		&i.Lon,
+		&i.Elevation,
+		&i.FirmwareVersion,
+		&i.RecordingInterval,
+		&i.SyncedAt,
+		&i.V1User,
+		&i.V1Pass,
+		&i.V1ApiToken,
+	)
+	return i, err
+}
+
+const updateWeatherlinkStationMetadata = `-- name: UpdateWeatherlinkStationMetadata :one
+UPDATE weatherlink
+SET
+  wl_station_id = $1,
+  lat = $2,
+  lon = $3,
+  elevation = $4,
+  firmware_version = $5,
+  recording_interval = $6,
+  synced_at = now(),
+  updated_at = now()
+WHERE id = $7
+RETURNING id, station_id, uuid, api_key, api_secret, created_at, updated_at, deleted_at, wl_station_id, lat, lon, elevation, firmware_version, recording_interval, synced_at, v1_user, v1_pass, v1_api_token
+`
+
+type UpdateWeatherlinkStationMetadataParams struct {
+	WlStationID       pgtype.Int4   `json:"wl_station_id"`
+	Lat               pgtype.Float4 `json:"lat"`
+	Lon               pgtype.Float4 `json:"lon"`
+	Elevation         pgtype.Float4 `json:"elevation"`
+	FirmwareVersion   pgtype.Text   `json:"firmware_version"`
+	RecordingInterval pgtype.Int4   `json:"recording_interval"`
+	ID                int64         `json:"id"`
+}
+
+func (q *Queries) UpdateWeatherlinkStationMetadata(ctx context.Context, arg UpdateWeatherlinkStationMetadataParams) (Weatherlink, error) {
+	row := q.db.QueryRow(ctx, updateWeatherlinkStationMetadata,
+		arg.WlStationID,
+		arg.Lat,
+		arg.Lon,
+		arg.Elevation,
+		arg.FirmwareVersion,
+		arg.RecordingInterval,
+		arg.ID,
+	)
+	var i Weatherlink
+	err := row.Scan(
+		&i.ID,
+		&i.StationID,
+		&i.Uuid,
+		&i.ApiKey,
+		&i.ApiSecret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.WlStationID,
+		&i.Lat,
+		&i.Lon,
+		&i.Elevation,
+		&i.FirmwareVersion,
+		&i.RecordingInterval,
+		&i.SyncedAt,
+		&i.V1User,
+		&i.V1Pass,
+		&i.V1ApiToken,
+	)
+	return i, err
+}
@@ -31,6 +31,49 @@ func (ts *WeatherlinkTestSuite) TearDownTest() {
 func (ts *WeatherlinkTestSuite) TestCreateWeatherlinkStation() {
 	createRandomWeatherlinkStation(ts.T(), "Default")
 	createRandomWeatherlinkStation(ts.T(), "V2")
+	createRandomWeatherlinkStation(ts.T(), "V1")
+}
+
+func (ts *WeatherlinkTestSuite) TestGetWeatherlinkStation() {
+	t := ts.T()
+	wl := createRandomWeatherlinkStation(t, "V1")
+
+	gotWl, err := testStore.GetWeatherlinkStation(context.Background(), wl.StationID)
+	require.NoError(t, err)
+	require.Equal(t, wl, gotWl)
+
+	_, err = testStore.GetWeatherlinkStation(context.Background(), wl.StationID+1)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *WeatherlinkTestSuite) TestUpdateWeatherlinkStation() {
+	t := ts.T()
+	wl := createRandomWeatherlinkStation(t, "V2")
+
+	arg := UpdateWeatherlinkStationParams{
+		StationID: wl.StationID,
+		ApiSecret: pgtype.Text{String: util.RandomString(24), Valid: true},
+		V1User:    pgtype.Text{String: util.RandomString(8), Valid: true},
+	}
+	gotWl, err := testStore.UpdateWeatherlinkStation(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, wl.ID, gotWl.ID)
+	require.Equal(t, wl.ApiKey, gotWl.ApiKey)
+	require.Equal(t, arg.ApiSecret, gotWl.ApiSecret)
+	require.Equal(t, arg.V1User, gotWl.V1User)
+	require.False(t, gotWl.V1Pass.Valid)
+	require.True(t, gotWl.UpdatedAt.Time.After(wl.UpdatedAt.Time))
+}
+
+func (ts *WeatherlinkTestSuite) TestDeleteWeatherlinkStation() {
+	t := ts.T()
+	wl := createRandomWeatherlinkStation(t, "Default")
+
+	err := testStore.DeleteWeatherlinkStation(context.Background(), wl.StationID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetWeatherlinkStation(context.Background(), wl.StationID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
 }
 
 func (ts *WeatherlinkTestSuite) TestListWeatherlinkStations() {
@@ -54,13 +97,115 @@ func (ts *WeatherlinkTestSuite) TestListWeatherlinkStations() {
 	}
 }
 
+func (ts *WeatherlinkTestSuite) TestUpdateWeatherlinkStationMetadata() {
+	t := ts.T()
+	wl := createRandomWeatherlinkStation(t, "V2")
+
+	arg := UpdateWeatherlinkStationMetadataParams{
+		ID:                wl.ID,
+		WlStationID:       pgtype.Int4{Int32: util.RandomInt[int32](1000, 9999), Valid: true},
+		Lat:               pgtype.Float4{Float32: getRandomLat(), Valid: true},
+		Lon:               pgtype.Float4{Float32: getRandomLon(), Valid: true},
+		FirmwareVersion:   pgtype.Text{String: "1.4.2", Valid: true},
+		RecordingInterval: pgtype.Int4{Int32: 5, Valid: true},
+	}
+	gotWl, err := testStore.UpdateWeatherlinkStationMetadata(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.WlStationID, gotWl.WlStationID)
+	require.Equal(t, arg.Lat, gotWl.Lat)
+	require.Equal(t, arg.Lon, gotWl.Lon)
+	require.False(t, gotWl.Elevation.Valid)
+	require.Equal(t, arg.FirmwareVersion, gotWl.FirmwareVersion)
+	require.Equal(t, arg.RecordingInterval, gotWl.RecordingInterval)
+	require.True(t, gotWl.SyncedAt.Valid)
+
+	gotWl, err = testStore.GetWeatherlinkStationByWLID(context.Background(), GetWeatherlinkStationByWLIDParams{
+		ApiKey:      wl.ApiKey,
+		WlStationID: arg.WlStationID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, wl.ID, gotWl.ID)
+
+	_, err = testStore.GetWeatherlinkStationByWLID(context.Background(), GetWeatherlinkStationByWLIDParams{
+		ApiKey:      wl.ApiKey,
+		WlStationID: pgtype.Int4{Int32: arg.WlStationID.Int32 + 1, Valid: true},
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *WeatherlinkTestSuite) TestCreateWeatherlinkStationTx() {
+	t := ts.T()
+	arg := CreateWeatherlinkStationTxParams{
+		Name:            util.RandomString(15),
+		Lat:             pgtype.Float4{Float32: getRandomLat(), Valid: true},
+		Lon:             pgtype.Float4{Float32: getRandomLon(), Valid: true},
+		ApiKey:          pgtype.Text{String: util.RandomString(12), Valid: true},
+		ApiSecret:       pgtype.Text{String: util.RandomString(24), Valid: true},
+		WlStationID:     pgtype.Int4{Int32: util.RandomInt[int32](1000, 9999), Valid: true},
+		FirmwareVersion: pgtype.Text{String: "1.4.2", Valid: true},
+	}
+
+	result, err := testStore.CreateWeatherlinkStationTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, result.Info.Name)
+	require.Equal(t, arg.Lat, result.Info.Lat)
+	require.Equal(t, "MO", result.Info.StationType.String)
+	require.Equal(t, result.Info.ID, result.Weatherlink.StationID)
+	require.Equal(t, arg.ApiKey, result.Weatherlink.ApiKey)
+	require.Equal(t, arg.WlStationID, result.Weatherlink.WlStationID)
+	require.Equal(t, arg.FirmwareVersion, result.Weatherlink.FirmwareVersion)
+}
+
+func (ts *WeatherlinkTestSuite) TestWeatherlinkChange() {
+	t := ts.T()
+	stn := createRandomStation(t, false)
+	n := 4
+	for i := 0; i < n; i++ {
+		change, err := testStore.CreateWeatherlinkChange(context.Background(), CreateWeatherlinkChangeParams{
+			StationID: stn.ID,
+			Field:     "firmware_version",
+			OldValue:  pgtype.Text{String: "1.4.1", Valid: true},
+			NewValue:  pgtype.Text{String: "1.4.2", Valid: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "PENDING", change.Status)
+		require.False(t, change.ReviewedAt.Valid)
+	}
+
+	pending := pgtype.Text{String: "PENDING", Valid: true}
+	changes, err := testStore.ListWeatherlinkChanges(context.Background(), ListWeatherlinkChangesParams{Status: pending})
+	require.NoError(t, err)
+	require.Len(t, changes, n)
+
+	change, err := testStore.UpdateWeatherlinkChangeStatus(context.Background(), UpdateWeatherlinkChangeStatusParams{
+		ID:     changes[0].ID,
+		Status: "APPROVED",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "APPROVED", change.Status)
+	require.True(t, change.ReviewedAt.Valid)
+
+	gotChange, err := testStore.GetWeatherlinkChange(context.Background(), change.ID)
+	require.NoError(t, err)
+	require.Equal(t, change, gotChange)
+
+	count, err := testStore.CountWeatherlinkChanges(context.Background(), pending)
+	require.NoError(t, err)
+	require.Equal(t, int64(n-1), count)
+
+	count, err = testStore.CountWeatherlinkChanges(context.Background(), pgtype.Text{})
+	require.NoError(t, err)
+	require.Equal(t, int64(n), count)
+}
+
 func createRandomWeatherlinkStation(t *testing.T, stationType string) Weatherlink {
 	stn := createRandomStation(t, false)
 	arg := CreateWeatherlinkStationParams{
 		StationID: stn.ID,
 	}
 
-	if stationType == "V2" {
+	switch stationType {
+	case "V2":
 		arg.ApiKey = pgtype.Text{
 			String: util.RandomString(12),
 			Valid:  true,
@@ -69,7 +214,20 @@ func createRandomWeatherlinkStation(t *testing.T, stationType string) Weatherlin
 			String: util.RandomString(24),
 			Valid:  true,
 		}
-	} else {
+	case "V1":
+		arg.V1User = pgtype.Text{
+			String: util.RandomString(8),
+			Valid:  true,
+		}
+		arg.V1Pass = pgtype.Text{
+			String: util.RandomString(12),
+			Valid:  true,
+		}
+		arg.V1ApiToken = pgtype.Text{
+			String: util.RandomString(32),
+			Valid:  true,
+		}
+	default:
 		arg.Uuid = pgtype.Text{
 			String: util.RandomString(24),
 			Valid:  true,
@@ -80,12 +238,17 @@ func createRandomWeatherlinkStation(t *testing.T, stationType string) Weatherlin
 	require.NoError(t, err)
 	require.NotEmpty(t, wl)
 
-	if stationType == "V2" {
+	switch stationType {
+	case "V2":
 		require.True(t, wl.ApiKey.Valid)
 		require.Equal(t, arg.ApiKey.String, wl.ApiKey.String)
 		require.True(t, wl.ApiSecret.Valid)
 		require.Equal(t, arg.ApiSecret.String, wl.ApiSecret.String)
-	} else {
+	case "V1":
+		require.Equal(t, arg.V1User, wl.V1User)
+		require.Equal(t, arg.V1Pass, wl.V1Pass)
+		require.Equal(t, arg.V1ApiToken, wl.V1ApiToken)
+	default:
 		require.True(t, wl.Uuid.Valid)
 		require.Equal(t, arg.Uuid.String, wl.Uuid.String)
 	}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
@@ -33,7 +34,7 @@ func (h *DefaultHandler) CSIStoreMisol(ctx *gin.Context) {
 		return
 	}
 
-	misol, err := sensor.NewMisolFromString(req.WeatherStr)
+	misolID, err := sensor.MisolStationID(req.WeatherStr)
 	if err != nil {
 		h.logger.Error().Err(err).
 			Str("msg", req.WeatherStr).
@@ -42,23 +43,34 @@ func (h *DefaultHandler) CSIStoreMisol(ctx *gin.Context) {
 		return
 	}
 
-	mStn, err := h.store.GetMisolStation(ctx, misol.StnID)
+	mStn, err := h.store.GetMisolStation(ctx, misolID)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
-			h.logger.Error().Err(err).
-				Int64("misolID", misol.StnID).
-				Str("msg", req.WeatherStr).
-				Msg("[CircuitSolutions] No station found")
-			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			misol, err := sensor.NewMisolFromString(req.WeatherStr)
+			if err != nil {
+				h.logger.Error().Err(err).
+					Str("msg", req.WeatherStr).
+					Msg("[CircuitSolutions] Invalid string")
+				ctx.JSON(http.StatusBadRequest, errorResponse(err))
+				return
+			}
+			h.registerMisolStation(ctx, misol, req.WeatherStr)
 			return
 		}
 		h.logger.Error().Err(err).
-			Int64("misolID", misol.StnID).
+			Int64("misolID", misolID).
 			Str("msg", req.WeatherStr).
 			Msg("[CircuitSolutions] An error occured")
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	if mStn.Status == MisolStatusPending {
+		h.logger.Info().
+			Int64("misolID", misolID).
+			Msg("[CircuitSolutions] Station pending approval")
+		ctx.JSON(http.StatusAccepted, gin.H{"message": "station pending approval"})
+		return
+	}
 	stn, err := h.store.GetStation(ctx, mStn.StationID)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
@@ -77,6 +89,26 @@ func (h *DefaultHandler) CSIStoreMisol(ctx *gin.Context) {
 		return
 	}
 
+	clockOpts, err := h.clockOpts(ctx, stn.ID)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("id", stn.ID).
+			Str("msg", req.WeatherStr).
+			Msg("[CircuitSolutions] Cannot get station clock settings")
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	misol, err := sensor.NewMisolFromString(req.WeatherStr, clockOpts...)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("id", stn.ID).
+			Str("msg", req.WeatherStr).
+			Msg("[CircuitSolutions] Invalid string")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	obsArg := db.CreateStationObservationParams{
 		StationID: stn.ID,
 		Pres:      util.ToFloat4(misol.Obs.Pres),
@@ -148,3 +180,31 @@ func (h *DefaultHandler) CSIStoreMisol(ctx *gin.Context) {
 		Msg("[CircuitSolutions] Data saved successfully")
 	ctx.JSON(http.StatusCreated, res)
 }
+
+// registerMisolStation creates a pending station from the location in the first payload of an unknown misol id.
+// Observations are not stored until an admin approves the registration.
+func (h *DefaultHandler) registerMisolStation(ctx *gin.Context, misol *sensor.Misol, weatherStr string) {
+	_, err := h.store.CreateMisolStationTx(ctx, db.CreateMisolStationTxParams{
+		ID:          misol.StnID,
+		Name:        fmt.Sprintf("Misol %d", misol.StnID),
+		Lat:         pgtype.Float4{Float32: misol.Lat, Valid: true},
+		Lon:         pgtype.Float4{Float32: misol.Lon, Valid: true},
+		Status:      util.ToPgText("INACTIVE"),
+		MisolStatus: util.ToPgText(MisolStatusPending),
+	})
+	if err != nil && db.ErrorCode(err) != db.UniqueViolation {
+		h.logger.Error().Err(err).
+			Int64("misolID", misol.StnID).
+			Str("msg", weatherStr).
+			Msg("[CircuitSolutions] Cannot register station")
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	h.logger.Info().
+		Int64("misolID", misol.StnID).
+		Float32("lat", misol.Lat).
+		Float32("lon", misol.Lon).
+		Msg("[CircuitSolutions] New station pending approval")
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "station pending approval"})
+}
@@ -3,9 +3,11 @@ package handlers
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
@@ -15,6 +17,8 @@ import (
 )
 
 func TestCSIStoreMisol(t *testing.T) {
+	loggerTime := time.Now().Add(-60 * time.Minute).Truncate(time.Second)
+
 	testCases := []struct {
 		name          string
 		body          gin.H
@@ -31,6 +35,8 @@ func TestCSIStoreMisol(t *testing.T) {
 					Return(db.MisolStation{ID: 17, StationID: 139}, nil)
 				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), int64(139)).
 					Return(db.ObservationsStation{}, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
 				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
 					Return(db.ObservationsObservation{}, nil)
 				store.EXPECT().CreateStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
@@ -41,6 +47,29 @@ func TestCSIStoreMisol(t *testing.T) {
 				require.Equal(t, http.StatusCreated, recorder.Code)
 			},
 		},
+		{
+			name: "ClockOffset",
+			body: gin.H{
+				"weather": fmt.Sprintf("75112112108101,123.8854,10.3157,%d,31,91,1007,6.7,13.4,105,1718,77,30.0001,2,23,3.7,3.8,0.012,17.8,0.065,50,10,25.2,54.4,0,90,1", loggerTime.Unix()),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), int64(75112112108101)).
+					Return(db.MisolStation{ID: 17, StationID: 139}, nil)
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), int64(139)).
+					Return(db.ObservationsStation{ID: 139}, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), int64(139)).
+					Return(db.StationClock{StationID: 139, Timezone: "Asia/Manila", ClockOffset: 60}, nil)
+				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationObservationParams) bool {
+					return arg.Timestamp.Time.Equal(loggerTime.Add(60 * time.Minute))
+				})).Return(db.ObservationsObservation{}, nil)
+				store.EXPECT().CreateStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationhealth{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
 		{
 			name: "MisolStationNotFound",
 			body: gin.H{
@@ -49,10 +78,31 @@ func TestCSIStoreMisol(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), int64(75112112108101)).
 					Return(db.MisolStation{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateMisolStationTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateMisolStationTxParams) bool {
+					return arg.ID == 75112112108101 &&
+						arg.Status.String == "INACTIVE" &&
+						arg.MisolStatus.String == MisolStatusPending &&
+						arg.Lat.Float32 > 10.3 && arg.Lat.Float32 < 10.33
+				})).Return(db.CreateMisolStationTxResult{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+			},
+		},
+		{
+			name: "MisolStationPending",
+			body: gin.H{
+				"weather": "75112112108101,123.8854,10.3157,1726809924,31,91,1007,6.7,13.4,105,1718,77,30.0001,2,23,3.7,3.8,0.012,17.8,0.065,50,10,25.2,54.4,0,90,1",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), int64(75112112108101)).
+					Return(db.MisolStation{ID: 75112112108101, StationID: 139, Status: MisolStatusPending}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationObservation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusAccepted, recorder.Code)
 			},
 		},
 		{
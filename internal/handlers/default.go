@@ -1,7 +1,11 @@
 package handlers
 
 import (
+	"net/http"
+	"time"
+
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
 	"github.com/emiliogozo/panahon-api-go/internal/token"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
@@ -11,10 +15,12 @@ import (
 )
 
 type DefaultHandler struct {
-	config     util.Config
-	store      db.Store
-	tokenMaker token.Maker
-	logger     *zerolog.Logger
+	config       util.Config
+	store        db.Store
+	tokenMaker   token.Maker
+	secrets      *util.SecretCipher
+	davisFactory sensor.DavisFactory
+	logger       *zerolog.Logger
 }
 
 func NewDefaultHandler(config util.Config, store db.Store, tokenMaker token.Maker, logger *zerolog.Logger) *DefaultHandler {
@@ -25,11 +31,22 @@ func NewDefaultHandler(config util.Config, store db.Store, tokenMaker token.Make
 		v.RegisterValidation("date_time", validDateTimeStr)
 	}
 
+	secrets, err := util.LoadSecretCipher(config.SecretEncryptionKey)
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot create secret cipher, secrets can not be encrypted")
+	}
+
+	davisClient := &http.Client{Timeout: 30 * time.Second}
+
 	return &DefaultHandler{
 		config:     config,
 		store:      store,
 		tokenMaker: tokenMaker,
-		logger:     logger,
+		secrets:    secrets,
+		davisFactory: func(creds sensor.DavisAPICredentials) sensor.DavisSensor {
+			return sensor.NewDavisWithClient(creds, davisClient)
+		},
+		logger: logger,
 	}
 }
 
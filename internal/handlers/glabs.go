@@ -2,12 +2,17 @@ package handlers
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -211,10 +216,27 @@ func newGLabsLoadResponse(g db.GlabsLoad) gLabsLoadRes {
 //	@Tags		globelabs
 //	@Accept		json
 //	@Produce	json
-//	@Param		req	query		gLabsLoadReq	true	"Globe Labs Load query"
-//	@Success	200	{object}	gLabsLoadRes
+//	@Description	Completes a top-up requested by the scheduler. Only the first callback for a known transaction is applied, other callbacks are only recorded.
+//	@Param		X-Callback-Token	header		string			false	"Callback token"
+//	@Param		token	query		string			false	"Callback token, used when the header is not set"
+//	@Param		req		body		gLabsLoadReq	true	"Globe Labs Load query"
+//	@Success	200		{object}	gLabsLoadRes
 //	@Router		/glabs/load [post]
 func (h *DefaultHandler) CreateGLabsLoad(ctx *gin.Context) {
+	token := ctx.GetHeader(glabsCallbackTokenHeader)
+	if token == "" {
+		// the query token is kept for callback urls without custom headers, the access log redacts it
+		token = ctx.Query("token")
+	}
+	if !validCallbackToken(token, h.config.GlabsCallbackToken) {
+		err := fmt.Errorf("invalid callback token")
+		h.logger.Warn().Err(err).
+			Str("client_ip", ctx.ClientIP()).
+			Msg("[GLabsLoad] Unauthorized callback")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
 	var req gLabsLoadReq
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		h.logger.Error().Err(err).
@@ -239,38 +261,54 @@ func (h *DefaultHandler) CreateGLabsLoad(ctx *gin.Context) {
 		return
 	}
 
-	_, err := h.store.GetStationByMobileNumber(ctx,
-		pgtype.Text{
-			String: mobileNumber,
-			Valid:  true,
-		},
-	)
-	if err != nil {
-		if errors.Is(err, db.ErrRecordNotFound) {
-			h.logger.Warn().Err(err).
-				Str("subscriber", mobileNumber).
-				Str("promo", req.OutboundRewardRequest.Promo).
-				Str("status", req.OutboundRewardRequest.Status).
-				Msg("[GLabsLoad] Unknown number")
-		}
-	}
-
-	gLabsLoad, err := h.store.CreateGLabsLoad(ctx, db.CreateGLabsLoadParams{
-		Promo: pgtype.Text{
-			String: req.OutboundRewardRequest.Promo,
+	// only a top-up requested by the scheduler is completed, and only once
+	gLabsLoad, err := h.store.CompleteGLabsLoad(ctx, db.CompleteGLabsLoadParams{
+		Status: pgtype.Text{
+			String: req.OutboundRewardRequest.Status,
 			Valid:  true,
 		},
 		TransactionID: pgtype.Int4{
 			Int32: int32(req.OutboundRewardRequest.TransactionID),
 			Valid: true,
 		},
-		Status: pgtype.Text{
-			String: req.OutboundRewardRequest.Status,
-			Valid:  true,
-		},
 		MobileNumber: mobileNumber,
 	})
 	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			h.logger.Warn().Err(err).
+				Str("subscriber", mobileNumber).
+				Int("transaction_id", req.OutboundRewardRequest.TransactionID).
+				Str("status", req.OutboundRewardRequest.Status).
+				Msg("[GLabsLoad] Unknown or already completed transaction")
+
+			// the callback is kept for reconciliation but the sim load is left as is
+			gLabsLoad, err = h.store.CreateGLabsLoadCallback(ctx, db.CreateGLabsLoadCallbackParams{
+				Promo: pgtype.Text{
+					String: req.OutboundRewardRequest.Promo,
+					Valid:  true,
+				},
+				TransactionID: pgtype.Int4{
+					Int32: int32(req.OutboundRewardRequest.TransactionID),
+					Valid: true,
+				},
+				Status: pgtype.Text{
+					String: req.OutboundRewardRequest.Status,
+					Valid:  true,
+				},
+				MobileNumber: mobileNumber,
+			})
+			if err != nil {
+				h.logger.Error().Err(err).
+					Str("sender", mobileNumber).
+					Str("promo", req.OutboundRewardRequest.Promo).
+					Str("status", req.OutboundRewardRequest.Status).
+					Msg("[GLabsLoad] Error occured")
+				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+				return
+			}
+			ctx.JSON(http.StatusOK, newGLabsLoadResponse(gLabsLoad))
+			return
+		}
 		h.logger.Error().Err(err).
 			Str("sender", mobileNumber).
 			Str("promo", req.OutboundRewardRequest.Promo).
@@ -280,7 +318,30 @@ func (h *DefaultHandler) CreateGLabsLoad(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, newGLabsLoadResponse(gLabsLoad))
+	if strings.EqualFold(req.OutboundRewardRequest.Status, service.GLabsLoadStatusSuccess) {
+		if err := h.store.ExtendSimCardLoad(ctx, mobileNumber); err != nil {
+			h.logger.Error().Err(err).
+				Str("sender", mobileNumber).
+				Msg("[GLabsLoad] Cannot extend load expiry")
+		}
+	} else {
+		service.AlertFailedLoad(ctx, h.store, h.config.GlabsLoadAlertNumber, mobileNumber,
+			req.OutboundRewardRequest.Promo, "status "+req.OutboundRewardRequest.Status, h.logger)
+	}
+
+	ctx.JSON(http.StatusOK, newGLabsLoadResponse(gLabsLoad))
+}
+
+// glabsCallbackTokenHeader is the header holding the load callback token
+const glabsCallbackTokenHeader = "X-Callback-Token"
+
+// validCallbackToken reports whether got matches the configured callback token.
+// An unset token rejects every callback.
+func validCallbackToken(got, want string) bool {
+	if want == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
 }
 
 type fetchGLabsAccessTokenReq struct {
@@ -319,3 +380,238 @@ func (g *gLabsOptInReq) fetchGLabsAccessToken(appID, appSecret string) error {
 	g.SubscriberNumber = gotGTok.SubscriberNumber
 	return nil
 }
+
+type gLabsInboundSMS struct {
+	DateTime           string         `json:"dateTime"`
+	DestinationAddress string         `json:"destinationAddress"`
+	MessageID          string         `json:"messageId"`
+	Message            string         `json:"message"`
+	SenderAddress      string         `json:"senderAddress" binding:"required"`
+	MultipartRefID     string         `json:"multipartRefId"`
+	MultipartSeqNum    gLabsSMSSeqNum `json:"multipartSeqNum"`
+} //@name GlobeLabsInboundSMS
+
+// gLabsSMSSeqNum accepts the sequence number either as a number or as a string, empty when not multipart
+type gLabsSMSSeqNum int32
+
+func (n *gLabsSMSSeqNum) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*n = 0
+		return nil
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid multipart sequence number: %s", s)
+	}
+	*n = gLabsSMSSeqNum(v)
+	return nil
+}
+
+type gLabsInboundSMSReq struct {
+	InboundSMSMessageList struct {
+		InboundSMSMessage           []gLabsInboundSMS `json:"inboundSMSMessage" binding:"required,dive"`
+		NumberOfMessagesInThisBatch int               `json:"numberOfMessagesInThisBatch"`
+	} `json:"inboundSMSMessageList"`
+} //@name GlobeLabsInboundSMSParams
+
+type gLabsInboundSMSRes struct {
+	Stored     int `json:"stored"`
+	Pending    int `json:"pending"`    // unknown sender, buffered until the number is claimed
+	Incomplete int `json:"incomplete"` // multipart message waiting for the other parts
+	Acked      int `json:"acked"`      // acknowledgement of a station command
+	Failed     int `json:"failed"`
+} //@name GlobeLabsInboundSMSResponse
+
+// GLabsInboundSMS
+//
+//	@Summary	Globe Labs inbound SMS notification, stores Lufft observation and health
+//	@Tags		globelabs
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	body		gLabsInboundSMSReq	true	"Globe Labs inbound SMS notification"
+//	@Success	200	{object}	gLabsInboundSMSRes
+//	@Router		/glabs/sms [post]
+func (h *DefaultHandler) GLabsInboundSMS(ctx *gin.Context) {
+	var req gLabsInboundSMSReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error().Err(err).
+			Msg("[GLabsSMS] Bad request")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// Globe Labs retries the whole batch on errors, failed messages are only logged
+	var res gLabsInboundSMSRes
+	for _, sms := range req.InboundSMSMessageList.InboundSMSMessage {
+		h.handleGLabsInboundSMS(ctx, sms, &res)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (h *DefaultHandler) handleGLabsInboundSMS(ctx *gin.Context, sms gLabsInboundSMS, res *gLabsInboundSMSRes) {
+	mobileNumber, ok := util.ParseMobileNumber(strings.TrimPrefix(sms.SenderAddress, "tel:"))
+	if !ok {
+		h.logger.Error().
+			Str("sender", sms.SenderAddress).
+			Str("msg", sms.Message).
+			Msg("[GLabsSMS] Invalid mobile number")
+		res.Failed++
+		return
+	}
+
+	msg := sms.Message
+	if sms.MultipartRefID != "" {
+		var complete bool
+		var err error
+		msg, complete, err = h.reassembleGLabsSMS(ctx, mobileNumber, sms)
+		if err != nil {
+			h.logger.Error().Err(err).
+				Str("sender", mobileNumber).
+				Str("ref_id", sms.MultipartRefID).
+				Msg("[GLabsSMS] Cannot reassemble multipart message")
+			res.Failed++
+			return
+		}
+		if !complete {
+			res.Incomplete++
+			return
+		}
+	}
+
+	if ack, ok := sensor.ParseLufftAck(msg); ok && h.config.LufftCommandsEnabled {
+		cmd, err := h.acknowledgeStationCommand(ctx, mobileNumber, ack)
+		if err != nil {
+			h.logger.Error().Err(err).
+				Str("sender", mobileNumber).
+				Str("msg", msg).
+				Msg("[GLabsSMS] Cannot acknowledge command")
+			res.Failed++
+			return
+		}
+		h.logger.Info().
+			Str("sender", mobileNumber).
+			Int64("command_id", cmd.ID).
+			Str("status", cmd.Status).
+			Msg("[GLabsSMS] Command acknowledged")
+		res.Acked++
+		return
+	}
+
+	station, err := h.store.GetStationByMobileNumber(ctx, pgtype.Text{
+		String: mobileNumber,
+		Valid:  true,
+	})
+	known := err == nil
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		h.logger.Error().Err(err).
+			Str("sender", mobileNumber).
+			Str("msg", msg).
+			Msg("[GLabsSMS] An error occured")
+		res.Failed++
+		return
+	}
+
+	var clockOpts []sensor.ClockSettings
+	if known {
+		clockOpts, err = h.clockOpts(ctx, station.ID)
+		if err != nil {
+			h.logger.Error().Err(err).
+				Str("sender", mobileNumber).
+				Str("msg", msg).
+				Msg("[GLabsSMS] An error occured")
+			res.Failed++
+			return
+		}
+	}
+
+	lufft, err := sensor.NewLufftFromString(msg, clockOpts...)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("sender", mobileNumber).
+			Str("msg", msg).
+			Msg("[GLabsSMS] Invalid string")
+		res.Failed++
+		return
+	}
+
+	if !known {
+		_, err = h.store.BufferPendingStationMessageTx(ctx, db.BufferPendingStationMessageTxParams{
+			MobileNumber: mobileNumber,
+			Msg:          msg,
+		})
+		if err != nil {
+			h.logger.Error().Err(err).
+				Str("sender", mobileNumber).
+				Str("msg", msg).
+				Msg("[GLabsSMS] Cannot buffer message")
+			res.Failed++
+			return
+		}
+		h.logger.Info().
+			Str("sender", mobileNumber).
+			Msg("[GLabsSMS] Unknown sender, message buffered")
+		res.Pending++
+		return
+	}
+
+	if _, _, err := h.storeLufft(ctx, station.ID, lufft); err != nil {
+		h.logger.Error().Err(err).
+			Str("sender", mobileNumber).
+			Str("msg", msg).
+			Msg("[GLabsSMS] Cannot store station data")
+		res.Failed++
+		return
+	}
+
+	h.logger.Debug().
+		Str("sender", mobileNumber).
+		Str("msg", msg).
+		Msg("[GLabsSMS] Data saved successfully")
+	res.Stored++
+}
+
+// reassembleGLabsSMS buffers a part of a multipart message.
+// The parts carry no total count, the message is complete once the consecutive parts form a whole lufft string.
+func (h *DefaultHandler) reassembleGLabsSMS(ctx *gin.Context, mobileNumber string, sms gLabsInboundSMS) (string, bool, error) {
+	err := h.store.CreateSmsPart(ctx, db.CreateSmsPartParams{
+		Sender: mobileNumber,
+		RefID:  sms.MultipartRefID,
+		SeqNum: int32(sms.MultipartSeqNum),
+		Msg:    sms.Message,
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	parts, err := h.store.ListSmsParts(ctx, db.ListSmsPartsParams{
+		Sender: mobileNumber,
+		RefID:  sms.MultipartRefID,
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	var sb strings.Builder
+	for i, p := range parts {
+		if p.SeqNum != int32(i+1) {
+			return "", false, nil
+		}
+		sb.WriteString(p.Msg)
+	}
+	msg := sb.String()
+	if !sensor.IsCompleteLufftString(msg) {
+		return "", false, nil
+	}
+
+	// only the request that removes the parts stores the message
+	n, err := h.store.DeleteSmsParts(ctx, db.DeleteSmsPartsParams{
+		Sender: mobileNumber,
+		RefID:  sms.MultipartRefID,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return msg, n > 0, nil
+}
@@ -14,6 +14,9 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jarcoal/httpmock"
@@ -290,80 +293,126 @@ func TestGLabsUnsubscribeApi(t *testing.T) {
 }
 
 func TestCreateGLabsLoadApi(t *testing.T) {
+	callbackToken := util.RandomString(32)
 	gLabsLoad := randomGLabsLoad()
+	gLabsLoad.Status = pgtype.Text{String: service.GLabsLoadStatusSuccess, Valid: true}
+	failedLoad := randomGLabsLoad()
+	failedLoad.Status = pgtype.Text{String: "FAILED", Valid: true}
+
+	callback := func(g db.GlabsLoad) gin.H {
+		return gin.H{
+			"outboundRewardRequest": gin.H{
+				"transaction_id": g.TransactionID,
+				"status":         g.Status,
+				"promo":          g.Promo,
+				"address":        g.MobileNumber[2:],
+			},
+		}
+	}
 
 	testCases := []struct {
 		name          string
+		token         string
+		tokenHeader   bool
 		body          gin.H
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
 	}{
 		{
-			name: "OK",
-			body: gin.H{
-				"outboundRewardRequest": gin.H{
-					"transaction_id": gLabsLoad.TransactionID,
-					"status":         gLabsLoad.Status,
-					"promo":          gLabsLoad.Promo,
-					"address":        gLabsLoad.MobileNumber[2:],
-				},
-			},
+			name:  "OK",
+			token: callbackToken,
+			body:  callback(gLabsLoad),
 			buildStubs: func(store *mockdb.MockStore) {
-				arg := db.CreateGLabsLoadParams{
-					TransactionID: gLabsLoad.TransactionID,
+				store.EXPECT().CompleteGLabsLoad(mock.AnythingOfType("*gin.Context"), db.CompleteGLabsLoadParams{
 					Status:        gLabsLoad.Status,
-					Promo:         gLabsLoad.Promo,
+					TransactionID: gLabsLoad.TransactionID,
 					MobileNumber:  gLabsLoad.MobileNumber,
-				}
-				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"),
-					pgtype.Text{
-						String: arg.MobileNumber,
-						Valid:  true,
-					},
-				).
-					Return(db.ObservationsStation{}, nil)
-				store.EXPECT().CreateGLabsLoad(mock.AnythingOfType("*gin.Context"), arg).
-					Return(gLabsLoad, nil)
+				}).Return(gLabsLoad, nil)
+				store.EXPECT().ExtendSimCardLoad(mock.AnythingOfType("*gin.Context"), gLabsLoad.MobileNumber).
+					Return(nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
-				require.Equal(t, http.StatusCreated, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
 				requireBodyMatchGLabsLoad(t, recorder.Body, gLabsLoad)
 			},
 		},
 		{
-			name: "UnknownMobileNumber",
-			body: gin.H{
-				"outboundRewardRequest": gin.H{
-					"transaction_id": gLabsLoad.TransactionID,
-					"status":         gLabsLoad.Status,
-					"promo":          gLabsLoad.Promo,
-					"address":        gLabsLoad.MobileNumber[2:],
-				},
+			name:  "FailedLoad",
+			token: callbackToken,
+			body:  callback(failedLoad),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CompleteGLabsLoad(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(failedLoad, nil)
 			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ExtendSimCardLoad", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsLoad(t, recorder.Body, failedLoad)
+			},
+		},
+		{
+			name:  "UnknownOrReplayedTransaction",
+			token: callbackToken,
+			body:  callback(gLabsLoad),
 			buildStubs: func(store *mockdb.MockStore) {
-				arg := db.CreateGLabsLoadParams{
+				store.EXPECT().CompleteGLabsLoad(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.GlabsLoad{}, db.ErrRecordNotFound)
+				recorded := gLabsLoad
+				recorded.CallbackAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+				store.EXPECT().CreateGLabsLoadCallback(mock.AnythingOfType("*gin.Context"), db.CreateGLabsLoadCallbackParams{
+					Promo:         gLabsLoad.Promo,
 					TransactionID: gLabsLoad.TransactionID,
 					Status:        gLabsLoad.Status,
-					Promo:         gLabsLoad.Promo,
 					MobileNumber:  gLabsLoad.MobileNumber,
-				}
-				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"),
-					pgtype.Text{
-						String: arg.MobileNumber,
-						Valid:  true,
-					},
-				).
-					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
-				store.EXPECT().CreateGLabsLoad(mock.AnythingOfType("*gin.Context"), arg).
-					Return(gLabsLoad, nil)
+				}).Return(recorded, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
-				require.Equal(t, http.StatusCreated, recorder.Code)
+				store.AssertNotCalled(t, "ExtendSimCardLoad", mock.Anything, mock.Anything)
+				store.AssertNotCalled(t, "CreateSmsOutbox", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
 				requireBodyMatchGLabsLoad(t, recorder.Body, gLabsLoad)
 			},
 		},
+		{
+			name:        "HeaderToken",
+			token:       callbackToken,
+			tokenHeader: true,
+			body:        callback(gLabsLoad),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CompleteGLabsLoad(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(gLabsLoad, nil)
+				store.EXPECT().ExtendSimCardLoad(mock.AnythingOfType("*gin.Context"), gLabsLoad.MobileNumber).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidToken",
+			token: util.RandomString(32),
+			body:  callback(gLabsLoad),
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CompleteGLabsLoad", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "NoToken",
+			body: callback(gLabsLoad),
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CompleteGLabsLoad", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -374,6 +423,7 @@ func TestCreateGLabsLoadApi(t *testing.T) {
 			tc.buildStubs(store)
 
 			handler := newTestHandler(store, nil)
+			handler.config.GlabsCallbackToken = callbackToken
 
 			router := gin.Default()
 			router.POST("", handler.CreateGLabsLoad)
@@ -384,9 +434,233 @@ func TestCreateGLabsLoadApi(t *testing.T) {
 			data, err := json.Marshal(tc.body)
 			require.NoError(t, err)
 
-			url := "/"
+			url := "/?token=" + tc.token
+			if tc.tokenHeader {
+				url = "/"
+			}
 			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
 			require.NoError(t, err)
+			if tc.tokenHeader {
+				request.Header.Set(glabsCallbackTokenHeader, tc.token)
+			}
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestGLabsInboundSMSApi(t *testing.T) {
+	mobileNum := gofakeit.Regex("9[0-9]{9}")
+	sender := "63" + mobileNum
+	msg := sensor.RandomLufft(time.Now()).String(23)
+	half := len(msg) / 2
+	station := db.ObservationsStation{ID: util.RandomInt[int64](1, 1000)}
+
+	inbound := func(msgs ...gin.H) gin.H {
+		return gin.H{
+			"inboundSMSMessageList": gin.H{
+				"inboundSMSMessage":           msgs,
+				"numberOfMessagesInThisBatch": len(msgs),
+			},
+		}
+	}
+	smsPart := func(message string, seqNum int) gin.H {
+		return gin.H{
+			"senderAddress":   "tel:+" + sender,
+			"message":         message,
+			"multipartRefId":  "ref123",
+			"multipartSeqNum": fmt.Sprintf("%d", seqNum),
+		}
+	}
+	storeStubs := func(store *mockdb.MockStore) {
+		store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: sender, Valid: true}).
+			Return(station, nil)
+		store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+			Return(db.StationClock{}, db.ErrRecordNotFound)
+		store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationObservationParams) bool {
+			return arg.StationID == station.ID
+		})).Return(db.ObservationsObservation{}, nil)
+		store.EXPECT().CreateStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return(db.ObservationsStationhealth{}, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "SinglePart",
+			body: inbound(gin.H{
+				"senderAddress":   "tel:+" + sender,
+				"message":         msg,
+				"multipartRefId":  "",
+				"multipartSeqNum": "",
+			}),
+			buildStubs: storeStubs,
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsInboundSMS(t, recorder.Body, gLabsInboundSMSRes{Stored: 1})
+			},
+		},
+		{
+			name: "UnknownSender",
+			body: inbound(gin.H{"senderAddress": "tel:+" + sender, "message": msg}),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+				store.EXPECT().BufferPendingStationMessageTx(mock.AnythingOfType("*gin.Context"), db.BufferPendingStationMessageTxParams{
+					MobileNumber: sender,
+					Msg:          msg,
+				}).Return(db.BufferPendingStationMessageTxResult{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsInboundSMS(t, recorder.Body, gLabsInboundSMSRes{Pending: 1})
+			},
+		},
+		{
+			name: "CommandAck",
+			body: inbound(gin.H{"senderAddress": "tel:+" + sender, "message": "ACK,INTERVAL,ERR,out of range"}),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: sender, Valid: true}).
+					Return(station, nil)
+				store.EXPECT().GetOutstandingStationCommand(mock.AnythingOfType("*gin.Context"), db.GetOutstandingStationCommandParams{
+					StationID: station.ID,
+					Command:   sensor.LufftCommandInterval,
+				}).Return(db.StationCommand{ID: 5, StationID: station.ID}, nil)
+				store.EXPECT().AcknowledgeStationCommand(mock.AnythingOfType("*gin.Context"), db.AcknowledgeStationCommandParams{
+					Status:     StationCommandStatusRejected,
+					AckMessage: pgtype.Text{String: "out of range", Valid: true},
+					ID:         5,
+				}).Return(db.StationCommand{ID: 5, Status: StationCommandStatusRejected}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsInboundSMS(t, recorder.Body, gLabsInboundSMSRes{Acked: 1})
+			},
+		},
+		{
+			name: "MultipartIncomplete",
+			body: inbound(smsPart(msg[:half], 1)),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSmsPart(mock.AnythingOfType("*gin.Context"), db.CreateSmsPartParams{
+					Sender: sender,
+					RefID:  "ref123",
+					SeqNum: 1,
+					Msg:    msg[:half],
+				}).Return(nil)
+				store.EXPECT().ListSmsParts(mock.AnythingOfType("*gin.Context"), db.ListSmsPartsParams{Sender: sender, RefID: "ref123"}).
+					Return([]db.SmsPart{{SeqNum: 1, Msg: msg[:half]}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "DeleteSmsParts", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsInboundSMS(t, recorder.Body, gLabsInboundSMSRes{Incomplete: 1})
+			},
+		},
+		{
+			name: "MultipartOutOfOrder",
+			body: inbound(smsPart(msg[half:], 2)),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSmsPart(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(nil)
+				store.EXPECT().ListSmsParts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.SmsPart{{SeqNum: 2, Msg: msg[half:]}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsInboundSMS(t, recorder.Body, gLabsInboundSMSRes{Incomplete: 1})
+			},
+		},
+		{
+			name: "MultipartComplete",
+			body: inbound(smsPart(msg[half:], 2)),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSmsPart(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(nil)
+				store.EXPECT().ListSmsParts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.SmsPart{{SeqNum: 1, Msg: msg[:half]}, {SeqNum: 2, Msg: msg[half:]}}, nil)
+				store.EXPECT().DeleteSmsParts(mock.AnythingOfType("*gin.Context"), db.DeleteSmsPartsParams{Sender: sender, RefID: "ref123"}).
+					Return(int64(2), nil)
+				storeStubs(store)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsInboundSMS(t, recorder.Body, gLabsInboundSMSRes{Stored: 1})
+			},
+		},
+		{
+			name: "MultipartAlreadyStored",
+			body: inbound(smsPart(msg[half:], 2)),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSmsPart(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(nil)
+				store.EXPECT().ListSmsParts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.SmsPart{{SeqNum: 1, Msg: msg[:half]}, {SeqNum: 2, Msg: msg[half:]}}, nil)
+				store.EXPECT().DeleteSmsParts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(0), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationObservation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsInboundSMS(t, recorder.Body, gLabsInboundSMSRes{Incomplete: 1})
+			},
+		},
+		{
+			name: "InvalidMessage",
+			body: inbound(gin.H{"senderAddress": "tel:+" + sender, "message": "hello"}),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{ID: 1}, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), int64(1)).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationObservation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchGLabsInboundSMS(t, recorder.Body, gLabsInboundSMSRes{Failed: 1})
+			},
+		},
+		{
+			name: "BadRequest",
+			body: gin.H{"inboundSMSMessageList": gin.H{}},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+			handler.config.LufftCommandsEnabled = true
+
+			router := gin.Default()
+			router.POST("/glabs/sms", handler.GLabsInboundSMS)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/glabs/sms", bytes.NewReader(data))
+			require.NoError(t, err)
 
 			router.ServeHTTP(recorder, request)
 
@@ -447,3 +721,13 @@ func requireBodyMatchGLabsLoad(t *testing.T, body *bytes.Buffer, g db.GlabsLoad)
 	require.Equal(t, g.Promo, gotGLabsLoad.Promo)
 	require.Equal(t, g.MobileNumber, gotGLabsLoad.MobileNumber)
 }
+
+func requireBodyMatchGLabsInboundSMS(t *testing.T, body *bytes.Buffer, want gLabsInboundSMSRes) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got gLabsInboundSMSRes
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -12,6 +12,7 @@ import (
 func newTestHandler(store db.Store, tokenMaker token.Maker) *DefaultHandler {
 	config := util.Config{
 		TokenSymmetricKey:   util.RandomString(32),
+		SecretEncryptionKey: util.RandomString(32),
 		AccessTokenDuration: time.Minute,
 		EnableFileLogging:   false,
 	}
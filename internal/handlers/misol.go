@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	MisolStatusPending  = "PENDING"
+	MisolStatusApproved = "APPROVED"
+)
+
+type misolStationRes struct {
+	ID        int64     `json:"id"`
+	StationID int64     `json:"station_id"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+} //@name MisolStation
+
+func newMisolStationResponse(m db.MisolStation) misolStationRes {
+	return misolStationRes{
+		ID:        m.ID,
+		StationID: m.StationID,
+		Status:    m.Status,
+		CreatedAt: m.CreatedAt.Time,
+	}
+}
+
+type listMisolStationsReq struct {
+	Status  string `form:"status" binding:"omitempty,oneof=PENDING APPROVED"`
+	Page    int32  `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage int32  `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name ListMisolStationsParams
+
+type paginatedMisolStations = util.PaginatedList[misolStationRes] //@name PaginatedMisolStations
+
+// ListMisolStations
+//
+//	@Summary	List Misol station mappings
+//	@Tags		misol
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	query	listMisolStationsReq	false	"List Misol stations parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedMisolStations
+//	@Router		/misol [get]
+func (h *DefaultHandler) ListMisolStations(ctx *gin.Context) {
+	var req listMisolStationsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	mStns, err := h.store.ListMisolStations(ctx, db.ListMisolStationsParams{
+		Status: util.ToPgText(req.Status),
+		Limit:  pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
+		Offset: offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]misolStationRes, len(mStns))
+	for i, m := range mStns {
+		items[i] = newMisolStationResponse(m)
+	}
+
+	count, err := h.store.CountMisolStations(ctx, util.ToPgText(req.Status))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type createMisolStationReq struct {
+	ID        int64 `json:"id" binding:"required,min=1"`
+	StationID int64 `json:"station_id" binding:"required,min=1"`
+} //@name CreateMisolStationParams
+
+// CreateMisolStation
+//
+//	@Summary	Map a Misol ID to a station
+//	@Tags		misol
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	body	createMisolStationReq	true	"Create Misol station parameters"
+//	@Security	BearerAuth
+//	@Success	201	{object}	misolStationRes
+//	@Router		/misol [post]
+func (h *DefaultHandler) CreateMisolStation(ctx *gin.Context) {
+	var req createMisolStationReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, req.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	mStn, err := h.store.CreateMisolStation(ctx, db.CreateMisolStationParams{
+		ID:        req.ID,
+		StationID: req.StationID,
+		Status:    util.ToPgText(MisolStatusApproved),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("misol id already registered")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newMisolStationResponse(mStn))
+}
+
+type misolStationUri struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type updateMisolStationReq struct {
+	StationID int64 `json:"station_id" binding:"required,min=1"`
+} //@name UpdateMisolStationParams
+
+// UpdateMisolStation
+//
+//	@Summary	Re-map a Misol ID to another station
+//	@Tags		misol
+//	@Accept		json
+//	@Produce	json
+//	@Param		id	path	int						true	"Misol ID"
+//	@Param		req	body	updateMisolStationReq	true	"Update Misol station parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	misolStationRes
+//	@Router		/misol/{id} [put]
+func (h *DefaultHandler) UpdateMisolStation(ctx *gin.Context) {
+	var uri misolStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateMisolStationReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, req.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	mStn, err := h.store.UpdateMisolStation(ctx, db.UpdateMisolStationParams{
+		ID:        uri.ID,
+		StationID: pgtype.Int8{Int64: req.StationID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("misol station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newMisolStationResponse(mStn))
+}
+
+// ApproveMisolStation
+//
+//	@Summary	Approve a pending Misol station registration
+//	@Tags		misol
+//	@Accept		json
+//	@Produce	json
+//	@Param		id	path	int	true	"Misol ID"
+//	@Security	BearerAuth
+//	@Success	200	{object}	misolStationRes
+//	@Router		/misol/{id}/approve [post]
+func (h *DefaultHandler) ApproveMisolStation(ctx *gin.Context) {
+	var uri misolStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	mStn, err := h.store.GetMisolStation(ctx, uri.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("misol station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if mStn.Status != MisolStatusPending {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("misol station already approved")))
+		return
+	}
+
+	// the station was created inactive, the next payload brings it online
+	if _, ok := h.setStationStatus(ctx, mStn.StationID, service.StationStatusOnline, "misol station approved"); !ok {
+		return
+	}
+
+	mStn, err = h.store.UpdateMisolStation(ctx, db.UpdateMisolStationParams{
+		ID:     mStn.ID,
+		Status: util.ToPgText(MisolStatusApproved),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newMisolStationResponse(mStn))
+}
+
+// DeleteMisolStation
+//
+//	@Summary	Delete a Misol ID mapping, a pending registration also removes its station
+//	@Tags		misol
+//	@Accept		json
+//	@Produce	json
+//	@Param		id	path	int	true	"Misol ID"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/misol/{id} [delete]
+func (h *DefaultHandler) DeleteMisolStation(ctx *gin.Context) {
+	var uri misolStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	mStn, err := h.store.GetMisolStation(ctx, uri.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("misol station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if mStn.Status == MisolStatusPending {
+		err = h.store.DeleteMisolStationTx(ctx, db.DeleteMisolStationTxParams{
+			ID:        mStn.ID,
+			StationID: mStn.StationID,
+		})
+	} else {
+		err = h.store.DeleteMisolStation(ctx, mStn.ID)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,446 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMisolStationsAPI(t *testing.T) {
+	n := 3
+	mStns := make([]db.MisolStation, n)
+	for i := range mStns {
+		mStns[i] = randomMisolStation(MisolStatusPending)
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Pending",
+			query: "?status=PENDING&page=1&per_page=3",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListMisolStations(mock.AnythingOfType("*gin.Context"), db.ListMisolStationsParams{
+					Status: pgtype.Text{String: MisolStatusPending, Valid: true},
+					Limit:  pgtype.Int4{Int32: 3, Valid: true},
+					Offset: 0,
+				}).Return(mStns, nil)
+				store.EXPECT().CountMisolStations(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: MisolStatusPending, Valid: true}).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes paginatedMisolStations
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Items, n)
+				require.Equal(t, mStns[0].ID, gotRes.Items[0].ID)
+				require.Equal(t, MisolStatusPending, gotRes.Items[0].Status)
+			},
+		},
+		{
+			name:  "InvalidStatus",
+			query: "?status=UNKNOWN",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListMisolStations", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListMisolStations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.MisolStation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/misol", handler.ListMisolStations)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/misol"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestCreateMisolStationAPI(t *testing.T) {
+	mStn := randomMisolStation(MisolStatusApproved)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"id": mStn.ID, "station_id": mStn.StationID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), mStn.StationID).
+					Return(db.ObservationsStation{ID: mStn.StationID}, nil)
+				store.EXPECT().CreateMisolStation(mock.AnythingOfType("*gin.Context"), db.CreateMisolStationParams{
+					ID:        mStn.ID,
+					StationID: mStn.StationID,
+					Status:    util.ToPgText(MisolStatusApproved),
+				}).Return(mStn, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "StationNotFound",
+			body: gin.H{"id": mStn.ID, "station_id": mStn.StationID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), mStn.StationID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateMisolStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadyRegistered",
+			body: gin.H{"id": mStn.ID, "station_id": mStn.StationID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), mStn.StationID).
+					Return(db.ObservationsStation{ID: mStn.StationID}, nil)
+				store.EXPECT().CreateMisolStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.MisolStation{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidBody",
+			body: gin.H{"id": mStn.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/misol", handler.CreateMisolStation)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/misol", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUpdateMisolStationAPI(t *testing.T) {
+	mStn := randomMisolStation(MisolStatusApproved)
+	newStationID := util.RandomInt[int64](1, 1000)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"station_id": newStationID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), newStationID).
+					Return(db.ObservationsStation{ID: newStationID}, nil)
+				updated := mStn
+				updated.StationID = newStationID
+				store.EXPECT().UpdateMisolStation(mock.AnythingOfType("*gin.Context"), db.UpdateMisolStationParams{
+					ID:        mStn.ID,
+					StationID: pgtype.Int8{Int64: newStationID, Valid: true},
+				}).Return(updated, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes misolStationRes
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Equal(t, newStationID, gotRes.StationID)
+			},
+		},
+		{
+			name: "MisolStationNotFound",
+			body: gin.H{"station_id": newStationID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), newStationID).
+					Return(db.ObservationsStation{ID: newStationID}, nil)
+				store.EXPECT().UpdateMisolStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.MisolStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/misol/:id", handler.UpdateMisolStation)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/misol/%d", mStn.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestApproveMisolStationAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mStn          db.MisolStation
+		buildStubs    func(store *mockdb.MockStore, mStn db.MisolStation)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			mStn: randomMisolStation(MisolStatusPending),
+			buildStubs: func(store *mockdb.MockStore, mStn db.MisolStation) {
+				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), mStn.ID).Return(mStn, nil)
+				store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateStationStatusTxParams) bool {
+					return arg.StationID == mStn.StationID && arg.Status == "ONLINE"
+				})).Return(db.UpdateStationStatusTxResult{Changed: true}, nil)
+				approved := mStn
+				approved.Status = MisolStatusApproved
+				store.EXPECT().UpdateMisolStation(mock.AnythingOfType("*gin.Context"), db.UpdateMisolStationParams{
+					ID:     mStn.ID,
+					Status: util.ToPgText(MisolStatusApproved),
+				}).Return(approved, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes misolStationRes
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Equal(t, MisolStatusApproved, gotRes.Status)
+			},
+		},
+		{
+			name: "AlreadyApproved",
+			mStn: randomMisolStation(MisolStatusApproved),
+			buildStubs: func(store *mockdb.MockStore, mStn db.MisolStation) {
+				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), mStn.ID).Return(mStn, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateMisolStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			mStn: randomMisolStation(MisolStatusPending),
+			buildStubs: func(store *mockdb.MockStore, mStn db.MisolStation) {
+				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), mStn.ID).
+					Return(db.MisolStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store, tc.mStn)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/misol/:id/approve", handler.ApproveMisolStation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/misol/%d/approve", tc.mStn.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteMisolStationAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mStn          db.MisolStation
+		buildStubs    func(store *mockdb.MockStore, mStn db.MisolStation)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "Approved",
+			mStn: randomMisolStation(MisolStatusApproved),
+			buildStubs: func(store *mockdb.MockStore, mStn db.MisolStation) {
+				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), mStn.ID).Return(mStn, nil)
+				store.EXPECT().DeleteMisolStation(mock.AnythingOfType("*gin.Context"), mStn.ID).Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "DeleteStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Pending",
+			mStn: randomMisolStation(MisolStatusPending),
+			buildStubs: func(store *mockdb.MockStore, mStn db.MisolStation) {
+				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), mStn.ID).Return(mStn, nil)
+				store.EXPECT().DeleteMisolStationTx(mock.AnythingOfType("*gin.Context"), db.DeleteMisolStationTxParams{
+					ID:        mStn.ID,
+					StationID: mStn.StationID,
+				}).Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "DeleteMisolStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			mStn: randomMisolStation(MisolStatusApproved),
+			buildStubs: func(store *mockdb.MockStore, mStn db.MisolStation) {
+				store.EXPECT().GetMisolStation(mock.AnythingOfType("*gin.Context"), mStn.ID).
+					Return(db.MisolStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store, tc.mStn)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE("/misol/:id", handler.DeleteMisolStation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/misol/%d", tc.mStn.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomMisolStation(status string) db.MisolStation {
+	return db.MisolStation{
+		ID:        util.RandomInt[int64](1, 1000000),
+		StationID: util.RandomInt[int64](1, 1000),
+		Status:    status,
+	}
+}
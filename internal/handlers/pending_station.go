@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type pendingStationRes struct {
+	ID           int64     `json:"id"`
+	MobileNumber string    `json:"mobile_number"`
+	MessageCount int32     `json:"message_count"`
+	FirstSeenAt  time.Time `json:"first_seen_at"`
+	LastSeenAt   time.Time `json:"last_seen_at"`
+} //@name PendingStation
+
+func newPendingStationResponse(p db.PendingStation) pendingStationRes {
+	return pendingStationRes{
+		ID:           p.ID,
+		MobileNumber: p.MobileNumber,
+		MessageCount: p.MessageCount,
+		FirstSeenAt:  p.FirstSeenAt.Time,
+		LastSeenAt:   p.LastSeenAt.Time,
+	}
+}
+
+type pendingStationMessageRes struct {
+	ID         int64     `json:"id"`
+	Msg        string    `json:"msg"`
+	ReceivedAt time.Time `json:"received_at"`
+} //@name PendingStationMessage
+
+type listPendingStationsReq struct {
+	Page    int32 `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage int32 `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name ListPendingStationsParams
+
+type paginatedPendingStations = util.PaginatedList[pendingStationRes] //@name PaginatedPendingStations
+
+// ListPendingStations
+//
+//	@Summary	List unknown senders waiting to be claimed
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	query	listPendingStationsReq	false	"List pending stations parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedPendingStations
+//	@Router		/pending-stations [get]
+func (h *DefaultHandler) ListPendingStations(ctx *gin.Context) {
+	var req listPendingStationsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	pending, err := h.store.ListPendingStations(ctx, db.ListPendingStationsParams{
+		Limit:  pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
+		Offset: offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]pendingStationRes, len(pending))
+	for i, p := range pending {
+		items[i] = newPendingStationResponse(p)
+	}
+
+	count, err := h.store.CountPendingStations(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type pendingStationUri struct {
+	MobileNumber string `uri:"mobile_number" binding:"required"`
+}
+
+// ListPendingStationMessages
+//
+//	@Summary	List the messages buffered for an unknown sender
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string	true	"Mobile number"
+//	@Security	BearerAuth
+//	@Success	200	{array}	pendingStationMessageRes
+//	@Router		/pending-stations/{mobile_number}/messages [get]
+func (h *DefaultHandler) ListPendingStationMessages(ctx *gin.Context) {
+	var uri pendingStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	pending, ok := h.getPendingStation(ctx, uri.MobileNumber)
+	if !ok {
+		return
+	}
+
+	msgs, err := h.store.ListPendingStationMessages(ctx, pending.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]pendingStationMessageRes, len(msgs))
+	for i, m := range msgs {
+		res[i] = pendingStationMessageRes{
+			ID:         m.ID,
+			Msg:        m.Msg,
+			ReceivedAt: m.ReceivedAt.Time,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type claimPendingStationReq struct {
+	StationID int64                    `json:"station_id" binding:"omitempty,min=1"`
+	Station   *models.CreateStationReq `json:"station" binding:"required_without=StationID"`
+} //@name ClaimPendingStationParams
+
+type claimPendingStationRes struct {
+	Station  models.Station `json:"station"`
+	Ingested int            `json:"ingested"`
+	Failed   int            `json:"failed"` // messages kept in the queue, claim again with the station_id to retry
+} //@name ClaimPendingStationResult
+
+// ClaimPendingStation
+//
+//	@Summary		Assign an unknown sender to a new or existing station and ingest its buffered messages
+//	@Description	Messages that cannot be ingested stay buffered under the mobile number until the claim is retried or dismissed.
+//	@Tags			stations
+//	@Accept			json
+//	@Produce		json
+//	@Param			mobile_number	path	string					true	"Mobile number"
+//	@Param			req				body	claimPendingStationReq	true	"Claim parameters"
+//	@Security		BearerAuth
+//	@Success		200	{object}	claimPendingStationRes
+//	@Router			/pending-stations/{mobile_number}/claim [post]
+func (h *DefaultHandler) ClaimPendingStation(ctx *gin.Context) {
+	var uri pendingStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req claimPendingStationReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg := db.ClaimPendingStationTxParams{
+		MobileNumber: uri.MobileNumber,
+		StationID:    req.StationID,
+	}
+	if req.StationID == 0 {
+		arg.Station = req.Station.Transform()
+	}
+
+	result, err := h.store.ClaimPendingStationTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("pending station or station not found")))
+			return
+		}
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("mobile number already assigned to a station")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := claimPendingStationRes{
+		Station: models.NewStation(result.Station, false),
+	}
+	for _, m := range result.Messages {
+		if _, _, err := h.storeLufftMessage(ctx, result.Station.ID, m.Msg, m.ReceivedAt.Time); err != nil {
+			h.logger.Error().Err(err).
+				Str("sender", uri.MobileNumber).
+				Str("msg", m.Msg).
+				Msg("[PromoTexter] Cannot ingest buffered message")
+			res.Failed++
+			continue
+		}
+		res.Ingested++
+		if err := h.store.DeletePendingStationMessage(ctx, m.ID); err != nil {
+			h.logger.Error().Err(err).
+				Int64("id", m.ID).
+				Msg("[PromoTexter] Cannot remove ingested buffered message")
+		}
+	}
+
+	// the failed messages stay queued under the number for a retry
+	if res.Failed == 0 {
+		if err := h.store.DeletePendingStation(ctx, result.PendingStation.ID); err != nil {
+			h.logger.Error().Err(err).
+				Str("sender", uri.MobileNumber).
+				Msg("[PromoTexter] Cannot remove claimed pending station")
+		}
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// DeletePendingStation
+//
+//	@Summary	Dismiss an unknown sender and its buffered messages
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string	true	"Mobile number"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/pending-stations/{mobile_number} [delete]
+func (h *DefaultHandler) DeletePendingStation(ctx *gin.Context) {
+	var uri pendingStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	pending, ok := h.getPendingStation(ctx, uri.MobileNumber)
+	if !ok {
+		return
+	}
+
+	if err := h.store.DeletePendingStation(ctx, pending.ID); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+func (h *DefaultHandler) getPendingStation(ctx *gin.Context, mobileNumber string) (db.PendingStation, bool) {
+	pending, err := h.store.GetPendingStation(ctx, mobileNumber)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("pending station not found")))
+			return pending, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return pending, false
+	}
+	return pending, true
+}
@@ -0,0 +1,309 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPendingStationsAPI(t *testing.T) {
+	n := 3
+	pending := make([]db.PendingStation, n)
+	for i := range pending {
+		pending[i] = randomPendingStation()
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "?page=1&per_page=3",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPendingStations(mock.AnythingOfType("*gin.Context"), db.ListPendingStationsParams{
+					Limit:  pgtype.Int4{Int32: 3, Valid: true},
+					Offset: 0,
+				}).Return(pending, nil)
+				store.EXPECT().CountPendingStations(mock.AnythingOfType("*gin.Context")).Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes paginatedPendingStations
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Items, n)
+				require.Equal(t, pending[0].MobileNumber, gotRes.Items[0].MobileNumber)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPendingStations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.PendingStation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/pending-stations", handler.ListPendingStations)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/pending-stations"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestClaimPendingStationAPI(t *testing.T) {
+	pending := randomPendingStation()
+	var lufft sensor.Lufft
+	gofakeit.Struct(&lufft)
+	msgs := []db.PendingStationMessage{
+		{ID: 1, PendingStationID: pending.ID, Msg: lufft.String(23)},
+		{ID: 2, PendingStationID: pending.ID, Msg: lufft.String(23)},
+	}
+	station := db.ObservationsStation{
+		ID:           util.RandomInt[int64](1, 1000),
+		Name:         util.RandomString(12),
+		MobileNumber: util.ToPgText(pending.MobileNumber),
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "NewStation",
+			body: gin.H{"station": gin.H{"name": station.Name}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimPendingStationTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ClaimPendingStationTxParams) bool {
+					return arg.MobileNumber == pending.MobileNumber && arg.StationID == 0 && arg.Station.Name == station.Name
+				})).Return(db.ClaimPendingStationTxResult{PendingStation: pending, Station: station, Messages: msgs}, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.StationClock{}, db.ErrRecordNotFound).Times(len(msgs))
+				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationObservationParams) bool {
+					return arg.StationID == station.ID
+				})).Return(db.ObservationsObservation{}, nil).Times(len(msgs))
+				store.EXPECT().CreateStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationhealth{}, nil).Times(len(msgs))
+				for _, m := range msgs {
+					store.EXPECT().DeletePendingStationMessage(mock.AnythingOfType("*gin.Context"), m.ID).Return(nil)
+				}
+				store.EXPECT().DeletePendingStation(mock.AnythingOfType("*gin.Context"), pending.ID).Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes claimPendingStationRes
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Equal(t, station.ID, gotRes.Station.ID)
+				require.Equal(t, len(msgs), gotRes.Ingested)
+				require.Zero(t, gotRes.Failed)
+			},
+		},
+		{
+			name: "ExistingStation",
+			body: gin.H{"station_id": station.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimPendingStationTx(mock.AnythingOfType("*gin.Context"), db.ClaimPendingStationTxParams{
+					MobileNumber: pending.MobileNumber,
+					StationID:    station.ID,
+				}).Return(db.ClaimPendingStationTxResult{PendingStation: pending, Station: station, Messages: msgs[:1]}, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsObservation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes claimPendingStationRes
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Zero(t, gotRes.Ingested)
+				require.Equal(t, 1, gotRes.Failed)
+
+				// the message stays queued for a retry
+				store.AssertNotCalled(t, "DeletePendingStationMessage", mock.Anything, mock.Anything)
+				store.AssertNotCalled(t, "DeletePendingStation", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"station_id": station.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimPendingStationTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ClaimPendingStationTxResult{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "NumberAlreadyAssigned",
+			body: gin.H{"station_id": station.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimPendingStationTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ClaimPendingStationTxResult{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "MissingStation",
+			body: gin.H{},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ClaimPendingStationTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/pending-stations/:mobile_number/claim", handler.ClaimPendingStation)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/pending-stations/%s/claim", pending.MobileNumber)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeletePendingStationAPI(t *testing.T) {
+	pending := randomPendingStation()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPendingStation(mock.AnythingOfType("*gin.Context"), pending.MobileNumber).Return(pending, nil)
+				store.EXPECT().DeletePendingStation(mock.AnythingOfType("*gin.Context"), pending.ID).Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPendingStation(mock.AnythingOfType("*gin.Context"), pending.MobileNumber).
+					Return(db.PendingStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "DeletePendingStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE("/pending-stations/:mobile_number", handler.DeletePendingStation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/pending-stations/%s", pending.MobileNumber)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomPendingStation() db.PendingStation {
+	return db.PendingStation{
+		ID:           util.RandomInt[int64](1, 1000),
+		MobileNumber: gofakeit.Regex("639[0-9]{9}"),
+		MessageCount: util.RandomInt[int32](1, 10),
+	}
+}
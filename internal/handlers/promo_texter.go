@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	"github.com/emiliogozo/panahon-api-go/internal/sensor"
@@ -35,16 +37,6 @@ func (h *DefaultHandler) PromoTexterStoreLufft(ctx *gin.Context) {
 		return
 	}
 
-	lufft, err := sensor.NewLufftFromString(req.Msg)
-	if err != nil {
-		h.logger.Error().Err(err).
-			Str("sender", req.Number).
-			Str("msg", req.Msg).
-			Msg("[PromoTexter] Invalid string")
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
-		return
-	}
-
 	mobileNumber, ok := util.ParseMobileNumber(req.Number)
 	if !ok {
 		err := fmt.Errorf("invalid mobile number: %s", req.Number)
@@ -56,29 +48,140 @@ func (h *DefaultHandler) PromoTexterStoreLufft(ctx *gin.Context) {
 		return
 	}
 
+	if ack, ok := sensor.ParseLufftAck(req.Msg); ok && h.config.LufftCommandsEnabled {
+		h.promoTexterStationCommandAck(ctx, mobileNumber, req.Msg, ack)
+		return
+	}
+
 	station, err := h.store.GetStationByMobileNumber(ctx, pgtype.Text{
 		String: mobileNumber,
 		Valid:  true,
 	})
-	if err != nil {
-		if errors.Is(err, db.ErrRecordNotFound) {
+	known := err == nil
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		h.logger.Error().Err(err).
+			Str("sender", req.Number).
+			Str("msg", req.Msg).
+			Msg("[PromoTexter] AN error occured")
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var clockOpts []sensor.ClockSettings
+	if known {
+		clockOpts, err = h.clockOpts(ctx, station.ID)
+		if err != nil {
 			h.logger.Error().Err(err).
 				Str("sender", req.Number).
 				Str("msg", req.Msg).
-				Msg("[PromoTexter] No station found")
-			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+				Msg("[PromoTexter] AN error occured")
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 			return
 		}
+	}
+
+	// only valid messages are stored or buffered
+	lufft, err := sensor.NewLufftFromString(req.Msg, clockOpts...)
+	if err != nil {
 		h.logger.Error().Err(err).
 			Str("sender", req.Number).
 			Str("msg", req.Msg).
-			Msg("[PromoTexter] AN error occured")
+			Msg("[PromoTexter] Invalid string")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !known {
+		h.bufferPendingStationMessage(ctx, mobileNumber, req.Msg)
+		return
+	}
+
+	obs, health, err := h.storeLufft(ctx, station.ID, lufft)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("sender", req.Number).
+			Str("msg", req.Msg).
+			Msg("[PromoTexter] Cannot store station data")
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := newObservationResponse(station, obs, health)
+
+	h.logger.Debug().
+		Str("sender", req.Number).
+		Str("msg", req.Msg).
+		Msg("[PromoTexter] Data saved successfully")
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// promoTexterStationCommandAck records the acknowledgement of a command sent to the station logger
+func (h *DefaultHandler) promoTexterStationCommandAck(ctx *gin.Context, mobileNumber, msg string, ack sensor.LufftAck) {
+	cmd, err := h.acknowledgeStationCommand(ctx, mobileNumber, ack)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			h.logger.Warn().
+				Str("sender", mobileNumber).
+				Str("msg", msg).
+				Msg("[PromoTexter] No pending command for acknowledgement")
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("no pending command")))
+			return
+		}
+		h.logger.Error().Err(err).
+			Str("sender", mobileNumber).
+			Str("msg", msg).
+			Msg("[PromoTexter] Cannot acknowledge command")
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	h.logger.Info().
+		Str("sender", mobileNumber).
+		Int64("command_id", cmd.ID).
+		Str("status", cmd.Status).
+		Msg("[PromoTexter] Command acknowledged")
+	ctx.JSON(http.StatusOK, newStationCommandResponse(cmd, ""))
+}
+
+// bufferPendingStationMessage keeps the message of an unknown sender until an admin claims the number
+func (h *DefaultHandler) bufferPendingStationMessage(ctx *gin.Context, mobileNumber, msg string) {
+	_, err := h.store.BufferPendingStationMessageTx(ctx, db.BufferPendingStationMessageTxParams{
+		MobileNumber: mobileNumber,
+		Msg:          msg,
+	})
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("sender", mobileNumber).
+			Str("msg", msg).
+			Msg("[PromoTexter] Cannot buffer message")
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	h.logger.Info().
+		Str("sender", mobileNumber).
+		Msg("[PromoTexter] Unknown sender, message buffered")
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "station pending"})
+}
+
+// storeLufftMessage parses a lufft message received at receivedAt using the station clock settings and stores the observation and health
+func (h *DefaultHandler) storeLufftMessage(ctx context.Context, stationID int64, msg string, receivedAt time.Time) (db.ObservationsObservation, db.ObservationsStationhealth, error) {
+	clockOpts, err := h.clockOpts(ctx, stationID)
+	if err != nil {
+		return db.ObservationsObservation{}, db.ObservationsStationhealth{}, err
+	}
+	lufft, err := sensor.NewLufftFromStringAt(msg, receivedAt, clockOpts...)
+	if err != nil {
+		return db.ObservationsObservation{}, db.ObservationsStationhealth{}, err
+	}
+
+	return h.storeLufft(ctx, stationID, lufft)
+}
+
+// storeLufft stores the observation and health of a parsed lufft message
+func (h *DefaultHandler) storeLufft(ctx context.Context, stationID int64, lufft *sensor.Lufft) (db.ObservationsObservation, db.ObservationsStationhealth, error) {
 	obsArg := db.CreateStationObservationParams{
-		StationID: station.ID,
+		StationID: stationID,
 		Pres:      util.ToFloat4(lufft.Obs.Pres),
 		Rr:        util.ToFloat4(lufft.Obs.Rr),
 		Rh:        util.ToFloat4(lufft.Obs.Rh),
@@ -99,16 +202,11 @@ func (h *DefaultHandler) PromoTexterStoreLufft(ctx *gin.Context) {
 
 	obs, err := h.store.CreateStationObservation(ctx, obsArg)
 	if err != nil {
-		h.logger.Error().Err(err).
-			Str("sender", req.Number).
-			Str("msg", req.Msg).
-			Msg("[PromoTexter] Cannot store station observation")
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		return db.ObservationsObservation{}, db.ObservationsStationhealth{}, fmt.Errorf("cannot store station observation: %w", err)
 	}
 
 	healthArg := db.CreateStationHealthParams{
-		StationID:         station.ID,
+		StationID:         stationID,
 		Vb1:               util.ToFloat4(lufft.Health.Vb1),
 		Vb2:               util.ToFloat4(lufft.Health.Vb2),
 		Curr:              util.ToFloat4(lufft.Health.Curr),
@@ -132,18 +230,8 @@ func (h *DefaultHandler) PromoTexterStoreLufft(ctx *gin.Context) {
 
 	health, err := h.store.CreateStationHealth(ctx, healthArg)
 	if err != nil {
-		h.logger.Error().Err(err).
-			Str("sender", req.Number).
-			Str("msg", req.Msg).
-			Msg("[PromoTexter] Cannot store station status")
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.ObservationsObservation{}, db.ObservationsStationhealth{}, fmt.Errorf("cannot store station status: %w", err)
 	}
 
-	res := newObservationResponse(station, obs, health)
-
-	h.logger.Debug().
-		Str("sender", req.Number).
-		Str("msg", req.Msg).
-		Msg("[PromoTexter] Data saved successfully")
-	ctx.JSON(http.StatusCreated, res)
+	return obs, health, nil
 }
@@ -37,6 +37,8 @@ func TestPromoTexterStoreLufft(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
 					Return(db.ObservationsStation{}, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
 				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
 					Return(db.ObservationsObservation{}, nil)
 				store.EXPECT().CreateStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
@@ -48,7 +50,7 @@ func TestPromoTexterStoreLufft(t *testing.T) {
 			},
 		},
 		{
-			name: "NotFound",
+			name: "UnknownSender",
 			body: gin.H{
 				"number": mobileNum,
 				"msg":    lufft.String(23),
@@ -56,9 +58,71 @@ func TestPromoTexterStoreLufft(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
 					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+				store.EXPECT().BufferPendingStationMessageTx(mock.AnythingOfType("*gin.Context"), db.BufferPendingStationMessageTxParams{
+					MobileNumber: mobileNum,
+					Msg:          lufft.String(23),
+				}).Return(db.BufferPendingStationMessageTxResult{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationObservation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidString",
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    "hello",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "BufferPendingStationMessageTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "CommandAck",
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    "ACK,12,REBOOT,OK",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				station := db.ObservationsStation{ID: 3}
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(station, nil)
+				store.EXPECT().GetStationCommand(mock.AnythingOfType("*gin.Context"), int64(12)).
+					Return(db.StationCommand{ID: 12, StationID: station.ID, Command: sensor.LufftCommandReboot, Status: StationCommandStatusPending}, nil)
+				store.EXPECT().AcknowledgeStationCommand(mock.AnythingOfType("*gin.Context"), db.AcknowledgeStationCommandParams{
+					Status: StationCommandStatusAcknowledged,
+					ID:     12,
+				}).Return(db.StationCommand{ID: 12, StationID: station.ID, Status: StationCommandStatusAcknowledged}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationObservation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "CommandAckOtherStation",
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    "ACK,12,REBOOT,OK",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{ID: 3}, nil)
+				store.EXPECT().GetStationCommand(mock.AnythingOfType("*gin.Context"), int64(12)).
+					Return(db.StationCommand{ID: 12, StationID: 4, Command: sensor.LufftCommandReboot}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "AcknowledgeStationCommand", mock.Anything, mock.Anything)
 				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
@@ -87,6 +151,7 @@ func TestPromoTexterStoreLufft(t *testing.T) {
 			tc.buildStubs(store)
 
 			handler := newTestHandler(store, nil)
+			handler.config.LufftCommandsEnabled = true
 
 			router := gin.Default()
 			router.POST("", handler.PromoTexterStoreLufft)
@@ -0,0 +1,367 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type clockDriftReportReq struct {
+	StartDate string  `form:"start_date" binding:"omitempty,date_time"`
+	EndDate   string  `form:"end_date" binding:"omitempty,date_time"`
+	MinDrift  float32 `form:"min_drift" binding:"omitempty,min=0"`      // minimum uncorrected drift in minutes
+	Page      int32   `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage   int32   `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name ClockDriftReportParams
+
+type clockDriftRes struct {
+	StationID               int64     `json:"station_id"`
+	Name                    string    `json:"name"`
+	Timezone                string    `json:"timezone"`
+	ClockOffset             int32     `json:"clock_offset"`
+	SampleCount             int64     `json:"sample_count"`
+	AvgMinutesDifference    float32   `json:"avg_minutes_difference"`
+	MedianMinutesDifference float32   `json:"median_minutes_difference"`
+	MinMinutesDifference    int32     `json:"min_minutes_difference"`
+	MaxMinutesDifference    int32     `json:"max_minutes_difference"`
+	OutOfRangeCount         int64     `json:"out_of_range_count"`
+	ResidualDrift           float32   `json:"residual_drift"`
+	SuggestedClockOffset    int32     `json:"suggested_clock_offset"`
+	LastTimestamp           time.Time `json:"last_timestamp"`
+} //@name ClockDrift
+
+func newClockDriftResponse(row db.ListStationClockDriftRow) clockDriftRes {
+	return clockDriftRes{
+		StationID:               row.StationID,
+		Name:                    row.Name,
+		Timezone:                row.Timezone,
+		ClockOffset:             row.ClockOffset,
+		SampleCount:             row.SampleCount,
+		AvgMinutesDifference:    row.AvgMinutesDifference,
+		MedianMinutesDifference: row.MedianMinutesDifference,
+		MinMinutesDifference:    row.MinMinutesDifference,
+		MaxMinutesDifference:    row.MaxMinutesDifference,
+		OutOfRangeCount:         row.OutOfRangeCount,
+		ResidualDrift:           row.MedianMinutesDifference - float32(row.ClockOffset),
+		SuggestedClockOffset:    int32(math.Round(float64(row.MedianMinutesDifference))),
+		LastTimestamp:           row.LastTimestamp.Time,
+	}
+}
+
+type paginatedClockDrift = util.PaginatedList[clockDriftRes] //@name PaginatedClockDrift
+
+// ClockDriftReport
+//
+//	@Summary	Logger clock drift report
+//	@Tags		reports
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	query	clockDriftReportReq	false	"Clock drift report parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedClockDrift
+//	@Router		/reports/drift [get]
+func (h *DefaultHandler) ClockDriftReport(ctx *gin.Context) {
+	var req clockDriftReportReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	startDate, isStartDate := util.ParseDateTime(req.StartDate)
+	endDate, isEndDate := util.ParseDateTime(req.EndDate)
+	offset := (req.Page - 1) * req.PerPage
+
+	arg := db.ListStationClockDriftParams{
+		IsStartDate: isStartDate,
+		StartDate: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: !startDate.IsZero(),
+		},
+		IsEndDate: isEndDate,
+		EndDate: pgtype.Timestamptz{
+			Time:  endDate,
+			Valid: !endDate.IsZero(),
+		},
+		MinDrift: req.MinDrift,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: req.PerPage > 0,
+		},
+		Offset: offset,
+	}
+
+	rows, err := h.store.ListStationClockDrift(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]clockDriftRes, len(rows))
+	for i, row := range rows {
+		items[i] = newClockDriftResponse(row)
+	}
+
+	count, err := h.store.CountStationClockDrift(ctx, db.CountStationClockDriftParams{
+		IsStartDate: arg.IsStartDate,
+		StartDate:   arg.StartDate,
+		IsEndDate:   arg.IsEndDate,
+		EndDate:     arg.EndDate,
+		MinDrift:    arg.MinDrift,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type batteryReportReq struct {
+	Days    int     `form:"days,default=14" binding:"omitempty,min=3,max=90"` // days of health history used
+	Cutoff  float32 `form:"cutoff" binding:"omitempty,gt=0"`                  // battery cutoff voltage, defaults to 11.5
+	Status  string  `form:"status" binding:"omitempty,oneof=CRITICAL DECLINING STABLE INSUFFICIENT_DATA"`
+	Page    int32   `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage int32   `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name BatteryReportParams
+
+type paginatedBatteryForecasts = util.PaginatedList[service.BatteryForecast] //@name PaginatedBatteryForecasts
+
+// BatteryReport
+//
+//	@Summary	Stations ranked by projected battery cutoff
+//	@Tags		reports
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	query	batteryReportReq	false	"Battery report parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedBatteryForecasts
+//	@Router		/reports/battery [get]
+func (h *DefaultHandler) BatteryReport(ctx *gin.Context) {
+	var req batteryReportReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	forecasts, err := h.forecastBatteries(ctx, pgtype.Int8{}, req.Days, req.Cutoff)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	service.RankBatteryForecasts(forecasts)
+
+	if req.Status != "" {
+		filtered := forecasts[:0]
+		for _, f := range forecasts {
+			if f.Status == req.Status {
+				filtered = append(filtered, f)
+			}
+		}
+		forecasts = filtered
+	}
+
+	count := int32(len(forecasts))
+	items := forecasts
+	if req.PerPage > 0 {
+		start := min((req.Page-1)*req.PerPage, count)
+		end := min(start+req.PerPage, count)
+		items = forecasts[start:end]
+	}
+	if items == nil {
+		items = []service.BatteryForecast{}
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, count, items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type availabilityReportReq struct {
+	StartDate string `form:"start_date" binding:"required,date_time"`
+	EndDate   string `form:"end_date" binding:"required,date_time"`
+	GroupBy   string `form:"group_by,default=station" binding:"omitempty,oneof=station province provider"`
+	Format    string `form:"format,default=json" binding:"omitempty,oneof=json csv"`
+} //@name AvailabilityReportParams
+
+type availabilityReportRes struct {
+	StartDate string                      `json:"start_date"`
+	EndDate   string                      `json:"end_date"`
+	GroupBy   string                      `json:"group_by"`
+	Groups    []service.AvailabilityGroup `json:"groups"`
+} //@name AvailabilityReport
+
+// maxAvailabilityReportDays limits the report period
+const maxAvailabilityReportDays = 366
+
+// AvailabilityReport
+//
+//	@Summary	Received versus expected records per day
+//	@Tags		reports
+//	@Accept		json
+//	@Produce	json,text/csv
+//	@Param		req	query	availabilityReportReq	false	"Availability report parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	availabilityReportRes
+//	@Router		/reports/availability [get]
+func (h *DefaultHandler) AvailabilityReport(ctx *gin.Context) {
+	var req availabilityReportReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	startDate, _ := util.ParseDateTime(req.StartDate)
+	endDate, _ := util.ParseDateTime(req.EndDate)
+	startDate = truncateDay(startDate)
+	endDate = truncateDay(endDate)
+	if endDate.Before(startDate) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("end_date is before start_date")))
+		return
+	}
+	if endDate.Sub(startDate) >= maxAvailabilityReportDays*24*time.Hour {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("report period is longer than %d days", maxAvailabilityReportDays)))
+		return
+	}
+
+	stations, err := h.store.ListStations(ctx, db.ListStationsParams{})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	period := pgtype.Timestamptz{Time: startDate, Valid: true}
+	periodEnd := pgtype.Timestamptz{Time: endDate.AddDate(0, 0, 1), Valid: true}
+	records, err := h.store.ListStationDailyRecordCounts(ctx, db.ListStationDailyRecordCountsParams{
+		StartDate: period,
+		EndDate:   periodEnd,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	dataStatus, err := h.store.ListStationDailyDataStatus(ctx, db.ListStationDailyDataStatusParams{
+		StartDate: period,
+		EndDate:   periodEnd,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	stationIDs := make([]int64, len(stations))
+	for i, stn := range stations {
+		stationIDs[i] = stn.ID
+	}
+	maintenance, err := h.store.ListStationMaintenanceWindowsInRange(ctx, db.ListStationMaintenanceWindowsInRangeParams{
+		StationIds: stationIDs,
+		StartDate:  period,
+		EndDate:    periodEnd,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := availabilityReportRes{
+		StartDate: startDate.Format(time.DateOnly),
+		EndDate:   endDate.Format(time.DateOnly),
+		GroupBy:   req.GroupBy,
+		Groups:    service.BuildAvailabilityReport(stations, records, dataStatus, maintenance, startDate, endDate, req.GroupBy),
+	}
+
+	if req.Format == "csv" {
+		data, err := availabilityCSV(res)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		filename := fmt.Sprintf("availability_%s_%s.csv", res.StartDate, res.EndDate)
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		ctx.Data(http.StatusOK, "text/csv", data)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// truncateDay returns the start of the day in the time's location
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// availabilityCSV writes a row per group per day, preceded by the group total with "all" as the day
+func availabilityCSV(res availabilityReportRes) ([]byte, error) {
+	vars := service.AvailabilityVariables(res.Groups)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	header := []string{res.GroupBy, "name", "day", "stations", "maintenance", "expected", "received", "availability", "avg_data_count"}
+	for _, v := range vars {
+		header = append(header, v+"_completeness")
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	writeRow := func(g service.AvailabilityGroup, d service.AvailabilityDay) error {
+		avgDataCount := ""
+		if d.AvgDataCount != nil {
+			avgDataCount = formatFloat(*d.AvgDataCount)
+		}
+		row := []string{
+			g.Key,
+			g.Name,
+			d.Day,
+			strconv.Itoa(d.Stations),
+			strconv.Itoa(d.Maintenance),
+			strconv.FormatInt(d.Expected, 10),
+			strconv.FormatInt(d.Received, 10),
+			formatFloat(d.Availability),
+			avgDataCount,
+		}
+		for _, v := range vars {
+			row = append(row, formatFloat(d.Variables[v]))
+		}
+		return w.Write(row)
+	}
+
+	for _, g := range res.Groups {
+		total := service.AvailabilityDay{
+			Day:          "all",
+			Stations:     g.Stations,
+			Maintenance:  g.Maintenance,
+			Expected:     g.Expected,
+			Received:     g.Received,
+			Availability: g.Availability,
+			AvgDataCount: g.AvgDataCount,
+			Variables:    g.Variables,
+		}
+		if err := writeRow(g, total); err != nil {
+			return nil, err
+		}
+		for _, d := range g.Days {
+			if err := writeRow(g, d); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
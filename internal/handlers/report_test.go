@@ -0,0 +1,413 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockDriftReportAPI(t *testing.T) {
+	n := 3
+	rows := make([]db.ListStationClockDriftRow, n)
+	for i := range rows {
+		rows[i] = db.ListStationClockDriftRow{
+			StationID:               util.RandomInt[int64](1, 1000),
+			Name:                    util.RandomString(12),
+			Timezone:                "Asia/Manila",
+			ClockOffset:             10,
+			SampleCount:             util.RandomInt[int64](1, 100),
+			MedianMinutesDifference: 70.4,
+		}
+	}
+
+	type Query struct {
+		startDate string
+		minDrift  string
+		page      string
+		perPage   string
+	}
+
+	testCases := []struct {
+		name          string
+		query         Query
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			query: Query{
+				startDate: "2024-01-01",
+				minDrift:  "5",
+				page:      "1",
+				perPage:   "5",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationClockDrift(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationClockDriftParams) bool {
+					return arg.IsStartDate && !arg.IsEndDate && arg.MinDrift == 5 && arg.Limit.Int32 == 5 && arg.Offset == 0
+				})).Return(rows, nil)
+				store.EXPECT().CountStationClockDrift(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotRes util.PaginatedList[clockDriftRes]
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Items, n)
+				require.Equal(t, int32(n), gotRes.Count)
+				for i, item := range gotRes.Items {
+					require.Equal(t, rows[i].StationID, item.StationID)
+					require.InDelta(t, 60.4, item.ResidualDrift, 0.01)
+					require.Equal(t, int32(70), item.SuggestedClockOffset)
+				}
+			},
+		},
+		{
+			name: "InvalidStartDate",
+			query: Query{
+				startDate: "2024-01",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationClockDrift", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidMinDrift",
+			query: Query{
+				minDrift: "-1",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationClockDrift", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: Query{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationClockDrift(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ListStationClockDriftRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/reports/drift", handler.ClockDriftReport)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/reports/drift", nil)
+			require.NoError(t, err)
+
+			q := request.URL.Query()
+			if len(tc.query.startDate) > 0 {
+				q.Add("start_date", tc.query.startDate)
+			}
+			if len(tc.query.minDrift) > 0 {
+				q.Add("min_drift", tc.query.minDrift)
+			}
+			if len(tc.query.page) > 0 {
+				q.Add("page", tc.query.page)
+			}
+			if len(tc.query.perPage) > 0 {
+				q.Add("per_page", tc.query.perPage)
+			}
+			request.URL.RawQuery = q.Encode()
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestBatteryReportAPI(t *testing.T) {
+	today := time.Now().Truncate(24 * time.Hour)
+	var rows []db.ListStationBatteryDailyRow
+	for _, stn := range []struct {
+		id   int64
+		mins []float32
+	}{
+		{1, []float32{12.6, 12.6, 12.5, 12.6, 12.6}},
+		{2, []float32{12.4, 12.2, 12.0, 11.8, 11.6}},
+		{3, []float32{12.6, 12.5, 12.4, 12.3, 12.2}},
+	} {
+		for i, m := range stn.mins {
+			rows = append(rows, db.ListStationBatteryDailyRow{
+				StationID: stn.id,
+				Name:      util.RandomString(12),
+				Day:       pgtype.Date{Time: today.AddDate(0, 0, i-len(stn.mins)+1), Valid: true},
+				Vb1Min:    m,
+				Vb1Max:    m + 1.2,
+			})
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: "?days=7",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationBatteryDaily(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationBatteryDailyParams) bool {
+					return !arg.StationID.Valid && time.Since(arg.Since.Time) > 6*24*time.Hour
+				})).Return(rows, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotRes util.PaginatedList[service.BatteryForecast]
+				err := json.NewDecoder(recorder.Body).Decode(&gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Items, 3)
+				require.Equal(t, int64(2), gotRes.Items[0].StationID)
+				require.Equal(t, service.BatteryStatusCritical, gotRes.Items[0].Status)
+				require.Equal(t, int64(3), gotRes.Items[1].StationID)
+				require.Equal(t, service.BatteryStatusDeclining, gotRes.Items[1].Status)
+				require.Equal(t, int64(1), gotRes.Items[2].StationID)
+			},
+		},
+		{
+			name:  "FilterStatus",
+			query: "?status=DECLINING&per_page=1",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationBatteryDaily(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(rows, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotRes util.PaginatedList[service.BatteryForecast]
+				err := json.NewDecoder(recorder.Body).Decode(&gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Items, 1)
+				require.Equal(t, int32(1), gotRes.Count)
+				require.Equal(t, int64(3), gotRes.Items[0].StationID)
+			},
+		},
+		{
+			name:  "InvalidDays",
+			query: "?days=1",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationBatteryDaily", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/reports/battery", handler.BatteryReport)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/reports/battery"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestAvailabilityReportAPI(t *testing.T) {
+	day := func(s string) pgtype.Date {
+		d, _ := time.Parse(time.DateOnly, s)
+		return pgtype.Date{Time: d, Valid: true}
+	}
+	stations := []db.ObservationsStation{
+		{ID: 1, Name: "Alpha", Province: util.ToPgText("Laguna"), ReportingInterval: pgtype.Int4{Int32: 60, Valid: true}},
+		{ID: 2, Name: "Bravo", Province: util.ToPgText("Laguna"), ReportingInterval: pgtype.Int4{Int32: 60, Valid: true}},
+		{ID: 3, Name: "Charlie", Status: util.ToPgText("INACTIVE")},
+	}
+	records := []db.ListStationDailyRecordCountsRow{
+		{StationID: 1, Day: day("2024-03-01"), RecordCount: 24},
+		{StationID: 1, Day: day("2024-03-02"), RecordCount: 12},
+		{StationID: 2, Day: day("2024-03-01"), RecordCount: 6},
+	}
+	dataStatus := []db.ListStationDailyDataStatusRow{
+		{StationID: 1, Day: day("2024-03-01"), DataStatus: "1111111111", RecordCount: 24, DataCount: 240},
+	}
+	// a quarter of the second day
+	maintenanceStart := time.Date(2024, 3, 2, 12, 0, 0, 0, time.FixedZone("PHT", 8*60*60))
+	maintenance := []db.StationMaintenance{
+		{
+			ID:          1,
+			StationID:   2,
+			WindowStart: pgtype.Timestamptz{Time: maintenanceStart, Valid: true},
+			WindowEnd:   pgtype.Timestamptz{Time: maintenanceStart.Add(6 * time.Hour), Valid: true},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: "?start_date=2024-03-01&end_date=2024-03-02",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), db.ListStationsParams{}).Return(stations, nil)
+				store.EXPECT().ListStationDailyRecordCounts(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationDailyRecordCountsParams) bool {
+					return arg.EndDate.Time.Sub(arg.StartDate.Time) == 48*time.Hour
+				})).Return(records, nil)
+				store.EXPECT().ListStationDailyDataStatus(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(dataStatus, nil)
+				store.EXPECT().ListStationMaintenanceWindowsInRange(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationMaintenanceWindowsInRangeParams) bool {
+					return len(arg.StationIds) == len(stations) && arg.EndDate.Time.Sub(arg.StartDate.Time) == 48*time.Hour
+				})).Return(maintenance, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotRes availabilityReportRes
+				err := json.NewDecoder(recorder.Body).Decode(&gotRes)
+				require.NoError(t, err)
+				require.Equal(t, "station", gotRes.GroupBy)
+				require.Len(t, gotRes.Groups, 2)
+				require.Equal(t, "1", gotRes.Groups[0].Key)
+				require.Equal(t, int64(48), gotRes.Groups[0].Expected)
+				require.InDelta(t, 75, gotRes.Groups[0].Availability, 0.01)
+				require.Len(t, gotRes.Groups[0].Days, 2)
+				require.InDelta(t, 100, gotRes.Groups[0].Days[0].Variables["temp"], 0.01)
+				require.Equal(t, 1, gotRes.Groups[1].Maintenance)
+				require.Equal(t, int64(42), gotRes.Groups[1].Expected)
+				require.Equal(t, int64(18), gotRes.Groups[1].Days[1].Expected)
+			},
+		},
+		{
+			name:  "CSV",
+			query: "?start_date=2024-03-01&end_date=2024-03-02&group_by=province&format=csv",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(stations, nil)
+				store.EXPECT().ListStationDailyRecordCounts(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(records, nil)
+				store.EXPECT().ListStationDailyDataStatus(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(dataStatus, nil)
+				store.EXPECT().ListStationMaintenanceWindowsInRange(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(maintenance, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Header().Get("Content-Type"), "text/csv")
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+				// header, total and 2 days of the single province
+				require.Len(t, lines, 4)
+				require.True(t, strings.HasPrefix(lines[0], "province,name,day,stations,maintenance,expected,received,availability"))
+				require.True(t, strings.HasPrefix(lines[1], "Laguna,,all,2,1,90,42,46.67"))
+			},
+		},
+		{
+			name:  "EndBeforeStart",
+			query: "?start_date=2024-03-02&end_date=2024-03-01",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStations", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "MissingDates",
+			query: "?group_by=provider",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStations", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "?start_date=2024-03-01&end_date=2024-03-31",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/reports/availability", handler.AvailabilityReport)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/reports/availability"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
@@ -0,0 +1,509 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	SimCardStatusActive    = "ACTIVE"
+	SimCardStatusSuspended = "SUSPENDED"
+	SimCardStatusLost      = "LOST"
+)
+
+type simCardRes struct {
+	MobileNumber     string     `json:"mobile_number"`
+	Type             string     `json:"type,omitempty"`
+	Iccid            string     `json:"iccid,omitempty"`
+	Status           string     `json:"status"`
+	StationID        *int64     `json:"station_id,omitempty"`
+	LoadPromo        string     `json:"load_promo,omitempty"`
+	LoadValidityDays *int32     `json:"load_validity_days,omitempty"`
+	LoadExpiresAt    *time.Time `json:"load_expires_at,omitempty"`
+	LoadRequestedAt  *time.Time `json:"load_requested_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+} //@name SimCard
+
+func newSimCardResponse(s db.SimCard) simCardRes {
+	res := simCardRes{
+		MobileNumber: s.MobileNumber,
+		Type:         s.Type.String,
+		Iccid:        s.Iccid.String,
+		Status:       s.Status,
+		LoadPromo:    s.LoadPromo.String,
+		CreatedAt:    s.CreatedAt.Time,
+	}
+	if s.LoadValidityDays.Valid {
+		res.LoadValidityDays = &s.LoadValidityDays.Int32
+	}
+	if s.LoadExpiresAt.Valid {
+		res.LoadExpiresAt = &s.LoadExpiresAt.Time
+	}
+	if s.LoadRequestedAt.Valid {
+		res.LoadRequestedAt = &s.LoadRequestedAt.Time
+	}
+	return res
+}
+
+type simCardAssignmentRes struct {
+	ID           int64      `json:"id"`
+	MobileNumber string     `json:"mobile_number"`
+	StationID    int64      `json:"station_id"`
+	AssignedAt   time.Time  `json:"assigned_at"`
+	UnassignedAt *time.Time `json:"unassigned_at,omitempty"`
+} //@name SimCardAssignment
+
+func newSimCardAssignmentResponse(a db.SimCardAssignment) simCardAssignmentRes {
+	res := simCardAssignmentRes{
+		ID:           a.ID,
+		MobileNumber: a.MobileNumber,
+		StationID:    a.StationID,
+		AssignedAt:   a.AssignedAt.Time,
+	}
+	if a.UnassignedAt.Valid {
+		res.UnassignedAt = &a.UnassignedAt.Time
+	}
+	return res
+}
+
+type listSimCardsReq struct {
+	Status  string `form:"status" binding:"omitempty,oneof=ACTIVE SUSPENDED LOST"`
+	Type    string `form:"type"`
+	Page    int32  `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage int32  `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name ListSimCardsParams
+
+type paginatedSimCards = util.PaginatedList[simCardRes] //@name PaginatedSimCards
+
+// ListSimCards
+//
+//	@Summary	List SIM cards
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	query	listSimCardsReq	false	"List SIM cards parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedSimCards
+//	@Router		/sims [get]
+func (h *DefaultHandler) ListSimCards(ctx *gin.Context) {
+	var req listSimCardsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	sims, err := h.store.ListSimCards(ctx, db.ListSimCardsParams{
+		Status: util.ToPgText(req.Status),
+		Type:   util.ToPgText(req.Type),
+		Limit:  pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
+		Offset: offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]simCardRes, len(sims))
+	for i, s := range sims {
+		items[i] = newSimCardResponse(s)
+	}
+
+	count, err := h.store.CountSimCards(ctx, db.CountSimCardsParams{
+		Status: util.ToPgText(req.Status),
+		Type:   util.ToPgText(req.Type),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type createSimCardReq struct {
+	MobileNumber string `json:"mobile_number" binding:"required"`
+	Type         string `json:"type"` // carrier, e.g. GLOBE or SMART
+	Iccid        string `json:"iccid" binding:"omitempty,numeric,min=18,max=22"`
+} //@name CreateSimCardParams
+
+// CreateSimCard
+//
+//	@Summary	Add a SIM card to the inventory
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	body	createSimCardReq	true	"Create SIM card parameters"
+//	@Security	BearerAuth
+//	@Success	201	{object}	simCardRes
+//	@Router		/sims [post]
+func (h *DefaultHandler) CreateSimCard(ctx *gin.Context) {
+	var req createSimCardReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	mobileNumber, ok := util.ParseMobileNumber(req.MobileNumber)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid mobile number: %s", req.MobileNumber)))
+		return
+	}
+
+	sim, err := h.store.CreateSimCard(ctx, db.CreateSimCardParams{
+		MobileNumber: mobileNumber,
+		Type:         util.ToPgText(req.Type),
+		Iccid:        util.ToPgText(req.Iccid),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("mobile number or iccid already registered")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newSimCardResponse(sim))
+}
+
+type simCardUri struct {
+	MobileNumber string `uri:"mobile_number" binding:"required"`
+}
+
+// bindSimCardUri returns the normalized mobile number of the path
+func bindSimCardUri(ctx *gin.Context) (string, bool) {
+	var uri simCardUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return "", false
+	}
+
+	mobileNumber, ok := util.ParseMobileNumber(uri.MobileNumber)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid mobile number: %s", uri.MobileNumber)))
+		return "", false
+	}
+	return mobileNumber, true
+}
+
+// GetSimCard
+//
+//	@Summary	Get a SIM card and the station it is in
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string	true	"Mobile number"
+//	@Security	BearerAuth
+//	@Success	200	{object}	simCardRes
+//	@Router		/sims/{mobile_number} [get]
+func (h *DefaultHandler) GetSimCard(ctx *gin.Context) {
+	mobileNumber, ok := bindSimCardUri(ctx)
+	if !ok {
+		return
+	}
+
+	sim, ok := h.getSimCard(ctx, mobileNumber)
+	if !ok {
+		return
+	}
+
+	res := newSimCardResponse(sim)
+	assignment, err := h.store.GetActiveSimCardAssignment(ctx, mobileNumber)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err == nil {
+		res.StationID = &assignment.StationID
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type updateSimCardReq struct {
+	Type   string `json:"type"`
+	Iccid  string `json:"iccid" binding:"omitempty,numeric,min=18,max=22"`
+	Status string `json:"status" binding:"omitempty,oneof=ACTIVE SUSPENDED LOST"`
+} //@name UpdateSimCardParams
+
+// UpdateSimCard
+//
+//	@Summary	Update a SIM card
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string				true	"Mobile number"
+//	@Param		req				body	updateSimCardReq	true	"Update SIM card parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	simCardRes
+//	@Router		/sims/{mobile_number} [put]
+func (h *DefaultHandler) UpdateSimCard(ctx *gin.Context) {
+	mobileNumber, ok := bindSimCardUri(ctx)
+	if !ok {
+		return
+	}
+
+	var req updateSimCardReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	sim, err := h.store.UpdateSimCard(ctx, db.UpdateSimCardParams{
+		Type:         util.ToPgText(req.Type),
+		Iccid:        util.ToPgText(req.Iccid),
+		Status:       util.ToPgText(req.Status),
+		MobileNumber: mobileNumber,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("sim card not found")))
+			return
+		}
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("iccid already registered")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSimCardResponse(sim))
+}
+
+// DeleteSimCard
+//
+//	@Summary	Remove a SIM card that is not in a station
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string	true	"Mobile number"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/sims/{mobile_number} [delete]
+func (h *DefaultHandler) DeleteSimCard(ctx *gin.Context) {
+	mobileNumber, ok := bindSimCardUri(ctx)
+	if !ok {
+		return
+	}
+
+	if _, ok := h.getSimCard(ctx, mobileNumber); !ok {
+		return
+	}
+
+	_, err := h.store.GetActiveSimCardAssignment(ctx, mobileNumber)
+	if err == nil {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("sim card is assigned to a station")))
+		return
+	}
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if err := h.store.DeleteSimCard(ctx, mobileNumber); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// ListSimCardAssignments
+//
+//	@Summary	List the stations a SIM card has been in
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string	true	"Mobile number"
+//	@Security	BearerAuth
+//	@Success	200	{array}	simCardAssignmentRes
+//	@Router		/sims/{mobile_number}/assignments [get]
+func (h *DefaultHandler) ListSimCardAssignments(ctx *gin.Context) {
+	mobileNumber, ok := bindSimCardUri(ctx)
+	if !ok {
+		return
+	}
+
+	assignments, err := h.store.ListSimCardAssignments(ctx, db.ListSimCardAssignmentsParams{
+		MobileNumber: util.ToPgText(mobileNumber),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]simCardAssignmentRes, len(assignments))
+	for i, a := range assignments {
+		res[i] = newSimCardAssignmentResponse(a)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type assignSimCardReq struct {
+	StationID int64 `json:"station_id" binding:"required,min=1"`
+} //@name AssignSimCardParams
+
+type assignSimCardRes struct {
+	Station    models.Station       `json:"station"`
+	Assignment simCardAssignmentRes `json:"assignment"`
+} //@name AssignSimCardResult
+
+// AssignSimCard
+//
+//	@Summary	Put a SIM card in a station, replacing the SIM the station had
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string				true	"Mobile number"
+//	@Param		req				body	assignSimCardReq	true	"Assign SIM card parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	assignSimCardRes
+//	@Router		/sims/{mobile_number}/assign [post]
+func (h *DefaultHandler) AssignSimCard(ctx *gin.Context) {
+	mobileNumber, ok := bindSimCardUri(ctx)
+	if !ok {
+		return
+	}
+
+	var req assignSimCardReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	sim, ok := h.getSimCard(ctx, mobileNumber)
+	if !ok {
+		return
+	}
+	if sim.Status != SimCardStatusActive {
+		ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("sim card is %s", sim.Status)))
+		return
+	}
+
+	result, err := h.store.AssignSimCardTx(ctx, db.AssignSimCardTxParams{
+		MobileNumber: mobileNumber,
+		StationID:    req.StationID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("sim card assignment changed, try again")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, assignSimCardRes{
+		Station:    models.NewStation(result.Station, false),
+		Assignment: newSimCardAssignmentResponse(result.Assignment),
+	})
+}
+
+// UnassignSimCard
+//
+//	@Summary	Take a SIM card out of its station
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string	true	"Mobile number"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/sims/{mobile_number}/unassign [post]
+func (h *DefaultHandler) UnassignSimCard(ctx *gin.Context) {
+	mobileNumber, ok := bindSimCardUri(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.store.UnassignSimCardTx(ctx, mobileNumber); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("sim card is not assigned")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type updateSimCardLoadReq struct {
+	Promo        string     `json:"promo" binding:"required"`
+	ValidityDays int32      `json:"validity_days" binding:"required,min=1"`
+	ExpiresAt    *time.Time `json:"expires_at"` // current load expiry, unknown when empty
+} //@name UpdateSimCardLoadParams
+
+// UpdateSimCardLoad
+//
+//	@Summary	Set the prepaid load promo used for automatic top-ups
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path	string					true	"Mobile number"
+//	@Param		req				body	updateSimCardLoadReq	true	"Update SIM load parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	simCardRes
+//	@Router		/sims/{mobile_number}/load [put]
+func (h *DefaultHandler) UpdateSimCardLoad(ctx *gin.Context) {
+	mobileNumber, ok := bindSimCardUri(ctx)
+	if !ok {
+		return
+	}
+
+	var req updateSimCardLoadReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg := db.UpdateSimCardLoadParams{
+		LoadPromo:        util.ToPgText(req.Promo),
+		LoadValidityDays: pgtype.Int4{Int32: req.ValidityDays, Valid: true},
+		MobileNumber:     mobileNumber,
+	}
+	if req.ExpiresAt != nil {
+		arg.LoadExpiresAt = pgtype.Timestamptz{Time: *req.ExpiresAt, Valid: true}
+	}
+
+	sim, err := h.store.UpdateSimCardLoad(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("sim card not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSimCardResponse(sim))
+}
+
+func (h *DefaultHandler) getSimCard(ctx *gin.Context, mobileNumber string) (db.SimCard, bool) {
+	sim, err := h.store.GetSimCard(ctx, mobileNumber)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("sim card not found")))
+			return sim, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return sim, false
+	}
+	return sim, true
+}
@@ -0,0 +1,537 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSimCardLoadAPI(t *testing.T) {
+	mobileNumber := gofakeit.Regex("639[0-9]{9}")
+	expiresAt := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	sim := db.SimCard{
+		MobileNumber:     mobileNumber,
+		LoadPromo:        util.ToPgText("LOAD50"),
+		LoadValidityDays: pgtype.Int4{Int32: 30, Valid: true},
+		LoadExpiresAt:    pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"promo": "LOAD50", "validity_days": 30, "expires_at": expiresAt},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateSimCardLoad(mock.AnythingOfType("*gin.Context"), db.UpdateSimCardLoadParams{
+					LoadPromo:        sim.LoadPromo,
+					LoadValidityDays: sim.LoadValidityDays,
+					LoadExpiresAt:    sim.LoadExpiresAt,
+					MobileNumber:     mobileNumber,
+				}).Return(sim, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var got simCardRes
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, "LOAD50", got.LoadPromo)
+				require.Equal(t, int32(30), *got.LoadValidityDays)
+				require.True(t, expiresAt.Equal(*got.LoadExpiresAt))
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"promo": "LOAD50", "validity_days": 30},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateSimCardLoad(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.SimCard{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "MissingPromo",
+			body: gin.H{"validity_days": 30},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpdateSimCardLoad", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/sims/:mobile_number/load", handler.UpdateSimCardLoad)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/sims/%s/load", mobileNumber)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestCreateSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"mobile_number": "0" + sim.MobileNumber[2:],
+				"type":          sim.Type.String,
+				"iccid":         sim.Iccid.String,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSimCard(mock.AnythingOfType("*gin.Context"), db.CreateSimCardParams{
+					MobileNumber: sim.MobileNumber,
+					Type:         sim.Type,
+					Iccid:        sim.Iccid,
+				}).Return(sim, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchSimCard(t, recorder.Body, sim)
+			},
+		},
+		{
+			name: "InvalidMobileNumber",
+			body: gin.H{"mobile_number": "12345"},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateSimCard", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Duplicate",
+			body: gin.H{"mobile_number": sim.MobileNumber},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSimCard(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.SimCard{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/sims", handler.CreateSimCard)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/sims", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestGetSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+
+	testCases := []struct {
+		name          string
+		mobileNumber  string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:         "OK",
+			mobileNumber: sim.MobileNumber,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().GetActiveSimCardAssignment(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(db.SimCardAssignment{MobileNumber: sim.MobileNumber, StationID: 7}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got simCardRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.NotNil(t, got.StationID)
+				require.Equal(t, int64(7), *got.StationID)
+			},
+		},
+		{
+			name:         "Unassigned",
+			mobileNumber: sim.MobileNumber,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().GetActiveSimCardAssignment(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(db.SimCardAssignment{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSimCard(t, recorder.Body, sim)
+			},
+		},
+		{
+			name:         "NotFound",
+			mobileNumber: sim.MobileNumber,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(db.SimCard{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:         "InvalidMobileNumber",
+			mobileNumber: "abc",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetSimCard", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/sims/:mobile_number", handler.GetSimCard)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/sims/%s", tc.mobileNumber)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().GetActiveSimCardAssignment(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(db.SimCardAssignment{}, db.ErrRecordNotFound)
+				store.EXPECT().DeleteSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Assigned",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().GetActiveSimCardAssignment(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(db.SimCardAssignment{StationID: 1}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "DeleteSimCard", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE("/sims/:mobile_number", handler.DeleteSimCard)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/sims/%s", sim.MobileNumber)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestAssignSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+	stationID := gofakeit.Int64()&0xffff + 1
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"station_id": stationID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().AssignSimCardTx(mock.AnythingOfType("*gin.Context"), db.AssignSimCardTxParams{
+					MobileNumber: sim.MobileNumber,
+					StationID:    stationID,
+				}).Return(db.AssignSimCardTxResult{
+					Station: db.ObservationsStation{
+						ID:           stationID,
+						MobileNumber: util.ToPgText(sim.MobileNumber),
+					},
+					Assignment: db.SimCardAssignment{ID: 1, MobileNumber: sim.MobileNumber, StationID: stationID},
+				}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got assignSimCardRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, stationID, got.Station.ID)
+				require.Equal(t, stationID, got.Assignment.StationID)
+				require.Nil(t, got.Assignment.UnassignedAt)
+			},
+		},
+		{
+			name: "LostSimCard",
+			body: gin.H{"station_id": stationID},
+			buildStubs: func(store *mockdb.MockStore) {
+				lost := sim
+				lost.Status = SimCardStatusLost
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(lost, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "AssignSimCardTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "StationNotFound",
+			body: gin.H{"station_id": stationID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().AssignSimCardTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.AssignSimCardTxResult{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "MissingStationID",
+			body: gin.H{},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetSimCard", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/sims/:mobile_number/assign", handler.AssignSimCard)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/sims/%s/assign", sim.MobileNumber)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUnassignSimCardAPI(t *testing.T) {
+	mobileNumber := gofakeit.Regex("639[0-9]{9}")
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UnassignSimCardTx(mock.AnythingOfType("*gin.Context"), mobileNumber).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "NotAssigned",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UnassignSimCardTx(mock.AnythingOfType("*gin.Context"), mobileNumber).
+					Return(db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/sims/:mobile_number/unassign", handler.UnassignSimCard)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/sims/%s/unassign", mobileNumber)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomSimCard() db.SimCard {
+	return db.SimCard{
+		MobileNumber: gofakeit.Regex("639[0-9]{9}"),
+		Type:         util.ToPgText(gofakeit.RandomString([]string{"GLOBE", "SMART"})),
+		Iccid:        util.ToPgText(gofakeit.Regex("8963[0-9]{15}")),
+		Status:       SimCardStatusActive,
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Second), Valid: true},
+	}
+}
+
+func requireBodyMatchSimCard(t *testing.T, body io.Reader, sim db.SimCard) {
+	var got simCardRes
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+
+	require.Equal(t, sim.MobileNumber, got.MobileNumber)
+	require.Equal(t, sim.Type.String, got.Type)
+	require.Equal(t, sim.Iccid.String, got.Iccid)
+	require.Equal(t, sim.Status, got.Status)
+}
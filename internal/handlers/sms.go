@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/notify/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type smsOutboxRes struct {
+	ID             int64      `json:"id"`
+	MobileNumber   string     `json:"mobile_number"`
+	Message        string     `json:"message"`
+	Status         string     `json:"status"`
+	Attempts       int32      `json:"attempts"`
+	LastError      string     `json:"last_error,omitempty"`
+	DeliveryStatus string     `json:"delivery_status,omitempty"`
+	SentAt         *time.Time `json:"sent_at,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+} //@name SMSOutbox
+
+func newSmsOutboxResponse(m db.SmsOutbox) smsOutboxRes {
+	res := smsOutboxRes{
+		ID:             m.ID,
+		MobileNumber:   m.MobileNumber,
+		Message:        m.Message,
+		Status:         m.Status,
+		Attempts:       m.Attempts,
+		LastError:      m.LastError.String,
+		DeliveryStatus: m.DeliveryStatus.String,
+		CreatedAt:      m.CreatedAt.Time,
+	}
+	if m.SentAt.Valid {
+		res.SentAt = &m.SentAt.Time
+	}
+	if m.ExpiresAt.Valid {
+		res.ExpiresAt = &m.ExpiresAt.Time
+	}
+	return res
+}
+
+type createSmsOutboxReq struct {
+	MobileNumber string `json:"mobile_number" binding:"required,mobile_number"`
+	Message      string `json:"message" binding:"required,max=480"`
+} //@name CreateSMSParams
+
+// CreateSmsOutbox
+//
+//	@Summary	Queue an SMS to a station SIM or an opted-in subscriber
+//	@Tags		sms
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	body	createSmsOutboxReq	true	"Create SMS parameters"
+//	@Security	BearerAuth
+//	@Success	202	{object}	smsOutboxRes
+//	@Router		/sms [post]
+func (h *DefaultHandler) CreateSmsOutbox(ctx *gin.Context) {
+	var req createSmsOutboxReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	m, err := sms.Enqueue(ctx, h.store, req.MobileNumber, req.Message)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, newSmsOutboxResponse(m))
+}
+
+type listSmsOutboxReq struct {
+	Status       string `form:"status" binding:"omitempty,oneof=QUEUED SENDING SENT FAILED"`
+	MobileNumber string `form:"mobile_number" binding:"omitempty,mobile_number"`
+	Page         int32  `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage      int32  `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name ListSMSParams
+
+type paginatedSmsOutbox = util.PaginatedList[smsOutboxRes] //@name PaginatedSMSOutbox
+
+// ListSmsOutbox
+//
+//	@Summary	List queued and sent SMS
+//	@Tags		sms
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	query	listSmsOutboxReq	false	"List SMS parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedSmsOutbox
+//	@Router		/sms [get]
+func (h *DefaultHandler) ListSmsOutbox(ctx *gin.Context) {
+	var req listSmsOutboxReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var mobileNumber pgtype.Text
+	if req.MobileNumber != "" {
+		number, _ := util.ParseMobileNumber(req.MobileNumber)
+		mobileNumber = util.ToPgText(number)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	msgs, err := h.store.ListSmsOutbox(ctx, db.ListSmsOutboxParams{
+		Status:       util.ToPgText(req.Status),
+		MobileNumber: mobileNumber,
+		Limit:        pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
+		Offset:       offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]smsOutboxRes, len(msgs))
+	for i, m := range msgs {
+		items[i] = newSmsOutboxResponse(m)
+	}
+
+	count, err := h.store.CountSmsOutbox(ctx, db.CountSmsOutboxParams{
+		Status:       util.ToPgText(req.Status),
+		MobileNumber: mobileNumber,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type getSmsOutboxReq struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// GetSmsOutbox
+//
+//	@Summary	Get SMS delivery status
+//	@Tags		sms
+//	@Accept		json
+//	@Produce	json
+//	@Param		id	path	int	true	"SMS ID"
+//	@Security	BearerAuth
+//	@Success	200	{object}	smsOutboxRes
+//	@Router		/sms/{id} [get]
+func (h *DefaultHandler) GetSmsOutbox(ctx *gin.Context) {
+	var req getSmsOutboxReq
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	m, err := h.store.GetSmsOutbox(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("sms not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSmsOutboxResponse(m))
+}
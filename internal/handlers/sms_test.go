@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/notify/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSmsOutboxAPI(t *testing.T) {
+	m := randomSmsOutbox()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"mobile_number": m.MobileNumber, "message": m.Message},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSmsOutbox(mock.AnythingOfType("*gin.Context"), db.CreateSmsOutboxParams{
+					MobileNumber: m.MobileNumber,
+					Message:      m.Message,
+				}).Return(m, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+				requireBodyMatchSmsOutbox(t, recorder.Body, m)
+			},
+		},
+		{
+			name: "InvalidMobileNumber",
+			body: gin.H{"mobile_number": "1234", "message": m.Message},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateSmsOutbox", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MessageTooLong",
+			body: gin.H{"mobile_number": m.MobileNumber, "message": util.RandomString(481)},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateSmsOutbox", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/sms", handler.CreateSmsOutbox)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/sms", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListSmsOutboxAPI(t *testing.T) {
+	n := 3
+	msgs := make([]db.SmsOutbox, n)
+	for i := range msgs {
+		msgs[i] = randomSmsOutbox()
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "?status=FAILED&mobile_number=639171234567&page=1&per_page=3",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsOutbox(mock.AnythingOfType("*gin.Context"), db.ListSmsOutboxParams{
+					Status:       util.ToPgText(sms.StatusFailed),
+					MobileNumber: util.ToPgText("639171234567"),
+					Limit:        pgtype.Int4{Int32: 3, Valid: true},
+					Offset:       0,
+				}).Return(msgs, nil)
+				store.EXPECT().CountSmsOutbox(mock.AnythingOfType("*gin.Context"), db.CountSmsOutboxParams{
+					Status:       util.ToPgText(sms.StatusFailed),
+					MobileNumber: util.ToPgText("639171234567"),
+				}).Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes paginatedSmsOutbox
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Items, n)
+			},
+		},
+		{
+			name:  "InvalidStatus",
+			query: "?status=UNKNOWN",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListSmsOutbox", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/sms", handler.ListSmsOutbox)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/sms"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestGetSmsOutboxAPI(t *testing.T) {
+	m := randomSmsOutbox()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSmsOutbox(mock.AnythingOfType("*gin.Context"), m.ID).Return(m, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSmsOutbox(t, recorder.Body, m)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSmsOutbox(mock.AnythingOfType("*gin.Context"), m.ID).
+					Return(db.SmsOutbox{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/sms/:id", handler.GetSmsOutbox)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/sms/%d", m.ID), nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomSmsOutbox() db.SmsOutbox {
+	return db.SmsOutbox{
+		ID:           util.RandomInt[int64](1, 1000),
+		MobileNumber: "63917" + fmt.Sprintf("%07d", util.RandomInt(0, 9999999)),
+		Message:      util.RandomString(40),
+		Status:       sms.StatusQueued,
+	}
+}
+
+func requireBodyMatchSmsOutbox(t *testing.T, body *bytes.Buffer, m db.SmsOutbox) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got smsOutboxRes
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, m.ID, got.ID)
+	require.Equal(t, m.MobileNumber, got.MobileNumber)
+	require.Equal(t, m.Message, got.Message)
+	require.Equal(t, m.Status, got.Status)
+}
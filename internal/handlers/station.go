@@ -6,9 +6,11 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
 	"github.com/emiliogozo/panahon-api-go/internal/token"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
@@ -44,12 +46,91 @@ func (h *DefaultHandler) CreateStation(ctx *gin.Context) {
 	ctx.JSON(http.StatusCreated, res)
 }
 
+// stationFilterReq are the station filters shared by the station and latest observation lists
+type stationFilterReq struct {
+	Q             string `form:"q" binding:"omitempty,max=100"` // search text for the name and address
+	Status        string `form:"status" binding:"omitempty"`
+	StationType   string `form:"station_type" binding:"omitempty"`
+	StationType2  string `form:"station_type2" binding:"omitempty"`
+	Province      string `form:"province" binding:"omitempty"`
+	Region        string `form:"region" binding:"omitempty"`
+	ProviderID    string `form:"provider_id" binding:"omitempty"`
+	PriorityLevel string `form:"priority_level" binding:"omitempty"`
+	InstalledFrom string `form:"installed_from" binding:"omitempty,date_time"` // earliest date installed
+	InstalledTo   string `form:"installed_to" binding:"omitempty,date_time"`   // latest date installed
+	Circle        string `form:"circle" binding:"omitempty"`                   // x,y,r in degrees
+	BBox          string `form:"bbox" binding:"omitempty"`                     // xmin,ymin,xmax,ymax in degrees
+}
+
+// params returns the filters as query parameters. Province and region
+// names are matched in their canonical form when they are recognized.
+func (r stationFilterReq) params() (db.CountStationsParams, error) {
+	arg := db.CountStationsParams{
+		Status:        util.ToPgText(r.Status),
+		Q:             util.ToPgText(strings.TrimSpace(r.Q)),
+		StationType:   util.ToPgText(r.StationType),
+		StationType2:  util.ToPgText(r.StationType2),
+		Province:      util.ToPgText(r.Province),
+		Region:        util.ToPgText(r.Region),
+		ProviderID:    util.ToPgText(r.ProviderID),
+		PriorityLevel: util.ToPgText(r.PriorityLevel),
+	}
+	if province, ok := util.ParseProvince(r.Province); ok {
+		arg.Province = util.ToPgText(string(province))
+	}
+	if region, ok := util.ParseRegion(r.Region); ok {
+		arg.Region = util.ToPgText(string(region))
+	}
+	if len(r.InstalledFrom) > 0 {
+		from, _ := util.ParseDateTime(r.InstalledFrom)
+		arg.InstalledFrom = util.ToPgDate(from.Format(time.DateOnly))
+	}
+	if len(r.InstalledTo) > 0 {
+		to, _ := util.ParseDateTime(r.InstalledTo)
+		arg.InstalledTo = util.ToPgDate(to.Format(time.DateOnly))
+	}
+	if arg.InstalledFrom.Valid && arg.InstalledTo.Valid && arg.InstalledTo.Time.Before(arg.InstalledFrom.Time) {
+		return arg, errors.New("installed_to is before installed_from")
+	}
+	if len(r.Circle) > 0 {
+		v, err := parseFloatList(r.Circle, 3)
+		if err != nil {
+			return arg, fmt.Errorf("invalid parameter: circle = %s", r.Circle)
+		}
+		arg.Cx, arg.Cy, arg.R = v[0], v[1], v[2]
+	}
+	if len(r.BBox) > 0 {
+		v, err := parseFloatList(r.BBox, 4)
+		if err != nil {
+			return arg, fmt.Errorf("invalid parameter: bbox = %s", r.BBox)
+		}
+		arg.Xmin, arg.Ymin, arg.Xmax, arg.Ymax = v[0], v[1], v[2], v[3]
+	}
+	return arg, nil
+}
+
+// parseFloatList parses a comma separated list of n numbers
+func parseFloatList(s string, n int) ([]pgtype.Float4, error) {
+	vals := strings.Split(s, ",")
+	if len(vals) != n {
+		return nil, fmt.Errorf("expected %d values", n)
+	}
+	res := make([]pgtype.Float4, n)
+	for i, v := range vals {
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 32)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = pgtype.Float4{Float32: float32(f), Valid: true}
+	}
+	return res, nil
+}
+
 type listStationsReq struct {
-	Circle  string `form:"circle" binding:"omitempty"`
-	BBox    string `form:"bbox" binding:"omitempty"`
-	Status  string `form:"status" binding:"omitempty"`
-	Page    int32  `form:"page,default=1" binding:"omitempty,min=1"` // page number
-	PerPage int32  `form:"per_page" binding:"omitempty,min=1"`       // limit
+	stationFilterReq
+	Sort    string `form:"sort" binding:"omitempty,oneof=id -id name -name date_installed -date_installed priority_level -priority_level relevance"` // defaults to relevance when searching, id otherwise
+	Page    int32  `form:"page,default=1" binding:"omitempty,min=1"`                                                                                 // page number
+	PerPage int32  `form:"per_page" binding:"omitempty,min=1"`                                                                                       // limit
 } //@name ListStationsParams
 
 type paginatedStations = util.PaginatedList[models.Station] //@name PaginatedStations
@@ -71,87 +152,39 @@ func (h *DefaultHandler) ListStations(ctx *gin.Context) {
 		return
 	}
 
-	offset := (req.Page - 1) * req.PerPage
-
-	var stations []db.ObservationsStation
-	var err error
-	var cX, cY, cR float64
-	var xMin, yMin, xMax, yMax float64
-	if len(req.Circle) > 0 {
-		cArgs := strings.Split(req.Circle, ",")
-		if len(cArgs) != 3 {
-			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid parameter: circle = %s", req.Circle)))
-			return
-		}
-		for i := range cArgs {
-			switch i {
-			case 0:
-				cX, err = strconv.ParseFloat(cArgs[i], 32)
-			case 1:
-				cY, err = strconv.ParseFloat(cArgs[i], 32)
-			case 2:
-				cR, err = strconv.ParseFloat(cArgs[i], 32)
-			default:
-				continue
-			}
-			if err != nil {
-				ctx.JSON(http.StatusBadRequest, err)
-				return
-			}
-		}
-		stations, err = h.store.ListStationsWithinRadius(
-			ctx,
-			db.ListStationsWithinRadiusParams{
-				Cx:     float32(cX),
-				Cy:     float32(cY),
-				R:      float32(cR),
-				Status: util.ToPgText(req.Status),
-				Limit:  pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
-				Offset: offset,
-			})
-	} else if len(req.BBox) > 0 {
-		rArgs := strings.Split(req.BBox, ",")
-		if len(rArgs) != 4 {
-			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid parameter: bbox = %s", req.BBox)))
-			return
-		}
-		for i := range rArgs {
-			switch i {
-			case 0:
-				xMin, err = strconv.ParseFloat(rArgs[i], 32)
-			case 1:
-				yMin, err = strconv.ParseFloat(rArgs[i], 32)
-			case 2:
-				xMax, err = strconv.ParseFloat(rArgs[i], 32)
-			case 3:
-				yMax, err = strconv.ParseFloat(rArgs[i], 32)
-			default:
-				continue
-			}
-			if err != nil {
-				ctx.JSON(http.StatusBadRequest, err)
-				return
-			}
-		}
-		stations, err = h.store.ListStationsWithinBBox(
-			ctx,
-			db.ListStationsWithinBBoxParams{
-				Xmin:   float32(xMin),
-				Ymin:   float32(yMin),
-				Xmax:   float32(xMax),
-				Ymax:   float32(yMax),
-				Status: util.ToPgText(req.Status),
-				Limit:  pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
-				Offset: offset,
-			})
-	} else {
-		arg := db.ListStationsParams{
-			Status: util.ToPgText(req.Status),
-			Limit:  pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
-			Offset: offset,
-		}
-		stations, err = h.store.ListStations(ctx, arg)
+	filter, err := req.params()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	sort := util.ToPgText(req.Sort)
+	if !sort.Valid && filter.Q.Valid {
+		sort = util.ToPgText("relevance")
 	}
+
+	offset := (req.Page - 1) * req.PerPage
+	stations, err := h.store.ListStations(ctx, db.ListStationsParams{
+		Status:        filter.Status,
+		Q:             filter.Q,
+		StationType:   filter.StationType,
+		StationType2:  filter.StationType2,
+		Province:      filter.Province,
+		Region:        filter.Region,
+		ProviderID:    filter.ProviderID,
+		PriorityLevel: filter.PriorityLevel,
+		InstalledFrom: filter.InstalledFrom,
+		InstalledTo:   filter.InstalledTo,
+		Cx:            filter.Cx,
+		Cy:            filter.Cy,
+		R:             filter.R,
+		Xmin:          filter.Xmin,
+		Ymin:          filter.Ymin,
+		Xmax:          filter.Xmax,
+		Ymax:          filter.Ymax,
+		Sort:          sort,
+		Limit:         pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
+		Offset:        offset,
+	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -170,30 +203,7 @@ func (h *DefaultHandler) ListStations(ctx *gin.Context) {
 		items[i] = models.NewStation(station, isSimpleResponse)
 	}
 
-	var count int64
-	if len(req.Circle) > 0 {
-		count, err = h.store.CountStationsWithinRadius(
-			ctx,
-			db.CountStationsWithinRadiusParams{
-				Cx:     float32(cX),
-				Cy:     float32(cY),
-				R:      float32(cR),
-				Status: util.ToPgText(req.Status),
-			})
-	} else if len(req.BBox) > 0 {
-		count, err = h.store.CountStationsWithinBBox(
-			ctx,
-			db.CountStationsWithinBBoxParams{
-				Xmin:   float32(xMin),
-				Ymin:   float32(yMin),
-				Xmax:   float32(xMax),
-				Ymax:   float32(yMax),
-				Status: util.ToPgText(req.Status),
-			})
-	} else {
-		count, err = h.store.CountStations(ctx, util.ToPgText(req.Status))
-	}
-
+	count, err := h.store.CountStations(ctx, filter)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -273,19 +283,36 @@ func (h *DefaultHandler) UpdateStation(ctx *gin.Context) {
 	}
 	req.ID = uri.ID
 
+	if len(req.Status) > 0 && !service.IsStationStatus(req.Status) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid station status %s", req.Status)))
+		return
+	}
+
 	arg := req.Transform()
 
-	station, err := h.store.UpdateStation(ctx, arg)
+	result, err := h.store.UpdateStationTx(ctx, db.UpdateStationTxParams{
+		UpdateStationParams: arg,
+		Reason:              "station updated",
+		CanTransition:       service.CanTransitionStationStatus,
+	})
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
 			return
 		}
+		if errors.Is(err, db.ErrInvalidStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("station cannot change status to %s", req.Status)))
+			return
+		}
+		if errors.Is(err, db.ErrInvalidEffectiveFrom) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	res := models.NewStation(station, false)
+	res := models.NewStation(result.Station, false)
 	ctx.JSON(http.StatusOK, res)
 }
 
@@ -295,7 +322,7 @@ type deleteStationReq struct {
 
 // DeleteStation
 //
-//	@Summary	Delete station
+//	@Summary	Soft delete station
 //	@Tags		stations
 //	@Accept		json
 //	@Produce	json
@@ -310,11 +337,109 @@ func (h *DefaultHandler) DeleteStation(ctx *gin.Context) {
 		return
 	}
 
-	err := h.store.DeleteStation(ctx, req.ID)
+	n, err := h.store.DeleteStation(ctx, req.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	if n == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type restoreStationReq struct {
+	ID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+// RestoreStation
+//
+//	@Summary	Restore a deleted station
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	200	{object}	models.Station
+//	@Router		/stations/{station_id}/restore [post]
+func (h *DefaultHandler) RestoreStation(ctx *gin.Context) {
+	var req restoreStationReq
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	station, err := h.store.RestoreStation(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("deleted station not found")))
+			return
+		}
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("the station mobile number is used by another station")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewStation(station, false))
+}
+
+type purgeStationUri struct {
+	ID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type purgeStationReq struct {
+	// Confirm must be the station name
+	Confirm string `json:"confirm" binding:"required"`
+} //@name PurgeStationParams
+
+// PurgeStation
+//
+//	@Summary	Permanently remove a deleted station and all its data
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int				true	"Station ID"
+//	@Param		req			body	purgeStationReq	true	"Purge station parameters"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/stations/{station_id}/purge [delete]
+func (h *DefaultHandler) PurgeStation(ctx *gin.Context) {
+	var uri purgeStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req purgeStationReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	station, err := h.store.GetDeletedStation(ctx, uri.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("deleted station not found, delete the station first")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if req.Confirm != station.Name {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("confirm does not match the station name")))
+		return
+	}
+
+	if err := h.store.PurgeStation(ctx, station.ID); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	h.logger.Warn().Int64("station_id", station.ID).Str("name", station.Name).Msg("station purged")
 
 	ctx.JSON(http.StatusNoContent, nil)
 }
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type stationClockRes struct {
+	StationID   int64  `json:"station_id"`
+	Timezone    string `json:"timezone"`
+	ClockOffset int32  `json:"clock_offset"`
+} //@name StationClock
+
+func newStationClockResponse(stationID int64, cs sensor.ClockSettings) stationClockRes {
+	return stationClockRes{
+		StationID:   stationID,
+		Timezone:    cs.Timezone,
+		ClockOffset: cs.ClockOffset,
+	}
+}
+
+type stationClockUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+// GetStationClock
+//
+//	@Summary	Get station clock settings
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	200	{object}	stationClockRes
+//	@Router		/stations/{station_id}/clock [get]
+func (h *DefaultHandler) GetStationClock(ctx *gin.Context) {
+	var uri stationClockUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	cs, _, err := h.getClockSettings(ctx, uri.StationID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationClockResponse(uri.StationID, cs))
+}
+
+type updateStationClockReq struct {
+	Timezone    string `json:"timezone" binding:"omitempty,timezone"`
+	ClockOffset int32  `json:"clock_offset"`
+} //@name UpdateStationClockParams
+
+// UpdateStationClock
+//
+//	@Summary	Update station clock settings
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		req			body	updateStationClockReq	true	"Update station clock parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	stationClockRes
+//	@Router		/stations/{station_id}/clock [put]
+func (h *DefaultHandler) UpdateStationClock(ctx *gin.Context) {
+	var uri stationClockUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateStationClockReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Timezone == "" {
+		req.Timezone = sensor.DefaultTimezone
+	}
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	clock, err := h.store.UpsertStationClock(ctx, db.UpsertStationClockParams{
+		StationID:   uri.StationID,
+		Timezone:    req.Timezone,
+		ClockOffset: req.ClockOffset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationClockResponse(clock.StationID, sensor.ClockSettings{
+		Timezone:    clock.Timezone,
+		ClockOffset: clock.ClockOffset,
+	}))
+}
+
+// DeleteStationClock
+//
+//	@Summary	Reset station clock settings to the defaults
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/stations/{station_id}/clock [delete]
+func (h *DefaultHandler) DeleteStationClock(ctx *gin.Context) {
+	var uri stationClockUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err := h.store.DeleteStationClock(ctx, uri.StationID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// getClockSettings returns the clock settings of the station and whether they were explicitly configured
+func (h *DefaultHandler) getClockSettings(ctx context.Context, stationID int64) (sensor.ClockSettings, bool, error) {
+	return service.GetClockSettings(ctx, h.store, stationID)
+}
+
+// clockOpts returns the station clock settings in the form accepted by the sensor parsers
+func (h *DefaultHandler) clockOpts(ctx context.Context, stationID int64) ([]sensor.ClockSettings, error) {
+	clockSettings, ok, err := h.getClockSettings(ctx, stationID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get station clock settings: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return []sensor.ClockSettings{clockSettings}, nil
+}
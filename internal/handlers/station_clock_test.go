@@ -0,0 +1,358 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStationClockAPI(t *testing.T) {
+	station := randomStation(t)
+
+	testCases := []struct {
+		name          string
+		stationID     int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:      "OK",
+			stationID: station.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.StationClock{StationID: station.ID, Timezone: "UTC", ClockOffset: 15}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchStationClock(t, recorder.Body, stationClockRes{
+					StationID:   station.ID,
+					Timezone:    "UTC",
+					ClockOffset: 15,
+				})
+			},
+		},
+		{
+			name:      "Default",
+			stationID: station.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchStationClock(t, recorder.Body, stationClockRes{
+					StationID: station.ID,
+					Timezone:  sensor.DefaultTimezone,
+				})
+			},
+		},
+		{
+			name:      "NotFound",
+			stationID: station.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "InternalError",
+			stationID: station.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.StationClock{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidID",
+			stationID: 0,
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStationClock", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET(":station_id/clock", handler.GetStationClock)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/clock", tc.stationID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUpdateStationClockAPI(t *testing.T) {
+	station := randomStation(t)
+
+	testCases := []struct {
+		name          string
+		stationID     int64
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:      "OK",
+			stationID: station.ID,
+			body: gin.H{
+				"timezone":     "Asia/Tokyo",
+				"clock_offset": -30,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpsertStationClockParams{
+					StationID:   station.ID,
+					Timezone:    "Asia/Tokyo",
+					ClockOffset: -30,
+				}
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().UpsertStationClock(mock.AnythingOfType("*gin.Context"), arg).
+					Return(db.StationClock{StationID: station.ID, Timezone: arg.Timezone, ClockOffset: arg.ClockOffset}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchStationClock(t, recorder.Body, stationClockRes{
+					StationID:   station.ID,
+					Timezone:    "Asia/Tokyo",
+					ClockOffset: -30,
+				})
+			},
+		},
+		{
+			name:      "DefaultTimezone",
+			stationID: station.ID,
+			body: gin.H{
+				"clock_offset": 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpsertStationClockParams{
+					StationID:   station.ID,
+					Timezone:    sensor.DefaultTimezone,
+					ClockOffset: 5,
+				}
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().UpsertStationClock(mock.AnythingOfType("*gin.Context"), arg).
+					Return(db.StationClock{StationID: station.ID, Timezone: arg.Timezone, ClockOffset: arg.ClockOffset}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidTimezone",
+			stationID: station.ID,
+			body: gin.H{
+				"timezone": "Mars/Olympus_Mons",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpsertStationClock", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "NotFound",
+			stationID: station.ID,
+			body: gin.H{
+				"clock_offset": 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "InternalError",
+			stationID: station.ID,
+			body: gin.H{
+				"clock_offset": 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().UpsertStationClock(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.StationClock{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT(":station_id/clock", handler.UpdateStationClock)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/%d/clock", tc.stationID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteStationClockAPI(t *testing.T) {
+	station := randomStation(t)
+
+	testCases := []struct {
+		name          string
+		stationID     int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:      "OK",
+			stationID: station.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).Return(station, nil)
+				store.EXPECT().DeleteStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:      "NotFound",
+			stationID: station.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "DeleteStationClock", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "InternalError",
+			stationID: station.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).Return(station, nil)
+				store.EXPECT().DeleteStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidID",
+			stationID: 0,
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "DeleteStationClock", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE(":station_id/clock", handler.DeleteStationClock)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/clock", tc.stationID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func requireBodyMatchStationClock(t *testing.T, body *bytes.Buffer, clock stationClockRes) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotClock stationClockRes
+	err = json.Unmarshal(data, &gotClock)
+	require.NoError(t, err)
+	require.Equal(t, clock, gotClock)
+}
@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/notify/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	StationCommandStatusPending      = "PENDING"
+	StationCommandStatusAcknowledged = "ACKNOWLEDGED"
+	StationCommandStatusRejected     = "REJECTED"
+
+	// timeSyncTTL is how long a TIMESYNC SMS may wait in the outbox, a later clock value would be off
+	timeSyncTTL = 10 * time.Minute
+)
+
+type stationCommandRes struct {
+	ID             int64      `json:"id"`
+	StationID      int64      `json:"station_id"`
+	Command        string     `json:"command"`
+	Interval       *int32     `json:"interval,omitempty"`
+	Message        string     `json:"message"`
+	Status         string     `json:"status"`
+	SmsOutboxID    *int64     `json:"sms_outbox_id,omitempty"`
+	SmsStatus      string     `json:"sms_status,omitempty"`
+	AckMessage     string     `json:"ack_message,omitempty"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+} //@name StationCommand
+
+func newStationCommandResponse(c db.StationCommand, smsStatus string) stationCommandRes {
+	res := stationCommandRes{
+		ID:         c.ID,
+		StationID:  c.StationID,
+		Command:    c.Command,
+		Message:    c.Message,
+		Status:     c.Status,
+		SmsStatus:  smsStatus,
+		AckMessage: c.AckMessage.String,
+		CreatedAt:  c.CreatedAt.Time,
+	}
+	if c.Interval.Valid {
+		res.Interval = &c.Interval.Int32
+	}
+	if c.SmsOutboxID.Valid {
+		res.SmsOutboxID = &c.SmsOutboxID.Int64
+	}
+	if c.AcknowledgedAt.Valid {
+		res.AcknowledgedAt = &c.AcknowledgedAt.Time
+	}
+	return res
+}
+
+type stationCommandUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type createStationCommandReq struct {
+	Command  string `json:"command" binding:"required,oneof=INTERVAL REBOOT TIMESYNC"`
+	Interval int32  `json:"interval" binding:"required_if=Command INTERVAL,omitempty,min=1,max=60"` // reporting interval in minutes
+} //@name CreateStationCommandParams
+
+// CreateStationCommand
+//
+//	@Summary	Queue a configuration command to a station logger by SMS
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		req			body	createStationCommandReq	true	"Create station command parameters"
+//	@Security	BearerAuth
+//	@Success	202	{object}	stationCommandRes
+//	@Router		/stations/{station_id}/commands [post]
+func (h *DefaultHandler) CreateStationCommand(ctx *gin.Context) {
+	if !h.config.LufftCommandsEnabled {
+		ctx.JSON(http.StatusNotImplemented, errorResponse(errors.New("station commands are disabled")))
+		return
+	}
+
+	var uri stationCommandUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req createStationCommandReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	station, err := h.store.GetStation(ctx, uri.StationID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	mobileNumber, ok := util.ParseMobileNumber(station.MobileNumber.String)
+	if !ok {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(errors.New("station has no valid mobile number")))
+		return
+	}
+
+	// the outbox cannot send to a SIM that has not subscribed to the app
+	_, err = h.store.GetLatestSimAccessToken(ctx, db.GetLatestSimAccessTokenParams{
+		MobileNumber: mobileNumber,
+		Type:         sms.GLabsAccessTokenType,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(sms.ErrNoAccessToken))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var clockOpts []sensor.ClockSettings
+	if req.Command == sensor.LufftCommandTimeSync {
+		clockSettings, ok, err := h.getClockSettings(ctx, station.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if ok {
+			clockOpts = append(clockOpts, clockSettings)
+		}
+	}
+
+	arg := db.CreateStationCommandTxParams{
+		CreateStationCommandParams: db.CreateStationCommandParams{
+			StationID: station.ID,
+			Command:   req.Command,
+			Interval:  pgtype.Int4{Int32: req.Interval, Valid: req.Command == sensor.LufftCommandInterval},
+		},
+		MobileNumber: mobileNumber,
+		FormatMessage: func(cmd db.StationCommand) (string, error) {
+			// the clock value is taken when queued, the SMS expires before the value gets stale
+			return sensor.FormatLufftCommand(station.LoggerVersion.String, sensor.LufftCommand{
+				ID:       cmd.ID,
+				Name:     cmd.Command,
+				Interval: cmd.Interval.Int32,
+				Time:     time.Now(),
+			}, clockOpts...)
+		},
+	}
+
+	if req.Command == sensor.LufftCommandTimeSync {
+		arg.ExpiresAt = pgtype.Timestamptz{Time: time.Now().Add(timeSyncTTL), Valid: true}
+	}
+
+	result, err := h.store.CreateStationCommandTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, newStationCommandResponse(result.Command, result.Sms.Status))
+}
+
+type listStationCommandsReq struct {
+	Status  string `form:"status" binding:"omitempty,oneof=PENDING ACKNOWLEDGED REJECTED"`
+	Page    int32  `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage int32  `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name ListStationCommandsParams
+
+type paginatedStationCommands = util.PaginatedList[stationCommandRes] //@name PaginatedStationCommands
+
+// ListStationCommands
+//
+//	@Summary	List the commands sent to a station logger
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		req			query	listStationCommandsReq	false	"List station commands parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedStationCommands
+//	@Router		/stations/{station_id}/commands [get]
+func (h *DefaultHandler) ListStationCommands(ctx *gin.Context) {
+	var uri stationCommandUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listStationCommandsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	cmds, err := h.store.ListStationCommands(ctx, db.ListStationCommandsParams{
+		StationID: uri.StationID,
+		Status:    util.ToPgText(req.Status),
+		Limit:     pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
+		Offset:    offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]stationCommandRes, len(cmds))
+	for i, c := range cmds {
+		items[i] = newStationCommandResponse(db.StationCommand{
+			ID:             c.ID,
+			StationID:      c.StationID,
+			Command:        c.Command,
+			Interval:       c.Interval,
+			Message:        c.Message,
+			Status:         c.Status,
+			SmsOutboxID:    c.SmsOutboxID,
+			AckMessage:     c.AckMessage,
+			AcknowledgedAt: c.AcknowledgedAt,
+			CreatedAt:      c.CreatedAt,
+			UpdatedAt:      c.UpdatedAt,
+		}, c.SmsStatus.String)
+	}
+
+	count, err := h.store.CountStationCommands(ctx, db.CountStationCommandsParams{
+		StationID: uri.StationID,
+		Status:    util.ToPgText(req.Status),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// acknowledgeStationCommand matches a logger acknowledgement to the command it answers.
+// Loggers that do not echo the command id acknowledge the oldest pending command of the same name.
+func (h *DefaultHandler) acknowledgeStationCommand(ctx context.Context, mobileNumber string, ack sensor.LufftAck) (db.StationCommand, error) {
+	station, err := h.store.GetStationByMobileNumber(ctx, util.ToPgText(mobileNumber))
+	if err != nil {
+		return db.StationCommand{}, err
+	}
+
+	var cmd db.StationCommand
+	if ack.ID > 0 {
+		cmd, err = h.store.GetStationCommand(ctx, ack.ID)
+		if err == nil && (cmd.StationID != station.ID || cmd.Command != ack.Name) {
+			err = db.ErrRecordNotFound
+		}
+	} else {
+		cmd, err = h.store.GetOutstandingStationCommand(ctx, db.GetOutstandingStationCommandParams{
+			StationID: station.ID,
+			Command:   ack.Name,
+		})
+	}
+	if err != nil {
+		return db.StationCommand{}, err
+	}
+
+	status := StationCommandStatusAcknowledged
+	if !ack.OK {
+		status = StationCommandStatusRejected
+	}
+
+	return h.store.AcknowledgeStationCommand(ctx, db.AcknowledgeStationCommandParams{
+		Status:     status,
+		AckMessage: util.ToPgText(ack.Message),
+		ID:         cmd.ID,
+	})
+}
@@ -0,0 +1,309 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/notify/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStationCommandAPI(t *testing.T) {
+	station := db.ObservationsStation{
+		ID:            util.RandomInt[int64](1, 1000),
+		MobileNumber:  util.ToPgText(gofakeit.Regex("639[0-9]{9}")),
+		LoggerVersion: util.ToPgText("2.0"),
+	}
+
+	// runs the message callback like the transaction does
+	createTx := func(store *mockdb.MockStore, cmdID int64, check func(arg db.CreateStationCommandTxParams) bool) {
+		store.EXPECT().CreateStationCommandTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(check)).
+			RunAndReturn(func(_ context.Context, arg db.CreateStationCommandTxParams) (db.CreateStationCommandTxResult, error) {
+				cmd := db.StationCommand{
+					ID:        cmdID,
+					StationID: arg.StationID,
+					Command:   arg.Command,
+					Interval:  arg.Interval,
+					Status:    StationCommandStatusPending,
+				}
+				msg, err := arg.FormatMessage(cmd)
+				if err != nil {
+					return db.CreateStationCommandTxResult{}, err
+				}
+				cmd.Message = msg
+				return db.CreateStationCommandTxResult{
+					Command: cmd,
+					Sms:     db.SmsOutbox{MobileNumber: arg.MobileNumber, Message: msg, Status: "QUEUED"},
+				}, nil
+			})
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		disabled      bool
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "Interval",
+			body: gin.H{"command": sensor.LufftCommandInterval, "interval": 10},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).Return(station, nil)
+				store.EXPECT().GetLatestSimAccessToken(mock.AnythingOfType("*gin.Context"), db.GetLatestSimAccessTokenParams{
+					MobileNumber: station.MobileNumber.String,
+					Type:         sms.GLabsAccessTokenType,
+				}).Return(db.SimAccessToken{}, nil)
+				createTx(store, 8, func(arg db.CreateStationCommandTxParams) bool {
+					return arg.StationID == station.ID &&
+						arg.MobileNumber == station.MobileNumber.String &&
+						arg.Interval == pgtype.Int4{Int32: 10, Valid: true} &&
+						!arg.ExpiresAt.Valid
+				})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "GetStationClock", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+
+				got := requireBodyStationCommand(t, recorder.Body)
+				require.Equal(t, "CMD,8,INTERVAL,10", got.Message)
+				require.Equal(t, StationCommandStatusPending, got.Status)
+				require.Equal(t, "QUEUED", got.SmsStatus)
+			},
+		},
+		{
+			name: "TimeSync",
+			body: gin.H{"command": sensor.LufftCommandTimeSync},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).Return(station, nil)
+				store.EXPECT().GetLatestSimAccessToken(mock.AnythingOfType("*gin.Context"), db.GetLatestSimAccessTokenParams{
+					MobileNumber: station.MobileNumber.String,
+					Type:         sms.GLabsAccessTokenType,
+				}).Return(db.SimAccessToken{}, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.StationClock{StationID: station.ID, Timezone: "UTC"}, nil)
+				createTx(store, 9, func(arg db.CreateStationCommandTxParams) bool {
+					return arg.Command == sensor.LufftCommandTimeSync && !arg.Interval.Valid &&
+						arg.ExpiresAt.Valid && arg.ExpiresAt.Time.After(time.Now())
+				})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+
+				got := requireBodyStationCommand(t, recorder.Body)
+				require.Regexp(t, `^CMD,9,TIMESYNC,\d{4}:\d{2}:\d{2}:\d{2}:\d{2}:\d{2}$`, got.Message)
+			},
+		},
+		{
+			name: "MissingInterval",
+			body: gin.H{"command": sensor.LufftCommandInterval},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownCommand",
+			body: gin.H{"command": "FORMAT"},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NoMobileNumber",
+			body: gin.H{"command": sensor.LufftCommandReboot},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{ID: station.ID}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationCommandTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "NoAccessToken",
+			body: gin.H{"command": sensor.LufftCommandReboot},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).Return(station, nil)
+				store.EXPECT().GetLatestSimAccessToken(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.SimAccessToken{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationCommandTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+				require.Contains(t, recorder.Body.String(), sms.ErrNoAccessToken.Error())
+			},
+		},
+		{
+			name:     "Disabled",
+			body:     gin.H{"command": sensor.LufftCommandReboot},
+			disabled: true,
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotImplemented, recorder.Code)
+			},
+		},
+		{
+			name: "StationNotFound",
+			body: gin.H{"command": sensor.LufftCommandReboot},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+			handler.config.LufftCommandsEnabled = !tc.disabled
+
+			router := gin.Default()
+			router.POST("/stations/:station_id/commands", handler.CreateStationCommand)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/commands", station.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListStationCommandsAPI(t *testing.T) {
+	stationID := util.RandomInt[int64](1, 1000)
+	n := 3
+	cmds := make([]db.ListStationCommandsRow, n)
+	for i := range cmds {
+		cmds[i] = db.ListStationCommandsRow{
+			ID:        int64(i + 1),
+			StationID: stationID,
+			Command:   sensor.LufftCommandReboot,
+			Status:    StationCommandStatusAcknowledged,
+			SmsStatus: util.ToPgText("SENT"),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "?status=ACKNOWLEDGED&page=1&per_page=3",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationCommands(mock.AnythingOfType("*gin.Context"), db.ListStationCommandsParams{
+					StationID: stationID,
+					Status:    util.ToPgText(StationCommandStatusAcknowledged),
+					Limit:     pgtype.Int4{Int32: 3, Valid: true},
+					Offset:    0,
+				}).Return(cmds, nil)
+				store.EXPECT().CountStationCommands(mock.AnythingOfType("*gin.Context"), db.CountStationCommandsParams{
+					StationID: stationID,
+					Status:    util.ToPgText(StationCommandStatusAcknowledged),
+				}).Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes paginatedStationCommands
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Items, n)
+				require.Equal(t, "SENT", gotRes.Items[0].SmsStatus)
+			},
+		},
+		{
+			name:  "InvalidStatus",
+			query: "?status=DONE",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationCommands", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/commands", handler.ListStationCommands)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/commands%s", stationID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func requireBodyStationCommand(t *testing.T, body *bytes.Buffer) stationCommandRes {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got stationCommandRes
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	return got
+}
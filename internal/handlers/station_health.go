@@ -1,7 +1,15 @@
 package handlers
 
 import (
+	"errors"
+	"net/http"
+	"time"
+
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -67,3 +75,277 @@ func newStationHealth(h db.ObservationsStationhealth) StationHealth {
 	}
 	return res
 }
+
+type stationHealthPoint struct {
+	ID         int64           `json:"id"`
+	Timestamp  time.Time       `json:"timestamp"`
+	Vb1        *float32        `json:"vb1"`
+	Vb2        *float32        `json:"vb2"`
+	Curr       *float32        `json:"curr"`
+	Bp1        *float32        `json:"bp1"`
+	Bp2        *float32        `json:"bp2"`
+	Ss         *int32          `json:"ss"`
+	TempArq    *float32        `json:"temp_arq"`
+	RhArq      *float32        `json:"rh_arq"`
+	ErrorMsg   string          `json:"error_msg,omitempty"`
+	DataCount  int32           `json:"data_count"`
+	DataStatus string          `json:"data_status"`
+	Sensors    map[string]bool `json:"sensors"` // parsed data_status, true when the sensor is reporting
+} //@name StationHealthPoint
+
+func newStationHealthPoint(h db.ObservationsStationhealth) stationHealthPoint {
+	return stationHealthPoint{
+		ID:         h.ID,
+		Timestamp:  h.Timestamp.Time,
+		Vb1:        float4Ptr(h.Vb1),
+		Vb2:        float4Ptr(h.Vb2),
+		Curr:       float4Ptr(h.Curr),
+		Bp1:        float4Ptr(h.Bp1),
+		Bp2:        float4Ptr(h.Bp2),
+		Ss:         int4Ptr(h.Ss),
+		TempArq:    float4Ptr(h.TempArq),
+		RhArq:      float4Ptr(h.RhArq),
+		ErrorMsg:   h.ErrorMsg.String,
+		DataCount:  h.DataCount.Int32,
+		DataStatus: h.DataStatus.String,
+		Sensors:    sensor.ParseDataStatus(h.DataStatus.String),
+	}
+}
+
+type healthStats struct {
+	Min *float32 `json:"min"`
+	Max *float32 `json:"max"`
+	Avg *float64 `json:"avg"`
+} //@name StationHealthStats
+
+func newHealthStats(min, max pgtype.Float4, avg pgtype.Float8) healthStats {
+	stats := healthStats{
+		Min: float4Ptr(min),
+		Max: float4Ptr(max),
+	}
+	if avg.Valid {
+		stats.Avg = &avg.Float64
+	}
+	return stats
+}
+
+type stationHealthSummary struct {
+	Count          int64           `json:"count"`
+	FirstTimestamp *time.Time      `json:"first_timestamp"`
+	LastTimestamp  *time.Time      `json:"last_timestamp"`
+	Vb1            healthStats     `json:"vb1"`
+	Vb2            healthStats     `json:"vb2"`
+	Curr           healthStats     `json:"curr"`
+	Bp1            healthStats     `json:"bp1"`
+	Bp2            healthStats     `json:"bp2"`
+	Ss             healthStats     `json:"ss"`
+	TempArq        healthStats     `json:"temp_arq"`
+	RhArq          healthStats     `json:"rh_arq"`
+	Sensors        map[string]bool `json:"sensors"` // sensors reporting in the latest record
+} //@name StationHealthSummary
+
+func newStationHealthSummary(s db.GetStationHealthSummaryRow) stationHealthSummary {
+	res := stationHealthSummary{
+		Count:   s.Count,
+		Vb1:     newHealthStats(s.Vb1Min, s.Vb1Max, s.Vb1Avg),
+		Vb2:     newHealthStats(s.Vb2Min, s.Vb2Max, s.Vb2Avg),
+		Curr:    newHealthStats(s.CurrMin, s.CurrMax, s.CurrAvg),
+		Bp1:     newHealthStats(s.Bp1Min, s.Bp1Max, s.Bp1Avg),
+		Bp2:     newHealthStats(s.Bp2Min, s.Bp2Max, s.Bp2Avg),
+		TempArq: newHealthStats(s.TempArqMin, s.TempArqMax, s.TempArqAvg),
+		RhArq:   newHealthStats(s.RhArqMin, s.RhArqMax, s.RhArqAvg),
+		Ss: newHealthStats(
+			pgtype.Float4{Float32: float32(s.SsMin.Int32), Valid: s.SsMin.Valid},
+			pgtype.Float4{Float32: float32(s.SsMax.Int32), Valid: s.SsMax.Valid},
+			s.SsAvg,
+		),
+		Sensors: sensor.ParseDataStatus(s.LastDataStatus),
+	}
+	if s.FirstTimestamp.Valid {
+		res.FirstTimestamp = &s.FirstTimestamp.Time
+	}
+	if s.LastTimestamp.Valid {
+		res.LastTimestamp = &s.LastTimestamp.Time
+	}
+	return res
+}
+
+type stationHealthUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type listStationHealthReq struct {
+	Page      int32   `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage   int32   `form:"per_page" binding:"omitempty,min=1"`       // limit
+	StartDate string  `form:"start_date" binding:"omitempty,date_time"`
+	EndDate   string  `form:"end_date" binding:"omitempty,date_time"`
+	Cutoff    float32 `form:"cutoff" binding:"omitempty,gt=0"` // battery cutoff voltage, defaults to 11.5
+} //@name ListStationHealthParams
+
+type paginatedStationHealthPoints = util.PaginatedList[stationHealthPoint] //@name PaginatedStationHealthPoints
+
+type stationHealthRes struct {
+	Summary stationHealthSummary         `json:"summary"`
+	Battery service.BatteryForecast      `json:"battery"` // forecast from the recent days, independent of the date filter
+	Series  paginatedStationHealthPoints `json:"series"`
+} //@name StationHealthResponse
+
+// ListStationHealth
+//
+//	@Summary	Station health time series and summary
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int						true	"Station ID"
+//	@Param		req			query		listStationHealthReq	false	"Station health parameters"
+//	@Success	200			{object}	stationHealthRes
+//	@Router		/stations/{station_id}/health [get]
+func (h *DefaultHandler) ListStationHealth(ctx *gin.Context) {
+	var uri stationHealthUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationHealthReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	startDate, isStartDate := util.ParseDateTime(req.StartDate)
+	endDate, isEndDate := util.ParseDateTime(req.EndDate)
+	offset := (req.Page - 1) * req.PerPage
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	startTs := pgtype.Timestamptz{Time: startDate, Valid: !startDate.IsZero()}
+	endTs := pgtype.Timestamptz{Time: endDate, Valid: !endDate.IsZero()}
+
+	healths, err := h.store.ListStationHealths(ctx, db.ListStationHealthsParams{
+		StationID:   uri.StationID,
+		IsStartDate: isStartDate,
+		StartDate:   startTs,
+		IsEndDate:   isEndDate,
+		EndDate:     endTs,
+		Limit:       pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage != 0},
+		Offset:      offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]stationHealthPoint, len(healths))
+	for i, hl := range healths {
+		items[i] = newStationHealthPoint(hl)
+	}
+
+	summary, err := h.store.GetStationHealthSummary(ctx, db.GetStationHealthSummaryParams{
+		StationID:   uri.StationID,
+		IsStartDate: isStartDate,
+		StartDate:   startTs,
+		IsEndDate:   isEndDate,
+		EndDate:     endTs,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	battery, err := h.forecastBatteries(ctx, pgtype.Int8{Int64: uri.StationID, Valid: true}, service.DefaultBatteryWindowDays, req.Cutoff)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	forecast := service.BatteryForecast{
+		StationID: uri.StationID,
+		Status:    service.BatteryStatusInsufficientData,
+		Cutoff:    batteryCutoff(req.Cutoff),
+	}
+	if len(battery) > 0 {
+		forecast = battery[0]
+	}
+
+	ctx.JSON(http.StatusOK, stationHealthRes{
+		Summary: newStationHealthSummary(summary),
+		Battery: forecast,
+		Series:  util.NewPaginatedList(req.Page, req.PerPage, int32(summary.Count), items),
+	})
+}
+
+type getStationHealthUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+	ID        int64 `uri:"id" binding:"required,min=1"`
+}
+
+// GetStationHealth
+//
+//	@Summary	Get a station health record
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int	true	"Station ID"
+//	@Param		id			path		int	true	"Station health ID"
+//	@Success	200			{object}	stationHealthPoint
+//	@Router		/stations/{station_id}/health/{id} [get]
+func (h *DefaultHandler) GetStationHealth(ctx *gin.Context) {
+	var uri getStationHealthUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	health, err := h.store.GetStationHealth(ctx, db.GetStationHealthParams{
+		StationID: uri.StationID,
+		ID:        uri.ID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station health not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationHealthPoint(health))
+}
+
+func (h *DefaultHandler) forecastBatteries(ctx *gin.Context, stationID pgtype.Int8, days int, cutoff float32) ([]service.BatteryForecast, error) {
+	now := time.Now()
+	rows, err := h.store.ListStationBatteryDaily(ctx, db.ListStationBatteryDailyParams{
+		Since:     pgtype.Timestamptz{Time: now.AddDate(0, 0, -days), Valid: true},
+		StationID: stationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.ForecastBatteries(rows, batteryCutoff(cutoff), now), nil
+}
+
+func batteryCutoff(cutoff float32) float32 {
+	if cutoff > 0 {
+		return cutoff
+	}
+	return service.DefaultBatteryCutoff
+}
+
+func float4Ptr(v pgtype.Float4) *float32 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float32
+}
+
+func int4Ptr(v pgtype.Int4) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int32
+}
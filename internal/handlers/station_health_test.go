@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStationHealthAPI(t *testing.T) {
+	stationID := gofakeit.Int64()&0xffff + 1
+	n := 3
+	healths := make([]db.ObservationsStationhealth, n)
+	for i := range healths {
+		healths[i] = randomStationHealth(stationID)
+	}
+	summary := db.GetStationHealthSummaryRow{
+		Count:          int64(n),
+		LastTimestamp:  healths[0].Timestamp,
+		Vb1Min:         pgtype.Float4{Float32: 11.8, Valid: true},
+		Vb1Max:         pgtype.Float4{Float32: 13.2, Valid: true},
+		Vb1Avg:         pgtype.Float8{Float64: 12.5, Valid: true},
+		SsMin:          pgtype.Int4{Int32: 12, Valid: true},
+		SsMax:          pgtype.Int4{Int32: 28, Valid: true},
+		SsAvg:          pgtype.Float8{Float64: 20, Valid: true},
+		LastDataStatus: "1101111111",
+	}
+	today := time.Now().Truncate(24 * time.Hour)
+	battery := make([]db.ListStationBatteryDailyRow, 5)
+	for i := range battery {
+		battery[i] = db.ListStationBatteryDailyRow{
+			StationID: stationID,
+			Day:       pgtype.Date{Time: today.AddDate(0, 0, i-len(battery)+1), Valid: true},
+			Vb1Min:    12.6 - float32(i)*0.1,
+			Vb1Max:    13.8,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: "?start_date=2024-03-01&end_date=2024-03-02&per_page=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), stationID).
+					Return(db.ObservationsStation{ID: stationID}, nil)
+				store.EXPECT().ListStationHealths(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationHealthsParams) bool {
+					return arg.StationID == stationID && arg.IsStartDate && arg.IsEndDate && arg.Limit.Int32 == 10
+				})).Return(healths, nil)
+				store.EXPECT().GetStationHealthSummary(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.GetStationHealthSummaryParams) bool {
+					return arg.StationID == stationID && arg.IsStartDate && arg.IsEndDate
+				})).Return(summary, nil)
+				store.EXPECT().ListStationBatteryDaily(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationBatteryDailyParams) bool {
+					return arg.StationID.Int64 == stationID
+				})).Return(battery, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got stationHealthRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got.Series.Items, n)
+				require.Equal(t, int32(n), got.Series.Count)
+				require.Equal(t, int64(n), got.Summary.Count)
+				require.InDelta(t, 12.5, *got.Summary.Vb1.Avg, 0.001)
+				require.InDelta(t, 28, *got.Summary.Ss.Max, 0.001)
+				require.Nil(t, got.Summary.Vb2.Avg)
+				require.True(t, got.Summary.Sensors["temp"])
+				require.False(t, got.Summary.Sensors["pres"])
+				require.Equal(t, healths[0].Vb1.Float32, *got.Series.Items[0].Vb1)
+				require.Len(t, got.Series.Items[0].Sensors, 10)
+				require.Equal(t, service.BatteryStatusDeclining, got.Battery.Status)
+				require.InDelta(t, -0.1, *got.Battery.TrendPerDay, 0.001)
+			},
+		},
+		{
+			name:  "StationNotFound",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), stationID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ListStationHealths", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "?start_date=yesterday",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/health", handler.ListStationHealth)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/health%s", stationID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestGetStationHealthAPI(t *testing.T) {
+	health := randomStationHealth(gofakeit.Int64()&0xffff + 1)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationHealth(mock.AnythingOfType("*gin.Context"), db.GetStationHealthParams{
+					StationID: health.StationID,
+					ID:        health.ID,
+				}).Return(health, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got stationHealthPoint
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, health.ID, got.ID)
+				require.Equal(t, health.DataStatus.String, got.DataStatus)
+				require.Nil(t, got.Vb2)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationhealth{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/health/:id", handler.GetStationHealth)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/health/%d", health.StationID, health.ID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomStationHealth(stationID int64) db.ObservationsStationhealth {
+	return db.ObservationsStationhealth{
+		ID:         gofakeit.Int64()&0xffff + 1,
+		StationID:  stationID,
+		Vb1:        pgtype.Float4{Float32: gofakeit.Float32Range(11, 14), Valid: true},
+		Bp1:        pgtype.Float4{Float32: gofakeit.Float32Range(0, 20), Valid: true},
+		Ss:         pgtype.Int4{Int32: int32(gofakeit.IntRange(0, 31)), Valid: true},
+		TempArq:    pgtype.Float4{Float32: gofakeit.Float32Range(20, 40), Valid: true},
+		RhArq:      pgtype.Float4{Float32: gofakeit.Float32Range(40, 90), Valid: true},
+		DataCount:  pgtype.Int4{Int32: 9, Valid: true},
+		DataStatus: util.ToPgText("1101111111"),
+		Timestamp:  pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Minute), Valid: true},
+	}
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+// maxStationImportSize limits the size of the import file
+const maxStationImportSize = 10 << 20
+
+type importStationsReq struct {
+	Format string `form:"format,default=csv" binding:"omitempty,oneof=csv geojson"`
+	DryRun bool   `form:"dry_run"` // only validate the file
+} //@name ImportStationsParams
+
+type importStationsRes struct {
+	DryRun  bool                       `json:"dry_run"`
+	Total   int                        `json:"total"`
+	Invalid int                        `json:"invalid"` // rows with errors
+	Created int                        `json:"created"`
+	Rows    []service.StationImportRow `json:"rows"`
+} //@name ImportStationsResult
+
+// ImportStations
+//
+//	@Summary		Create stations from a CSV or GeoJSON file
+//	@Description	The stations are only created when every row is valid, all at once. Use dry_run to check the file first.
+//	@Description	CSV files need a header row with the station field names, GeoJSON files are a FeatureCollection of points with the same names as properties.
+//	@Tags			stations
+//	@Accept			text/csv,application/geo+json
+//	@Produce		json
+//	@Param			req	query	importStationsReq	false	"Import stations parameters"
+//	@Param			file	body	string	true	"CSV or GeoJSON file"
+//	@Security		BearerAuth
+//	@Success		201	{object}	importStationsRes
+//	@Success		200	{object}	importStationsRes	"dry run"
+//	@Failure		422	{object}	importStationsRes	"invalid rows"
+//	@Router			/stations/import [post]
+func (h *DefaultHandler) ImportStations(ctx *gin.Context) {
+	var req importStationsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	body := http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxStationImportSize)
+	var rows []service.StationImportRow
+	var err error
+	if req.Format == "geojson" {
+		rows, err = service.ParseStationsGeoJSON(body)
+	} else {
+		rows, err = service.ParseStationsCSV(body)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if len(rows) == 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("no stations to import")))
+		return
+	}
+
+	var existing []db.ObservationsStation
+	if mobileNumbers := service.StationImportMobileNumbers(rows); len(mobileNumbers) > 0 {
+		existing, err = h.store.ListStationsByMobileNumbers(ctx, mobileNumbers)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	res := importStationsRes{
+		DryRun:  req.DryRun,
+		Total:   len(rows),
+		Invalid: service.ValidateStationImport(rows, existing),
+		Rows:    rows,
+	}
+	if req.DryRun {
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+	if res.Invalid > 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, res)
+		return
+	}
+
+	arg := db.ImportStationsTxParams{
+		Stations: make([]db.CreateStationParams, len(rows)),
+	}
+	for i, r := range rows {
+		arg.Stations[i] = r.Station
+	}
+	result, err := h.store.ImportStationsTx(ctx, arg)
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			// a station took one of the mobile numbers after the validation
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	for i, stn := range result.Stations {
+		rows[i].ID = stn.ID
+	}
+	res.Created = len(result.Stations)
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+type exportStationsReq struct {
+	Format string `form:"format,default=csv" binding:"omitempty,oneof=csv geojson"`
+	Status string `form:"status" binding:"omitempty"`
+} //@name ExportStationsParams
+
+// ExportStations
+//
+//	@Summary		Export the stations as CSV or GeoJSON
+//	@Description	The export uses the import format so that it can be edited and imported into another instance.
+//	@Tags			stations
+//	@Produce		text/csv,application/geo+json
+//	@Param			req	query	exportStationsReq	false	"Export stations parameters"
+//	@Security		BearerAuth
+//	@Success		200	{object}	service.StationFeatureCollection
+//	@Router			/stations/export [get]
+func (h *DefaultHandler) ExportStations(ctx *gin.Context) {
+	var req exportStationsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	stations, err := h.store.ListStations(ctx, db.ListStationsParams{
+		Status: util.ToPgText(req.Status),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if req.Format == "geojson" {
+		data, err := json.Marshal(service.NewStationFeatureCollection(stations))
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		ctx.Header("Content-Disposition", `attachment; filename="stations.geojson"`)
+		ctx.Data(http.StatusOK, "application/geo+json", data)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := service.WriteStationsCSV(&buf, stations); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	ctx.Header("Content-Disposition", `attachment; filename="stations.csv"`)
+	ctx.Data(http.StatusOK, "text/csv", buf.Bytes())
+}
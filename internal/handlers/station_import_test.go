@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportStationsAPI(t *testing.T) {
+	validCSV := "name,lat,lon,mobile_number,province,region\n" +
+		"Los Banos,14.17,121.24,09171234567,Laguna,IV-A\n" +
+		"Calamba,14.21,121.16,,Laguna,Calabarzon\n"
+	invalidCSV := "name,lat,lon,mobile_number,province\n" +
+		"Los Banos,14.17,121.24,09171234567,Laguna\n" +
+		"Calamba,14.21,,,Laguna City\n"
+
+	testCases := []struct {
+		name          string
+		query         string
+		body          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: validCSV,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationsByMobileNumbers(mock.AnythingOfType("*gin.Context"), []string{"639171234567"}).
+					Return([]db.ObservationsStation{}, nil)
+				store.EXPECT().ImportStationsTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ImportStationsTxParams) bool {
+					return len(arg.Stations) == 2 && arg.Stations[0].Region.String == "Calabarzon" && !arg.Stations[1].MobileNumber.Valid
+				})).Return(db.ImportStationsTxResult{Stations: []db.ObservationsStation{{ID: 11}, {ID: 12}}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var got importStationsRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, 2, got.Total)
+				require.Equal(t, 2, got.Created)
+				require.Zero(t, got.Invalid)
+				require.Equal(t, int64(11), got.Rows[0].ID)
+				require.Equal(t, int64(12), got.Rows[1].ID)
+			},
+		},
+		{
+			name:  "GeoJSON",
+			query: "?format=geojson",
+			body: `{"type":"FeatureCollection","features":[` +
+				`{"type":"Feature","geometry":{"type":"Point","coordinates":[121.24,14.17]},"properties":{"name":"Los Banos"}}]}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ImportStationsTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ImportStationsTxParams) bool {
+					return len(arg.Stations) == 1 && arg.Stations[0].Lat.Valid && arg.Stations[0].Lon.Valid
+				})).Return(db.ImportStationsTxResult{Stations: []db.ObservationsStation{{ID: 11}}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ListStationsByMobileNumbers", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name:  "DryRun",
+			query: "?dry_run=true",
+			body:  invalidCSV,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationsByMobileNumbers(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsStation{{ID: 3, MobileNumber: util.ToPgText("639171234567")}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ImportStationsTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got importStationsRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.True(t, got.DryRun)
+				require.Equal(t, 2, got.Invalid)
+				require.Zero(t, got.Created)
+				require.Len(t, got.Rows, 2)
+				require.Equal(t, 3, got.Rows[1].Row)
+				require.Equal(t, []string{"lat and lon must be set together", "unknown province: Laguna City"}, got.Rows[1].Errors)
+			},
+		},
+		{
+			name: "DuplicateMobileNumber",
+			body: validCSV,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationsByMobileNumbers(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsStation{{ID: 3, MobileNumber: util.ToPgText("639171234567")}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ImportStationsTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+
+				var got importStationsRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, 1, got.Invalid)
+				require.Equal(t, []string{"mobile_number 639171234567 is used by station 3"}, got.Rows[0].Errors)
+			},
+		},
+		{
+			name: "InvalidRows",
+			body: invalidCSV,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationsByMobileNumbers(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsStation{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ImportStationsTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+
+				var got importStationsRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, 1, got.Invalid)
+				require.Empty(t, got.Rows[0].Errors)
+			},
+		},
+		{
+			name: "Conflict",
+			body: validCSV,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationsByMobileNumbers(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsStation{}, nil)
+				store.EXPECT().ImportStationsTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ImportStationsTxResult{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "MissingHeader",
+			body: "",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ImportStationsTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NoRows",
+			body: "name,lat,lon\n",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ImportStationsTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidFormat",
+			query: "?format=kml",
+			body:  validCSV,
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ImportStationsTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/stations/import", handler.ImportStations)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/stations/import"+tc.query, strings.NewReader(tc.body))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestExportStationsAPI(t *testing.T) {
+	stations := []db.ObservationsStation{randomStation(t), randomStation(t)}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "CSV",
+			query: "?status=ONLINE",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationsParams) bool {
+					return arg.Status.String == "ONLINE" && !arg.Limit.Valid
+				})).Return(stations, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+
+				rows, err := service.ParseStationsCSV(recorder.Body)
+				require.NoError(t, err)
+				require.Len(t, rows, len(stations))
+				require.Equal(t, stations[0].Name, rows[0].Name)
+			},
+		},
+		{
+			name:  "GeoJSON",
+			query: "?format=geojson",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(stations, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/geo+json", recorder.Header().Get("Content-Type"))
+
+				var got service.StationFeatureCollection
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, "FeatureCollection", got.Type)
+				require.Len(t, got.Features, len(stations))
+				require.Equal(t, stations[1].Name, got.Features[1].Properties["name"])
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/export", handler.ExportStations)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/stations/export"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
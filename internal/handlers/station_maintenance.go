@@ -0,0 +1,470 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type stationMaintenanceRes struct {
+	ID                int64      `json:"id"`
+	StationID         int64      `json:"station_id"`
+	TechnicianID      *int64     `json:"technician_id,omitempty"`
+	VisitDate         util.Date  `json:"visit_date"`
+	Actions           string     `json:"actions"`
+	PartsReplaced     []string   `json:"parts_replaced"`
+	SensorsCalibrated []string   `json:"sensors_calibrated"`
+	Photos            []string   `json:"photos"`
+	WindowStart       *time.Time `json:"window_start,omitempty"`
+	WindowEnd         *time.Time `json:"window_end,omitempty"`
+	WindowStatus      string     `json:"window_status,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+} //@name StationMaintenance
+
+func newStationMaintenanceResponse(m db.StationMaintenance) stationMaintenanceRes {
+	res := stationMaintenanceRes{
+		ID:                m.ID,
+		StationID:         m.StationID,
+		VisitDate:         util.Date{Time: m.VisitDate.Time},
+		Actions:           m.Actions,
+		PartsReplaced:     m.PartsReplaced,
+		SensorsCalibrated: m.SensorsCalibrated,
+		Photos:            m.Photos,
+		WindowStatus:      m.WindowStatus.String,
+		CreatedAt:         m.CreatedAt.Time,
+		UpdatedAt:         m.UpdatedAt.Time,
+	}
+	if m.TechnicianID.Valid {
+		res.TechnicianID = &m.TechnicianID.Int64
+	}
+	if m.WindowStart.Valid {
+		res.WindowStart = &m.WindowStart.Time
+	}
+	if m.WindowEnd.Valid {
+		res.WindowEnd = &m.WindowEnd.Time
+	}
+	return res
+}
+
+type stationMaintenanceUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type listStationMaintenanceReq struct {
+	StartDate string `form:"start_date" binding:"omitempty,datetime=2006-01-02"` // earliest visit date
+	EndDate   string `form:"end_date" binding:"omitempty,datetime=2006-01-02"`   // latest visit date
+	Page      int32  `form:"page,default=1" binding:"omitempty,min=1"`           // page number
+	PerPage   int32  `form:"per_page" binding:"omitempty,min=1"`                 // limit
+} //@name ListStationMaintenanceParams
+
+type paginatedStationMaintenance = util.PaginatedList[stationMaintenanceRes] //@name PaginatedStationMaintenance
+
+// ListStationMaintenance
+//
+//	@Summary	List the maintenance visits of a station
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int							true	"Station ID"
+//	@Param		req			query	listStationMaintenanceReq	false	"List station maintenance parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedStationMaintenance
+//	@Router		/stations/{station_id}/maintenance [get]
+func (h *DefaultHandler) ListStationMaintenance(ctx *gin.Context) {
+	var uri stationMaintenanceUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationMaintenanceReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	startDate := util.ToPgDate(req.StartDate)
+	endDate := util.ToPgDate(req.EndDate)
+	offset := (req.Page - 1) * req.PerPage
+	visits, err := h.store.ListStationMaintenance(ctx, db.ListStationMaintenanceParams{
+		StationID:   uri.StationID,
+		IsStartDate: startDate.Valid,
+		StartDate:   startDate,
+		IsEndDate:   endDate.Valid,
+		EndDate:     endDate,
+		Limit:       pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
+		Offset:      offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	count, err := h.store.CountStationMaintenance(ctx, db.CountStationMaintenanceParams{
+		StationID:   uri.StationID,
+		IsStartDate: startDate.Valid,
+		StartDate:   startDate,
+		IsEndDate:   endDate.Valid,
+		EndDate:     endDate,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]stationMaintenanceRes, len(visits))
+	for i, m := range visits {
+		items[i] = newStationMaintenanceResponse(m)
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type stationMaintenanceItemUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+	ID        int64 `uri:"id" binding:"required,min=1"`
+}
+
+// GetStationMaintenance
+//
+//	@Summary	Get a station maintenance visit
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Param		id			path	int	true	"Station maintenance ID"
+//	@Security	BearerAuth
+//	@Success	200	{object}	stationMaintenanceRes
+//	@Router		/stations/{station_id}/maintenance/{id} [get]
+func (h *DefaultHandler) GetStationMaintenance(ctx *gin.Context) {
+	var uri stationMaintenanceItemUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	m, err := h.store.GetStationMaintenance(ctx, db.GetStationMaintenanceParams{
+		StationID: uri.StationID,
+		ID:        uri.ID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station maintenance not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationMaintenanceResponse(m))
+}
+
+type createStationMaintenanceReq struct {
+	TechnicianID      *int64     `json:"technician_id" binding:"omitempty,min=1"` // user ID of the technician
+	VisitDate         string     `json:"visit_date" binding:"required,datetime=2006-01-02"`
+	Actions           string     `json:"actions"`
+	PartsReplaced     []string   `json:"parts_replaced" binding:"omitempty,dive,required"`
+	SensorsCalibrated []string   `json:"sensors_calibrated" binding:"omitempty,dive,required"`
+	Photos            []string   `json:"photos" binding:"omitempty,dive,url"` // photo URLs
+	WindowStart       *time.Time `json:"window_start"`                        // start of the planned maintenance window
+	WindowEnd         *time.Time `json:"window_end"`                          // end of the planned maintenance window
+} //@name CreateStationMaintenanceParams
+
+// CreateStationMaintenance
+//
+//	@Summary	Log a station maintenance visit
+//	@Description	A visit with a window puts the station into MAINTENANCE while the window is open.
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int							true	"Station ID"
+//	@Param		req			body	createStationMaintenanceReq	true	"Create station maintenance parameters"
+//	@Security	BearerAuth
+//	@Success	201	{object}	stationMaintenanceRes
+//	@Router		/stations/{station_id}/maintenance [post]
+func (h *DefaultHandler) CreateStationMaintenance(ctx *gin.Context) {
+	var uri stationMaintenanceUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req createStationMaintenanceReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if err := validateMaintenanceWindow(req.WindowStart, req.WindowEnd); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	arg := db.CreateStationMaintenanceParams{
+		StationID:         uri.StationID,
+		VisitDate:         util.ToPgDate(req.VisitDate),
+		Actions:           req.Actions,
+		PartsReplaced:     nonNilStrings(req.PartsReplaced),
+		SensorsCalibrated: nonNilStrings(req.SensorsCalibrated),
+		Photos:            nonNilStrings(req.Photos),
+	}
+	if req.TechnicianID != nil {
+		arg.TechnicianID = pgtype.Int8{Int64: *req.TechnicianID, Valid: true}
+	}
+	if req.WindowStart != nil {
+		arg.WindowStart = pgtype.Timestamptz{Time: *req.WindowStart, Valid: true}
+		arg.WindowEnd = pgtype.Timestamptz{Time: *req.WindowEnd, Valid: true}
+		arg.WindowStatus = util.ToPgText(service.MaintenanceWindowPlanned)
+	}
+
+	m, err := h.store.CreateStationMaintenance(ctx, arg)
+	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("technician not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newStationMaintenanceResponse(m))
+}
+
+type updateStationMaintenanceReq struct {
+	TechnicianID      *int64     `json:"technician_id" binding:"omitempty,min=1"` // user ID of the technician
+	VisitDate         string     `json:"visit_date" binding:"omitempty,datetime=2006-01-02"`
+	Actions           *string    `json:"actions"`
+	PartsReplaced     []string   `json:"parts_replaced" binding:"omitempty,dive,required"`
+	SensorsCalibrated []string   `json:"sensors_calibrated" binding:"omitempty,dive,required"`
+	Photos            []string   `json:"photos" binding:"omitempty,dive,url"` // photo URLs
+	WindowStart       *time.Time `json:"window_start"`                        // start of the planned maintenance window
+	WindowEnd         *time.Time `json:"window_end"`                          // end of the planned maintenance window, set to now to end an open window early
+} //@name UpdateStationMaintenanceParams
+
+// UpdateStationMaintenance
+//
+//	@Summary	Update a station maintenance visit
+//	@Description	Only the end of an open window can be changed, closed windows can no longer be changed.
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int							true	"Station ID"
+//	@Param		id			path	int							true	"Station maintenance ID"
+//	@Param		req			body	updateStationMaintenanceReq	true	"Update station maintenance parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	stationMaintenanceRes
+//	@Router		/stations/{station_id}/maintenance/{id} [put]
+func (h *DefaultHandler) UpdateStationMaintenance(ctx *gin.Context) {
+	var uri stationMaintenanceItemUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req updateStationMaintenanceReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	m, err := h.store.GetStationMaintenance(ctx, db.GetStationMaintenanceParams{
+		StationID: uri.StationID,
+		ID:        uri.ID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station maintenance not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	arg := db.UpdateStationMaintenanceParams{
+		StationID:         uri.StationID,
+		ID:                uri.ID,
+		VisitDate:         util.ToPgDate(req.VisitDate),
+		PartsReplaced:     req.PartsReplaced,
+		SensorsCalibrated: req.SensorsCalibrated,
+		Photos:            req.Photos,
+	}
+	if req.TechnicianID != nil {
+		arg.TechnicianID = pgtype.Int8{Int64: *req.TechnicianID, Valid: true}
+	}
+	if req.Actions != nil {
+		arg.Actions = pgtype.Text{String: *req.Actions, Valid: true}
+	}
+
+	if req.WindowStart != nil || req.WindowEnd != nil {
+		switch m.WindowStatus.String {
+		case service.MaintenanceWindowClosed:
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("maintenance window is already closed")))
+			return
+		case service.MaintenanceWindowOpen:
+			if req.WindowStart != nil {
+				ctx.JSON(http.StatusConflict, errorResponse(errors.New("maintenance window is already open")))
+				return
+			}
+		}
+
+		start, end := req.WindowStart, req.WindowEnd
+		if start == nil && m.WindowStart.Valid {
+			start = &m.WindowStart.Time
+		}
+		if end == nil && m.WindowEnd.Valid {
+			end = &m.WindowEnd.Time
+		}
+		if err := validateMaintenanceWindow(start, end); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		arg.WindowStart = pgtype.Timestamptz{Time: *start, Valid: true}
+		arg.WindowEnd = pgtype.Timestamptz{Time: *end, Valid: true}
+		if !m.WindowStatus.Valid {
+			arg.WindowStatus = util.ToPgText(service.MaintenanceWindowPlanned)
+		}
+	}
+
+	m, err = h.store.UpdateStationMaintenance(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station maintenance not found")))
+			return
+		}
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("technician not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationMaintenanceResponse(m))
+}
+
+// DeleteStationMaintenance
+//
+//	@Summary	Delete a station maintenance visit
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Param		id			path	int	true	"Station maintenance ID"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/stations/{station_id}/maintenance/{id} [delete]
+func (h *DefaultHandler) DeleteStationMaintenance(ctx *gin.Context) {
+	var uri stationMaintenanceItemUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	m, err := h.store.GetStationMaintenance(ctx, db.GetStationMaintenanceParams{
+		StationID: uri.StationID,
+		ID:        uri.ID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station maintenance not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if m.WindowStatus.String == service.MaintenanceWindowOpen {
+		// the station would be left in MAINTENANCE
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("end the open maintenance window before deleting it")))
+		return
+	}
+
+	err = h.store.DeleteStationMaintenance(ctx, db.DeleteStationMaintenanceParams{
+		StationID: uri.StationID,
+		ID:        uri.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// setObservationsMaintenance flags the observations taken during a station maintenance window
+func (h *DefaultHandler) setObservationsMaintenance(ctx *gin.Context, items []models.StationObservation) error {
+	stationIDs, start, end := observationsRange(items)
+	if len(stationIDs) == 0 {
+		return nil
+	}
+
+	windows, err := h.store.ListStationMaintenanceWindowsInRange(ctx, db.ListStationMaintenanceWindowsInRangeParams{
+		StationIds: stationIDs,
+		StartDate:  pgtype.Timestamptz{Time: start, Valid: true},
+		EndDate:    pgtype.Timestamptz{Time: end, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if len(windows) == 0 {
+		return nil
+	}
+	byStation := make(map[int64][]db.StationMaintenance)
+	for _, w := range windows {
+		byStation[w.StationID] = append(byStation[w.StationID], w)
+	}
+
+	for i := range items {
+		if items[i].Timestamp.IsZero() {
+			continue
+		}
+		items[i].Maintenance = service.InMaintenanceWindow(byStation[items[i].StationID], items[i].Timestamp)
+	}
+
+	return nil
+}
+
+func validateMaintenanceWindow(start, end *time.Time) error {
+	if start == nil && end == nil {
+		return nil
+	}
+	if start == nil || end == nil {
+		return errors.New("window_start and window_end must be set together")
+	}
+	if !end.After(*start) {
+		return errors.New("window_end must be after window_start")
+	}
+	return nil
+}
+
+// nonNilStrings keeps the NOT NULL array columns empty instead of null
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
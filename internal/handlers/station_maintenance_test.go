@@ -0,0 +1,492 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStationMaintenanceAPI(t *testing.T) {
+	station := randomStation(t)
+	visits := []db.StationMaintenance{
+		randomStationMaintenance(t, station.ID, ""),
+		randomStationMaintenance(t, station.ID, service.MaintenanceWindowClosed),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: "?start_date=2024-01-01&per_page=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().ListStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationMaintenanceParams) bool {
+					return arg.StationID == station.ID && arg.IsStartDate && !arg.IsEndDate && arg.Limit.Int32 == 10
+				})).Return(visits, nil)
+				store.EXPECT().CountStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(len(visits)), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got paginatedStationMaintenance
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, 2)
+				require.Equal(t, visits[0].Actions, got.Items[0].Actions)
+				require.Nil(t, got.Items[0].WindowStart)
+				require.Equal(t, service.MaintenanceWindowClosed, got.Items[1].WindowStatus)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "?start_date=20240101",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "StationNotFound",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ListStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/maintenance", handler.ListStationMaintenance)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/maintenance%s", station.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestCreateStationMaintenanceAPI(t *testing.T) {
+	station := randomStation(t)
+	visit := randomStationMaintenance(t, station.ID, service.MaintenanceWindowPlanned)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"technician_id":      visit.TechnicianID.Int64,
+				"visit_date":         "2024-03-01",
+				"actions":            visit.Actions,
+				"parts_replaced":     visit.PartsReplaced,
+				"sensors_calibrated": visit.SensorsCalibrated,
+				"window_start":       visit.WindowStart.Time,
+				"window_end":         visit.WindowEnd.Time,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().CreateStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationMaintenanceParams) bool {
+					return arg.StationID == station.ID && arg.TechnicianID == visit.TechnicianID &&
+						arg.VisitDate.Time.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) &&
+						arg.Photos != nil && arg.WindowStart.Time.Equal(visit.WindowStart.Time) &&
+						arg.WindowStatus.String == service.MaintenanceWindowPlanned
+				})).Return(visit, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var got stationMaintenanceRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, visit.ID, got.ID)
+				require.Equal(t, service.MaintenanceWindowPlanned, got.WindowStatus)
+			},
+		},
+		{
+			name: "NoWindow",
+			body: gin.H{"visit_date": "2024-03-01", "actions": visit.Actions},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().CreateStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationMaintenanceParams) bool {
+					return !arg.TechnicianID.Valid && !arg.WindowStart.Valid && !arg.WindowStatus.Valid
+				})).Return(randomStationMaintenance(t, station.ID, ""), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "MissingWindowEnd",
+			body: gin.H{"visit_date": "2024-03-01", "window_start": visit.WindowStart.Time},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "WindowEndBeforeStart",
+			body: gin.H{
+				"visit_date":   "2024-03-01",
+				"window_start": visit.WindowEnd.Time,
+				"window_end":   visit.WindowStart.Time,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MissingVisitDate",
+			body: gin.H{"actions": visit.Actions},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TechnicianNotFound",
+			body: gin.H{"visit_date": "2024-03-01", "technician_id": 9999},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().CreateStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.StationMaintenance{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StationNotFound",
+			body: gin.H{"visit_date": "2024-03-01"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/stations/:station_id/maintenance", handler.CreateStationMaintenance)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/maintenance", station.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUpdateStationMaintenanceAPI(t *testing.T) {
+	stationID := gofakeit.Int64()&0xffff + 1
+	visit := randomStationMaintenance(t, stationID, "")
+	open := randomStationMaintenance(t, stationID, service.MaintenanceWindowOpen)
+	closed := randomStationMaintenance(t, stationID, service.MaintenanceWindowClosed)
+	windowStart := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		visit         db.StationMaintenance
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "PlanWindow",
+			visit: visit,
+			body: gin.H{
+				"actions":      "replaced the battery",
+				"window_start": windowStart,
+				"window_end":   windowStart.Add(4 * time.Hour),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), db.GetStationMaintenanceParams{StationID: stationID, ID: visit.ID}).
+					Return(visit, nil)
+				store.EXPECT().UpdateStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateStationMaintenanceParams) bool {
+					return arg.ID == visit.ID && arg.Actions.String == "replaced the battery" && !arg.VisitDate.Valid &&
+						arg.PartsReplaced == nil && arg.WindowStart.Time.Equal(windowStart) &&
+						arg.WindowStatus.String == service.MaintenanceWindowPlanned
+				})).Return(visit, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "EndOpenWindow",
+			visit: open,
+			body:  gin.H{"window_end": open.WindowStart.Time.Add(time.Minute)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(open, nil)
+				store.EXPECT().UpdateStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateStationMaintenanceParams) bool {
+					return arg.WindowStart.Time.Equal(open.WindowStart.Time) &&
+						arg.WindowEnd.Time.Equal(open.WindowStart.Time.Add(time.Minute)) && !arg.WindowStatus.Valid
+				})).Return(open, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "MoveOpenWindow",
+			visit: open,
+			body:  gin.H{"window_start": windowStart},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(open, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:  "ClosedWindow",
+			visit: closed,
+			body:  gin.H{"window_end": time.Now()},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(closed, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:  "PartialWindow",
+			visit: visit,
+			body:  gin.H{"window_end": windowStart},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(visit, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "NotFound",
+			visit: visit,
+			body:  gin.H{"actions": "replaced the battery"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.StationMaintenance{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/stations/:station_id/maintenance/:id", handler.UpdateStationMaintenance)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/maintenance/%d", stationID, tc.visit.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteStationMaintenanceAPI(t *testing.T) {
+	stationID := gofakeit.Int64()&0xffff + 1
+	visit := randomStationMaintenance(t, stationID, service.MaintenanceWindowClosed)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.GetStationMaintenanceParams{StationID: stationID, ID: visit.ID}
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), arg).
+					Return(visit, nil)
+				store.EXPECT().DeleteStationMaintenance(mock.AnythingOfType("*gin.Context"), db.DeleteStationMaintenanceParams(arg)).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "OpenWindow",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(randomStationMaintenance(t, stationID, service.MaintenanceWindowOpen), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "DeleteStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMaintenance(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.StationMaintenance{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "DeleteStationMaintenance", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE("/stations/:station_id/maintenance/:id", handler.DeleteStationMaintenance)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/maintenance/%d", stationID, visit.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+// randomStationMaintenance creates a maintenance visit, an empty windowStatus has no maintenance window
+func randomStationMaintenance(t *testing.T, stationID int64, windowStatus string) db.StationMaintenance {
+	visitDate := gofakeit.DateRange(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+	res := db.StationMaintenance{
+		ID:                gofakeit.Int64()&0xffff + 1,
+		StationID:         stationID,
+		TechnicianID:      pgtype.Int8{Int64: gofakeit.Int64()&0xffff + 1, Valid: true},
+		VisitDate:         pgtype.Date{Time: visitDate.Truncate(24 * time.Hour), Valid: true},
+		Actions:           gofakeit.Sentence(5),
+		PartsReplaced:     []string{"battery"},
+		SensorsCalibrated: []string{"temp", "rh"},
+		Photos:            []string{gofakeit.URL()},
+		CreatedAt:         pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		UpdatedAt:         pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	if windowStatus != "" {
+		res.WindowStart = pgtype.Timestamptz{Time: visitDate.Add(time.Hour).Truncate(time.Second), Valid: true}
+		res.WindowEnd = pgtype.Timestamptz{Time: res.WindowStart.Time.Add(4 * time.Hour), Valid: true}
+		res.WindowStatus = pgtype.Text{String: windowStatus, Valid: true}
+	}
+	return res
+}
@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type stationMetadataUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type listStationMetadataHistoryReq struct {
+	Page    int32 `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage int32 `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name ListStationMetadataHistoryParams
+
+type paginatedStationMetadata = util.PaginatedList[models.StationMetadata] //@name PaginatedStationMetadata
+
+// ListStationMetadataHistory
+//
+//	@Summary	List station metadata versions
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int								true	"Station ID"
+//	@Param		req			query		listStationMetadataHistoryReq	false	"List station metadata history parameters"
+//	@Success	200			{object}	paginatedStationMetadata
+//	@Router		/stations/{station_id}/metadata [get]
+func (h *DefaultHandler) ListStationMetadataHistory(ctx *gin.Context) {
+	var uri stationMetadataUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationMetadataHistoryReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	stn, err := h.store.GetStation(ctx, uri.StationID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	history, err := h.store.ListStationMetadataHistory(ctx, db.ListStationMetadataHistoryParams{
+		StationID: uri.StationID,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: req.PerPage > 0,
+		},
+		Offset: offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	count, err := h.store.CountStationMetadataHistory(ctx, uri.StationID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]models.StationMetadata, len(history))
+	for i, m := range history {
+		items[i] = models.NewStationMetadata(m)
+	}
+	if count == 0 && req.Page == 1 {
+		// the station has not changed since it was created
+		items = append(items, models.NewStationMetadataFromStation(stn))
+		count = 1
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type updateStationMetadataRes struct {
+	Changed  bool                   `json:"changed"`
+	Metadata models.StationMetadata `json:"metadata"`
+} //@name UpdateStationMetadataResult
+
+// UpdateStationMetadata
+//
+//	@Summary	Start a new station metadata version
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int								true	"Station ID"
+//	@Param		req			body	models.UpdateStationMetadataReq	true	"Update station metadata parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	updateStationMetadataRes
+//	@Router		/stations/{station_id}/metadata [post]
+func (h *DefaultHandler) UpdateStationMetadata(ctx *gin.Context) {
+	var uri stationMetadataUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req models.UpdateStationMetadataReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	req.StationID = uri.StationID
+
+	arg := req.Transform()
+	if arg.EffectiveFrom.Time.After(time.Now()) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("effective_from cannot be in the future")))
+		return
+	}
+
+	result, ok := h.setStationMetadata(ctx, arg)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updateStationMetadataRes{
+		Changed:  result.Changed,
+		Metadata: models.NewStationMetadata(result.Metadata),
+	})
+}
+
+// setStationMetadata closes the current station metadata version and starts a new one
+func (h *DefaultHandler) setStationMetadata(ctx *gin.Context, arg db.UpdateStationMetadataTxParams) (db.UpdateStationMetadataTxResult, bool) {
+	result, err := h.store.UpdateStationMetadataTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return result, false
+		}
+		if errors.Is(err, db.ErrInvalidEffectiveFrom) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return result, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return result, false
+	}
+	return result, true
+}
+
+// setObservationsMetadata sets the station metadata valid at the time of each observation.
+// stations holds the already fetched stations, it is used for stations without metadata history.
+func (h *DefaultHandler) setObservationsMetadata(ctx *gin.Context, items []models.StationObservation, stations map[int64]db.ObservationsStation) error {
+	stationIDs, start, end := observationsRange(items)
+	if len(stationIDs) == 0 {
+		return nil
+	}
+
+	versions, err := h.store.ListStationMetadataInRange(ctx, db.ListStationMetadataInRangeParams{
+		StationIds: stationIDs,
+		StartDate:  pgtype.Timestamptz{Time: start, Valid: true},
+		EndDate:    pgtype.Timestamptz{Time: end, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	byStation := make(map[int64][]db.StationMetadataHistory)
+	for _, v := range versions {
+		byStation[v.StationID] = append(byStation[v.StationID], v)
+	}
+
+	if stations == nil {
+		stations = make(map[int64]db.ObservationsStation)
+	}
+	for i := range items {
+		if items[i].Timestamp.IsZero() {
+			continue
+		}
+		if m := models.FindStationMetadata(byStation[items[i].StationID], items[i].Timestamp); m != nil {
+			items[i].Metadata = m
+			continue
+		}
+
+		stn, ok := stations[items[i].StationID]
+		if !ok {
+			stn, err = h.store.GetStation(ctx, items[i].StationID)
+			if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+				return err
+			}
+			stations[items[i].StationID] = stn
+		}
+		if stn.ID == 0 {
+			continue
+		}
+		m := models.NewStationMetadataFromStation(stn)
+		items[i].Metadata = &m
+	}
+
+	return nil
+}
+
+// observationsRange returns the stations and the time range covered by the timestamped observations
+func observationsRange(items []models.StationObservation) (stationIDs []int64, start, end time.Time) {
+	seen := make(map[int64]bool)
+	for _, obs := range items {
+		if obs.Timestamp.IsZero() {
+			continue
+		}
+		if !seen[obs.StationID] {
+			seen[obs.StationID] = true
+			stationIDs = append(stationIDs, obs.StationID)
+		}
+		if start.IsZero() || obs.Timestamp.Before(start) {
+			start = obs.Timestamp
+		}
+		if obs.Timestamp.After(end) {
+			end = obs.Timestamp
+		}
+	}
+	return stationIDs, start, end
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStationMetadataHistoryAPI(t *testing.T) {
+	station := randomStation(t)
+	relocatedAt := time.Now().Add(-24 * time.Hour)
+	history := []db.StationMetadataHistory{
+		randomStationMetadataHistory(t, station.ID, relocatedAt, time.Time{}),
+		randomStationMetadataHistory(t, station.ID, time.Time{}, relocatedAt),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: "?per_page=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().ListStationMetadataHistory(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationMetadataHistoryParams) bool {
+					return arg.StationID == station.ID && arg.Limit.Int32 == 10 && arg.Offset == 0
+				})).Return(history, nil)
+				store.EXPECT().CountStationMetadataHistory(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(int64(len(history)), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got paginatedStationMetadata
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, 2)
+				require.Equal(t, history[0].LoggerSerial.String, got.Items[0].LoggerSerial)
+				require.NotNil(t, got.Items[0].EffectiveFrom)
+				require.Nil(t, got.Items[0].EffectiveTo)
+				require.Nil(t, got.Items[1].EffectiveFrom)
+				require.NotNil(t, got.Items[1].EffectiveTo)
+			},
+		},
+		{
+			name:  "NoHistory",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().ListStationMetadataHistory(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.StationMetadataHistory{}, nil)
+				store.EXPECT().CountStationMetadataHistory(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(0, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got paginatedStationMetadata
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, []models.StationMetadata{models.NewStationMetadataFromStation(station)}, got.Items)
+			},
+		},
+		{
+			name:  "StationNotFound",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ListStationMetadataHistory", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/metadata", handler.ListStationMetadataHistory)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/metadata%s", station.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUpdateStationMetadataAPI(t *testing.T) {
+	stationID := gofakeit.Int64()&0xffff + 1
+	metadata := randomStationMetadataHistory(t, stationID, time.Now().Add(-time.Hour), time.Time{})
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"lat":            metadata.Lat.Float32,
+				"lon":            metadata.Lon.Float32,
+				"wind_height":    metadata.WindHeight.Float32,
+				"logger_serial":  metadata.LoggerSerial.String,
+				"reason":         "relocated to the rooftop",
+				"effective_from": "2024-03-01T08:00:00",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationMetadataTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateStationMetadataTxParams) bool {
+					return arg.StationID == stationID && arg.Lat == metadata.Lat && arg.WindHeight == metadata.WindHeight &&
+						arg.LoggerSerial == metadata.LoggerSerial && !arg.TempHeight.Valid &&
+						arg.Reason == "relocated to the rooftop" &&
+						arg.EffectiveFrom.Time.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+				})).Return(db.UpdateStationMetadataTxResult{Changed: true, Metadata: metadata}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got updateStationMetadataRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.True(t, got.Changed)
+				require.Equal(t, metadata.ID, got.Metadata.ID)
+				require.Equal(t, metadata.LoggerSerial.String, got.Metadata.LoggerSerial)
+			},
+		},
+		{
+			name: "FutureEffectiveFrom",
+			body: gin.H{
+				"elevation":      10,
+				"effective_from": time.Now().Add(48 * time.Hour).Format("2006-01-02"),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpdateStationMetadataTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidHeight",
+			body: gin.H{"temp_height": -1},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpdateStationMetadataTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidEffectiveFrom",
+			body: gin.H{"elevation": 10, "effective_from": "2020-01-01"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationMetadataTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationMetadataTxResult{}, db.ErrInvalidEffectiveFrom)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "StationNotFound",
+			body: gin.H{"elevation": 10},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationMetadataTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationMetadataTxResult{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/stations/:station_id/metadata", handler.UpdateStationMetadata)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/metadata", stationID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+// randomStationMetadataHistory creates a metadata version, a zero from is the first version and a zero to is the current version
+func randomStationMetadataHistory(t *testing.T, stationID int64, from, to time.Time) db.StationMetadataHistory {
+	var m models.BaseStationMetadata
+	err := gofakeit.Struct(&m)
+	require.NoError(t, err)
+
+	res := db.StationMetadataHistory{
+		ID:            gofakeit.Int64()&0xffff + 1,
+		StationID:     stationID,
+		Lat:           util.ToFloat4(m.Lat),
+		Lon:           util.ToFloat4(m.Lon),
+		Elevation:     util.ToFloat4(m.Elevation),
+		LoggerSerial:  util.ToPgText(gofakeit.Numerify("SN#####")),
+		TempHeight:    util.ToFloat4(m.TempHeight),
+		WindHeight:    util.ToFloat4(m.WindHeight),
+		Reason:        gofakeit.Sentence(3),
+		EffectiveFrom: pgtype.Timestamptz{Time: time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		CreatedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	if !from.IsZero() {
+		res.EffectiveFrom.Time = from
+	}
+	if !to.IsZero() {
+		res.EffectiveTo = pgtype.Timestamptz{Time: to, Valid: true}
+	}
+	return res
+}
@@ -151,6 +151,15 @@ func (h *DefaultHandler) ListStationObservations(ctx *gin.Context) {
 	for i, obs := range obsSlice {
 		items[i] = models.NewStationObservation(obs)
 	}
+	err = h.setObservationsMetadata(ctx, items, map[int64]db.ObservationsStation{uri.StationID: stn})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err := h.setObservationsMaintenance(ctx, items); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
 
 	var count int64
 	if stn.StationType.String == "MO" {
@@ -221,8 +230,17 @@ func (h *DefaultHandler) GetStationObservation(ctx *gin.Context) {
 		return
 	}
 
-	res := models.NewStationObservation(obs)
-	ctx.JSON(http.StatusOK, res)
+	items := []models.StationObservation{models.NewStationObservation(obs)}
+	if err := h.setObservationsMetadata(ctx, items, nil); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err := h.setObservationsMaintenance(ctx, items); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, items[0])
 }
 
 type updateStationObsUri struct {
@@ -391,6 +409,14 @@ func (h *DefaultHandler) ListObservations(ctx *gin.Context) {
 	for i, observation := range obs {
 		items[i] = models.NewStationObservation(observation)
 	}
+	if err := h.setObservationsMetadata(ctx, items, nil); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err := h.setObservationsMaintenance(ctx, items); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
 
 	count, err := h.store.CountObservations(ctx, db.CountObservationsParams{
 		StationIds:  arg.StationIds,
@@ -495,15 +521,31 @@ func newLatestObservationResponse(data any) latestObservationRes {
 	}
 }
 
+type listLatestObservationsReq struct {
+	stationFilterReq
+} //@name ListLatestObservationsParams
+
 // ListLatestObservations
 //
 //	@Summary	list latest observation
 //	@Tags		observations
 //	@Produce	json
+//	@Param		req	query	listLatestObservationsReq	false	"List latest observations parameters"
 //	@Success	200	{array}	latestObservationRes
 //	@Router		/observations/latest [get]
 func (h *DefaultHandler) ListLatestObservations(ctx *gin.Context) {
-	_obsSlice, err := h.store.ListLatestObservations(ctx)
+	var req listLatestObservationsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	filter, err := req.params()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_obsSlice, err := h.store.ListLatestObservations(ctx, db.ListLatestObservationsParams(filter))
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
@@ -117,6 +118,25 @@ func TestListStationObservationsAPI(t *testing.T) {
 		stnMOObsSlice[i] = convertObservationToMOObservation(stnObsSlice[i])
 	}
 
+	relocatedAt := time.Now().Add(-150 * time.Minute)
+	timedObsSlice := make([]db.ObservationsObservation, n)
+	for i := range timedObsSlice {
+		timedObsSlice[i] = stnObsSlice[i]
+		timedObsSlice[i].Timestamp = pgtype.Timestamptz{Time: time.Now().Add(-time.Duration(i+1) * time.Hour).Truncate(time.Second), Valid: true}
+	}
+	metadataHistory := []db.StationMetadataHistory{
+		randomStationMetadataHistory(t, int64(stationID), time.Time{}, relocatedAt),
+		randomStationMetadataHistory(t, int64(stationID), relocatedAt, time.Time{}),
+	}
+	maintenance := []db.StationMaintenance{
+		{
+			ID:          1,
+			StationID:   int64(stationID),
+			WindowStart: pgtype.Timestamptz{Time: time.Now().Add(-90 * time.Minute), Valid: true},
+			WindowEnd:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		},
+	}
+
 	testCases := []struct {
 		name          string
 		query         listStationObsReq
@@ -270,6 +290,44 @@ func TestListStationObservationsAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name:  "WithMetadata",
+			query: listStationObsReq{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("int64")).
+					Return(db.ObservationsStation{ID: int64(stationID)}, nil)
+				store.EXPECT().ListStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(timedObsSlice, nil)
+				store.EXPECT().ListStationMetadataInRange(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationMetadataInRangeParams) bool {
+					return len(arg.StationIds) == 1 && arg.StationIds[0] == int64(stationID) &&
+						arg.StartDate.Time.Equal(timedObsSlice[n-1].Timestamp.Time) && arg.EndDate.Time.Equal(timedObsSlice[0].Timestamp.Time)
+				})).Return(metadataHistory, nil)
+				store.EXPECT().ListStationMaintenanceWindowsInRange(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(maintenance, nil)
+				store.EXPECT().CountStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got paginatedStationObservations
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, n)
+				for i, item := range got.Items {
+					require.NotNil(t, item.Metadata)
+					// observations are sorted from the latest, the first two are after the relocation
+					if i < 2 {
+						require.Equal(t, metadataHistory[1].ID, item.Metadata.ID)
+					} else {
+						require.Equal(t, metadataHistory[0].ID, item.Metadata.ID)
+					}
+					// only the latest observation was taken during the maintenance
+					require.Equal(t, i == 0, item.Maintenance)
+				}
+			},
+		},
 		{
 			name:  "EmptySlice",
 			query: listStationObsReq{},
@@ -1012,6 +1070,113 @@ func TestGetLatestStationObservationAPI(t *testing.T) {
 	}
 }
 
+func TestListLatestObservationsAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		query         listLatestObservationsReq
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: listLatestObservationsReq{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListLatestObservations(mock.AnythingOfType("*gin.Context"), db.ListLatestObservationsParams{}).
+					Return([]db.ListLatestObservationsRow{{ID: 1}, {ID: 2}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []latestObservationRes
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+			},
+		},
+		{
+			name: "HasFilters",
+			query: listLatestObservationsReq{
+				stationFilterReq: stationFilterReq{
+					Q:             "banos",
+					StationType2:  "AWS",
+					Region:        "ncr",
+					ProviderID:    "PAGASA",
+					PriorityLevel: "HIGH",
+					InstalledFrom: "2024-01-01",
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListLatestObservations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListLatestObservationsParams) bool {
+					return arg.Q.String == "banos" &&
+						arg.StationType2.String == "AWS" &&
+						arg.Region.String == "National Capital Region" &&
+						arg.ProviderID.String == "PAGASA" &&
+						arg.PriorityLevel.String == "HIGH" &&
+						arg.InstalledFrom.Valid && !arg.InstalledTo.Valid
+				})).
+					Return([]db.ListLatestObservationsRow{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidInstalledFrom",
+			query: listLatestObservationsReq{
+				stationFilterReq: stationFilterReq{InstalledFrom: "last year"},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListLatestObservations", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: listLatestObservationsReq{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListLatestObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ListLatestObservationsRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("", handler.ListLatestObservations)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			q := request.URL.Query()
+			addStationFilterQuery(q, tc.query.stationFilterReq)
+			request.URL.RawQuery = q.Encode()
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
 func randomObservation(t *testing.T) db.ObservationsObservation {
 	var o models.StationObservation
 	err := gofakeit.Struct(&o)
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type stationStatusHistoryRes struct {
+	ID             int64      `json:"id"`
+	StationID      int64      `json:"station_id"`
+	FromStatus     string     `json:"from_status,omitempty"`
+	ToStatus       string     `json:"to_status"`
+	Reason         string     `json:"reason,omitempty"`
+	LastObservedAt *time.Time `json:"last_observed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+} //@name StationStatusHistory
+
+func newStationStatusHistoryResponse(h db.StationStatusHistory) stationStatusHistoryRes {
+	res := stationStatusHistoryRes{
+		ID:         h.ID,
+		StationID:  h.StationID,
+		FromStatus: h.FromStatus.String,
+		ToStatus:   h.ToStatus,
+		Reason:     h.Reason,
+		CreatedAt:  h.CreatedAt.Time,
+	}
+	if h.LastObservedAt.Valid {
+		res.LastObservedAt = &h.LastObservedAt.Time
+	}
+	return res
+}
+
+type stationStatusUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type listStationStatusHistoryReq struct {
+	Page      int32  `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage   int32  `form:"per_page" binding:"omitempty,min=1"`       // limit
+	StartDate string `form:"start_date" binding:"omitempty,date_time"`
+	EndDate   string `form:"end_date" binding:"omitempty,date_time"`
+} //@name ListStationStatusHistoryParams
+
+type paginatedStationStatusHistory = util.PaginatedList[stationStatusHistoryRes] //@name PaginatedStationStatusHistory
+
+// ListStationStatusHistory
+//
+//	@Summary	List station status transitions
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int							true	"Station ID"
+//	@Param		req			query		listStationStatusHistoryReq	false	"List station status history parameters"
+//	@Success	200			{object}	paginatedStationStatusHistory
+//	@Router		/stations/{station_id}/status-history [get]
+func (h *DefaultHandler) ListStationStatusHistory(ctx *gin.Context) {
+	var uri stationStatusUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationStatusHistoryReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	startDate, isStartDate := util.ParseDateTime(req.StartDate)
+	endDate, isEndDate := util.ParseDateTime(req.EndDate)
+	offset := (req.Page - 1) * req.PerPage
+
+	arg := db.ListStationStatusHistoryParams{
+		StationID:   uri.StationID,
+		IsStartDate: isStartDate,
+		StartDate: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: !startDate.IsZero(),
+		},
+		IsEndDate: isEndDate,
+		EndDate: pgtype.Timestamptz{
+			Time:  endDate,
+			Valid: !endDate.IsZero(),
+		},
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: req.PerPage > 0,
+		},
+		Offset: offset,
+	}
+
+	history, err := h.store.ListStationStatusHistory(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]stationStatusHistoryRes, len(history))
+	for i, sh := range history {
+		items[i] = newStationStatusHistoryResponse(sh)
+	}
+
+	count, err := h.store.CountStationStatusHistory(ctx, db.CountStationStatusHistoryParams{
+		StationID:   arg.StationID,
+		IsStartDate: arg.IsStartDate,
+		StartDate:   arg.StartDate,
+		IsEndDate:   arg.IsEndDate,
+		EndDate:     arg.EndDate,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type updateStationStatusReq struct {
+	Status string `json:"status" binding:"required,oneof=ONLINE DELAYED OFFLINE INACTIVE MAINTENANCE"`
+	Reason string `json:"reason"`
+} //@name UpdateStationStatusParams
+
+type stationStatusRes struct {
+	StationID int64                    `json:"station_id"`
+	Status    string                   `json:"status"`
+	Changed   bool                     `json:"changed"`
+	History   *stationStatusHistoryRes `json:"history,omitempty"`
+} //@name StationStatus
+
+// UpdateStationStatus
+//
+//	@Summary	Change the station status
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		req			body	updateStationStatusReq	true	"Update station status parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	stationStatusRes
+//	@Router		/stations/{station_id}/status [put]
+func (h *DefaultHandler) UpdateStationStatus(ctx *gin.Context) {
+	var uri stationStatusUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req updateStationStatusReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, ok := h.setStationStatus(ctx, uri.StationID, req.Status, req.Reason)
+	if !ok {
+		return
+	}
+
+	res := stationStatusRes{
+		StationID: uri.StationID,
+		Status:    req.Status,
+		Changed:   result.Changed,
+	}
+	if result.Changed {
+		history := newStationStatusHistoryResponse(result.History)
+		res.History = &history
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// setStationStatus moves the station through the status state machine and records the transition
+func (h *DefaultHandler) setStationStatus(ctx *gin.Context, stationID int64, status, reason string) (db.UpdateStationStatusTxResult, bool) {
+	result, err := h.store.UpdateStationStatusTx(ctx, db.UpdateStationStatusTxParams{
+		StationID:     stationID,
+		Status:        status,
+		Reason:        reason,
+		CanTransition: service.CanTransitionStationStatus,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return result, false
+		}
+		if errors.Is(err, db.ErrInvalidStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("station cannot change status to %s", status)))
+			return result, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return result, false
+	}
+	return result, true
+}
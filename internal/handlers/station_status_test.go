@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStationStatusHistoryAPI(t *testing.T) {
+	stationID := gofakeit.Int64()&0xffff + 1
+	n := 3
+	history := make([]db.StationStatusHistory, n)
+	for i := range history {
+		history[i] = randomStationStatusHistory(stationID)
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: "?start_date=2024-03-01&per_page=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), stationID).
+					Return(db.ObservationsStation{ID: stationID}, nil)
+				store.EXPECT().ListStationStatusHistory(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationStatusHistoryParams) bool {
+					return arg.StationID == stationID && arg.IsStartDate && !arg.IsEndDate && arg.Limit.Int32 == 10
+				})).Return(history, nil)
+				store.EXPECT().CountStationStatusHistory(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CountStationStatusHistoryParams) bool {
+					return arg.StationID == stationID && arg.IsStartDate
+				})).Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got paginatedStationStatusHistory
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, n)
+				require.Equal(t, int32(n), got.Count)
+				require.Equal(t, history[0].ToStatus, got.Items[0].ToStatus)
+				require.Equal(t, history[0].FromStatus.String, got.Items[0].FromStatus)
+			},
+		},
+		{
+			name:  "StationNotFound",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), stationID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ListStationStatusHistory", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "?end_date=today",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/status-history", handler.ListStationStatusHistory)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/status-history%s", stationID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUpdateStationStatusAPI(t *testing.T) {
+	stationID := gofakeit.Int64()&0xffff + 1
+	history := randomStationStatusHistory(stationID)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"status": "MAINTENANCE", "reason": "replacing the rain gauge"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateStationStatusTxParams) bool {
+					return arg.StationID == stationID && arg.Status == "MAINTENANCE" &&
+						arg.Reason == "replacing the rain gauge" && arg.CanTransition != nil
+				})).Return(db.UpdateStationStatusTxResult{Changed: true, History: history}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got stationStatusRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.True(t, got.Changed)
+				require.Equal(t, "MAINTENANCE", got.Status)
+				require.NotNil(t, got.History)
+				require.Equal(t, history.ID, got.History.ID)
+			},
+		},
+		{
+			name: "Unchanged",
+			body: gin.H{"status": "ONLINE"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationStatusTxResult{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got stationStatusRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.False(t, got.Changed)
+				require.Nil(t, got.History)
+			},
+		},
+		{
+			name: "InvalidStatus",
+			body: gin.H{"status": "ACTIVE"},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpdateStationStatusTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTransition",
+			body: gin.H{"status": "OFFLINE"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationStatusTxResult{}, db.ErrInvalidStatusTransition)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "StationNotFound",
+			body: gin.H{"status": "INACTIVE"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationStatusTxResult{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/stations/:station_id/status", handler.UpdateStationStatus)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/status", stationID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomStationStatusHistory(stationID int64) db.StationStatusHistory {
+	return db.StationStatusHistory{
+		ID:             gofakeit.Int64()&0xffff + 1,
+		StationID:      stationID,
+		FromStatus:     util.ToPgText("ONLINE"),
+		ToStatus:       gofakeit.RandomString([]string{"DELAYED", "OFFLINE", "MAINTENANCE"}),
+		Reason:         gofakeit.Sentence(4),
+		LastObservedAt: pgtype.Timestamptz{Time: gofakeit.PastDate(), Valid: true},
+		CreatedAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
@@ -143,15 +145,15 @@ func TestListStationsAPI(t *testing.T) {
 		{
 			name: "HasStatus",
 			query: listStationsReq{
-				Status: "ONLINE",
+				stationFilterReq: stationFilterReq{Status: "ONLINE"},
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationsParams) bool {
 					return arg.Status.Valid && len(arg.Status.String) > 0
 				})).
 					Return(stations, nil)
-				store.EXPECT().CountStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg pgtype.Text) bool {
-					return arg.Valid && len(arg.String) > 0
+				store.EXPECT().CountStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CountStationsParams) bool {
+					return arg.Status.Valid && len(arg.Status.String) > 0
 				})).Return(int64(n), nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
@@ -160,18 +162,97 @@ func TestListStationsAPI(t *testing.T) {
 				requireBodyMatchStations(t, recorder.Body, stations)
 			},
 		},
+		{
+			name: "Search",
+			query: listStationsReq{
+				stationFilterReq: stationFilterReq{Q: " los banos "},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationsParams) bool {
+					return arg.Q.String == "los banos" && arg.Sort.String == "relevance"
+				})).
+					Return(stations, nil)
+				store.EXPECT().CountStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CountStationsParams) bool {
+					return arg.Q.String == "los banos"
+				})).Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "HasFilters",
+			query: listStationsReq{
+				stationFilterReq: stationFilterReq{
+					StationType:   "MO",
+					Province:      "laguna",
+					Region:        "IV-A",
+					InstalledFrom: "2024-01-01",
+					InstalledTo:   "2024-12-31",
+				},
+				Sort: "-date_installed",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationsParams) bool {
+					return arg.StationType.String == "MO" &&
+						arg.Province.String == "Laguna" &&
+						arg.Region.String == "Calabarzon" &&
+						arg.InstalledFrom.Time.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) &&
+						arg.InstalledTo.Time.Equal(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)) &&
+						arg.Sort.String == "-date_installed"
+				})).
+					Return(stations, nil)
+				store.EXPECT().CountStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CountStationsParams) bool {
+					return arg.Province.String == "Laguna" && arg.InstalledTo.Valid
+				})).Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidSort",
+			query: listStationsReq{
+				Sort: "mobile_number",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStations", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidInstalledRange",
+			query: listStationsReq{
+				stationFilterReq: stationFilterReq{
+					InstalledFrom: "2024-12-31",
+					InstalledTo:   "2024-01-01",
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStations", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name: "WithinCircle",
 			query: listStationsReq{
-				Circle: "121.0,5.5,1.0",
+				stationFilterReq: stationFilterReq{
+					Circle: "121.0,5.5,1.0",
+				},
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().ListStationsWithinRadius(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationsWithinRadiusParams) bool {
-					return assert.InDelta(t, arg.Cx, 121.0, 0.001) && assert.InDelta(t, arg.Cy, 5.5, 0.001) && assert.InDelta(t, arg.R, 1.0, 0.001)
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationsParams) bool {
+					return assert.InDelta(t, arg.Cx.Float32, 121.0, 0.001) && assert.InDelta(t, arg.Cy.Float32, 5.5, 0.001) && assert.InDelta(t, arg.R.Float32, 1.0, 0.001)
 				})).
 					Return(stations, nil)
-				store.EXPECT().CountStationsWithinRadius(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CountStationsWithinRadiusParams) bool {
-					return assert.InDelta(t, arg.Cx, 121.0, 0.001) && assert.InDelta(t, arg.Cy, 5.5, 0.001) && assert.InDelta(t, arg.R, 1.0, 0.001)
+				store.EXPECT().CountStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CountStationsParams) bool {
+					return assert.InDelta(t, arg.Cx.Float32, 121.0, 0.001) && assert.InDelta(t, arg.Cy.Float32, 5.5, 0.001) && assert.InDelta(t, arg.R.Float32, 1.0, 0.001)
 				})).Return(int64(n), nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
@@ -183,27 +264,31 @@ func TestListStationsAPI(t *testing.T) {
 		{
 			name: "InvalidCircle",
 			query: listStationsReq{
-				Circle: "121.0,5.5",
+				stationFilterReq: stationFilterReq{
+					Circle: "121.0,5.5",
+				},
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
-				store.AssertNotCalled(t, "ListStationsWithinRadius", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				store.AssertNotCalled(t, "ListStations", mock.AnythingOfType("*gin.Context"), mock.Anything)
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
 			name: "WithinBBox",
 			query: listStationsReq{
-				BBox: "121.0,5.5,122.5,7.6",
+				stationFilterReq: stationFilterReq{
+					BBox: "121.0,5.5,122.5,7.6",
+				},
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().ListStationsWithinBBox(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationsWithinBBoxParams) bool {
-					return assert.InDelta(t, arg.Xmin, 121.0, 0.001) && assert.InDelta(t, arg.Ymin, 5.5, 0.001) && assert.InDelta(t, arg.Xmax, 122.5, 0.001) && assert.InDelta(t, arg.Ymax, 7.6, 0.001)
+				store.EXPECT().ListStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListStationsParams) bool {
+					return assert.InDelta(t, arg.Xmin.Float32, 121.0, 0.001) && assert.InDelta(t, arg.Ymin.Float32, 5.5, 0.001) && assert.InDelta(t, arg.Xmax.Float32, 122.5, 0.001) && assert.InDelta(t, arg.Ymax.Float32, 7.6, 0.001)
 				})).
 					Return(stations, nil)
-				store.EXPECT().CountStationsWithinBBox(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CountStationsWithinBBoxParams) bool {
-					return assert.InDelta(t, arg.Xmin, 121.0, 0.001) && assert.InDelta(t, arg.Ymin, 5.5, 0.001) && assert.InDelta(t, arg.Xmax, 122.5, 0.001) && assert.InDelta(t, arg.Ymax, 7.6, 0.001)
+				store.EXPECT().CountStations(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CountStationsParams) bool {
+					return assert.InDelta(t, arg.Xmin.Float32, 121.0, 0.001) && assert.InDelta(t, arg.Ymin.Float32, 5.5, 0.001) && assert.InDelta(t, arg.Xmax.Float32, 122.5, 0.001) && assert.InDelta(t, arg.Ymax.Float32, 7.6, 0.001)
 				})).Return(int64(n), nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
@@ -215,12 +300,14 @@ func TestListStationsAPI(t *testing.T) {
 		{
 			name: "InvalidBBox",
 			query: listStationsReq{
-				BBox: "121.0,5.5",
+				stationFilterReq: stationFilterReq{
+					BBox: "121.0,5.5",
+				},
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
-				store.AssertNotCalled(t, "ListStationsWithinBBox", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				store.AssertNotCalled(t, "ListStations", mock.AnythingOfType("*gin.Context"), mock.Anything)
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
@@ -316,8 +403,9 @@ func TestListStationsAPI(t *testing.T) {
 			if len(tc.query.BBox) > 0 {
 				q.Add("bbox", tc.query.BBox)
 			}
-			if len(tc.query.Status) > 0 {
-				q.Add("status", tc.query.Status)
+			addStationFilterQuery(q, tc.query.stationFilterReq)
+			if len(tc.query.Sort) > 0 {
+				q.Add("sort", tc.query.Sort)
 			}
 			request.URL.RawQuery = q.Encode()
 
@@ -328,6 +416,26 @@ func TestListStationsAPI(t *testing.T) {
 	}
 }
 
+func addStationFilterQuery(q url.Values, filter stationFilterReq) {
+	params := map[string]string{
+		"q":              filter.Q,
+		"status":         filter.Status,
+		"station_type":   filter.StationType,
+		"station_type2":  filter.StationType2,
+		"province":       filter.Province,
+		"region":         filter.Region,
+		"provider_id":    filter.ProviderID,
+		"priority_level": filter.PriorityLevel,
+		"installed_from": filter.InstalledFrom,
+		"installed_to":   filter.InstalledTo,
+	}
+	for k, v := range params {
+		if len(v) > 0 {
+			q.Add(k, v)
+		}
+	}
+}
+
 func TestGetStationAPI(t *testing.T) {
 	station := randomStation(t)
 
@@ -433,8 +541,9 @@ func TestUpdateStationAPI(t *testing.T) {
 				"region":   station.Region,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateStation(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.UpdateStationParams")).
-					Return(station, nil)
+				store.EXPECT().UpdateStationTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateStationTxParams) bool {
+					return arg.ID == station.ID && arg.Lat == station.Lat && arg.Lon == station.Lon && arg.Reason == "station updated"
+				})).Return(db.UpdateStationTxResult{Station: station}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
@@ -442,13 +551,72 @@ func TestUpdateStationAPI(t *testing.T) {
 				requireBodyMatchStation(t, recorder.Body, station)
 			},
 		},
+		{
+			name:      "MetadataConflict",
+			stationID: station.ID,
+			body: gin.H{
+				"elevation": 12,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationTxResult{}, db.ErrInvalidEffectiveFrom)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:      "WithStatus",
+			stationID: station.ID,
+			body: gin.H{
+				"status": "MAINTENANCE",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateStationTxParams) bool {
+					return arg.ID == station.ID && arg.Status.String == "MAINTENANCE" && arg.CanTransition != nil
+				})).Return(db.UpdateStationTxResult{Station: station}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidStatus",
+			stationID: station.ID,
+			body: gin.H{
+				"status": "BROKEN",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpdateStationTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidStatusTransition",
+			stationID: station.ID,
+			body: gin.H{
+				"status": "OFFLINE",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateStationTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationTxResult{}, db.ErrInvalidStatusTransition)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
 		{
 			name:      "InternalError",
 			stationID: station.ID,
 			body:      gin.H{},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
-					Return(db.ObservationsStation{}, sql.ErrConnDone)
+				store.EXPECT().UpdateStationTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
@@ -460,8 +628,8 @@ func TestUpdateStationAPI(t *testing.T) {
 			stationID: station.ID,
 			body:      gin.H{},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
-					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateStationTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateStationTxResult{}, db.ErrRecordNotFound)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
@@ -512,19 +680,31 @@ func TestDeleteStationAPI(t *testing.T) {
 			stationID: station.ID,
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().DeleteStation(mock.AnythingOfType("*gin.Context"), station.ID).
-					Return(nil)
+					Return(1, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
 				require.Equal(t, http.StatusNoContent, recorder.Code)
 			},
 		},
+		{
+			name:      "AlreadyDeleted",
+			stationID: station.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(0, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
 		{
 			name:      "InternalError",
 			stationID: station.ID,
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().DeleteStation(mock.AnythingOfType("*gin.Context"), station.ID).
-					Return(sql.ErrConnDone)
+					Return(0, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
@@ -558,6 +738,164 @@ func TestDeleteStationAPI(t *testing.T) {
 	}
 }
 
+func TestRestoreStationAPI(t *testing.T) {
+	station := randomStation(t)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RestoreStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchStation(t, recorder.Body, station)
+			},
+		},
+		{
+			name: "NotDeleted",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RestoreStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "MobileNumberInUse",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RestoreStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST(":station_id/restore", handler.RestoreStation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/restore", station.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestPurgeStationAPI(t *testing.T) {
+	station := randomStation(t)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"confirm": station.Name},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetDeletedStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().PurgeStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "WrongConfirmation",
+			body: gin.H{"confirm": station.Name + "x"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetDeletedStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "PurgeStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MissingConfirmation",
+			body: gin.H{},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetDeletedStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotDeleted",
+			body: gin.H{"confirm": station.Name},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetDeletedStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "PurgeStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE(":station_id/purge", handler.PurgeStation)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/%d/purge", station.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
 func randomStation(t *testing.T) db.ObservationsStation {
 	var station models.Station
 	err := gofakeit.Struct(&station)
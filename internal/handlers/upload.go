@@ -0,0 +1,367 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	UploadProtocolWU      = "WU"
+	UploadProtocolEcowitt = "ECOWITT"
+	// UploadProtocolUMB registers a Lufft UMB device, the upload id is the hex device address e.g. 7001
+	UploadProtocolUMB = "UMB"
+	// UploadProtocolMQTT registers an MQTT device, the upload id is the {device} segment of the topic
+	UploadProtocolMQTT = "MQTT"
+)
+
+var errUploadUnauthorized = errors.New("invalid station id or key")
+
+// WUStoreObservation
+//
+//	@Summary	Store Weather Underground protocol observation
+//	@Tags		wunderground
+//	@Produce	plain
+//	@Param		ID			query		string	true	"Upload station ID"
+//	@Param		PASSWORD	query		string	true	"Upload station key"
+//	@Param		dateutc		query		string	false	"Observation time in UTC (YYYY-MM-DD HH:MM:SS or now)"
+//	@Success	200			{string}	string	"success"
+//	@Router		/weatherstation/updateweatherstation.php [get]
+func (h *DefaultHandler) WUStoreObservation(ctx *gin.Context) {
+	vals := ctx.Request.URL.Query()
+
+	uploadID, password, err := sensor.WUndergroundCredentials(vals)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("msg", sensor.EncodeUploadMessage(vals, "PASSWORD")).
+			Msg("[WUnderground] Invalid request")
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	uStn, err := h.getUploadStation(ctx, UploadProtocolWU, uploadID, password)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("uploadID", uploadID).
+			Msg("[WUnderground] Unauthorized upload")
+		if errors.Is(err, errUploadUnauthorized) {
+			ctx.String(http.StatusUnauthorized, err.Error())
+			return
+		}
+		ctx.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	clockOpts, err := h.clockOpts(ctx, uStn.StationID)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("id", uStn.StationID).
+			Msg("[WUnderground] Cannot get station clock settings")
+		ctx.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	wu, err := sensor.NewWUndergroundFromValues(vals, clockOpts...)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("msg", sensor.EncodeUploadMessage(vals, "PASSWORD")).
+			Msg("[WUnderground] Invalid request")
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if _, _, err := service.StoreStationObservation(ctx, h.store, uStn.StationID, wu.Obs, wu.Health); err != nil {
+		h.logger.Error().Err(err).
+			Int64("id", uStn.StationID).
+			Str("uploadID", wu.ID).
+			Msg("[WUnderground] Cannot store station observation")
+		ctx.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	h.logger.Debug().
+		Int64("id", uStn.StationID).
+		Str("uploadID", wu.ID).
+		Msg("[WUnderground] Data saved successfully")
+	ctx.String(http.StatusOK, "success\n")
+}
+
+type ecowittStoreObsUri struct {
+	Key string `uri:"key" binding:"required"`
+}
+
+// EcowittStoreObservation
+//
+//	@Summary	Store Ecowitt protocol observation
+//	@Tags		ecowitt
+//	@Accept		x-www-form-urlencoded
+//	@Produce	json
+//	@Param		key		path		string	true	"Upload station key"
+//	@Param		PASSKEY	formData	string	true	"Upload station ID"
+//	@Success	201		{object}	observationRes
+//	@Router		/ecowitt/{key} [post]
+func (h *DefaultHandler) EcowittStoreObservation(ctx *gin.Context) {
+	var uri ecowittStoreObsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if err := ctx.Request.ParseForm(); err != nil {
+		h.logger.Error().Err(err).
+			Msg("[Ecowitt] Bad request")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	vals := ctx.Request.PostForm
+
+	passKey, err := sensor.EcowittPassKey(vals)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("msg", sensor.EncodeUploadMessage(vals, "PASSKEY")).
+			Msg("[Ecowitt] Invalid request")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	uStn, err := h.getUploadStation(ctx, UploadProtocolEcowitt, passKey, uri.Key)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("uploadID", passKey).
+			Msg("[Ecowitt] Unauthorized upload")
+		if errors.Is(err, errUploadUnauthorized) {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	stn, err := h.store.GetStation(ctx, uStn.StationID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	clockOpts, err := h.clockOpts(ctx, stn.ID)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("id", stn.ID).
+			Msg("[Ecowitt] Cannot get station clock settings")
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ecowitt, err := sensor.NewEcowittFromValues(vals, clockOpts...)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("msg", sensor.EncodeUploadMessage(vals, "PASSKEY")).
+			Msg("[Ecowitt] Invalid request")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	obs, health, err := service.StoreStationObservation(ctx, h.store, stn.ID, ecowitt.Obs, ecowitt.Health)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("id", stn.ID).
+			Str("uploadID", ecowitt.PassKey).
+			Msg("[Ecowitt] Cannot store station observation")
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	h.logger.Debug().
+		Int64("id", stn.ID).
+		Str("uploadID", ecowitt.PassKey).
+		Msg("[Ecowitt] Data saved successfully")
+	ctx.JSON(http.StatusCreated, newObservationResponse(stn, obs, health))
+}
+
+// getUploadStation returns the upload station matching the protocol and id after checking the key
+func (h *DefaultHandler) getUploadStation(ctx *gin.Context, protocol, uploadID, uploadKey string) (db.UploadStation, error) {
+	uStn, err := h.store.GetUploadStation(ctx, db.GetUploadStationParams{
+		Protocol: protocol,
+		UploadID: uploadID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.UploadStation{}, errUploadUnauthorized
+		}
+		return db.UploadStation{}, err
+	}
+
+	if err := util.CheckPassword(uploadKey, uStn.UploadKey); err != nil {
+		return db.UploadStation{}, errUploadUnauthorized
+	}
+
+	return uStn, nil
+}
+
+type uploadStationRes struct {
+	ID        int64     `json:"id"`
+	StationID int64     `json:"station_id"`
+	Protocol  string    `json:"protocol"`
+	UploadID  string    `json:"upload_id"`
+	UploadKey string    `json:"upload_key,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+} //@name UploadStation
+
+func newUploadStationResponse(uStn db.UploadStation) uploadStationRes {
+	return uploadStationRes{
+		ID:        uStn.ID,
+		StationID: uStn.StationID,
+		Protocol:  uStn.Protocol,
+		UploadID:  uStn.UploadID,
+		CreatedAt: uStn.CreatedAt.Time,
+	}
+}
+
+type listUploadStationsUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+// ListUploadStations
+//
+//	@Summary	List station upload credentials
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	200	{array}	uploadStationRes
+//	@Router		/stations/{station_id}/uploads [get]
+func (h *DefaultHandler) ListUploadStations(ctx *gin.Context) {
+	var uri listUploadStationsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	uStns, err := h.store.ListUploadStations(ctx, uri.StationID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]uploadStationRes, len(uStns))
+	for i, uStn := range uStns {
+		res[i] = newUploadStationResponse(uStn)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type createUploadStationUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type createUploadStationReq struct {
+	Protocol  string `json:"protocol" binding:"required,oneof=WU ECOWITT UMB MQTT"`
+	UploadID  string `json:"upload_id" binding:"required,max=64"`
+	UploadKey string `json:"upload_key" binding:"omitempty,min=8,max=64"`
+} //@name CreateUploadStationParams
+
+// CreateUploadStation
+//
+//	@Summary	Create station upload credentials
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		req			body	createUploadStationReq	true	"Create upload credentials parameters"
+//	@Security	BearerAuth
+//	@Success	201	{object}	uploadStationRes
+//	@Router		/stations/{station_id}/uploads [post]
+func (h *DefaultHandler) CreateUploadStation(ctx *gin.Context) {
+	var uri createUploadStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req createUploadStationReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	uploadKey := req.UploadKey
+	if uploadKey == "" {
+		uploadKey = rand.Text()
+	}
+	hashedKey, err := util.HashPassword(uploadKey)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	uStn, err := h.store.CreateUploadStation(ctx, db.CreateUploadStationParams{
+		StationID: uri.StationID,
+		Protocol:  req.Protocol,
+		UploadID:  req.UploadID,
+		UploadKey: hashedKey,
+	})
+	if err != nil {
+		switch db.ErrorCode(err) {
+		case db.UniqueViolation:
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		case db.ForeignKeyViolation:
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := newUploadStationResponse(uStn)
+	res.UploadKey = uploadKey
+	ctx.JSON(http.StatusCreated, res)
+}
+
+type deleteUploadStationUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+	ID        int64 `uri:"id" binding:"required,min=1"`
+}
+
+// DeleteUploadStation
+//
+//	@Summary	Delete station upload credentials
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Param		id			path	int	true	"Upload credentials ID"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/stations/{station_id}/uploads/{id} [delete]
+func (h *DefaultHandler) DeleteUploadStation(ctx *gin.Context) {
+	var uri deleteUploadStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := h.store.DeleteUploadStation(ctx, db.DeleteUploadStationParams{
+		StationID: uri.StationID,
+		ID:        uri.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
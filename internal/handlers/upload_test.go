@@ -0,0 +1,500 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWUStoreObservation(t *testing.T) {
+	uploadKey := util.RandomString(12)
+	uStn := randomUploadStation(t, UploadProtocolWU, uploadKey)
+
+	newQuery := func(password string) url.Values {
+		return url.Values{
+			"ID":           {uStn.UploadID},
+			"PASSWORD":     {password},
+			"dateutc":      {time.Now().UTC().Format("2006-01-02 15:04:05")},
+			"tempf":        {"86"},
+			"humidity":     {"75"},
+			"windspeedmph": {"10"},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		query         url.Values
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: newQuery(uploadKey),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUploadStation(mock.AnythingOfType("*gin.Context"), db.GetUploadStationParams{
+					Protocol: UploadProtocolWU,
+					UploadID: uStn.UploadID,
+				}).Return(uStn, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), uStn.StationID).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationObservationParams) bool {
+					return arg.StationID == uStn.StationID && arg.Temp.Valid && arg.Temp.Float32 == 30
+				})).Return(db.ObservationsObservation{}, nil)
+				store.EXPECT().CreateStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationhealth{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "success\n", recorder.Body.String())
+			},
+		},
+		{
+			name:  "WrongKey",
+			query: newQuery("wrong-key"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUploadStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(uStn, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationObservation", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "UnknownStation",
+			query: newQuery(uploadKey),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUploadStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UploadStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "MissingPassword",
+			query: url.Values{"ID": {uStn.UploadID}, "tempf": {"86"}},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetUploadStation", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: newQuery(uploadKey),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUploadStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(uStn, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), uStn.StationID).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsObservation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/weatherstation/updateweatherstation.php", handler.WUStoreObservation)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/weatherstation/updateweatherstation.php?" + tc.query.Encode()
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestEcowittStoreObservation(t *testing.T) {
+	uploadKey := util.RandomString(12)
+	uStn := randomUploadStation(t, UploadProtocolEcowitt, uploadKey)
+	station := randomStation(t)
+	station.ID = uStn.StationID
+
+	form := url.Values{
+		"PASSKEY":      {uStn.UploadID},
+		"dateutc":      {time.Now().UTC().Format("2006-01-02 15:04:05")},
+		"tempf":        {"86"},
+		"humidity":     {"75"},
+		"baromrelin":   {"29.92"},
+		"windspeedmph": {"10"},
+	}
+
+	testCases := []struct {
+		name          string
+		key           string
+		form          url.Values
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			key:  uploadKey,
+			form: form,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUploadStation(mock.AnythingOfType("*gin.Context"), db.GetUploadStationParams{
+					Protocol: UploadProtocolEcowitt,
+					UploadID: uStn.UploadID,
+				}).Return(uStn, nil)
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), uStn.StationID).
+					Return(station, nil)
+				store.EXPECT().GetStationClock(mock.AnythingOfType("*gin.Context"), uStn.StationID).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationObservationParams) bool {
+					return arg.StationID == uStn.StationID && arg.Mslp.Valid
+				})).Return(db.ObservationsObservation{StationID: uStn.StationID}, nil)
+				store.EXPECT().CreateStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationhealth{StationID: uStn.StationID}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "WrongKey",
+			key:  "wrong-key",
+			form: form,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUploadStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(uStn, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "MissingPassKey",
+			key:  uploadKey,
+			form: url.Values{"tempf": {"86"}},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetUploadStation", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/ecowitt/:key", handler.EcowittStoreObservation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/ecowitt/%s", tc.key)
+			request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(tc.form.Encode()))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestCreateUploadStationAPI(t *testing.T) {
+	uStn := randomUploadStation(t, UploadProtocolWU, util.RandomString(12))
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"protocol":   uStn.Protocol,
+				"upload_id":  uStn.UploadID,
+				"upload_key": "my-secret-key",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateUploadStation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateUploadStationParams) bool {
+					return arg.StationID == uStn.StationID &&
+						arg.Protocol == uStn.Protocol &&
+						arg.UploadID == uStn.UploadID &&
+						util.CheckPassword("my-secret-key", arg.UploadKey) == nil
+				})).Return(uStn, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				gotRes := readUploadStationResponse(t, recorder.Body)
+				require.Equal(t, uStn.ID, gotRes.ID)
+				require.Equal(t, "my-secret-key", gotRes.UploadKey)
+			},
+		},
+		{
+			name: "GeneratedKey",
+			body: gin.H{
+				"protocol":  uStn.Protocol,
+				"upload_id": uStn.UploadID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateUploadStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(uStn, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				gotRes := readUploadStationResponse(t, recorder.Body)
+				require.NotEmpty(t, gotRes.UploadKey)
+			},
+		},
+		{
+			name: "InvalidProtocol",
+			body: gin.H{
+				"protocol":  "FTP",
+				"upload_id": uStn.UploadID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateUploadStation", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "DuplicateUploadID",
+			body: gin.H{
+				"protocol":  uStn.Protocol,
+				"upload_id": uStn.UploadID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateUploadStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UploadStation{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST(":station_id/uploads", handler.CreateUploadStation)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/%d/uploads", uStn.StationID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListUploadStationsAPI(t *testing.T) {
+	uStn := randomUploadStation(t, UploadProtocolWU, util.RandomString(12))
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListUploadStations(mock.AnythingOfType("*gin.Context"), uStn.StationID).
+					Return([]db.UploadStation{uStn}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotRes []uploadStationRes
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes, 1)
+				require.Equal(t, uStn.UploadID, gotRes[0].UploadID)
+				require.Empty(t, gotRes[0].UploadKey)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListUploadStations(mock.AnythingOfType("*gin.Context"), uStn.StationID).
+					Return([]db.UploadStation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET(":station_id/uploads", handler.ListUploadStations)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/uploads", uStn.StationID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteUploadStationAPI(t *testing.T) {
+	uStn := randomUploadStation(t, UploadProtocolWU, util.RandomString(12))
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteUploadStation(mock.AnythingOfType("*gin.Context"), db.DeleteUploadStationParams{
+					StationID: uStn.StationID,
+					ID:        uStn.ID,
+				}).Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteUploadStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE(":station_id/uploads/:id", handler.DeleteUploadStation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/uploads/%d", uStn.StationID, uStn.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomUploadStation(t *testing.T, protocol, uploadKey string) db.UploadStation {
+	hashedKey, err := util.HashPassword(uploadKey)
+	require.NoError(t, err)
+
+	return db.UploadStation{
+		ID:        util.RandomInt[int64](1, 1000),
+		StationID: util.RandomInt[int64](1, 1000),
+		Protocol:  protocol,
+		UploadID:  strings.ToUpper(util.RandomString(10)),
+		UploadKey: hashedKey,
+	}
+}
+
+func readUploadStationResponse(t *testing.T, body *bytes.Buffer) uploadStationRes {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotRes uploadStationRes
+	err = json.Unmarshal(data, &gotRes)
+	require.NoError(t, err)
+
+	return gotRes
+}
@@ -0,0 +1,505 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	WeatherlinkChangePending  = "PENDING"
+	WeatherlinkChangeApproved = "APPROVED"
+	WeatherlinkChangeRejected = "REJECTED"
+)
+
+type weatherlinkChangeRes struct {
+	ID         int64      `json:"id"`
+	StationID  int64      `json:"station_id"`
+	Field      string     `json:"field"`
+	OldValue   string     `json:"old_value,omitempty"`
+	NewValue   string     `json:"new_value,omitempty"`
+	Status     string     `json:"status"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+} //@name WeatherlinkChange
+
+func newWeatherlinkChangeResponse(c db.WeatherlinkChange) weatherlinkChangeRes {
+	res := weatherlinkChangeRes{
+		ID:        c.ID,
+		StationID: c.StationID,
+		Field:     c.Field,
+		OldValue:  c.OldValue.String,
+		NewValue:  c.NewValue.String,
+		Status:    c.Status,
+		CreatedAt: c.CreatedAt.Time,
+	}
+	if c.ReviewedAt.Valid {
+		res.ReviewedAt = &c.ReviewedAt.Time
+	}
+	return res
+}
+
+type listWeatherlinkChangesReq struct {
+	Status  string `form:"status" binding:"omitempty,oneof=PENDING APPROVED REJECTED"`
+	Page    int32  `form:"page,default=1" binding:"omitempty,min=1"` // page number
+	PerPage int32  `form:"per_page" binding:"omitempty,min=1"`       // limit
+} //@name ListWeatherlinkChangesParams
+
+type paginatedWeatherlinkChanges = util.PaginatedList[weatherlinkChangeRes] //@name PaginatedWeatherlinkChanges
+
+// ListWeatherlinkChanges
+//
+//	@Summary	List WeatherLink metadata changes
+//	@Tags		weatherlink
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	query	listWeatherlinkChangesReq	false	"List WeatherLink changes parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	paginatedWeatherlinkChanges
+//	@Router		/weatherlink/changes [get]
+func (h *DefaultHandler) ListWeatherlinkChanges(ctx *gin.Context) {
+	var req listWeatherlinkChangesReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	changes, err := h.store.ListWeatherlinkChanges(ctx, db.ListWeatherlinkChangesParams{
+		Status: util.ToPgText(req.Status),
+		Limit:  pgtype.Int4{Int32: req.PerPage, Valid: req.PerPage > 0},
+		Offset: offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]weatherlinkChangeRes, len(changes))
+	for i, c := range changes {
+		items[i] = newWeatherlinkChangeResponse(c)
+	}
+
+	count, err := h.store.CountWeatherlinkChanges(ctx, util.ToPgText(req.Status))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type reviewWeatherlinkChangeUri struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type reviewWeatherlinkChangeReq struct {
+	Status string `json:"status" binding:"required,oneof=APPROVED REJECTED"`
+} //@name ReviewWeatherlinkChangeParams
+
+// ReviewWeatherlinkChange
+//
+//	@Summary	Approve or reject a WeatherLink metadata change
+//	@Tags		weatherlink
+//	@Accept		json
+//	@Produce	json
+//	@Param		id	path	int							true	"WeatherLink change ID"
+//	@Param		req	body	reviewWeatherlinkChangeReq	true	"Review parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	weatherlinkChangeRes
+//	@Router		/weatherlink/changes/{id} [put]
+func (h *DefaultHandler) ReviewWeatherlinkChange(ctx *gin.Context) {
+	var uri reviewWeatherlinkChangeUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req reviewWeatherlinkChangeReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	change, err := h.store.GetWeatherlinkChange(ctx, uri.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("change not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if change.Status != WeatherlinkChangePending {
+		ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("change already %s", strings.ToLower(change.Status))))
+		return
+	}
+
+	// an approved location is applied to the station, firmware changes are only acknowledged
+	if req.Status == WeatherlinkChangeApproved && change.Field == service.WeatherlinkChangeLocation {
+		lat, lon, err := parseLocation(change.NewValue.String)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		// the relocation starts a new metadata version so older observations keep their location
+		_, ok := h.setStationMetadata(ctx, db.UpdateStationMetadataTxParams{
+			CreateStationMetadataHistoryParams: db.CreateStationMetadataHistoryParams{
+				StationID: change.StationID,
+				Lat:       pgtype.Float4{Float32: lat, Valid: true},
+				Lon:       pgtype.Float4{Float32: lon, Valid: true},
+				Reason:    "weatherlink sync",
+			},
+		})
+		if !ok {
+			return
+		}
+	}
+
+	change, err = h.store.UpdateWeatherlinkChangeStatus(ctx, db.UpdateWeatherlinkChangeStatusParams{
+		ID:     change.ID,
+		Status: req.Status,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newWeatherlinkChangeResponse(change))
+}
+
+func parseLocation(s string) (float32, float32, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid location: %s", s)
+	}
+	lat, err := strconv.ParseFloat(parts[0], 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid location: %s", s)
+	}
+	lon, err := strconv.ParseFloat(parts[1], 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid location: %s", s)
+	}
+	return float32(lat), float32(lon), nil
+}
+
+type weatherlinkStationRes struct {
+	StationID       int64      `json:"station_id"`
+	Uuid            string     `json:"uuid,omitempty"`
+	ApiKey          string     `json:"api_key,omitempty"`
+	HasApiSecret    bool       `json:"has_api_secret"`
+	V1User          string     `json:"v1_user,omitempty"`
+	HasV1Pass       bool       `json:"has_v1_pass"`
+	HasV1ApiToken   bool       `json:"has_v1_api_token"`
+	WlStationID     int32      `json:"wl_station_id,omitempty"`
+	FirmwareVersion string     `json:"firmware_version,omitempty"`
+	SyncedAt        *time.Time `json:"synced_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+} //@name WeatherlinkStation
+
+// newWeatherlinkStationResponse never includes the secrets, only whether they are set
+func newWeatherlinkStationResponse(wl db.Weatherlink) weatherlinkStationRes {
+	res := weatherlinkStationRes{
+		StationID:       wl.StationID,
+		Uuid:            wl.Uuid.String,
+		ApiKey:          wl.ApiKey.String,
+		HasApiSecret:    wl.ApiSecret.Valid && wl.ApiSecret.String != "",
+		V1User:          wl.V1User.String,
+		HasV1Pass:       wl.V1Pass.Valid && wl.V1Pass.String != "",
+		HasV1ApiToken:   wl.V1ApiToken.Valid && wl.V1ApiToken.String != "",
+		WlStationID:     wl.WlStationID.Int32,
+		FirmwareVersion: wl.FirmwareVersion.String,
+		CreatedAt:       wl.CreatedAt.Time,
+		UpdatedAt:       wl.UpdatedAt.Time,
+	}
+	if wl.SyncedAt.Valid {
+		res.SyncedAt = &wl.SyncedAt.Time
+	}
+	return res
+}
+
+type weatherlinkStationUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type weatherlinkStationReq struct {
+	Uuid       string `json:"uuid" binding:"omitempty,max=255"`
+	ApiKey     string `json:"api_key" binding:"omitempty,max=255"`
+	ApiSecret  string `json:"api_secret" binding:"omitempty,max=128"`
+	V1User     string `json:"v1_user" binding:"omitempty,max=255"`
+	V1Pass     string `json:"v1_pass" binding:"omitempty,max=128"`
+	V1ApiToken string `json:"v1_api_token" binding:"omitempty,max=128"`
+} //@name WeatherlinkStationParams
+
+// hasCredentials reports whether the request holds a complete set of credentials for at least one api
+func (req weatherlinkStationReq) hasCredentials() bool {
+	return req.Uuid != "" ||
+		(req.ApiKey != "" && req.ApiSecret != "") ||
+		(req.V1User != "" && req.V1Pass != "" && req.V1ApiToken != "")
+}
+
+// GetWeatherlinkStation
+//
+//	@Summary	Get station WeatherLink credentials
+//	@Tags		weatherlink
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	200	{object}	weatherlinkStationRes
+//	@Router		/stations/{station_id}/weatherlink [get]
+func (h *DefaultHandler) GetWeatherlinkStation(ctx *gin.Context) {
+	var uri weatherlinkStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	wl, err := h.store.GetWeatherlinkStation(ctx, uri.StationID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("weatherlink station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newWeatherlinkStationResponse(wl))
+}
+
+// CreateWeatherlinkStation
+//
+//	@Summary	Create station WeatherLink credentials
+//	@Tags		weatherlink
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		req			body	weatherlinkStationReq	true	"WeatherLink credentials"
+//	@Security	BearerAuth
+//	@Success	201	{object}	weatherlinkStationRes
+//	@Router		/stations/{station_id}/weatherlink [post]
+func (h *DefaultHandler) CreateWeatherlinkStation(ctx *gin.Context) {
+	var uri weatherlinkStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req weatherlinkStationReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if !req.hasCredentials() {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("uuid, api_key and api_secret, or v1_user, v1_pass and v1_api_token are required")))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err := h.store.GetWeatherlinkStation(ctx, uri.StationID)
+	if err == nil {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("weatherlink station already exists")))
+		return
+	}
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	encrypted, err := h.encryptSecrets(req.ApiSecret, req.V1Pass, req.V1ApiToken)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	wl, err := h.store.CreateWeatherlinkStation(ctx, db.CreateWeatherlinkStationParams{
+		StationID:  uri.StationID,
+		Uuid:       util.ToPgText(req.Uuid),
+		ApiKey:     util.ToPgText(req.ApiKey),
+		ApiSecret:  encrypted[0],
+		V1User:     util.ToPgText(req.V1User),
+		V1Pass:     encrypted[1],
+		V1ApiToken: encrypted[2],
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newWeatherlinkStationResponse(wl))
+}
+
+// UpdateWeatherlinkStation
+//
+//	@Summary	Update station WeatherLink credentials
+//	@Tags		weatherlink
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		req			body	weatherlinkStationReq	true	"WeatherLink credentials"
+//	@Security	BearerAuth
+//	@Success	200	{object}	weatherlinkStationRes
+//	@Router		/stations/{station_id}/weatherlink [put]
+func (h *DefaultHandler) UpdateWeatherlinkStation(ctx *gin.Context) {
+	var uri weatherlinkStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req weatherlinkStationReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	encrypted, err := h.encryptSecrets(req.ApiSecret, req.V1Pass, req.V1ApiToken)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// empty fields are left unchanged
+	wl, err := h.store.UpdateWeatherlinkStation(ctx, db.UpdateWeatherlinkStationParams{
+		StationID:  uri.StationID,
+		Uuid:       util.ToPgText(req.Uuid),
+		ApiKey:     util.ToPgText(req.ApiKey),
+		ApiSecret:  encrypted[0],
+		V1User:     util.ToPgText(req.V1User),
+		V1Pass:     encrypted[1],
+		V1ApiToken: encrypted[2],
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("weatherlink station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newWeatherlinkStationResponse(wl))
+}
+
+// DeleteWeatherlinkStation
+//
+//	@Summary	Delete station WeatherLink credentials
+//	@Tags		weatherlink
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/stations/{station_id}/weatherlink [delete]
+func (h *DefaultHandler) DeleteWeatherlinkStation(ctx *gin.Context) {
+	var uri weatherlinkStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := h.store.DeleteWeatherlinkStation(ctx, uri.StationID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type testWeatherlinkStationRes struct {
+	StationID    int64                            `json:"station_id"`
+	Observations []sensor.DavisCurrentObservation `json:"observations"`
+} //@name TestWeatherlinkStationResult
+
+// TestWeatherlinkStation
+//
+//	@Summary	Test the station WeatherLink credentials by fetching the latest observation
+//	@Tags		weatherlink
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	200	{object}	testWeatherlinkStationRes
+//	@Router		/stations/{station_id}/weatherlink/test [post]
+func (h *DefaultHandler) TestWeatherlinkStation(ctx *gin.Context) {
+	var uri weatherlinkStationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	wl, err := h.store.GetWeatherlinkStation(ctx, uri.StationID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("weatherlink station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	creds, err := service.DecryptDavisCredentials(h.secrets, sensor.DavisAPICredentials{
+		User:      wl.V1User.String,
+		Pass:      wl.V1Pass.String,
+		APIToken:  wl.V1ApiToken.String,
+		APIKey:    wl.ApiKey.String,
+		APISecret: wl.ApiSecret.String,
+		StnUUID:   wl.Uuid.String,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	obs, err := h.davisFactory(creds).FetchLatest(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, testWeatherlinkStationRes{
+		StationID:    uri.StationID,
+		Observations: obs,
+	})
+}
+
+// encryptSecrets encrypts the non empty values, empty values stay null
+func (h *DefaultHandler) encryptSecrets(values ...string) ([]pgtype.Text, error) {
+	encrypted := make([]pgtype.Text, len(values))
+	for i, v := range values {
+		if v == "" {
+			continue
+		}
+		enc, err := h.secrets.Encrypt(v)
+		if err != nil {
+			return nil, err
+		}
+		encrypted[i] = util.ToPgText(enc)
+	}
+	return encrypted, nil
+}
@@ -0,0 +1,536 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	mocksensor "github.com/emiliogozo/panahon-api-go/internal/mocks/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWeatherlinkChangesAPI(t *testing.T) {
+	n := 5
+	changes := make([]db.WeatherlinkChange, n)
+	for i := range changes {
+		changes[i] = randomWeatherlinkChange(service.WeatherlinkChangeFirmware)
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "?status=PENDING&page=1&per_page=5",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListWeatherlinkChanges(mock.AnythingOfType("*gin.Context"), db.ListWeatherlinkChangesParams{
+					Status: pgtype.Text{String: WeatherlinkChangePending, Valid: true},
+					Limit:  pgtype.Int4{Int32: 5, Valid: true},
+					Offset: 0,
+				}).Return(changes, nil)
+				store.EXPECT().CountWeatherlinkChanges(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: WeatherlinkChangePending, Valid: true}).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotRes paginatedWeatherlinkChanges
+				err = json.Unmarshal(data, &gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Items, n)
+				require.Equal(t, changes[0].ID, gotRes.Items[0].ID)
+			},
+		},
+		{
+			name:  "InvalidStatus",
+			query: "?status=UNKNOWN",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListWeatherlinkChanges", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListWeatherlinkChanges(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.WeatherlinkChange{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/changes", handler.ListWeatherlinkChanges)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/changes"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestReviewWeatherlinkChangeAPI(t *testing.T) {
+	locChange := randomWeatherlinkChange(service.WeatherlinkChangeLocation)
+	fwChange := randomWeatherlinkChange(service.WeatherlinkChangeFirmware)
+
+	testCases := []struct {
+		name          string
+		change        db.WeatherlinkChange
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore, change db.WeatherlinkChange)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:   "ApproveLocation",
+			change: locChange,
+			body:   gin.H{"status": WeatherlinkChangeApproved},
+			buildStubs: func(store *mockdb.MockStore, change db.WeatherlinkChange) {
+				store.EXPECT().GetWeatherlinkChange(mock.AnythingOfType("*gin.Context"), change.ID).Return(change, nil)
+				store.EXPECT().UpdateStationMetadataTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateStationMetadataTxParams) bool {
+					return arg.StationID == change.StationID &&
+						arg.Lat.Valid && arg.Lat.Float32 == float32(14.5) &&
+						arg.Lon.Valid && arg.Lon.Float32 == float32(121.25) &&
+						arg.Reason == "weatherlink sync"
+				})).Return(db.UpdateStationMetadataTxResult{Changed: true}, nil)
+				reviewed := change
+				reviewed.Status = WeatherlinkChangeApproved
+				store.EXPECT().UpdateWeatherlinkChangeStatus(mock.AnythingOfType("*gin.Context"), db.UpdateWeatherlinkChangeStatusParams{
+					ID:     change.ID,
+					Status: WeatherlinkChangeApproved,
+				}).Return(reviewed, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "ApproveFirmware",
+			change: fwChange,
+			body:   gin.H{"status": WeatherlinkChangeApproved},
+			buildStubs: func(store *mockdb.MockStore, change db.WeatherlinkChange) {
+				store.EXPECT().GetWeatherlinkChange(mock.AnythingOfType("*gin.Context"), change.ID).Return(change, nil)
+				store.EXPECT().UpdateWeatherlinkChangeStatus(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(change, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateStationMetadataTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "RejectLocation",
+			change: locChange,
+			body:   gin.H{"status": WeatherlinkChangeRejected},
+			buildStubs: func(store *mockdb.MockStore, change db.WeatherlinkChange) {
+				store.EXPECT().GetWeatherlinkChange(mock.AnythingOfType("*gin.Context"), change.ID).Return(change, nil)
+				store.EXPECT().UpdateWeatherlinkChangeStatus(mock.AnythingOfType("*gin.Context"), mock.Anything).Return(change, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateStationMetadataTx", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "AlreadyReviewed",
+			change: locChange,
+			body:   gin.H{"status": WeatherlinkChangeApproved},
+			buildStubs: func(store *mockdb.MockStore, change db.WeatherlinkChange) {
+				change.Status = WeatherlinkChangeRejected
+				store.EXPECT().GetWeatherlinkChange(mock.AnythingOfType("*gin.Context"), change.ID).Return(change, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "NotFound",
+			change: locChange,
+			body:   gin.H{"status": WeatherlinkChangeApproved},
+			buildStubs: func(store *mockdb.MockStore, change db.WeatherlinkChange) {
+				store.EXPECT().GetWeatherlinkChange(mock.AnythingOfType("*gin.Context"), change.ID).
+					Return(db.WeatherlinkChange{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidStatus",
+			change: locChange,
+			body:   gin.H{"status": WeatherlinkChangePending},
+			buildStubs: func(store *mockdb.MockStore, change db.WeatherlinkChange) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetWeatherlinkChange", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store, tc.change)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/changes/:id", handler.ReviewWeatherlinkChange)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/changes/%d", tc.change.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomWeatherlinkChange(field string) db.WeatherlinkChange {
+	change := db.WeatherlinkChange{
+		ID:        util.RandomInt[int64](1, 1000),
+		StationID: util.RandomInt[int64](1, 1000),
+		Field:     field,
+		Status:    WeatherlinkChangePending,
+	}
+	if field == service.WeatherlinkChangeLocation {
+		change.OldValue = util.ToPgText("14.600000,121.000000")
+		change.NewValue = util.ToPgText("14.500000,121.250000")
+	} else {
+		change.OldValue = util.ToPgText("1.4.1")
+		change.NewValue = util.ToPgText("1.4.2")
+	}
+	return change
+}
+
+func TestCreateWeatherlinkStationAPI(t *testing.T) {
+	station := randomStation(t)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "V2",
+			body: gin.H{"api_key": "v2key", "api_secret": "v2secret"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).Return(station, nil)
+				store.EXPECT().GetWeatherlinkStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.Weatherlink{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateWeatherlinkStation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateWeatherlinkStationParams) bool {
+					return arg.StationID == station.ID &&
+						arg.ApiKey.String == "v2key" &&
+						util.IsEncryptedSecret(arg.ApiSecret.String) &&
+						!arg.V1Pass.Valid && !arg.V1ApiToken.Valid
+				})).
+					RunAndReturn(func(ctx context.Context, arg db.CreateWeatherlinkStationParams) (db.Weatherlink, error) {
+						return db.Weatherlink{StationID: arg.StationID, ApiKey: arg.ApiKey, ApiSecret: arg.ApiSecret}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				require.NotContains(t, recorder.Body.String(), "v2secret")
+
+				var gotRes weatherlinkStationRes
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotRes)
+				require.NoError(t, err)
+				require.Equal(t, "v2key", gotRes.ApiKey)
+				require.True(t, gotRes.HasApiSecret)
+			},
+		},
+		{
+			name: "V1",
+			body: gin.H{"v1_user": "user", "v1_pass": "pass", "v1_api_token": "token"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).Return(station, nil)
+				store.EXPECT().GetWeatherlinkStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.Weatherlink{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateWeatherlinkStation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateWeatherlinkStationParams) bool {
+					return arg.V1User.String == "user" &&
+						util.IsEncryptedSecret(arg.V1Pass.String) &&
+						util.IsEncryptedSecret(arg.V1ApiToken.String) &&
+						!arg.ApiSecret.Valid
+				})).Return(db.Weatherlink{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "IncompleteCredentials",
+			body: gin.H{"api_key": "v2key", "v1_user": "user"},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateWeatherlinkStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StationNotFound",
+			body: gin.H{"uuid": util.RandomString(32)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadyExists",
+			body: gin.H{"uuid": util.RandomString(32)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).Return(station, nil)
+				store.EXPECT().GetWeatherlinkStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.Weatherlink{StationID: station.ID}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateWeatherlinkStation", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/stations/:station_id/weatherlink", handler.CreateWeatherlinkStation)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/weatherlink", station.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUpdateWeatherlinkStationAPI(t *testing.T) {
+	station := randomStation(t)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"api_secret": "newsecret"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateWeatherlinkStation(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateWeatherlinkStationParams) bool {
+					return arg.StationID == station.ID &&
+						!arg.ApiKey.Valid && !arg.Uuid.Valid &&
+						util.IsEncryptedSecret(arg.ApiSecret.String)
+				})).Return(db.Weatherlink{StationID: station.ID}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"api_key": "newkey"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateWeatherlinkStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.Weatherlink{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/stations/:station_id/weatherlink", handler.UpdateWeatherlinkStation)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/weatherlink", station.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestTestWeatherlinkStationAPI(t *testing.T) {
+	station := randomStation(t)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, davisSensor *mocksensor.MockDavisSensor, secrets *util.SecretCipher)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore, creds sensor.DavisAPICredentials)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, davisSensor *mocksensor.MockDavisSensor, secrets *util.SecretCipher) {
+				encSecret, err := secrets.Encrypt("v2secret")
+				require.NoError(t, err)
+				store.EXPECT().GetWeatherlinkStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.Weatherlink{StationID: station.ID, ApiKey: util.ToPgText("v2key"), ApiSecret: util.ToPgText(encSecret)}, nil)
+				davisSensor.EXPECT().FetchLatest(mock.AnythingOfType("*gin.Context")).
+					Return([]sensor.DavisCurrentObservation{{Temp: pgtype.Float4{Float32: 28.5, Valid: true}}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, creds sensor.DavisAPICredentials) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "v2key", creds.APIKey)
+				require.Equal(t, "v2secret", creds.APISecret)
+
+				var gotRes testWeatherlinkStationRes
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotRes)
+				require.NoError(t, err)
+				require.Len(t, gotRes.Observations, 1)
+				require.InDelta(t, 28.5, gotRes.Observations[0].Temp.Float32, 0.001)
+			},
+		},
+		{
+			name: "InvalidCredentials",
+			buildStubs: func(store *mockdb.MockStore, davisSensor *mocksensor.MockDavisSensor, secrets *util.SecretCipher) {
+				store.EXPECT().GetWeatherlinkStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.Weatherlink{StationID: station.ID, V1User: util.ToPgText("user"), V1Pass: util.ToPgText("pass"), V1ApiToken: util.ToPgText("token")}, nil)
+				davisSensor.EXPECT().FetchLatest(mock.AnythingOfType("*gin.Context")).
+					Return(nil, sensor.ErrDavisInvalidCredentials)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, creds sensor.DavisAPICredentials) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusBadGateway, recorder.Code)
+				require.Equal(t, "pass", creds.Pass)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore, davisSensor *mocksensor.MockDavisSensor, secrets *util.SecretCipher) {
+				store.EXPECT().GetWeatherlinkStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.Weatherlink{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, creds sensor.DavisAPICredentials) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			davisSensor := mocksensor.NewMockDavisSensor(t)
+
+			handler := newTestHandler(store, nil)
+			tc.buildStubs(store, davisSensor, handler.secrets)
+
+			var gotCreds sensor.DavisAPICredentials
+			handler.davisFactory = func(creds sensor.DavisAPICredentials) sensor.DavisSensor {
+				gotCreds = creds
+				return davisSensor
+			}
+
+			router := gin.Default()
+			router.POST("/stations/:station_id/weatherlink/test", handler.TestWeatherlinkStation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/weatherlink/test", station.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store, gotCreds)
+		})
+	}
+}
@@ -1,12 +1,22 @@
 package middlewares
 
 import (
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
 )
 
+// secretQueryKeys are the query parameters that carry credentials, matched case-insensitively
+var secretQueryKeys = []string{"password", "passkey", "token", "access_token"}
+
+// secretRouteParams are the route parameters that carry credentials
+var secretRouteParams = []string{"key"}
+
+const redacted = "REDACTED"
+
 func Zerologger(logger *zerolog.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
@@ -20,8 +30,9 @@ func Zerologger(logger *zerolog.Logger) gin.HandlerFunc {
 		method := c.Request.Method
 		statusCode := c.Writer.Status()
 
+		path = redactPath(c, path)
 		if raw != "" {
-			path = path + "?" + raw
+			path = path + "?" + redactQuery(raw)
 		}
 
 		event := logger.Info()
@@ -42,3 +53,35 @@ func Zerologger(logger *zerolog.Logger) gin.HandlerFunc {
 			Msg("[GIN]")
 	}
 }
+
+// redactPath logs the route pattern instead of the path when a route parameter is a secret
+func redactPath(c *gin.Context, path string) string {
+	for _, p := range secretRouteParams {
+		if _, ok := c.Params.Get(p); ok {
+			return c.FullPath()
+		}
+	}
+	return path
+}
+
+// redactQuery replaces the values of the secret query parameters
+func redactQuery(raw string) string {
+	vals, err := url.ParseQuery(raw)
+	if err != nil {
+		// the query cannot be inspected, so none of it is logged
+		return redacted
+	}
+	found := false
+	for k := range vals {
+		for _, s := range secretQueryKeys {
+			if strings.EqualFold(k, s) {
+				vals[k] = []string{redacted}
+				found = true
+			}
+		}
+	}
+	if !found {
+		return raw
+	}
+	return vals.Encode()
+}
@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZerologger(t *testing.T) {
+	secret := util.RandomString(16)
+
+	testCases := []struct {
+		name     string
+		method   string
+		route    string
+		url      string
+		wantPath string
+	}{
+		{
+			name:     "SecretQuery",
+			method:   http.MethodGet,
+			route:    "/weatherstation/updateweatherstation.php",
+			url:      "/weatherstation/updateweatherstation.php?ID=abc&PASSWORD=" + secret + "&tempf=70",
+			wantPath: "/weatherstation/updateweatherstation.php?ID=abc&PASSWORD=REDACTED&tempf=70",
+		},
+		{
+			name:     "SecretToken",
+			method:   http.MethodPost,
+			route:    "/glabs/load",
+			url:      "/glabs/load?token=" + secret,
+			wantPath: "/glabs/load?token=REDACTED",
+		},
+		{
+			name:     "SecretRouteParam",
+			method:   http.MethodPost,
+			route:    "/ecowitt/:key",
+			url:      "/ecowitt/" + secret,
+			wantPath: "/ecowitt/:key",
+		},
+		{
+			name:     "NoSecret",
+			method:   http.MethodGet,
+			route:    "/stations",
+			url:      "/stations?page=2",
+			wantPath: "/stations?page=2",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := zerolog.New(&buf)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(Zerologger(&logger))
+			router.Handle(tc.method, tc.route, func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(tc.method, tc.url, nil)
+			require.NoError(t, err)
+			router.ServeHTTP(recorder, request)
+
+			out := buf.String()
+			require.False(t, strings.Contains(out, secret), "secret in log: %s", out)
+			require.Contains(t, out, `"path":"`+tc.wantPath+`"`)
+		})
+	}
+}
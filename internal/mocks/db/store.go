@@ -26,6 +26,120 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
+// AcknowledgeStationCommand provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AcknowledgeStationCommand(ctx context.Context, arg db.AcknowledgeStationCommandParams) (db.StationCommand, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeStationCommand")
+	}
+
+	var r0 db.StationCommand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.AcknowledgeStationCommandParams) (db.StationCommand, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.AcknowledgeStationCommandParams) db.StationCommand); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.StationCommand)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.AcknowledgeStationCommandParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_AcknowledgeStationCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcknowledgeStationCommand'
+type MockStore_AcknowledgeStationCommand_Call struct {
+	*mock.Call
+}
+
+// AcknowledgeStationCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.AcknowledgeStationCommandParams
+func (_e *MockStore_Expecter) AcknowledgeStationCommand(ctx interface{}, arg interface{}) *MockStore_AcknowledgeStationCommand_Call {
+	return &MockStore_AcknowledgeStationCommand_Call{Call: _e.mock.On("AcknowledgeStationCommand", ctx, arg)}
+}
+
+func (_c *MockStore_AcknowledgeStationCommand_Call) Run(run func(ctx context.Context, arg db.AcknowledgeStationCommandParams)) *MockStore_AcknowledgeStationCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.AcknowledgeStationCommandParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_AcknowledgeStationCommand_Call) Return(_a0 db.StationCommand, _a1 error) *MockStore_AcknowledgeStationCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_AcknowledgeStationCommand_Call) RunAndReturn(run func(context.Context, db.AcknowledgeStationCommandParams) (db.StationCommand, error)) *MockStore_AcknowledgeStationCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignSimCardTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AssignSimCardTx(ctx context.Context, arg db.AssignSimCardTxParams) (db.AssignSimCardTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignSimCardTx")
+	}
+
+	var r0 db.AssignSimCardTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.AssignSimCardTxParams) (db.AssignSimCardTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.AssignSimCardTxParams) db.AssignSimCardTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.AssignSimCardTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.AssignSimCardTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_AssignSimCardTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignSimCardTx'
+type MockStore_AssignSimCardTx_Call struct {
+	*mock.Call
+}
+
+// AssignSimCardTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.AssignSimCardTxParams
+func (_e *MockStore_Expecter) AssignSimCardTx(ctx interface{}, arg interface{}) *MockStore_AssignSimCardTx_Call {
+	return &MockStore_AssignSimCardTx_Call{Call: _e.mock.On("AssignSimCardTx", ctx, arg)}
+}
+
+func (_c *MockStore_AssignSimCardTx_Call) Run(run func(ctx context.Context, arg db.AssignSimCardTxParams)) *MockStore_AssignSimCardTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.AssignSimCardTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_AssignSimCardTx_Call) Return(_a0 db.AssignSimCardTxResult, _a1 error) *MockStore_AssignSimCardTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_AssignSimCardTx_Call) RunAndReturn(run func(context.Context, db.AssignSimCardTxParams) (db.AssignSimCardTxResult, error)) *MockStore_AssignSimCardTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BatchCreateUserRoles provides a mock function with given fields: ctx, arg
 func (_m *MockStore) BatchCreateUserRoles(ctx context.Context, arg []db.BatchCreateUserRolesParams) *db.BatchCreateUserRolesBatchResults {
 	ret := _m.Called(ctx, arg)
@@ -124,6 +238,63 @@ func (_c *MockStore_BatchDeleteUserRoles_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// BufferPendingStationMessageTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BufferPendingStationMessageTx(ctx context.Context, arg db.BufferPendingStationMessageTxParams) (db.BufferPendingStationMessageTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BufferPendingStationMessageTx")
+	}
+
+	var r0 db.BufferPendingStationMessageTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.BufferPendingStationMessageTxParams) (db.BufferPendingStationMessageTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.BufferPendingStationMessageTxParams) db.BufferPendingStationMessageTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.BufferPendingStationMessageTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.BufferPendingStationMessageTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_BufferPendingStationMessageTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BufferPendingStationMessageTx'
+type MockStore_BufferPendingStationMessageTx_Call struct {
+	*mock.Call
+}
+
+// BufferPendingStationMessageTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.BufferPendingStationMessageTxParams
+func (_e *MockStore_Expecter) BufferPendingStationMessageTx(ctx interface{}, arg interface{}) *MockStore_BufferPendingStationMessageTx_Call {
+	return &MockStore_BufferPendingStationMessageTx_Call{Call: _e.mock.On("BufferPendingStationMessageTx", ctx, arg)}
+}
+
+func (_c *MockStore_BufferPendingStationMessageTx_Call) Run(run func(ctx context.Context, arg db.BufferPendingStationMessageTxParams)) *MockStore_BufferPendingStationMessageTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.BufferPendingStationMessageTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BufferPendingStationMessageTx_Call) Return(_a0 db.BufferPendingStationMessageTxResult, _a1 error) *MockStore_BufferPendingStationMessageTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_BufferPendingStationMessageTx_Call) RunAndReturn(run func(context.Context, db.BufferPendingStationMessageTxParams) (db.BufferPendingStationMessageTxResult, error)) *MockStore_BufferPendingStationMessageTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BulkCreateUserRoles provides a mock function with given fields: ctx, arg
 func (_m *MockStore) BulkCreateUserRoles(ctx context.Context, arg []db.UserRolesParams) ([]db.UserRolesParams, []error) {
 	ret := _m.Called(ctx, arg)
@@ -234,27 +405,29 @@ func (_c *MockStore_BulkDeleteUserRoles_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// CountLufftStationMsg provides a mock function with given fields: ctx, stationID
-func (_m *MockStore) CountLufftStationMsg(ctx context.Context, stationID int64) (int64, error) {
-	ret := _m.Called(ctx, stationID)
+// ClaimDueSmsOutbox provides a mock function with given fields: ctx, limit
+func (_m *MockStore) ClaimDueSmsOutbox(ctx context.Context, limit int32) ([]db.SmsOutbox, error) {
+	ret := _m.Called(ctx, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountLufftStationMsg")
+		panic("no return value specified for ClaimDueSmsOutbox")
 	}
 
-	var r0 int64
+	var r0 []db.SmsOutbox
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
-		return rf(ctx, stationID)
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]db.SmsOutbox, error)); ok {
+		return rf(ctx, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
-		r0 = rf(ctx, stationID)
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []db.SmsOutbox); ok {
+		r0 = rf(ctx, limit)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SmsOutbox)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, stationID)
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -262,55 +435,55 @@ func (_m *MockStore) CountLufftStationMsg(ctx context.Context, stationID int64)
 	return r0, r1
 }
 
-// MockStore_CountLufftStationMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLufftStationMsg'
-type MockStore_CountLufftStationMsg_Call struct {
+// MockStore_ClaimDueSmsOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueSmsOutbox'
+type MockStore_ClaimDueSmsOutbox_Call struct {
 	*mock.Call
 }
 
-// CountLufftStationMsg is a helper method to define mock.On call
+// ClaimDueSmsOutbox is a helper method to define mock.On call
 //   - ctx context.Context
-//   - stationID int64
-func (_e *MockStore_Expecter) CountLufftStationMsg(ctx interface{}, stationID interface{}) *MockStore_CountLufftStationMsg_Call {
-	return &MockStore_CountLufftStationMsg_Call{Call: _e.mock.On("CountLufftStationMsg", ctx, stationID)}
+//   - limit int32
+func (_e *MockStore_Expecter) ClaimDueSmsOutbox(ctx interface{}, limit interface{}) *MockStore_ClaimDueSmsOutbox_Call {
+	return &MockStore_ClaimDueSmsOutbox_Call{Call: _e.mock.On("ClaimDueSmsOutbox", ctx, limit)}
 }
 
-func (_c *MockStore_CountLufftStationMsg_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_CountLufftStationMsg_Call {
+func (_c *MockStore_ClaimDueSmsOutbox_Call) Run(run func(ctx context.Context, limit int32)) *MockStore_ClaimDueSmsOutbox_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int32))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountLufftStationMsg_Call) Return(_a0 int64, _a1 error) *MockStore_CountLufftStationMsg_Call {
+func (_c *MockStore_ClaimDueSmsOutbox_Call) Return(_a0 []db.SmsOutbox, _a1 error) *MockStore_ClaimDueSmsOutbox_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountLufftStationMsg_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockStore_CountLufftStationMsg_Call {
+func (_c *MockStore_ClaimDueSmsOutbox_Call) RunAndReturn(run func(context.Context, int32) ([]db.SmsOutbox, error)) *MockStore_ClaimDueSmsOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountMOObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountMOObservations(ctx context.Context, arg db.CountMOObservationsParams) (int64, error) {
+// ClaimPendingStationTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ClaimPendingStationTx(ctx context.Context, arg db.ClaimPendingStationTxParams) (db.ClaimPendingStationTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountMOObservations")
+		panic("no return value specified for ClaimPendingStationTx")
 	}
 
-	var r0 int64
+	var r0 db.ClaimPendingStationTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountMOObservationsParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ClaimPendingStationTxParams) (db.ClaimPendingStationTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountMOObservationsParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ClaimPendingStationTxParams) db.ClaimPendingStationTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(db.ClaimPendingStationTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountMOObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ClaimPendingStationTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -319,225 +492,196 @@ func (_m *MockStore) CountMOObservations(ctx context.Context, arg db.CountMOObse
 	return r0, r1
 }
 
-// MockStore_CountMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountMOObservations'
-type MockStore_CountMOObservations_Call struct {
+// MockStore_ClaimPendingStationTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPendingStationTx'
+type MockStore_ClaimPendingStationTx_Call struct {
 	*mock.Call
 }
 
-// CountMOObservations is a helper method to define mock.On call
+// ClaimPendingStationTx is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountMOObservationsParams
-func (_e *MockStore_Expecter) CountMOObservations(ctx interface{}, arg interface{}) *MockStore_CountMOObservations_Call {
-	return &MockStore_CountMOObservations_Call{Call: _e.mock.On("CountMOObservations", ctx, arg)}
+//   - arg db.ClaimPendingStationTxParams
+func (_e *MockStore_Expecter) ClaimPendingStationTx(ctx interface{}, arg interface{}) *MockStore_ClaimPendingStationTx_Call {
+	return &MockStore_ClaimPendingStationTx_Call{Call: _e.mock.On("ClaimPendingStationTx", ctx, arg)}
 }
 
-func (_c *MockStore_CountMOObservations_Call) Run(run func(ctx context.Context, arg db.CountMOObservationsParams)) *MockStore_CountMOObservations_Call {
+func (_c *MockStore_ClaimPendingStationTx_Call) Run(run func(ctx context.Context, arg db.ClaimPendingStationTxParams)) *MockStore_ClaimPendingStationTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountMOObservationsParams))
+		run(args[0].(context.Context), args[1].(db.ClaimPendingStationTxParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountMOObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountMOObservations_Call {
+func (_c *MockStore_ClaimPendingStationTx_Call) Return(_a0 db.ClaimPendingStationTxResult, _a1 error) *MockStore_ClaimPendingStationTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountMOObservations_Call) RunAndReturn(run func(context.Context, db.CountMOObservationsParams) (int64, error)) *MockStore_CountMOObservations_Call {
+func (_c *MockStore_ClaimPendingStationTx_Call) RunAndReturn(run func(context.Context, db.ClaimPendingStationTxParams) (db.ClaimPendingStationTxResult, error)) *MockStore_ClaimPendingStationTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountObservations(ctx context.Context, arg db.CountObservationsParams) (int64, error) {
-	ret := _m.Called(ctx, arg)
+// ClearStationMobileNumber provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) ClearStationMobileNumber(ctx context.Context, mobileNumber pgtype.Text) error {
+	ret := _m.Called(ctx, mobileNumber)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountObservations")
-	}
-
-	var r0 int64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountObservationsParams) (int64, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountObservationsParams) int64); ok {
-		r0 = rf(ctx, arg)
-	} else {
-		r0 = ret.Get(0).(int64)
+		panic("no return value specified for ClearStationMobileNumber")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountObservationsParams) error); ok {
-		r1 = rf(ctx, arg)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) error); ok {
+		r0 = rf(ctx, mobileNumber)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CountObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountObservations'
-type MockStore_CountObservations_Call struct {
+// MockStore_ClearStationMobileNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearStationMobileNumber'
+type MockStore_ClearStationMobileNumber_Call struct {
 	*mock.Call
 }
 
-// CountObservations is a helper method to define mock.On call
+// ClearStationMobileNumber is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountObservationsParams
-func (_e *MockStore_Expecter) CountObservations(ctx interface{}, arg interface{}) *MockStore_CountObservations_Call {
-	return &MockStore_CountObservations_Call{Call: _e.mock.On("CountObservations", ctx, arg)}
+//   - mobileNumber pgtype.Text
+func (_e *MockStore_Expecter) ClearStationMobileNumber(ctx interface{}, mobileNumber interface{}) *MockStore_ClearStationMobileNumber_Call {
+	return &MockStore_ClearStationMobileNumber_Call{Call: _e.mock.On("ClearStationMobileNumber", ctx, mobileNumber)}
 }
 
-func (_c *MockStore_CountObservations_Call) Run(run func(ctx context.Context, arg db.CountObservationsParams)) *MockStore_CountObservations_Call {
+func (_c *MockStore_ClearStationMobileNumber_Call) Run(run func(ctx context.Context, mobileNumber pgtype.Text)) *MockStore_ClearStationMobileNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountObservationsParams))
+		run(args[0].(context.Context), args[1].(pgtype.Text))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountObservations_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_ClearStationMobileNumber_Call) Return(_a0 error) *MockStore_ClearStationMobileNumber_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CountObservations_Call) RunAndReturn(run func(context.Context, db.CountObservationsParams) (int64, error)) *MockStore_CountObservations_Call {
+func (_c *MockStore_ClearStationMobileNumber_Call) RunAndReturn(run func(context.Context, pgtype.Text) error) *MockStore_ClearStationMobileNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountRoles provides a mock function with given fields: ctx
-func (_m *MockStore) CountRoles(ctx context.Context) (int64, error) {
-	ret := _m.Called(ctx)
+// CloseSimCardAssignments provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CloseSimCardAssignments(ctx context.Context, arg db.CloseSimCardAssignmentsParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountRoles")
-	}
-
-	var r0 int64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = rf(ctx)
-	} else {
-		r0 = ret.Get(0).(int64)
+		panic("no return value specified for CloseSimCardAssignments")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CloseSimCardAssignmentsParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CountRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRoles'
-type MockStore_CountRoles_Call struct {
+// MockStore_CloseSimCardAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseSimCardAssignments'
+type MockStore_CloseSimCardAssignments_Call struct {
 	*mock.Call
 }
 
-// CountRoles is a helper method to define mock.On call
+// CloseSimCardAssignments is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) CountRoles(ctx interface{}) *MockStore_CountRoles_Call {
-	return &MockStore_CountRoles_Call{Call: _e.mock.On("CountRoles", ctx)}
+//   - arg db.CloseSimCardAssignmentsParams
+func (_e *MockStore_Expecter) CloseSimCardAssignments(ctx interface{}, arg interface{}) *MockStore_CloseSimCardAssignments_Call {
+	return &MockStore_CloseSimCardAssignments_Call{Call: _e.mock.On("CloseSimCardAssignments", ctx, arg)}
 }
 
-func (_c *MockStore_CountRoles_Call) Run(run func(ctx context.Context)) *MockStore_CountRoles_Call {
+func (_c *MockStore_CloseSimCardAssignments_Call) Run(run func(ctx context.Context, arg db.CloseSimCardAssignmentsParams)) *MockStore_CloseSimCardAssignments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(db.CloseSimCardAssignmentsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountRoles_Call) Return(_a0 int64, _a1 error) *MockStore_CountRoles_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_CloseSimCardAssignments_Call) Return(_a0 error) *MockStore_CloseSimCardAssignments_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CountRoles_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountRoles_Call {
+func (_c *MockStore_CloseSimCardAssignments_Call) RunAndReturn(run func(context.Context, db.CloseSimCardAssignmentsParams) error) *MockStore_CloseSimCardAssignments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStationMOObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountStationMOObservations(ctx context.Context, arg db.CountStationMOObservationsParams) (int64, error) {
+// CloseStationMetadataHistory provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CloseStationMetadataHistory(ctx context.Context, arg db.CloseStationMetadataHistoryParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountStationMOObservations")
+		panic("no return value specified for CloseStationMetadataHistory")
 	}
 
-	var r0 int64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationMOObservationsParams) (int64, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationMOObservationsParams) int64); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CloseStationMetadataHistoryParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationMOObservationsParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CountStationMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationMOObservations'
-type MockStore_CountStationMOObservations_Call struct {
+// MockStore_CloseStationMetadataHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseStationMetadataHistory'
+type MockStore_CloseStationMetadataHistory_Call struct {
 	*mock.Call
 }
 
-// CountStationMOObservations is a helper method to define mock.On call
+// CloseStationMetadataHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountStationMOObservationsParams
-func (_e *MockStore_Expecter) CountStationMOObservations(ctx interface{}, arg interface{}) *MockStore_CountStationMOObservations_Call {
-	return &MockStore_CountStationMOObservations_Call{Call: _e.mock.On("CountStationMOObservations", ctx, arg)}
+//   - arg db.CloseStationMetadataHistoryParams
+func (_e *MockStore_Expecter) CloseStationMetadataHistory(ctx interface{}, arg interface{}) *MockStore_CloseStationMetadataHistory_Call {
+	return &MockStore_CloseStationMetadataHistory_Call{Call: _e.mock.On("CloseStationMetadataHistory", ctx, arg)}
 }
 
-func (_c *MockStore_CountStationMOObservations_Call) Run(run func(ctx context.Context, arg db.CountStationMOObservationsParams)) *MockStore_CountStationMOObservations_Call {
+func (_c *MockStore_CloseStationMetadataHistory_Call) Run(run func(ctx context.Context, arg db.CloseStationMetadataHistoryParams)) *MockStore_CloseStationMetadataHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountStationMOObservationsParams))
+		run(args[0].(context.Context), args[1].(db.CloseStationMetadataHistoryParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStationMOObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationMOObservations_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_CloseStationMetadataHistory_Call) Return(_a0 error) *MockStore_CloseStationMetadataHistory_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CountStationMOObservations_Call) RunAndReturn(run func(context.Context, db.CountStationMOObservationsParams) (int64, error)) *MockStore_CountStationMOObservations_Call {
+func (_c *MockStore_CloseStationMetadataHistory_Call) RunAndReturn(run func(context.Context, db.CloseStationMetadataHistoryParams) error) *MockStore_CloseStationMetadataHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStationObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountStationObservations(ctx context.Context, arg db.CountStationObservationsParams) (int64, error) {
+// CompleteGLabsLoad provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CompleteGLabsLoad(ctx context.Context, arg db.CompleteGLabsLoadParams) (db.GlabsLoad, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountStationObservations")
+		panic("no return value specified for CompleteGLabsLoad")
 	}
 
-	var r0 int64
+	var r0 db.GlabsLoad
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationObservationsParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CompleteGLabsLoadParams) (db.GlabsLoad, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationObservationsParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CompleteGLabsLoadParams) db.GlabsLoad); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(db.GlabsLoad)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CompleteGLabsLoadParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -546,56 +690,56 @@ func (_m *MockStore) CountStationObservations(ctx context.Context, arg db.CountS
 	return r0, r1
 }
 
-// MockStore_CountStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationObservations'
-type MockStore_CountStationObservations_Call struct {
+// MockStore_CompleteGLabsLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteGLabsLoad'
+type MockStore_CompleteGLabsLoad_Call struct {
 	*mock.Call
 }
 
-// CountStationObservations is a helper method to define mock.On call
+// CompleteGLabsLoad is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountStationObservationsParams
-func (_e *MockStore_Expecter) CountStationObservations(ctx interface{}, arg interface{}) *MockStore_CountStationObservations_Call {
-	return &MockStore_CountStationObservations_Call{Call: _e.mock.On("CountStationObservations", ctx, arg)}
+//   - arg db.CompleteGLabsLoadParams
+func (_e *MockStore_Expecter) CompleteGLabsLoad(ctx interface{}, arg interface{}) *MockStore_CompleteGLabsLoad_Call {
+	return &MockStore_CompleteGLabsLoad_Call{Call: _e.mock.On("CompleteGLabsLoad", ctx, arg)}
 }
 
-func (_c *MockStore_CountStationObservations_Call) Run(run func(ctx context.Context, arg db.CountStationObservationsParams)) *MockStore_CountStationObservations_Call {
+func (_c *MockStore_CompleteGLabsLoad_Call) Run(run func(ctx context.Context, arg db.CompleteGLabsLoadParams)) *MockStore_CompleteGLabsLoad_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountStationObservationsParams))
+		run(args[0].(context.Context), args[1].(db.CompleteGLabsLoadParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStationObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationObservations_Call {
+func (_c *MockStore_CompleteGLabsLoad_Call) Return(_a0 db.GlabsLoad, _a1 error) *MockStore_CompleteGLabsLoad_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountStationObservations_Call) RunAndReturn(run func(context.Context, db.CountStationObservationsParams) (int64, error)) *MockStore_CountStationObservations_Call {
+func (_c *MockStore_CompleteGLabsLoad_Call) RunAndReturn(run func(context.Context, db.CompleteGLabsLoadParams) (db.GlabsLoad, error)) *MockStore_CompleteGLabsLoad_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStations provides a mock function with given fields: ctx, status
-func (_m *MockStore) CountStations(ctx context.Context, status pgtype.Text) (int64, error) {
-	ret := _m.Called(ctx, status)
+// CountLufftStationMsg provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) CountLufftStationMsg(ctx context.Context, stationID int64) (int64, error) {
+	ret := _m.Called(ctx, stationID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountStations")
+		panic("no return value specified for CountLufftStationMsg")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (int64, error)); ok {
-		return rf(ctx, status)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, stationID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) int64); ok {
-		r0 = rf(ctx, status)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, stationID)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
-		r1 = rf(ctx, status)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -603,55 +747,55 @@ func (_m *MockStore) CountStations(ctx context.Context, status pgtype.Text) (int
 	return r0, r1
 }
 
-// MockStore_CountStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStations'
-type MockStore_CountStations_Call struct {
+// MockStore_CountLufftStationMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLufftStationMsg'
+type MockStore_CountLufftStationMsg_Call struct {
 	*mock.Call
 }
 
-// CountStations is a helper method to define mock.On call
+// CountLufftStationMsg is a helper method to define mock.On call
 //   - ctx context.Context
-//   - status pgtype.Text
-func (_e *MockStore_Expecter) CountStations(ctx interface{}, status interface{}) *MockStore_CountStations_Call {
-	return &MockStore_CountStations_Call{Call: _e.mock.On("CountStations", ctx, status)}
+//   - stationID int64
+func (_e *MockStore_Expecter) CountLufftStationMsg(ctx interface{}, stationID interface{}) *MockStore_CountLufftStationMsg_Call {
+	return &MockStore_CountLufftStationMsg_Call{Call: _e.mock.On("CountLufftStationMsg", ctx, stationID)}
 }
 
-func (_c *MockStore_CountStations_Call) Run(run func(ctx context.Context, status pgtype.Text)) *MockStore_CountStations_Call {
+func (_c *MockStore_CountLufftStationMsg_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_CountLufftStationMsg_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStations_Call {
+func (_c *MockStore_CountLufftStationMsg_Call) Return(_a0 int64, _a1 error) *MockStore_CountLufftStationMsg_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountStations_Call) RunAndReturn(run func(context.Context, pgtype.Text) (int64, error)) *MockStore_CountStations_Call {
+func (_c *MockStore_CountLufftStationMsg_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockStore_CountLufftStationMsg_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStationsWithinBBox provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountStationsWithinBBox(ctx context.Context, arg db.CountStationsWithinBBoxParams) (int64, error) {
+// CountMOObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountMOObservations(ctx context.Context, arg db.CountMOObservationsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountStationsWithinBBox")
+		panic("no return value specified for CountMOObservations")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinBBoxParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountMOObservationsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinBBoxParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountMOObservationsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationsWithinBBoxParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountMOObservationsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -660,56 +804,56 @@ func (_m *MockStore) CountStationsWithinBBox(ctx context.Context, arg db.CountSt
 	return r0, r1
 }
 
-// MockStore_CountStationsWithinBBox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationsWithinBBox'
-type MockStore_CountStationsWithinBBox_Call struct {
+// MockStore_CountMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountMOObservations'
+type MockStore_CountMOObservations_Call struct {
 	*mock.Call
 }
 
-// CountStationsWithinBBox is a helper method to define mock.On call
+// CountMOObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountStationsWithinBBoxParams
-func (_e *MockStore_Expecter) CountStationsWithinBBox(ctx interface{}, arg interface{}) *MockStore_CountStationsWithinBBox_Call {
-	return &MockStore_CountStationsWithinBBox_Call{Call: _e.mock.On("CountStationsWithinBBox", ctx, arg)}
+//   - arg db.CountMOObservationsParams
+func (_e *MockStore_Expecter) CountMOObservations(ctx interface{}, arg interface{}) *MockStore_CountMOObservations_Call {
+	return &MockStore_CountMOObservations_Call{Call: _e.mock.On("CountMOObservations", ctx, arg)}
 }
 
-func (_c *MockStore_CountStationsWithinBBox_Call) Run(run func(ctx context.Context, arg db.CountStationsWithinBBoxParams)) *MockStore_CountStationsWithinBBox_Call {
+func (_c *MockStore_CountMOObservations_Call) Run(run func(ctx context.Context, arg db.CountMOObservationsParams)) *MockStore_CountMOObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountStationsWithinBBoxParams))
+		run(args[0].(context.Context), args[1].(db.CountMOObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStationsWithinBBox_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationsWithinBBox_Call {
+func (_c *MockStore_CountMOObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountMOObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountStationsWithinBBox_Call) RunAndReturn(run func(context.Context, db.CountStationsWithinBBoxParams) (int64, error)) *MockStore_CountStationsWithinBBox_Call {
+func (_c *MockStore_CountMOObservations_Call) RunAndReturn(run func(context.Context, db.CountMOObservationsParams) (int64, error)) *MockStore_CountMOObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStationsWithinRadius provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountStationsWithinRadius(ctx context.Context, arg db.CountStationsWithinRadiusParams) (int64, error) {
-	ret := _m.Called(ctx, arg)
+// CountMisolStations provides a mock function with given fields: ctx, status
+func (_m *MockStore) CountMisolStations(ctx context.Context, status pgtype.Text) (int64, error) {
+	ret := _m.Called(ctx, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountStationsWithinRadius")
+		panic("no return value specified for CountMisolStations")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinRadiusParams) (int64, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (int64, error)); ok {
+		return rf(ctx, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinRadiusParams) int64); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) int64); ok {
+		r0 = rf(ctx, status)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationsWithinRadiusParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -717,56 +861,56 @@ func (_m *MockStore) CountStationsWithinRadius(ctx context.Context, arg db.Count
 	return r0, r1
 }
 
-// MockStore_CountStationsWithinRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationsWithinRadius'
-type MockStore_CountStationsWithinRadius_Call struct {
+// MockStore_CountMisolStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountMisolStations'
+type MockStore_CountMisolStations_Call struct {
 	*mock.Call
 }
 
-// CountStationsWithinRadius is a helper method to define mock.On call
+// CountMisolStations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountStationsWithinRadiusParams
-func (_e *MockStore_Expecter) CountStationsWithinRadius(ctx interface{}, arg interface{}) *MockStore_CountStationsWithinRadius_Call {
-	return &MockStore_CountStationsWithinRadius_Call{Call: _e.mock.On("CountStationsWithinRadius", ctx, arg)}
+//   - status pgtype.Text
+func (_e *MockStore_Expecter) CountMisolStations(ctx interface{}, status interface{}) *MockStore_CountMisolStations_Call {
+	return &MockStore_CountMisolStations_Call{Call: _e.mock.On("CountMisolStations", ctx, status)}
 }
 
-func (_c *MockStore_CountStationsWithinRadius_Call) Run(run func(ctx context.Context, arg db.CountStationsWithinRadiusParams)) *MockStore_CountStationsWithinRadius_Call {
+func (_c *MockStore_CountMisolStations_Call) Run(run func(ctx context.Context, status pgtype.Text)) *MockStore_CountMisolStations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountStationsWithinRadiusParams))
+		run(args[0].(context.Context), args[1].(pgtype.Text))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStationsWithinRadius_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationsWithinRadius_Call {
+func (_c *MockStore_CountMisolStations_Call) Return(_a0 int64, _a1 error) *MockStore_CountMisolStations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountStationsWithinRadius_Call) RunAndReturn(run func(context.Context, db.CountStationsWithinRadiusParams) (int64, error)) *MockStore_CountStationsWithinRadius_Call {
+func (_c *MockStore_CountMisolStations_Call) RunAndReturn(run func(context.Context, pgtype.Text) (int64, error)) *MockStore_CountMisolStations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountUsers provides a mock function with given fields: ctx
-func (_m *MockStore) CountUsers(ctx context.Context) (int64, error) {
-	ret := _m.Called(ctx)
+// CountObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountObservations(ctx context.Context, arg db.CountObservationsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountUsers")
+		panic("no return value specified for CountObservations")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountObservationsParams) (int64, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountObservationsParams) int64); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -774,55 +918,56 @@ func (_m *MockStore) CountUsers(ctx context.Context) (int64, error) {
 	return r0, r1
 }
 
-// MockStore_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
-type MockStore_CountUsers_Call struct {
+// MockStore_CountObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountObservations'
+type MockStore_CountObservations_Call struct {
 	*mock.Call
 }
 
-// CountUsers is a helper method to define mock.On call
+// CountObservations is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) CountUsers(ctx interface{}) *MockStore_CountUsers_Call {
-	return &MockStore_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx)}
+//   - arg db.CountObservationsParams
+func (_e *MockStore_Expecter) CountObservations(ctx interface{}, arg interface{}) *MockStore_CountObservations_Call {
+	return &MockStore_CountObservations_Call{Call: _e.mock.On("CountObservations", ctx, arg)}
 }
 
-func (_c *MockStore_CountUsers_Call) Run(run func(ctx context.Context)) *MockStore_CountUsers_Call {
+func (_c *MockStore_CountObservations_Call) Run(run func(ctx context.Context, arg db.CountObservationsParams)) *MockStore_CountObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(db.CountObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountUsers_Call) Return(_a0 int64, _a1 error) *MockStore_CountUsers_Call {
+func (_c *MockStore_CountObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountUsers_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountUsers_Call {
+func (_c *MockStore_CountObservations_Call) RunAndReturn(run func(context.Context, db.CountObservationsParams) (int64, error)) *MockStore_CountObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateCurrentObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateCurrentObservation(ctx context.Context, arg db.CreateCurrentObservationParams) (db.ObservationsCurrent, error) {
-	ret := _m.Called(ctx, arg)
+// CountOpenStationMaintenanceWindows provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) CountOpenStationMaintenanceWindows(ctx context.Context, stationID int64) (int64, error) {
+	ret := _m.Called(ctx, stationID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCurrentObservation")
+		panic("no return value specified for CountOpenStationMaintenanceWindows")
 	}
 
-	var r0 db.ObservationsCurrent
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateCurrentObservationParams) (db.ObservationsCurrent, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, stationID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateCurrentObservationParams) db.ObservationsCurrent); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, stationID)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsCurrent)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateCurrentObservationParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -830,56 +975,56 @@ func (_m *MockStore) CreateCurrentObservation(ctx context.Context, arg db.Create
 	return r0, r1
 }
 
-// MockStore_CreateCurrentObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCurrentObservation'
-type MockStore_CreateCurrentObservation_Call struct {
+// MockStore_CountOpenStationMaintenanceWindows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenStationMaintenanceWindows'
+type MockStore_CountOpenStationMaintenanceWindows_Call struct {
 	*mock.Call
 }
 
-// CreateCurrentObservation is a helper method to define mock.On call
+// CountOpenStationMaintenanceWindows is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateCurrentObservationParams
-func (_e *MockStore_Expecter) CreateCurrentObservation(ctx interface{}, arg interface{}) *MockStore_CreateCurrentObservation_Call {
-	return &MockStore_CreateCurrentObservation_Call{Call: _e.mock.On("CreateCurrentObservation", ctx, arg)}
+//   - stationID int64
+func (_e *MockStore_Expecter) CountOpenStationMaintenanceWindows(ctx interface{}, stationID interface{}) *MockStore_CountOpenStationMaintenanceWindows_Call {
+	return &MockStore_CountOpenStationMaintenanceWindows_Call{Call: _e.mock.On("CountOpenStationMaintenanceWindows", ctx, stationID)}
 }
 
-func (_c *MockStore_CreateCurrentObservation_Call) Run(run func(ctx context.Context, arg db.CreateCurrentObservationParams)) *MockStore_CreateCurrentObservation_Call {
+func (_c *MockStore_CountOpenStationMaintenanceWindows_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_CountOpenStationMaintenanceWindows_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateCurrentObservationParams))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateCurrentObservation_Call) Return(_a0 db.ObservationsCurrent, _a1 error) *MockStore_CreateCurrentObservation_Call {
+func (_c *MockStore_CountOpenStationMaintenanceWindows_Call) Return(_a0 int64, _a1 error) *MockStore_CountOpenStationMaintenanceWindows_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateCurrentObservation_Call) RunAndReturn(run func(context.Context, db.CreateCurrentObservationParams) (db.ObservationsCurrent, error)) *MockStore_CreateCurrentObservation_Call {
+func (_c *MockStore_CountOpenStationMaintenanceWindows_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockStore_CountOpenStationMaintenanceWindows_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateGLabsLoad provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateGLabsLoad(ctx context.Context, arg db.CreateGLabsLoadParams) (db.GlabsLoad, error) {
-	ret := _m.Called(ctx, arg)
+// CountPendingStations provides a mock function with given fields: ctx
+func (_m *MockStore) CountPendingStations(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateGLabsLoad")
+		panic("no return value specified for CountPendingStations")
 	}
 
-	var r0 db.GlabsLoad
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadParams) (db.GlabsLoad, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadParams) db.GlabsLoad); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(db.GlabsLoad)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateGLabsLoadParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -887,56 +1032,55 @@ func (_m *MockStore) CreateGLabsLoad(ctx context.Context, arg db.CreateGLabsLoad
 	return r0, r1
 }
 
-// MockStore_CreateGLabsLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGLabsLoad'
-type MockStore_CreateGLabsLoad_Call struct {
+// MockStore_CountPendingStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPendingStations'
+type MockStore_CountPendingStations_Call struct {
 	*mock.Call
 }
 
-// CreateGLabsLoad is a helper method to define mock.On call
+// CountPendingStations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateGLabsLoadParams
-func (_e *MockStore_Expecter) CreateGLabsLoad(ctx interface{}, arg interface{}) *MockStore_CreateGLabsLoad_Call {
-	return &MockStore_CreateGLabsLoad_Call{Call: _e.mock.On("CreateGLabsLoad", ctx, arg)}
+func (_e *MockStore_Expecter) CountPendingStations(ctx interface{}) *MockStore_CountPendingStations_Call {
+	return &MockStore_CountPendingStations_Call{Call: _e.mock.On("CountPendingStations", ctx)}
 }
 
-func (_c *MockStore_CreateGLabsLoad_Call) Run(run func(ctx context.Context, arg db.CreateGLabsLoadParams)) *MockStore_CreateGLabsLoad_Call {
+func (_c *MockStore_CountPendingStations_Call) Run(run func(ctx context.Context)) *MockStore_CountPendingStations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateGLabsLoadParams))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateGLabsLoad_Call) Return(_a0 db.GlabsLoad, _a1 error) *MockStore_CreateGLabsLoad_Call {
+func (_c *MockStore_CountPendingStations_Call) Return(_a0 int64, _a1 error) *MockStore_CountPendingStations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateGLabsLoad_Call) RunAndReturn(run func(context.Context, db.CreateGLabsLoadParams) (db.GlabsLoad, error)) *MockStore_CreateGLabsLoad_Call {
+func (_c *MockStore_CountPendingStations_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountPendingStations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateMisolStation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateMisolStation(ctx context.Context, arg db.CreateMisolStationParams) (db.MisolStation, error) {
-	ret := _m.Called(ctx, arg)
+// CountRoles provides a mock function with given fields: ctx
+func (_m *MockStore) CountRoles(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateMisolStation")
+		panic("no return value specified for CountRoles")
 	}
 
-	var r0 db.MisolStation
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateMisolStationParams) (db.MisolStation, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateMisolStationParams) db.MisolStation); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(db.MisolStation)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateMisolStationParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -944,55 +1088,54 @@ func (_m *MockStore) CreateMisolStation(ctx context.Context, arg db.CreateMisolS
 	return r0, r1
 }
 
-// MockStore_CreateMisolStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMisolStation'
-type MockStore_CreateMisolStation_Call struct {
+// MockStore_CountRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRoles'
+type MockStore_CountRoles_Call struct {
 	*mock.Call
 }
 
-// CreateMisolStation is a helper method to define mock.On call
+// CountRoles is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateMisolStationParams
-func (_e *MockStore_Expecter) CreateMisolStation(ctx interface{}, arg interface{}) *MockStore_CreateMisolStation_Call {
-	return &MockStore_CreateMisolStation_Call{Call: _e.mock.On("CreateMisolStation", ctx, arg)}
+func (_e *MockStore_Expecter) CountRoles(ctx interface{}) *MockStore_CountRoles_Call {
+	return &MockStore_CountRoles_Call{Call: _e.mock.On("CountRoles", ctx)}
 }
 
-func (_c *MockStore_CreateMisolStation_Call) Run(run func(ctx context.Context, arg db.CreateMisolStationParams)) *MockStore_CreateMisolStation_Call {
+func (_c *MockStore_CountRoles_Call) Run(run func(ctx context.Context)) *MockStore_CountRoles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateMisolStationParams))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateMisolStation_Call) Return(_a0 db.MisolStation, _a1 error) *MockStore_CreateMisolStation_Call {
+func (_c *MockStore_CountRoles_Call) Return(_a0 int64, _a1 error) *MockStore_CountRoles_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateMisolStation_Call) RunAndReturn(run func(context.Context, db.CreateMisolStationParams) (db.MisolStation, error)) *MockStore_CreateMisolStation_Call {
+func (_c *MockStore_CountRoles_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountRoles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateMisolStationTx provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateMisolStationTx(ctx context.Context, arg db.CreateMisolStationTxParams) (db.CreateMisolStationTxResult, error) {
+// CountSimCards provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSimCards(ctx context.Context, arg db.CountSimCardsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateMisolStationTx")
+		panic("no return value specified for CountSimCards")
 	}
 
-	var r0 db.CreateMisolStationTxResult
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateMisolStationTxParams) (db.CreateMisolStationTxResult, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSimCardsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateMisolStationTxParams) db.CreateMisolStationTxResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSimCardsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.CreateMisolStationTxResult)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateMisolStationTxParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountSimCardsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1001,55 +1144,55 @@ func (_m *MockStore) CreateMisolStationTx(ctx context.Context, arg db.CreateMiso
 	return r0, r1
 }
 
-// MockStore_CreateMisolStationTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMisolStationTx'
-type MockStore_CreateMisolStationTx_Call struct {
+// MockStore_CountSimCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSimCards'
+type MockStore_CountSimCards_Call struct {
 	*mock.Call
 }
 
-// CreateMisolStationTx is a helper method to define mock.On call
+// CountSimCards is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateMisolStationTxParams
-func (_e *MockStore_Expecter) CreateMisolStationTx(ctx interface{}, arg interface{}) *MockStore_CreateMisolStationTx_Call {
-	return &MockStore_CreateMisolStationTx_Call{Call: _e.mock.On("CreateMisolStationTx", ctx, arg)}
+//   - arg db.CountSimCardsParams
+func (_e *MockStore_Expecter) CountSimCards(ctx interface{}, arg interface{}) *MockStore_CountSimCards_Call {
+	return &MockStore_CountSimCards_Call{Call: _e.mock.On("CountSimCards", ctx, arg)}
 }
 
-func (_c *MockStore_CreateMisolStationTx_Call) Run(run func(ctx context.Context, arg db.CreateMisolStationTxParams)) *MockStore_CreateMisolStationTx_Call {
+func (_c *MockStore_CountSimCards_Call) Run(run func(ctx context.Context, arg db.CountSimCardsParams)) *MockStore_CountSimCards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateMisolStationTxParams))
+		run(args[0].(context.Context), args[1].(db.CountSimCardsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateMisolStationTx_Call) Return(_a0 db.CreateMisolStationTxResult, _a1 error) *MockStore_CreateMisolStationTx_Call {
+func (_c *MockStore_CountSimCards_Call) Return(_a0 int64, _a1 error) *MockStore_CountSimCards_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateMisolStationTx_Call) RunAndReturn(run func(context.Context, db.CreateMisolStationTxParams) (db.CreateMisolStationTxResult, error)) *MockStore_CreateMisolStationTx_Call {
+func (_c *MockStore_CountSimCards_Call) RunAndReturn(run func(context.Context, db.CountSimCardsParams) (int64, error)) *MockStore_CountSimCards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRole provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateRole(ctx context.Context, arg db.CreateRoleParams) (db.Role, error) {
+// CountSmsOutbox provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSmsOutbox(ctx context.Context, arg db.CountSmsOutboxParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRole")
+		panic("no return value specified for CountSmsOutbox")
 	}
 
-	var r0 db.Role
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRoleParams) (db.Role, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSmsOutboxParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRoleParams) db.Role); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSmsOutboxParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Role)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateRoleParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountSmsOutboxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1058,55 +1201,55 @@ func (_m *MockStore) CreateRole(ctx context.Context, arg db.CreateRoleParams) (d
 	return r0, r1
 }
 
-// MockStore_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
-type MockStore_CreateRole_Call struct {
+// MockStore_CountSmsOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSmsOutbox'
+type MockStore_CountSmsOutbox_Call struct {
 	*mock.Call
 }
 
-// CreateRole is a helper method to define mock.On call
+// CountSmsOutbox is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateRoleParams
-func (_e *MockStore_Expecter) CreateRole(ctx interface{}, arg interface{}) *MockStore_CreateRole_Call {
-	return &MockStore_CreateRole_Call{Call: _e.mock.On("CreateRole", ctx, arg)}
+//   - arg db.CountSmsOutboxParams
+func (_e *MockStore_Expecter) CountSmsOutbox(ctx interface{}, arg interface{}) *MockStore_CountSmsOutbox_Call {
+	return &MockStore_CountSmsOutbox_Call{Call: _e.mock.On("CountSmsOutbox", ctx, arg)}
 }
 
-func (_c *MockStore_CreateRole_Call) Run(run func(ctx context.Context, arg db.CreateRoleParams)) *MockStore_CreateRole_Call {
+func (_c *MockStore_CountSmsOutbox_Call) Run(run func(ctx context.Context, arg db.CountSmsOutboxParams)) *MockStore_CountSmsOutbox_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateRoleParams))
+		run(args[0].(context.Context), args[1].(db.CountSmsOutboxParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_CreateRole_Call {
+func (_c *MockStore_CountSmsOutbox_Call) Return(_a0 int64, _a1 error) *MockStore_CountSmsOutbox_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateRole_Call) RunAndReturn(run func(context.Context, db.CreateRoleParams) (db.Role, error)) *MockStore_CreateRole_Call {
+func (_c *MockStore_CountSmsOutbox_Call) RunAndReturn(run func(context.Context, db.CountSmsOutboxParams) (int64, error)) *MockStore_CountSmsOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSession provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+// CountStationClockDrift provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationClockDrift(ctx context.Context, arg db.CountStationClockDriftParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSession")
+		panic("no return value specified for CountStationClockDrift")
 	}
 
-	var r0 db.Session
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSessionParams) (db.Session, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationClockDriftParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSessionParams) db.Session); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationClockDriftParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Session)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSessionParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationClockDriftParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1115,55 +1258,55 @@ func (_m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionPara
 	return r0, r1
 }
 
-// MockStore_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
-type MockStore_CreateSession_Call struct {
+// MockStore_CountStationClockDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationClockDrift'
+type MockStore_CountStationClockDrift_Call struct {
 	*mock.Call
 }
 
-// CreateSession is a helper method to define mock.On call
+// CountStationClockDrift is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateSessionParams
-func (_e *MockStore_Expecter) CreateSession(ctx interface{}, arg interface{}) *MockStore_CreateSession_Call {
-	return &MockStore_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, arg)}
+//   - arg db.CountStationClockDriftParams
+func (_e *MockStore_Expecter) CountStationClockDrift(ctx interface{}, arg interface{}) *MockStore_CountStationClockDrift_Call {
+	return &MockStore_CountStationClockDrift_Call{Call: _e.mock.On("CountStationClockDrift", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSession_Call) Run(run func(ctx context.Context, arg db.CreateSessionParams)) *MockStore_CreateSession_Call {
+func (_c *MockStore_CountStationClockDrift_Call) Run(run func(ctx context.Context, arg db.CountStationClockDriftParams)) *MockStore_CountStationClockDrift_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateSessionParams))
+		run(args[0].(context.Context), args[1].(db.CountStationClockDriftParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSession_Call) Return(_a0 db.Session, _a1 error) *MockStore_CreateSession_Call {
+func (_c *MockStore_CountStationClockDrift_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationClockDrift_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSession_Call) RunAndReturn(run func(context.Context, db.CreateSessionParams) (db.Session, error)) *MockStore_CreateSession_Call {
+func (_c *MockStore_CountStationClockDrift_Call) RunAndReturn(run func(context.Context, db.CountStationClockDriftParams) (int64, error)) *MockStore_CountStationClockDrift_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSimAccessToken provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSimAccessToken(ctx context.Context, arg db.CreateSimAccessTokenParams) (db.SimAccessToken, error) {
+// CountStationCommands provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationCommands(ctx context.Context, arg db.CountStationCommandsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSimAccessToken")
+		panic("no return value specified for CountStationCommands")
 	}
 
-	var r0 db.SimAccessToken
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimAccessTokenParams) (db.SimAccessToken, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationCommandsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimAccessTokenParams) db.SimAccessToken); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationCommandsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.SimAccessToken)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimAccessTokenParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationCommandsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1172,55 +1315,55 @@ func (_m *MockStore) CreateSimAccessToken(ctx context.Context, arg db.CreateSimA
 	return r0, r1
 }
 
-// MockStore_CreateSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimAccessToken'
-type MockStore_CreateSimAccessToken_Call struct {
+// MockStore_CountStationCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationCommands'
+type MockStore_CountStationCommands_Call struct {
 	*mock.Call
 }
 
-// CreateSimAccessToken is a helper method to define mock.On call
+// CountStationCommands is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateSimAccessTokenParams
-func (_e *MockStore_Expecter) CreateSimAccessToken(ctx interface{}, arg interface{}) *MockStore_CreateSimAccessToken_Call {
-	return &MockStore_CreateSimAccessToken_Call{Call: _e.mock.On("CreateSimAccessToken", ctx, arg)}
+//   - arg db.CountStationCommandsParams
+func (_e *MockStore_Expecter) CountStationCommands(ctx interface{}, arg interface{}) *MockStore_CountStationCommands_Call {
+	return &MockStore_CountStationCommands_Call{Call: _e.mock.On("CountStationCommands", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSimAccessToken_Call) Run(run func(ctx context.Context, arg db.CreateSimAccessTokenParams)) *MockStore_CreateSimAccessToken_Call {
+func (_c *MockStore_CountStationCommands_Call) Run(run func(ctx context.Context, arg db.CountStationCommandsParams)) *MockStore_CountStationCommands_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateSimAccessTokenParams))
+		run(args[0].(context.Context), args[1].(db.CountStationCommandsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_CreateSimAccessToken_Call {
+func (_c *MockStore_CountStationCommands_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationCommands_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSimAccessToken_Call) RunAndReturn(run func(context.Context, db.CreateSimAccessTokenParams) (db.SimAccessToken, error)) *MockStore_CreateSimAccessToken_Call {
+func (_c *MockStore_CountStationCommands_Call) RunAndReturn(run func(context.Context, db.CountStationCommandsParams) (int64, error)) *MockStore_CountStationCommands_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSimCard provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSimCard(ctx context.Context, arg db.CreateSimCardParams) (db.SimCard, error) {
+// CountStationMOObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationMOObservations(ctx context.Context, arg db.CountStationMOObservationsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSimCard")
+		panic("no return value specified for CountStationMOObservations")
 	}
 
-	var r0 db.SimCard
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardParams) (db.SimCard, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationMOObservationsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardParams) db.SimCard); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationMOObservationsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.SimCard)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimCardParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationMOObservationsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1229,55 +1372,55 @@ func (_m *MockStore) CreateSimCard(ctx context.Context, arg db.CreateSimCardPara
 	return r0, r1
 }
 
-// MockStore_CreateSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimCard'
-type MockStore_CreateSimCard_Call struct {
+// MockStore_CountStationMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationMOObservations'
+type MockStore_CountStationMOObservations_Call struct {
 	*mock.Call
 }
 
-// CreateSimCard is a helper method to define mock.On call
+// CountStationMOObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateSimCardParams
-func (_e *MockStore_Expecter) CreateSimCard(ctx interface{}, arg interface{}) *MockStore_CreateSimCard_Call {
-	return &MockStore_CreateSimCard_Call{Call: _e.mock.On("CreateSimCard", ctx, arg)}
+//   - arg db.CountStationMOObservationsParams
+func (_e *MockStore_Expecter) CountStationMOObservations(ctx interface{}, arg interface{}) *MockStore_CountStationMOObservations_Call {
+	return &MockStore_CountStationMOObservations_Call{Call: _e.mock.On("CountStationMOObservations", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSimCard_Call) Run(run func(ctx context.Context, arg db.CreateSimCardParams)) *MockStore_CreateSimCard_Call {
+func (_c *MockStore_CountStationMOObservations_Call) Run(run func(ctx context.Context, arg db.CountStationMOObservationsParams)) *MockStore_CountStationMOObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateSimCardParams))
+		run(args[0].(context.Context), args[1].(db.CountStationMOObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_CreateSimCard_Call {
+func (_c *MockStore_CountStationMOObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationMOObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSimCard_Call) RunAndReturn(run func(context.Context, db.CreateSimCardParams) (db.SimCard, error)) *MockStore_CreateSimCard_Call {
+func (_c *MockStore_CountStationMOObservations_Call) RunAndReturn(run func(context.Context, db.CountStationMOObservationsParams) (int64, error)) *MockStore_CountStationMOObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateStation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateStation(ctx context.Context, arg db.CreateStationParams) (db.ObservationsStation, error) {
+// CountStationMaintenance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationMaintenance(ctx context.Context, arg db.CountStationMaintenanceParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateStation")
+		panic("no return value specified for CountStationMaintenance")
 	}
 
-	var r0 db.ObservationsStation
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationParams) (db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationMaintenanceParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationParams) db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationMaintenanceParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStation)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationMaintenanceParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1286,56 +1429,56 @@ func (_m *MockStore) CreateStation(ctx context.Context, arg db.CreateStationPara
 	return r0, r1
 }
 
-// MockStore_CreateStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStation'
-type MockStore_CreateStation_Call struct {
+// MockStore_CountStationMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationMaintenance'
+type MockStore_CountStationMaintenance_Call struct {
 	*mock.Call
 }
 
-// CreateStation is a helper method to define mock.On call
+// CountStationMaintenance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateStationParams
-func (_e *MockStore_Expecter) CreateStation(ctx interface{}, arg interface{}) *MockStore_CreateStation_Call {
-	return &MockStore_CreateStation_Call{Call: _e.mock.On("CreateStation", ctx, arg)}
+//   - arg db.CountStationMaintenanceParams
+func (_e *MockStore_Expecter) CountStationMaintenance(ctx interface{}, arg interface{}) *MockStore_CountStationMaintenance_Call {
+	return &MockStore_CountStationMaintenance_Call{Call: _e.mock.On("CountStationMaintenance", ctx, arg)}
 }
 
-func (_c *MockStore_CreateStation_Call) Run(run func(ctx context.Context, arg db.CreateStationParams)) *MockStore_CreateStation_Call {
+func (_c *MockStore_CountStationMaintenance_Call) Run(run func(ctx context.Context, arg db.CountStationMaintenanceParams)) *MockStore_CountStationMaintenance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateStationParams))
+		run(args[0].(context.Context), args[1].(db.CountStationMaintenanceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_CreateStation_Call {
+func (_c *MockStore_CountStationMaintenance_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationMaintenance_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateStation_Call) RunAndReturn(run func(context.Context, db.CreateStationParams) (db.ObservationsStation, error)) *MockStore_CreateStation_Call {
+func (_c *MockStore_CountStationMaintenance_Call) RunAndReturn(run func(context.Context, db.CountStationMaintenanceParams) (int64, error)) *MockStore_CountStationMaintenance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateStationHealth provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateStationHealth(ctx context.Context, arg db.CreateStationHealthParams) (db.ObservationsStationhealth, error) {
-	ret := _m.Called(ctx, arg)
+// CountStationMetadataHistory provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) CountStationMetadataHistory(ctx context.Context, stationID int64) (int64, error) {
+	ret := _m.Called(ctx, stationID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateStationHealth")
+		panic("no return value specified for CountStationMetadataHistory")
 	}
 
-	var r0 db.ObservationsStationhealth
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthParams) (db.ObservationsStationhealth, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, stationID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthParams) db.ObservationsStationhealth); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, stationID)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStationhealth)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationHealthParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1343,55 +1486,55 @@ func (_m *MockStore) CreateStationHealth(ctx context.Context, arg db.CreateStati
 	return r0, r1
 }
 
-// MockStore_CreateStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationHealth'
-type MockStore_CreateStationHealth_Call struct {
+// MockStore_CountStationMetadataHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationMetadataHistory'
+type MockStore_CountStationMetadataHistory_Call struct {
 	*mock.Call
 }
 
-// CreateStationHealth is a helper method to define mock.On call
+// CountStationMetadataHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateStationHealthParams
-func (_e *MockStore_Expecter) CreateStationHealth(ctx interface{}, arg interface{}) *MockStore_CreateStationHealth_Call {
-	return &MockStore_CreateStationHealth_Call{Call: _e.mock.On("CreateStationHealth", ctx, arg)}
+//   - stationID int64
+func (_e *MockStore_Expecter) CountStationMetadataHistory(ctx interface{}, stationID interface{}) *MockStore_CountStationMetadataHistory_Call {
+	return &MockStore_CountStationMetadataHistory_Call{Call: _e.mock.On("CountStationMetadataHistory", ctx, stationID)}
 }
 
-func (_c *MockStore_CreateStationHealth_Call) Run(run func(ctx context.Context, arg db.CreateStationHealthParams)) *MockStore_CreateStationHealth_Call {
+func (_c *MockStore_CountStationMetadataHistory_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_CountStationMetadataHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateStationHealthParams))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_CreateStationHealth_Call {
+func (_c *MockStore_CountStationMetadataHistory_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationMetadataHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateStationHealth_Call) RunAndReturn(run func(context.Context, db.CreateStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_CreateStationHealth_Call {
+func (_c *MockStore_CountStationMetadataHistory_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockStore_CountStationMetadataHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateStationMOObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateStationMOObservation(ctx context.Context, arg db.CreateStationMOObservationParams) (db.ObservationsMoObservation, error) {
+// CountStationObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationObservations(ctx context.Context, arg db.CountStationObservationsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateStationMOObservation")
+		panic("no return value specified for CountStationObservations")
 	}
 
-	var r0 db.ObservationsMoObservation
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMOObservationParams) (db.ObservationsMoObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationObservationsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMOObservationParams) db.ObservationsMoObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationObservationsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsMoObservation)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationMOObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationObservationsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1400,55 +1543,55 @@ func (_m *MockStore) CreateStationMOObservation(ctx context.Context, arg db.Crea
 	return r0, r1
 }
 
-// MockStore_CreateStationMOObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationMOObservation'
-type MockStore_CreateStationMOObservation_Call struct {
+// MockStore_CountStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationObservations'
+type MockStore_CountStationObservations_Call struct {
 	*mock.Call
 }
 
-// CreateStationMOObservation is a helper method to define mock.On call
+// CountStationObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateStationMOObservationParams
-func (_e *MockStore_Expecter) CreateStationMOObservation(ctx interface{}, arg interface{}) *MockStore_CreateStationMOObservation_Call {
-	return &MockStore_CreateStationMOObservation_Call{Call: _e.mock.On("CreateStationMOObservation", ctx, arg)}
+//   - arg db.CountStationObservationsParams
+func (_e *MockStore_Expecter) CountStationObservations(ctx interface{}, arg interface{}) *MockStore_CountStationObservations_Call {
+	return &MockStore_CountStationObservations_Call{Call: _e.mock.On("CountStationObservations", ctx, arg)}
 }
 
-func (_c *MockStore_CreateStationMOObservation_Call) Run(run func(ctx context.Context, arg db.CreateStationMOObservationParams)) *MockStore_CreateStationMOObservation_Call {
+func (_c *MockStore_CountStationObservations_Call) Run(run func(ctx context.Context, arg db.CountStationObservationsParams)) *MockStore_CountStationObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateStationMOObservationParams))
+		run(args[0].(context.Context), args[1].(db.CountStationObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateStationMOObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_CreateStationMOObservation_Call {
+func (_c *MockStore_CountStationObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateStationMOObservation_Call) RunAndReturn(run func(context.Context, db.CreateStationMOObservationParams) (db.ObservationsMoObservation, error)) *MockStore_CreateStationMOObservation_Call {
+func (_c *MockStore_CountStationObservations_Call) RunAndReturn(run func(context.Context, db.CountStationObservationsParams) (int64, error)) *MockStore_CountStationObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateStationObservation(ctx context.Context, arg db.CreateStationObservationParams) (db.ObservationsObservation, error) {
+// CountStationStatusHistory provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationStatusHistory(ctx context.Context, arg db.CountStationStatusHistoryParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateStationObservation")
+		panic("no return value specified for CountStationStatusHistory")
 	}
 
-	var r0 db.ObservationsObservation
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationParams) (db.ObservationsObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationStatusHistoryParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationParams) db.ObservationsObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationStatusHistoryParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsObservation)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationStatusHistoryParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1457,55 +1600,55 @@ func (_m *MockStore) CreateStationObservation(ctx context.Context, arg db.Create
 	return r0, r1
 }
 
-// MockStore_CreateStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationObservation'
-type MockStore_CreateStationObservation_Call struct {
+// MockStore_CountStationStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationStatusHistory'
+type MockStore_CountStationStatusHistory_Call struct {
 	*mock.Call
 }
 
-// CreateStationObservation is a helper method to define mock.On call
+// CountStationStatusHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateStationObservationParams
-func (_e *MockStore_Expecter) CreateStationObservation(ctx interface{}, arg interface{}) *MockStore_CreateStationObservation_Call {
-	return &MockStore_CreateStationObservation_Call{Call: _e.mock.On("CreateStationObservation", ctx, arg)}
+//   - arg db.CountStationStatusHistoryParams
+func (_e *MockStore_Expecter) CountStationStatusHistory(ctx interface{}, arg interface{}) *MockStore_CountStationStatusHistory_Call {
+	return &MockStore_CountStationStatusHistory_Call{Call: _e.mock.On("CountStationStatusHistory", ctx, arg)}
 }
 
-func (_c *MockStore_CreateStationObservation_Call) Run(run func(ctx context.Context, arg db.CreateStationObservationParams)) *MockStore_CreateStationObservation_Call {
+func (_c *MockStore_CountStationStatusHistory_Call) Run(run func(ctx context.Context, arg db.CountStationStatusHistoryParams)) *MockStore_CountStationStatusHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateStationObservationParams))
+		run(args[0].(context.Context), args[1].(db.CountStationStatusHistoryParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_CreateStationObservation_Call {
+func (_c *MockStore_CountStationStatusHistory_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationStatusHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateStationObservation_Call) RunAndReturn(run func(context.Context, db.CreateStationObservationParams) (db.ObservationsObservation, error)) *MockStore_CreateStationObservation_Call {
+func (_c *MockStore_CountStationStatusHistory_Call) RunAndReturn(run func(context.Context, db.CountStationStatusHistoryParams) (int64, error)) *MockStore_CountStationStatusHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// CountStations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStations(ctx context.Context, arg db.CountStationsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for CountStations")
 	}
 
-	var r0 db.User
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (db.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) db.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1514,56 +1657,1584 @@ func (_m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (d
 	return r0, r1
 }
 
-// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockStore_CreateUser_Call struct {
+// MockStore_CountStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStations'
+type MockStore_CountStations_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// CountStations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateUserParams
-func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
-	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//   - arg db.CountStationsParams
+func (_e *MockStore_Expecter) CountStations(ctx interface{}, arg interface{}) *MockStore_CountStations_Call {
+	return &MockStore_CountStations_Call{Call: _e.mock.On("CountStations", ctx, arg)}
 }
 
-func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *MockStore_CreateUser_Call {
+func (_c *MockStore_CountStations_Call) Run(run func(ctx context.Context, arg db.CountStationsParams)) *MockStore_CountStations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateUserParams))
+		run(args[0].(context.Context), args[1].(db.CountStationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) Return(_a0 db.User, _a1 error) *MockStore_CreateUser_Call {
+func (_c *MockStore_CountStations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, db.CreateUserParams) (db.User, error)) *MockStore_CreateUser_Call {
+func (_c *MockStore_CountStations_Call) RunAndReturn(run func(context.Context, db.CountStationsParams) (int64, error)) *MockStore_CountStations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateWeatherlinkStation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateWeatherlinkStation(ctx context.Context, arg db.CreateWeatherlinkStationParams) (db.Weatherlink, error) {
-	ret := _m.Called(ctx, arg)
+// CountUsers provides a mock function with given fields: ctx
+func (_m *MockStore) CountUsers(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateWeatherlinkStation")
+		panic("no return value specified for CountUsers")
 	}
 
-	var r0 db.Weatherlink
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateWeatherlinkStationParams) (db.Weatherlink, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateWeatherlinkStationParams) db.Weatherlink); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(db.Weatherlink)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateWeatherlinkStationParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
+type MockStore_CountUsers_Call struct {
+	*mock.Call
+}
+
+// CountUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) CountUsers(ctx interface{}) *MockStore_CountUsers_Call {
+	return &MockStore_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx)}
+}
+
+func (_c *MockStore_CountUsers_Call) Run(run func(ctx context.Context)) *MockStore_CountUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountUsers_Call) Return(_a0 int64, _a1 error) *MockStore_CountUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountUsers_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountWeatherlinkChanges provides a mock function with given fields: ctx, status
+func (_m *MockStore) CountWeatherlinkChanges(ctx context.Context, status pgtype.Text) (int64, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountWeatherlinkChanges")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (int64, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) int64); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountWeatherlinkChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountWeatherlinkChanges'
+type MockStore_CountWeatherlinkChanges_Call struct {
+	*mock.Call
+}
+
+// CountWeatherlinkChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status pgtype.Text
+func (_e *MockStore_Expecter) CountWeatherlinkChanges(ctx interface{}, status interface{}) *MockStore_CountWeatherlinkChanges_Call {
+	return &MockStore_CountWeatherlinkChanges_Call{Call: _e.mock.On("CountWeatherlinkChanges", ctx, status)}
+}
+
+func (_c *MockStore_CountWeatherlinkChanges_Call) Run(run func(ctx context.Context, status pgtype.Text)) *MockStore_CountWeatherlinkChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountWeatherlinkChanges_Call) Return(_a0 int64, _a1 error) *MockStore_CountWeatherlinkChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountWeatherlinkChanges_Call) RunAndReturn(run func(context.Context, pgtype.Text) (int64, error)) *MockStore_CountWeatherlinkChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCurrentObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateCurrentObservation(ctx context.Context, arg db.CreateCurrentObservationParams) (db.ObservationsCurrent, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCurrentObservation")
+	}
+
+	var r0 db.ObservationsCurrent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateCurrentObservationParams) (db.ObservationsCurrent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateCurrentObservationParams) db.ObservationsCurrent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsCurrent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateCurrentObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateCurrentObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCurrentObservation'
+type MockStore_CreateCurrentObservation_Call struct {
+	*mock.Call
+}
+
+// CreateCurrentObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateCurrentObservationParams
+func (_e *MockStore_Expecter) CreateCurrentObservation(ctx interface{}, arg interface{}) *MockStore_CreateCurrentObservation_Call {
+	return &MockStore_CreateCurrentObservation_Call{Call: _e.mock.On("CreateCurrentObservation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateCurrentObservation_Call) Run(run func(ctx context.Context, arg db.CreateCurrentObservationParams)) *MockStore_CreateCurrentObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateCurrentObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateCurrentObservation_Call) Return(_a0 db.ObservationsCurrent, _a1 error) *MockStore_CreateCurrentObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateCurrentObservation_Call) RunAndReturn(run func(context.Context, db.CreateCurrentObservationParams) (db.ObservationsCurrent, error)) *MockStore_CreateCurrentObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGLabsLoad provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateGLabsLoad(ctx context.Context, arg db.CreateGLabsLoadParams) (db.GlabsLoad, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGLabsLoad")
+	}
+
+	var r0 db.GlabsLoad
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadParams) (db.GlabsLoad, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadParams) db.GlabsLoad); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GlabsLoad)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateGLabsLoadParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateGLabsLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGLabsLoad'
+type MockStore_CreateGLabsLoad_Call struct {
+	*mock.Call
+}
+
+// CreateGLabsLoad is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateGLabsLoadParams
+func (_e *MockStore_Expecter) CreateGLabsLoad(ctx interface{}, arg interface{}) *MockStore_CreateGLabsLoad_Call {
+	return &MockStore_CreateGLabsLoad_Call{Call: _e.mock.On("CreateGLabsLoad", ctx, arg)}
+}
+
+func (_c *MockStore_CreateGLabsLoad_Call) Run(run func(ctx context.Context, arg db.CreateGLabsLoadParams)) *MockStore_CreateGLabsLoad_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateGLabsLoadParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateGLabsLoad_Call) Return(_a0 db.GlabsLoad, _a1 error) *MockStore_CreateGLabsLoad_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateGLabsLoad_Call) RunAndReturn(run func(context.Context, db.CreateGLabsLoadParams) (db.GlabsLoad, error)) *MockStore_CreateGLabsLoad_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGLabsLoadCallback provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateGLabsLoadCallback(ctx context.Context, arg db.CreateGLabsLoadCallbackParams) (db.GlabsLoad, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGLabsLoadCallback")
+	}
+
+	var r0 db.GlabsLoad
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadCallbackParams) (db.GlabsLoad, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadCallbackParams) db.GlabsLoad); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GlabsLoad)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateGLabsLoadCallbackParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateGLabsLoadCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGLabsLoadCallback'
+type MockStore_CreateGLabsLoadCallback_Call struct {
+	*mock.Call
+}
+
+// CreateGLabsLoadCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateGLabsLoadCallbackParams
+func (_e *MockStore_Expecter) CreateGLabsLoadCallback(ctx interface{}, arg interface{}) *MockStore_CreateGLabsLoadCallback_Call {
+	return &MockStore_CreateGLabsLoadCallback_Call{Call: _e.mock.On("CreateGLabsLoadCallback", ctx, arg)}
+}
+
+func (_c *MockStore_CreateGLabsLoadCallback_Call) Run(run func(ctx context.Context, arg db.CreateGLabsLoadCallbackParams)) *MockStore_CreateGLabsLoadCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateGLabsLoadCallbackParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateGLabsLoadCallback_Call) Return(_a0 db.GlabsLoad, _a1 error) *MockStore_CreateGLabsLoadCallback_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateGLabsLoadCallback_Call) RunAndReturn(run func(context.Context, db.CreateGLabsLoadCallbackParams) (db.GlabsLoad, error)) *MockStore_CreateGLabsLoadCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMisolStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMisolStation(ctx context.Context, arg db.CreateMisolStationParams) (db.MisolStation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMisolStation")
+	}
+
+	var r0 db.MisolStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateMisolStationParams) (db.MisolStation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateMisolStationParams) db.MisolStation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.MisolStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateMisolStationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateMisolStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMisolStation'
+type MockStore_CreateMisolStation_Call struct {
+	*mock.Call
+}
+
+// CreateMisolStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateMisolStationParams
+func (_e *MockStore_Expecter) CreateMisolStation(ctx interface{}, arg interface{}) *MockStore_CreateMisolStation_Call {
+	return &MockStore_CreateMisolStation_Call{Call: _e.mock.On("CreateMisolStation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMisolStation_Call) Run(run func(ctx context.Context, arg db.CreateMisolStationParams)) *MockStore_CreateMisolStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateMisolStationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMisolStation_Call) Return(_a0 db.MisolStation, _a1 error) *MockStore_CreateMisolStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateMisolStation_Call) RunAndReturn(run func(context.Context, db.CreateMisolStationParams) (db.MisolStation, error)) *MockStore_CreateMisolStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMisolStationTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMisolStationTx(ctx context.Context, arg db.CreateMisolStationTxParams) (db.CreateMisolStationTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMisolStationTx")
+	}
+
+	var r0 db.CreateMisolStationTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateMisolStationTxParams) (db.CreateMisolStationTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateMisolStationTxParams) db.CreateMisolStationTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateMisolStationTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateMisolStationTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateMisolStationTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMisolStationTx'
+type MockStore_CreateMisolStationTx_Call struct {
+	*mock.Call
+}
+
+// CreateMisolStationTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateMisolStationTxParams
+func (_e *MockStore_Expecter) CreateMisolStationTx(ctx interface{}, arg interface{}) *MockStore_CreateMisolStationTx_Call {
+	return &MockStore_CreateMisolStationTx_Call{Call: _e.mock.On("CreateMisolStationTx", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMisolStationTx_Call) Run(run func(ctx context.Context, arg db.CreateMisolStationTxParams)) *MockStore_CreateMisolStationTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateMisolStationTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMisolStationTx_Call) Return(_a0 db.CreateMisolStationTxResult, _a1 error) *MockStore_CreateMisolStationTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateMisolStationTx_Call) RunAndReturn(run func(context.Context, db.CreateMisolStationTxParams) (db.CreateMisolStationTxResult, error)) *MockStore_CreateMisolStationTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePendingStationMessage provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreatePendingStationMessage(ctx context.Context, arg db.CreatePendingStationMessageParams) (db.PendingStationMessage, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePendingStationMessage")
+	}
+
+	var r0 db.PendingStationMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreatePendingStationMessageParams) (db.PendingStationMessage, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreatePendingStationMessageParams) db.PendingStationMessage); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.PendingStationMessage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreatePendingStationMessageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreatePendingStationMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePendingStationMessage'
+type MockStore_CreatePendingStationMessage_Call struct {
+	*mock.Call
+}
+
+// CreatePendingStationMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreatePendingStationMessageParams
+func (_e *MockStore_Expecter) CreatePendingStationMessage(ctx interface{}, arg interface{}) *MockStore_CreatePendingStationMessage_Call {
+	return &MockStore_CreatePendingStationMessage_Call{Call: _e.mock.On("CreatePendingStationMessage", ctx, arg)}
+}
+
+func (_c *MockStore_CreatePendingStationMessage_Call) Run(run func(ctx context.Context, arg db.CreatePendingStationMessageParams)) *MockStore_CreatePendingStationMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreatePendingStationMessageParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreatePendingStationMessage_Call) Return(_a0 db.PendingStationMessage, _a1 error) *MockStore_CreatePendingStationMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreatePendingStationMessage_Call) RunAndReturn(run func(context.Context, db.CreatePendingStationMessageParams) (db.PendingStationMessage, error)) *MockStore_CreatePendingStationMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRole provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateRole(ctx context.Context, arg db.CreateRoleParams) (db.Role, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRoleParams) (db.Role, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRoleParams) db.Role); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateRoleParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type MockStore_CreateRole_Call struct {
+	*mock.Call
+}
+
+// CreateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateRoleParams
+func (_e *MockStore_Expecter) CreateRole(ctx interface{}, arg interface{}) *MockStore_CreateRole_Call {
+	return &MockStore_CreateRole_Call{Call: _e.mock.On("CreateRole", ctx, arg)}
+}
+
+func (_c *MockStore_CreateRole_Call) Run(run func(ctx context.Context, arg db.CreateRoleParams)) *MockStore_CreateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateRoleParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_CreateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateRole_Call) RunAndReturn(run func(context.Context, db.CreateRoleParams) (db.Role, error)) *MockStore_CreateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSession provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSessionParams) (db.Session, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSessionParams) db.Session); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSessionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type MockStore_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSessionParams
+func (_e *MockStore_Expecter) CreateSession(ctx interface{}, arg interface{}) *MockStore_CreateSession_Call {
+	return &MockStore_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSession_Call) Run(run func(ctx context.Context, arg db.CreateSessionParams)) *MockStore_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSessionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSession_Call) Return(_a0 db.Session, _a1 error) *MockStore_CreateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSession_Call) RunAndReturn(run func(context.Context, db.CreateSessionParams) (db.Session, error)) *MockStore_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSimAccessToken provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSimAccessToken(ctx context.Context, arg db.CreateSimAccessTokenParams) (db.SimAccessToken, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSimAccessToken")
+	}
+
+	var r0 db.SimAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimAccessTokenParams) (db.SimAccessToken, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimAccessTokenParams) db.SimAccessToken); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SimAccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimAccessTokenParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimAccessToken'
+type MockStore_CreateSimAccessToken_Call struct {
+	*mock.Call
+}
+
+// CreateSimAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSimAccessTokenParams
+func (_e *MockStore_Expecter) CreateSimAccessToken(ctx interface{}, arg interface{}) *MockStore_CreateSimAccessToken_Call {
+	return &MockStore_CreateSimAccessToken_Call{Call: _e.mock.On("CreateSimAccessToken", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSimAccessToken_Call) Run(run func(ctx context.Context, arg db.CreateSimAccessTokenParams)) *MockStore_CreateSimAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSimAccessTokenParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_CreateSimAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSimAccessToken_Call) RunAndReturn(run func(context.Context, db.CreateSimAccessTokenParams) (db.SimAccessToken, error)) *MockStore_CreateSimAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSimCard provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSimCard(ctx context.Context, arg db.CreateSimCardParams) (db.SimCard, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSimCard")
+	}
+
+	var r0 db.SimCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardParams) (db.SimCard, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardParams) db.SimCard); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SimCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimCardParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimCard'
+type MockStore_CreateSimCard_Call struct {
+	*mock.Call
+}
+
+// CreateSimCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSimCardParams
+func (_e *MockStore_Expecter) CreateSimCard(ctx interface{}, arg interface{}) *MockStore_CreateSimCard_Call {
+	return &MockStore_CreateSimCard_Call{Call: _e.mock.On("CreateSimCard", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSimCard_Call) Run(run func(ctx context.Context, arg db.CreateSimCardParams)) *MockStore_CreateSimCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSimCardParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_CreateSimCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSimCard_Call) RunAndReturn(run func(context.Context, db.CreateSimCardParams) (db.SimCard, error)) *MockStore_CreateSimCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSimCardAssignment provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSimCardAssignment(ctx context.Context, arg db.CreateSimCardAssignmentParams) (db.SimCardAssignment, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSimCardAssignment")
+	}
+
+	var r0 db.SimCardAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardAssignmentParams) (db.SimCardAssignment, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardAssignmentParams) db.SimCardAssignment); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SimCardAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimCardAssignmentParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSimCardAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimCardAssignment'
+type MockStore_CreateSimCardAssignment_Call struct {
+	*mock.Call
+}
+
+// CreateSimCardAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSimCardAssignmentParams
+func (_e *MockStore_Expecter) CreateSimCardAssignment(ctx interface{}, arg interface{}) *MockStore_CreateSimCardAssignment_Call {
+	return &MockStore_CreateSimCardAssignment_Call{Call: _e.mock.On("CreateSimCardAssignment", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSimCardAssignment_Call) Run(run func(ctx context.Context, arg db.CreateSimCardAssignmentParams)) *MockStore_CreateSimCardAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSimCardAssignmentParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSimCardAssignment_Call) Return(_a0 db.SimCardAssignment, _a1 error) *MockStore_CreateSimCardAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSimCardAssignment_Call) RunAndReturn(run func(context.Context, db.CreateSimCardAssignmentParams) (db.SimCardAssignment, error)) *MockStore_CreateSimCardAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSmsOutbox provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSmsOutbox(ctx context.Context, arg db.CreateSmsOutboxParams) (db.SmsOutbox, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSmsOutbox")
+	}
+
+	var r0 db.SmsOutbox
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSmsOutboxParams) (db.SmsOutbox, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSmsOutboxParams) db.SmsOutbox); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SmsOutbox)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSmsOutboxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSmsOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSmsOutbox'
+type MockStore_CreateSmsOutbox_Call struct {
+	*mock.Call
+}
+
+// CreateSmsOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSmsOutboxParams
+func (_e *MockStore_Expecter) CreateSmsOutbox(ctx interface{}, arg interface{}) *MockStore_CreateSmsOutbox_Call {
+	return &MockStore_CreateSmsOutbox_Call{Call: _e.mock.On("CreateSmsOutbox", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSmsOutbox_Call) Run(run func(ctx context.Context, arg db.CreateSmsOutboxParams)) *MockStore_CreateSmsOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSmsOutboxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSmsOutbox_Call) Return(_a0 db.SmsOutbox, _a1 error) *MockStore_CreateSmsOutbox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSmsOutbox_Call) RunAndReturn(run func(context.Context, db.CreateSmsOutboxParams) (db.SmsOutbox, error)) *MockStore_CreateSmsOutbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSmsPart provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSmsPart(ctx context.Context, arg db.CreateSmsPartParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSmsPart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSmsPartParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateSmsPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSmsPart'
+type MockStore_CreateSmsPart_Call struct {
+	*mock.Call
+}
+
+// CreateSmsPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSmsPartParams
+func (_e *MockStore_Expecter) CreateSmsPart(ctx interface{}, arg interface{}) *MockStore_CreateSmsPart_Call {
+	return &MockStore_CreateSmsPart_Call{Call: _e.mock.On("CreateSmsPart", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSmsPart_Call) Run(run func(ctx context.Context, arg db.CreateSmsPartParams)) *MockStore_CreateSmsPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSmsPartParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSmsPart_Call) Return(_a0 error) *MockStore_CreateSmsPart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateSmsPart_Call) RunAndReturn(run func(context.Context, db.CreateSmsPartParams) error) *MockStore_CreateSmsPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStation(ctx context.Context, arg db.CreateStationParams) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStation")
+	}
+
+	var r0 db.ObservationsStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationParams) (db.ObservationsStation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationParams) db.ObservationsStation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStation'
+type MockStore_CreateStation_Call struct {
+	*mock.Call
+}
+
+// CreateStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationParams
+func (_e *MockStore_Expecter) CreateStation(ctx interface{}, arg interface{}) *MockStore_CreateStation_Call {
+	return &MockStore_CreateStation_Call{Call: _e.mock.On("CreateStation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStation_Call) Run(run func(ctx context.Context, arg db.CreateStationParams)) *MockStore_CreateStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_CreateStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStation_Call) RunAndReturn(run func(context.Context, db.CreateStationParams) (db.ObservationsStation, error)) *MockStore_CreateStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationCommand provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationCommand(ctx context.Context, arg db.CreateStationCommandParams) (db.StationCommand, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStationCommand")
+	}
+
+	var r0 db.StationCommand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationCommandParams) (db.StationCommand, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationCommandParams) db.StationCommand); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.StationCommand)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationCommandParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationCommand'
+type MockStore_CreateStationCommand_Call struct {
+	*mock.Call
+}
+
+// CreateStationCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationCommandParams
+func (_e *MockStore_Expecter) CreateStationCommand(ctx interface{}, arg interface{}) *MockStore_CreateStationCommand_Call {
+	return &MockStore_CreateStationCommand_Call{Call: _e.mock.On("CreateStationCommand", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationCommand_Call) Run(run func(ctx context.Context, arg db.CreateStationCommandParams)) *MockStore_CreateStationCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationCommandParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationCommand_Call) Return(_a0 db.StationCommand, _a1 error) *MockStore_CreateStationCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationCommand_Call) RunAndReturn(run func(context.Context, db.CreateStationCommandParams) (db.StationCommand, error)) *MockStore_CreateStationCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationCommandTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationCommandTx(ctx context.Context, arg db.CreateStationCommandTxParams) (db.CreateStationCommandTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStationCommandTx")
+	}
+
+	var r0 db.CreateStationCommandTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationCommandTxParams) (db.CreateStationCommandTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationCommandTxParams) db.CreateStationCommandTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateStationCommandTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationCommandTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationCommandTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationCommandTx'
+type MockStore_CreateStationCommandTx_Call struct {
+	*mock.Call
+}
+
+// CreateStationCommandTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationCommandTxParams
+func (_e *MockStore_Expecter) CreateStationCommandTx(ctx interface{}, arg interface{}) *MockStore_CreateStationCommandTx_Call {
+	return &MockStore_CreateStationCommandTx_Call{Call: _e.mock.On("CreateStationCommandTx", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationCommandTx_Call) Run(run func(ctx context.Context, arg db.CreateStationCommandTxParams)) *MockStore_CreateStationCommandTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationCommandTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationCommandTx_Call) Return(_a0 db.CreateStationCommandTxResult, _a1 error) *MockStore_CreateStationCommandTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationCommandTx_Call) RunAndReturn(run func(context.Context, db.CreateStationCommandTxParams) (db.CreateStationCommandTxResult, error)) *MockStore_CreateStationCommandTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationHealth provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationHealth(ctx context.Context, arg db.CreateStationHealthParams) (db.ObservationsStationhealth, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStationHealth")
+	}
+
+	var r0 db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthParams) db.ObservationsStationhealth); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationHealthParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationHealth'
+type MockStore_CreateStationHealth_Call struct {
+	*mock.Call
+}
+
+// CreateStationHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationHealthParams
+func (_e *MockStore_Expecter) CreateStationHealth(ctx interface{}, arg interface{}) *MockStore_CreateStationHealth_Call {
+	return &MockStore_CreateStationHealth_Call{Call: _e.mock.On("CreateStationHealth", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationHealth_Call) Run(run func(ctx context.Context, arg db.CreateStationHealthParams)) *MockStore_CreateStationHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationHealthParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_CreateStationHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationHealth_Call) RunAndReturn(run func(context.Context, db.CreateStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_CreateStationHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationMOObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationMOObservation(ctx context.Context, arg db.CreateStationMOObservationParams) (db.ObservationsMoObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStationMOObservation")
+	}
+
+	var r0 db.ObservationsMoObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMOObservationParams) (db.ObservationsMoObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMOObservationParams) db.ObservationsMoObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsMoObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationMOObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationMOObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationMOObservation'
+type MockStore_CreateStationMOObservation_Call struct {
+	*mock.Call
+}
+
+// CreateStationMOObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationMOObservationParams
+func (_e *MockStore_Expecter) CreateStationMOObservation(ctx interface{}, arg interface{}) *MockStore_CreateStationMOObservation_Call {
+	return &MockStore_CreateStationMOObservation_Call{Call: _e.mock.On("CreateStationMOObservation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationMOObservation_Call) Run(run func(ctx context.Context, arg db.CreateStationMOObservationParams)) *MockStore_CreateStationMOObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationMOObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationMOObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_CreateStationMOObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationMOObservation_Call) RunAndReturn(run func(context.Context, db.CreateStationMOObservationParams) (db.ObservationsMoObservation, error)) *MockStore_CreateStationMOObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationMaintenance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationMaintenance(ctx context.Context, arg db.CreateStationMaintenanceParams) (db.StationMaintenance, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStationMaintenance")
+	}
+
+	var r0 db.StationMaintenance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMaintenanceParams) (db.StationMaintenance, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMaintenanceParams) db.StationMaintenance); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.StationMaintenance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationMaintenanceParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationMaintenance'
+type MockStore_CreateStationMaintenance_Call struct {
+	*mock.Call
+}
+
+// CreateStationMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationMaintenanceParams
+func (_e *MockStore_Expecter) CreateStationMaintenance(ctx interface{}, arg interface{}) *MockStore_CreateStationMaintenance_Call {
+	return &MockStore_CreateStationMaintenance_Call{Call: _e.mock.On("CreateStationMaintenance", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationMaintenance_Call) Run(run func(ctx context.Context, arg db.CreateStationMaintenanceParams)) *MockStore_CreateStationMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationMaintenanceParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationMaintenance_Call) Return(_a0 db.StationMaintenance, _a1 error) *MockStore_CreateStationMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationMaintenance_Call) RunAndReturn(run func(context.Context, db.CreateStationMaintenanceParams) (db.StationMaintenance, error)) *MockStore_CreateStationMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationMetadataHistory provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationMetadataHistory(ctx context.Context, arg db.CreateStationMetadataHistoryParams) (db.StationMetadataHistory, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStationMetadataHistory")
+	}
+
+	var r0 db.StationMetadataHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMetadataHistoryParams) (db.StationMetadataHistory, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMetadataHistoryParams) db.StationMetadataHistory); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.StationMetadataHistory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationMetadataHistoryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationMetadataHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationMetadataHistory'
+type MockStore_CreateStationMetadataHistory_Call struct {
+	*mock.Call
+}
+
+// CreateStationMetadataHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationMetadataHistoryParams
+func (_e *MockStore_Expecter) CreateStationMetadataHistory(ctx interface{}, arg interface{}) *MockStore_CreateStationMetadataHistory_Call {
+	return &MockStore_CreateStationMetadataHistory_Call{Call: _e.mock.On("CreateStationMetadataHistory", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationMetadataHistory_Call) Run(run func(ctx context.Context, arg db.CreateStationMetadataHistoryParams)) *MockStore_CreateStationMetadataHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationMetadataHistoryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationMetadataHistory_Call) Return(_a0 db.StationMetadataHistory, _a1 error) *MockStore_CreateStationMetadataHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationMetadataHistory_Call) RunAndReturn(run func(context.Context, db.CreateStationMetadataHistoryParams) (db.StationMetadataHistory, error)) *MockStore_CreateStationMetadataHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationObservation(ctx context.Context, arg db.CreateStationObservationParams) (db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStationObservation")
+	}
+
+	var r0 db.ObservationsObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationParams) (db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationParams) db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationObservation'
+type MockStore_CreateStationObservation_Call struct {
+	*mock.Call
+}
+
+// CreateStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationObservationParams
+func (_e *MockStore_Expecter) CreateStationObservation(ctx interface{}, arg interface{}) *MockStore_CreateStationObservation_Call {
+	return &MockStore_CreateStationObservation_Call{Call: _e.mock.On("CreateStationObservation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationObservation_Call) Run(run func(ctx context.Context, arg db.CreateStationObservationParams)) *MockStore_CreateStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_CreateStationObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationObservation_Call) RunAndReturn(run func(context.Context, db.CreateStationObservationParams) (db.ObservationsObservation, error)) *MockStore_CreateStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationStatusHistory provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationStatusHistory(ctx context.Context, arg db.CreateStationStatusHistoryParams) (db.StationStatusHistory, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStationStatusHistory")
+	}
+
+	var r0 db.StationStatusHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationStatusHistoryParams) (db.StationStatusHistory, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationStatusHistoryParams) db.StationStatusHistory); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.StationStatusHistory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationStatusHistoryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationStatusHistory'
+type MockStore_CreateStationStatusHistory_Call struct {
+	*mock.Call
+}
+
+// CreateStationStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationStatusHistoryParams
+func (_e *MockStore_Expecter) CreateStationStatusHistory(ctx interface{}, arg interface{}) *MockStore_CreateStationStatusHistory_Call {
+	return &MockStore_CreateStationStatusHistory_Call{Call: _e.mock.On("CreateStationStatusHistory", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationStatusHistory_Call) Run(run func(ctx context.Context, arg db.CreateStationStatusHistoryParams)) *MockStore_CreateStationStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationStatusHistoryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationStatusHistory_Call) Return(_a0 db.StationStatusHistory, _a1 error) *MockStore_CreateStationStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationStatusHistory_Call) RunAndReturn(run func(context.Context, db.CreateStationStatusHistoryParams) (db.StationStatusHistory, error)) *MockStore_CreateStationStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUploadStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateUploadStation(ctx context.Context, arg db.CreateUploadStationParams) (db.UploadStation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUploadStation")
+	}
+
+	var r0 db.UploadStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUploadStationParams) (db.UploadStation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUploadStationParams) db.UploadStation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.UploadStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUploadStationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateUploadStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUploadStation'
+type MockStore_CreateUploadStation_Call struct {
+	*mock.Call
+}
+
+// CreateUploadStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateUploadStationParams
+func (_e *MockStore_Expecter) CreateUploadStation(ctx interface{}, arg interface{}) *MockStore_CreateUploadStation_Call {
+	return &MockStore_CreateUploadStation_Call{Call: _e.mock.On("CreateUploadStation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateUploadStation_Call) Run(run func(ctx context.Context, arg db.CreateUploadStationParams)) *MockStore_CreateUploadStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateUploadStationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateUploadStation_Call) Return(_a0 db.UploadStation, _a1 error) *MockStore_CreateUploadStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateUploadStation_Call) RunAndReturn(run func(context.Context, db.CreateUploadStationParams) (db.UploadStation, error)) *MockStore_CreateUploadStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (db.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) db.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockStore_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateUserParams
+func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
+	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *MockStore_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateUserParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) Return(_a0 db.User, _a1 error) *MockStore_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, db.CreateUserParams) (db.User, error)) *MockStore_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWeatherlinkChange provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateWeatherlinkChange(ctx context.Context, arg db.CreateWeatherlinkChangeParams) (db.WeatherlinkChange, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWeatherlinkChange")
+	}
+
+	var r0 db.WeatherlinkChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateWeatherlinkChangeParams) (db.WeatherlinkChange, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateWeatherlinkChangeParams) db.WeatherlinkChange); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.WeatherlinkChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateWeatherlinkChangeParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateWeatherlinkChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWeatherlinkChange'
+type MockStore_CreateWeatherlinkChange_Call struct {
+	*mock.Call
+}
+
+// CreateWeatherlinkChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateWeatherlinkChangeParams
+func (_e *MockStore_Expecter) CreateWeatherlinkChange(ctx interface{}, arg interface{}) *MockStore_CreateWeatherlinkChange_Call {
+	return &MockStore_CreateWeatherlinkChange_Call{Call: _e.mock.On("CreateWeatherlinkChange", ctx, arg)}
+}
+
+func (_c *MockStore_CreateWeatherlinkChange_Call) Run(run func(ctx context.Context, arg db.CreateWeatherlinkChangeParams)) *MockStore_CreateWeatherlinkChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateWeatherlinkChangeParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateWeatherlinkChange_Call) Return(_a0 db.WeatherlinkChange, _a1 error) *MockStore_CreateWeatherlinkChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateWeatherlinkChange_Call) RunAndReturn(run func(context.Context, db.CreateWeatherlinkChangeParams) (db.WeatherlinkChange, error)) *MockStore_CreateWeatherlinkChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWeatherlinkStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateWeatherlinkStation(ctx context.Context, arg db.CreateWeatherlinkStationParams) (db.Weatherlink, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWeatherlinkStation")
+	}
+
+	var r0 db.Weatherlink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateWeatherlinkStationParams) (db.Weatherlink, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateWeatherlinkStationParams) db.Weatherlink); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Weatherlink)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateWeatherlinkStationParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1576,474 +3247,4481 @@ type MockStore_CreateWeatherlinkStation_Call struct {
 	*mock.Call
 }
 
-// CreateWeatherlinkStation is a helper method to define mock.On call
+// CreateWeatherlinkStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateWeatherlinkStationParams
+func (_e *MockStore_Expecter) CreateWeatherlinkStation(ctx interface{}, arg interface{}) *MockStore_CreateWeatherlinkStation_Call {
+	return &MockStore_CreateWeatherlinkStation_Call{Call: _e.mock.On("CreateWeatherlinkStation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateWeatherlinkStation_Call) Run(run func(ctx context.Context, arg db.CreateWeatherlinkStationParams)) *MockStore_CreateWeatherlinkStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateWeatherlinkStationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateWeatherlinkStation_Call) Return(_a0 db.Weatherlink, _a1 error) *MockStore_CreateWeatherlinkStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateWeatherlinkStation_Call) RunAndReturn(run func(context.Context, db.CreateWeatherlinkStationParams) (db.Weatherlink, error)) *MockStore_CreateWeatherlinkStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWeatherlinkStationTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateWeatherlinkStationTx(ctx context.Context, arg db.CreateWeatherlinkStationTxParams) (db.CreateWeatherlinkStationTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWeatherlinkStationTx")
+	}
+
+	var r0 db.CreateWeatherlinkStationTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateWeatherlinkStationTxParams) (db.CreateWeatherlinkStationTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateWeatherlinkStationTxParams) db.CreateWeatherlinkStationTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateWeatherlinkStationTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateWeatherlinkStationTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateWeatherlinkStationTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWeatherlinkStationTx'
+type MockStore_CreateWeatherlinkStationTx_Call struct {
+	*mock.Call
+}
+
+// CreateWeatherlinkStationTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateWeatherlinkStationTxParams
+func (_e *MockStore_Expecter) CreateWeatherlinkStationTx(ctx interface{}, arg interface{}) *MockStore_CreateWeatherlinkStationTx_Call {
+	return &MockStore_CreateWeatherlinkStationTx_Call{Call: _e.mock.On("CreateWeatherlinkStationTx", ctx, arg)}
+}
+
+func (_c *MockStore_CreateWeatherlinkStationTx_Call) Run(run func(ctx context.Context, arg db.CreateWeatherlinkStationTxParams)) *MockStore_CreateWeatherlinkStationTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateWeatherlinkStationTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateWeatherlinkStationTx_Call) Return(_a0 db.CreateWeatherlinkStationTxResult, _a1 error) *MockStore_CreateWeatherlinkStationTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateWeatherlinkStationTx_Call) RunAndReturn(run func(context.Context, db.CreateWeatherlinkStationTxParams) (db.CreateWeatherlinkStationTxResult, error)) *MockStore_CreateWeatherlinkStationTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMisolStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteMisolStation(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMisolStation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMisolStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMisolStation'
+type MockStore_DeleteMisolStation_Call struct {
+	*mock.Call
+}
+
+// DeleteMisolStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteMisolStation(ctx interface{}, id interface{}) *MockStore_DeleteMisolStation_Call {
+	return &MockStore_DeleteMisolStation_Call{Call: _e.mock.On("DeleteMisolStation", ctx, id)}
+}
+
+func (_c *MockStore_DeleteMisolStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteMisolStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMisolStation_Call) Return(_a0 error) *MockStore_DeleteMisolStation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMisolStation_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteMisolStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMisolStationTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteMisolStationTx(ctx context.Context, arg db.DeleteMisolStationTxParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMisolStationTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteMisolStationTxParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteMisolStationTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMisolStationTx'
+type MockStore_DeleteMisolStationTx_Call struct {
+	*mock.Call
+}
+
+// DeleteMisolStationTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteMisolStationTxParams
+func (_e *MockStore_Expecter) DeleteMisolStationTx(ctx interface{}, arg interface{}) *MockStore_DeleteMisolStationTx_Call {
+	return &MockStore_DeleteMisolStationTx_Call{Call: _e.mock.On("DeleteMisolStationTx", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteMisolStationTx_Call) Run(run func(ctx context.Context, arg db.DeleteMisolStationTxParams)) *MockStore_DeleteMisolStationTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteMisolStationTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteMisolStationTx_Call) Return(_a0 error) *MockStore_DeleteMisolStationTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteMisolStationTx_Call) RunAndReturn(run func(context.Context, db.DeleteMisolStationTxParams) error) *MockStore_DeleteMisolStationTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePendingStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeletePendingStation(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePendingStation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeletePendingStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePendingStation'
+type MockStore_DeletePendingStation_Call struct {
+	*mock.Call
+}
+
+// DeletePendingStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeletePendingStation(ctx interface{}, id interface{}) *MockStore_DeletePendingStation_Call {
+	return &MockStore_DeletePendingStation_Call{Call: _e.mock.On("DeletePendingStation", ctx, id)}
+}
+
+func (_c *MockStore_DeletePendingStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeletePendingStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeletePendingStation_Call) Return(_a0 error) *MockStore_DeletePendingStation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeletePendingStation_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeletePendingStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePendingStationMessage provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeletePendingStationMessage(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePendingStationMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeletePendingStationMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePendingStationMessage'
+type MockStore_DeletePendingStationMessage_Call struct {
+	*mock.Call
+}
+
+// DeletePendingStationMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeletePendingStationMessage(ctx interface{}, id interface{}) *MockStore_DeletePendingStationMessage_Call {
+	return &MockStore_DeletePendingStationMessage_Call{Call: _e.mock.On("DeletePendingStationMessage", ctx, id)}
+}
+
+func (_c *MockStore_DeletePendingStationMessage_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeletePendingStationMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeletePendingStationMessage_Call) Return(_a0 error) *MockStore_DeletePendingStationMessage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeletePendingStationMessage_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeletePendingStationMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRole provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteRole(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type MockStore_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteRole(ctx interface{}, id interface{}) *MockStore_DeleteRole_Call {
+	return &MockStore_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, id)}
+}
+
+func (_c *MockStore_DeleteRole_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRole_Call) Return(_a0 error) *MockStore_DeleteRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteRole_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSession provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
+type MockStore_DeleteSession_Call struct {
+	*mock.Call
+}
+
+// DeleteSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteSession(ctx interface{}, id interface{}) *MockStore_DeleteSession_Call {
+	return &MockStore_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSession_Call) Return(_a0 error) *MockStore_DeleteSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSimAccessToken provides a mock function with given fields: ctx, accessToken
+func (_m *MockStore) DeleteSimAccessToken(ctx context.Context, accessToken string) error {
+	ret := _m.Called(ctx, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSimAccessToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, accessToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSimAccessToken'
+type MockStore_DeleteSimAccessToken_Call struct {
+	*mock.Call
+}
+
+// DeleteSimAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+func (_e *MockStore_Expecter) DeleteSimAccessToken(ctx interface{}, accessToken interface{}) *MockStore_DeleteSimAccessToken_Call {
+	return &MockStore_DeleteSimAccessToken_Call{Call: _e.mock.On("DeleteSimAccessToken", ctx, accessToken)}
+}
+
+func (_c *MockStore_DeleteSimAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockStore_DeleteSimAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSimAccessToken_Call) Return(_a0 error) *MockStore_DeleteSimAccessToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteSimAccessToken_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_DeleteSimAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSimCard provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) DeleteSimCard(ctx context.Context, mobileNumber string) error {
+	ret := _m.Called(ctx, mobileNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSimCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSimCard'
+type MockStore_DeleteSimCard_Call struct {
+	*mock.Call
+}
+
+// DeleteSimCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber string
+func (_e *MockStore_Expecter) DeleteSimCard(ctx interface{}, mobileNumber interface{}) *MockStore_DeleteSimCard_Call {
+	return &MockStore_DeleteSimCard_Call{Call: _e.mock.On("DeleteSimCard", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_DeleteSimCard_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_DeleteSimCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSimCard_Call) Return(_a0 error) *MockStore_DeleteSimCard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteSimCard_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_DeleteSimCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSmsParts provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteSmsParts(ctx context.Context, arg db.DeleteSmsPartsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSmsParts")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteSmsPartsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteSmsPartsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.DeleteSmsPartsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteSmsParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSmsParts'
+type MockStore_DeleteSmsParts_Call struct {
+	*mock.Call
+}
+
+// DeleteSmsParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteSmsPartsParams
+func (_e *MockStore_Expecter) DeleteSmsParts(ctx interface{}, arg interface{}) *MockStore_DeleteSmsParts_Call {
+	return &MockStore_DeleteSmsParts_Call{Call: _e.mock.On("DeleteSmsParts", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteSmsParts_Call) Run(run func(ctx context.Context, arg db.DeleteSmsPartsParams)) *MockStore_DeleteSmsParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteSmsPartsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSmsParts_Call) Return(_a0 int64, _a1 error) *MockStore_DeleteSmsParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteSmsParts_Call) RunAndReturn(run func(context.Context, db.DeleteSmsPartsParams) (int64, error)) *MockStore_DeleteSmsParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStaleSmsParts provides a mock function with given fields: ctx, receivedAt
+func (_m *MockStore) DeleteStaleSmsParts(ctx context.Context, receivedAt pgtype.Timestamptz) error {
+	ret := _m.Called(ctx, receivedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStaleSmsParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r0 = rf(ctx, receivedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStaleSmsParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStaleSmsParts'
+type MockStore_DeleteStaleSmsParts_Call struct {
+	*mock.Call
+}
+
+// DeleteStaleSmsParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receivedAt pgtype.Timestamptz
+func (_e *MockStore_Expecter) DeleteStaleSmsParts(ctx interface{}, receivedAt interface{}) *MockStore_DeleteStaleSmsParts_Call {
+	return &MockStore_DeleteStaleSmsParts_Call{Call: _e.mock.On("DeleteStaleSmsParts", ctx, receivedAt)}
+}
+
+func (_c *MockStore_DeleteStaleSmsParts_Call) Run(run func(ctx context.Context, receivedAt pgtype.Timestamptz)) *MockStore_DeleteStaleSmsParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStaleSmsParts_Call) Return(_a0 error) *MockStore_DeleteStaleSmsParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStaleSmsParts_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) error) *MockStore_DeleteStaleSmsParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteStation(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStation")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStation'
+type MockStore_DeleteStation_Call struct {
+	*mock.Call
+}
+
+// DeleteStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteStation(ctx interface{}, id interface{}) *MockStore_DeleteStation_Call {
+	return &MockStore_DeleteStation_Call{Call: _e.mock.On("DeleteStation", ctx, id)}
+}
+
+func (_c *MockStore_DeleteStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStation_Call) Return(_a0 int64, _a1 error) *MockStore_DeleteStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteStation_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockStore_DeleteStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationClock provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) DeleteStationClock(ctx context.Context, stationID int64) error {
+	ret := _m.Called(ctx, stationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStationClock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationClock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationClock'
+type MockStore_DeleteStationClock_Call struct {
+	*mock.Call
+}
+
+// DeleteStationClock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) DeleteStationClock(ctx interface{}, stationID interface{}) *MockStore_DeleteStationClock_Call {
+	return &MockStore_DeleteStationClock_Call{Call: _e.mock.On("DeleteStationClock", ctx, stationID)}
+}
+
+func (_c *MockStore_DeleteStationClock_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_DeleteStationClock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationClock_Call) Return(_a0 error) *MockStore_DeleteStationClock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationClock_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteStationClock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationHealth provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteStationHealth(ctx context.Context, arg db.DeleteStationHealthParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStationHealth")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationHealthParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationHealth'
+type MockStore_DeleteStationHealth_Call struct {
+	*mock.Call
+}
+
+// DeleteStationHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteStationHealthParams
+func (_e *MockStore_Expecter) DeleteStationHealth(ctx interface{}, arg interface{}) *MockStore_DeleteStationHealth_Call {
+	return &MockStore_DeleteStationHealth_Call{Call: _e.mock.On("DeleteStationHealth", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteStationHealth_Call) Run(run func(ctx context.Context, arg db.DeleteStationHealthParams)) *MockStore_DeleteStationHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteStationHealthParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationHealth_Call) Return(_a0 error) *MockStore_DeleteStationHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationHealth_Call) RunAndReturn(run func(context.Context, db.DeleteStationHealthParams) error) *MockStore_DeleteStationHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationMOObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteStationMOObservation(ctx context.Context, arg db.DeleteStationMOObservationParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStationMOObservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationMOObservationParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationMOObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationMOObservation'
+type MockStore_DeleteStationMOObservation_Call struct {
+	*mock.Call
+}
+
+// DeleteStationMOObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteStationMOObservationParams
+func (_e *MockStore_Expecter) DeleteStationMOObservation(ctx interface{}, arg interface{}) *MockStore_DeleteStationMOObservation_Call {
+	return &MockStore_DeleteStationMOObservation_Call{Call: _e.mock.On("DeleteStationMOObservation", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteStationMOObservation_Call) Run(run func(ctx context.Context, arg db.DeleteStationMOObservationParams)) *MockStore_DeleteStationMOObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteStationMOObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationMOObservation_Call) Return(_a0 error) *MockStore_DeleteStationMOObservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationMOObservation_Call) RunAndReturn(run func(context.Context, db.DeleteStationMOObservationParams) error) *MockStore_DeleteStationMOObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationMaintenance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteStationMaintenance(ctx context.Context, arg db.DeleteStationMaintenanceParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStationMaintenance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationMaintenanceParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationMaintenance'
+type MockStore_DeleteStationMaintenance_Call struct {
+	*mock.Call
+}
+
+// DeleteStationMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteStationMaintenanceParams
+func (_e *MockStore_Expecter) DeleteStationMaintenance(ctx interface{}, arg interface{}) *MockStore_DeleteStationMaintenance_Call {
+	return &MockStore_DeleteStationMaintenance_Call{Call: _e.mock.On("DeleteStationMaintenance", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteStationMaintenance_Call) Run(run func(ctx context.Context, arg db.DeleteStationMaintenanceParams)) *MockStore_DeleteStationMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteStationMaintenanceParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationMaintenance_Call) Return(_a0 error) *MockStore_DeleteStationMaintenance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationMaintenance_Call) RunAndReturn(run func(context.Context, db.DeleteStationMaintenanceParams) error) *MockStore_DeleteStationMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteStationObservation(ctx context.Context, arg db.DeleteStationObservationParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStationObservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationObservationParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationObservation'
+type MockStore_DeleteStationObservation_Call struct {
+	*mock.Call
+}
+
+// DeleteStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteStationObservationParams
+func (_e *MockStore_Expecter) DeleteStationObservation(ctx interface{}, arg interface{}) *MockStore_DeleteStationObservation_Call {
+	return &MockStore_DeleteStationObservation_Call{Call: _e.mock.On("DeleteStationObservation", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteStationObservation_Call) Run(run func(ctx context.Context, arg db.DeleteStationObservationParams)) *MockStore_DeleteStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteStationObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationObservation_Call) Return(_a0 error) *MockStore_DeleteStationObservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationObservation_Call) RunAndReturn(run func(context.Context, db.DeleteStationObservationParams) error) *MockStore_DeleteStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUploadStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteUploadStation(ctx context.Context, arg db.DeleteUploadStationParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUploadStation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteUploadStationParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteUploadStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUploadStation'
+type MockStore_DeleteUploadStation_Call struct {
+	*mock.Call
+}
+
+// DeleteUploadStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteUploadStationParams
+func (_e *MockStore_Expecter) DeleteUploadStation(ctx interface{}, arg interface{}) *MockStore_DeleteUploadStation_Call {
+	return &MockStore_DeleteUploadStation_Call{Call: _e.mock.On("DeleteUploadStation", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteUploadStation_Call) Run(run func(ctx context.Context, arg db.DeleteUploadStationParams)) *MockStore_DeleteUploadStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteUploadStationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteUploadStation_Call) Return(_a0 error) *MockStore_DeleteUploadStation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteUploadStation_Call) RunAndReturn(run func(context.Context, db.DeleteUploadStationParams) error) *MockStore_DeleteUploadStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteUser(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockStore_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockStore_DeleteUser_Call {
+	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *MockStore_DeleteUser_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) Return(_a0 error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWeatherlinkStation provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) DeleteWeatherlinkStation(ctx context.Context, stationID int64) error {
+	ret := _m.Called(ctx, stationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWeatherlinkStation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteWeatherlinkStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWeatherlinkStation'
+type MockStore_DeleteWeatherlinkStation_Call struct {
+	*mock.Call
+}
+
+// DeleteWeatherlinkStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) DeleteWeatherlinkStation(ctx interface{}, stationID interface{}) *MockStore_DeleteWeatherlinkStation_Call {
+	return &MockStore_DeleteWeatherlinkStation_Call{Call: _e.mock.On("DeleteWeatherlinkStation", ctx, stationID)}
+}
+
+func (_c *MockStore_DeleteWeatherlinkStation_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_DeleteWeatherlinkStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteWeatherlinkStation_Call) Return(_a0 error) *MockStore_DeleteWeatherlinkStation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteWeatherlinkStation_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteWeatherlinkStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtendSimCardLoad provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) ExtendSimCardLoad(ctx context.Context, mobileNumber string) error {
+	ret := _m.Called(ctx, mobileNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtendSimCardLoad")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_ExtendSimCardLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtendSimCardLoad'
+type MockStore_ExtendSimCardLoad_Call struct {
+	*mock.Call
+}
+
+// ExtendSimCardLoad is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber string
+func (_e *MockStore_Expecter) ExtendSimCardLoad(ctx interface{}, mobileNumber interface{}) *MockStore_ExtendSimCardLoad_Call {
+	return &MockStore_ExtendSimCardLoad_Call{Call: _e.mock.On("ExtendSimCardLoad", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_ExtendSimCardLoad_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_ExtendSimCardLoad_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_ExtendSimCardLoad_Call) Return(_a0 error) *MockStore_ExtendSimCardLoad_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_ExtendSimCardLoad_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_ExtendSimCardLoad_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FirstOrCreateSimAccessTokenTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FirstOrCreateSimAccessTokenTx(ctx context.Context, arg db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FirstOrCreateSimAccessTokenTx")
+	}
+
+	var r0 db.FirstOrCreateSimAccessTokenTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) db.FirstOrCreateSimAccessTokenTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.FirstOrCreateSimAccessTokenTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FirstOrCreateSimAccessTokenTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FirstOrCreateSimAccessTokenTx'
+type MockStore_FirstOrCreateSimAccessTokenTx_Call struct {
+	*mock.Call
+}
+
+// FirstOrCreateSimAccessTokenTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.FirstOrCreateSimAccessTokenTxParams
+func (_e *MockStore_Expecter) FirstOrCreateSimAccessTokenTx(ctx interface{}, arg interface{}) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+	return &MockStore_FirstOrCreateSimAccessTokenTx_Call{Call: _e.mock.On("FirstOrCreateSimAccessTokenTx", ctx, arg)}
+}
+
+func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) Run(run func(ctx context.Context, arg db.FirstOrCreateSimAccessTokenTxParams)) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.FirstOrCreateSimAccessTokenTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) Return(_a0 db.FirstOrCreateSimAccessTokenTxResult, _a1 error) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) RunAndReturn(run func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error)) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveSimCardAssignment provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) GetActiveSimCardAssignment(ctx context.Context, mobileNumber string) (db.SimCardAssignment, error) {
+	ret := _m.Called(ctx, mobileNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSimCardAssignment")
+	}
+
+	var r0 db.SimCardAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimCardAssignment, error)); ok {
+		return rf(ctx, mobileNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimCardAssignment); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Get(0).(db.SimCardAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mobileNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetActiveSimCardAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveSimCardAssignment'
+type MockStore_GetActiveSimCardAssignment_Call struct {
+	*mock.Call
+}
+
+// GetActiveSimCardAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber string
+func (_e *MockStore_Expecter) GetActiveSimCardAssignment(ctx interface{}, mobileNumber interface{}) *MockStore_GetActiveSimCardAssignment_Call {
+	return &MockStore_GetActiveSimCardAssignment_Call{Call: _e.mock.On("GetActiveSimCardAssignment", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_GetActiveSimCardAssignment_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_GetActiveSimCardAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetActiveSimCardAssignment_Call) Return(_a0 db.SimCardAssignment, _a1 error) *MockStore_GetActiveSimCardAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetActiveSimCardAssignment_Call) RunAndReturn(run func(context.Context, string) (db.SimCardAssignment, error)) *MockStore_GetActiveSimCardAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrentStationMetadataForUpdate provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) GetCurrentStationMetadataForUpdate(ctx context.Context, stationID int64) (db.StationMetadataHistory, error) {
+	ret := _m.Called(ctx, stationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentStationMetadataForUpdate")
+	}
+
+	var r0 db.StationMetadataHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.StationMetadataHistory, error)); ok {
+		return rf(ctx, stationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.StationMetadataHistory); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Get(0).(db.StationMetadataHistory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetCurrentStationMetadataForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentStationMetadataForUpdate'
+type MockStore_GetCurrentStationMetadataForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetCurrentStationMetadataForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) GetCurrentStationMetadataForUpdate(ctx interface{}, stationID interface{}) *MockStore_GetCurrentStationMetadataForUpdate_Call {
+	return &MockStore_GetCurrentStationMetadataForUpdate_Call{Call: _e.mock.On("GetCurrentStationMetadataForUpdate", ctx, stationID)}
+}
+
+func (_c *MockStore_GetCurrentStationMetadataForUpdate_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_GetCurrentStationMetadataForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetCurrentStationMetadataForUpdate_Call) Return(_a0 db.StationMetadataHistory, _a1 error) *MockStore_GetCurrentStationMetadataForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetCurrentStationMetadataForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.StationMetadataHistory, error)) *MockStore_GetCurrentStationMetadataForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetDeletedStation(ctx context.Context, id int64) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedStation")
+	}
+
+	var r0 db.ObservationsStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsStation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsStation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDeletedStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedStation'
+type MockStore_GetDeletedStation_Call struct {
+	*mock.Call
+}
+
+// GetDeletedStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetDeletedStation(ctx interface{}, id interface{}) *MockStore_GetDeletedStation_Call {
+	return &MockStore_GetDeletedStation_Call{Call: _e.mock.On("GetDeletedStation", ctx, id)}
+}
+
+func (_c *MockStore_GetDeletedStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetDeletedStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDeletedStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetDeletedStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDeletedStation_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsStation, error)) *MockStore_GetDeletedStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestSimAccessToken provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetLatestSimAccessToken(ctx context.Context, arg db.GetLatestSimAccessTokenParams) (db.SimAccessToken, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestSimAccessToken")
+	}
+
+	var r0 db.SimAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetLatestSimAccessTokenParams) (db.SimAccessToken, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetLatestSimAccessTokenParams) db.SimAccessToken); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SimAccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetLatestSimAccessTokenParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestSimAccessToken'
+type MockStore_GetLatestSimAccessToken_Call struct {
+	*mock.Call
+}
+
+// GetLatestSimAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetLatestSimAccessTokenParams
+func (_e *MockStore_Expecter) GetLatestSimAccessToken(ctx interface{}, arg interface{}) *MockStore_GetLatestSimAccessToken_Call {
+	return &MockStore_GetLatestSimAccessToken_Call{Call: _e.mock.On("GetLatestSimAccessToken", ctx, arg)}
+}
+
+func (_c *MockStore_GetLatestSimAccessToken_Call) Run(run func(ctx context.Context, arg db.GetLatestSimAccessTokenParams)) *MockStore_GetLatestSimAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetLatestSimAccessTokenParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_GetLatestSimAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestSimAccessToken_Call) RunAndReturn(run func(context.Context, db.GetLatestSimAccessTokenParams) (db.SimAccessToken, error)) *MockStore_GetLatestSimAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestStationObservation provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetLatestStationObservation(ctx context.Context, id int64) (db.GetLatestStationObservationRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestStationObservation")
+	}
+
+	var r0 db.GetLatestStationObservationRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.GetLatestStationObservationRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.GetLatestStationObservationRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.GetLatestStationObservationRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStationObservation'
+type MockStore_GetLatestStationObservation_Call struct {
+	*mock.Call
+}
+
+// GetLatestStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetLatestStationObservation(ctx interface{}, id interface{}) *MockStore_GetLatestStationObservation_Call {
+	return &MockStore_GetLatestStationObservation_Call{Call: _e.mock.On("GetLatestStationObservation", ctx, id)}
+}
+
+func (_c *MockStore_GetLatestStationObservation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetLatestStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestStationObservation_Call) Return(_a0 db.GetLatestStationObservationRow, _a1 error) *MockStore_GetLatestStationObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestStationObservation_Call) RunAndReturn(run func(context.Context, int64) (db.GetLatestStationObservationRow, error)) *MockStore_GetLatestStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMisolStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetMisolStation(ctx context.Context, id int64) (db.MisolStation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMisolStation")
+	}
+
+	var r0 db.MisolStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.MisolStation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.MisolStation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.MisolStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMisolStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMisolStation'
+type MockStore_GetMisolStation_Call struct {
+	*mock.Call
+}
+
+// GetMisolStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetMisolStation(ctx interface{}, id interface{}) *MockStore_GetMisolStation_Call {
+	return &MockStore_GetMisolStation_Call{Call: _e.mock.On("GetMisolStation", ctx, id)}
+}
+
+func (_c *MockStore_GetMisolStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetMisolStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMisolStation_Call) Return(_a0 db.MisolStation, _a1 error) *MockStore_GetMisolStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMisolStation_Call) RunAndReturn(run func(context.Context, int64) (db.MisolStation, error)) *MockStore_GetMisolStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNearestLatestStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetNearestLatestStationObservation(ctx context.Context, arg db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNearestLatestStationObservation")
+	}
+
+	var r0 db.GetNearestLatestStationObservationRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetNearestLatestStationObservationParams) db.GetNearestLatestStationObservationRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GetNearestLatestStationObservationRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetNearestLatestStationObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetNearestLatestStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNearestLatestStationObservation'
+type MockStore_GetNearestLatestStationObservation_Call struct {
+	*mock.Call
+}
+
+// GetNearestLatestStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetNearestLatestStationObservationParams
+func (_e *MockStore_Expecter) GetNearestLatestStationObservation(ctx interface{}, arg interface{}) *MockStore_GetNearestLatestStationObservation_Call {
+	return &MockStore_GetNearestLatestStationObservation_Call{Call: _e.mock.On("GetNearestLatestStationObservation", ctx, arg)}
+}
+
+func (_c *MockStore_GetNearestLatestStationObservation_Call) Run(run func(ctx context.Context, arg db.GetNearestLatestStationObservationParams)) *MockStore_GetNearestLatestStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetNearestLatestStationObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetNearestLatestStationObservation_Call) Return(_a0 db.GetNearestLatestStationObservationRow, _a1 error) *MockStore_GetNearestLatestStationObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetNearestLatestStationObservation_Call) RunAndReturn(run func(context.Context, db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error)) *MockStore_GetNearestLatestStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOutstandingStationCommand provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetOutstandingStationCommand(ctx context.Context, arg db.GetOutstandingStationCommandParams) (db.StationCommand, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutstandingStationCommand")
+	}
+
+	var r0 db.StationCommand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetOutstandingStationCommandParams) (db.StationCommand, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetOutstandingStationCommandParams) db.StationCommand); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.StationCommand)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetOutstandingStationCommandParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOutstandingStationCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOutstandingStationCommand'
+type MockStore_GetOutstandingStationCommand_Call struct {
+	*mock.Call
+}
+
+// GetOutstandingStationCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetOutstandingStationCommandParams
+func (_e *MockStore_Expecter) GetOutstandingStationCommand(ctx interface{}, arg interface{}) *MockStore_GetOutstandingStationCommand_Call {
+	return &MockStore_GetOutstandingStationCommand_Call{Call: _e.mock.On("GetOutstandingStationCommand", ctx, arg)}
+}
+
+func (_c *MockStore_GetOutstandingStationCommand_Call) Run(run func(ctx context.Context, arg db.GetOutstandingStationCommandParams)) *MockStore_GetOutstandingStationCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetOutstandingStationCommandParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOutstandingStationCommand_Call) Return(_a0 db.StationCommand, _a1 error) *MockStore_GetOutstandingStationCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOutstandingStationCommand_Call) RunAndReturn(run func(context.Context, db.GetOutstandingStationCommandParams) (db.StationCommand, error)) *MockStore_GetOutstandingStationCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingStation provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) GetPendingStation(ctx context.Context, mobileNumber string) (db.PendingStation, error) {
+	ret := _m.Called(ctx, mobileNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingStation")
+	}
+
+	var r0 db.PendingStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.PendingStation, error)); ok {
+		return rf(ctx, mobileNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.PendingStation); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Get(0).(db.PendingStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mobileNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPendingStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingStation'
+type MockStore_GetPendingStation_Call struct {
+	*mock.Call
+}
+
+// GetPendingStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber string
+func (_e *MockStore_Expecter) GetPendingStation(ctx interface{}, mobileNumber interface{}) *MockStore_GetPendingStation_Call {
+	return &MockStore_GetPendingStation_Call{Call: _e.mock.On("GetPendingStation", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_GetPendingStation_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_GetPendingStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPendingStation_Call) Return(_a0 db.PendingStation, _a1 error) *MockStore_GetPendingStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPendingStation_Call) RunAndReturn(run func(context.Context, string) (db.PendingStation, error)) *MockStore_GetPendingStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRole provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetRole(ctx context.Context, id int64) (db.Role, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRole")
+	}
+
+	var r0 db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Role, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Role); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRole'
+type MockStore_GetRole_Call struct {
+	*mock.Call
+}
+
+// GetRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetRole(ctx interface{}, id interface{}) *MockStore_GetRole_Call {
+	return &MockStore_GetRole_Call{Call: _e.mock.On("GetRole", ctx, id)}
+}
+
+func (_c *MockStore_GetRole_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_GetRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRole_Call) RunAndReturn(run func(context.Context, int64) (db.Role, error)) *MockStore_GetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleByName provides a mock function with given fields: ctx, name
+func (_m *MockStore) GetRoleByName(ctx context.Context, name string) (db.Role, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleByName")
+	}
+
+	var r0 db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.Role, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.Role); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(db.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRoleByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByName'
+type MockStore_GetRoleByName_Call struct {
+	*mock.Call
+}
+
+// GetRoleByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockStore_Expecter) GetRoleByName(ctx interface{}, name interface{}) *MockStore_GetRoleByName_Call {
+	return &MockStore_GetRoleByName_Call{Call: _e.mock.On("GetRoleByName", ctx, name)}
+}
+
+func (_c *MockStore_GetRoleByName_Call) Run(run func(ctx context.Context, name string)) *MockStore_GetRoleByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRoleByName_Call) Return(_a0 db.Role, _a1 error) *MockStore_GetRoleByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRoleByName_Call) RunAndReturn(run func(context.Context, string) (db.Role, error)) *MockStore_GetRoleByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSession provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSession")
+	}
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
+type MockStore_GetSession_Call struct {
+	*mock.Call
+}
+
+// GetSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetSession(ctx interface{}, id interface{}) *MockStore_GetSession_Call {
+	return &MockStore_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+}
+
+func (_c *MockStore_GetSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSession_Call) Return(_a0 db.Session, _a1 error) *MockStore_GetSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *MockStore_GetSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSimAccessToken provides a mock function with given fields: ctx, accessToken
+func (_m *MockStore) GetSimAccessToken(ctx context.Context, accessToken string) (db.SimAccessToken, error) {
+	ret := _m.Called(ctx, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSimAccessToken")
+	}
+
+	var r0 db.SimAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimAccessToken, error)); ok {
+		return rf(ctx, accessToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimAccessToken); ok {
+		r0 = rf(ctx, accessToken)
+	} else {
+		r0 = ret.Get(0).(db.SimAccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimAccessToken'
+type MockStore_GetSimAccessToken_Call struct {
+	*mock.Call
+}
+
+// GetSimAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+func (_e *MockStore_Expecter) GetSimAccessToken(ctx interface{}, accessToken interface{}) *MockStore_GetSimAccessToken_Call {
+	return &MockStore_GetSimAccessToken_Call{Call: _e.mock.On("GetSimAccessToken", ctx, accessToken)}
+}
+
+func (_c *MockStore_GetSimAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockStore_GetSimAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_GetSimAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSimAccessToken_Call) RunAndReturn(run func(context.Context, string) (db.SimAccessToken, error)) *MockStore_GetSimAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSimCard provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) GetSimCard(ctx context.Context, mobileNumber string) (db.SimCard, error) {
+	ret := _m.Called(ctx, mobileNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSimCard")
+	}
+
+	var r0 db.SimCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimCard, error)); ok {
+		return rf(ctx, mobileNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimCard); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Get(0).(db.SimCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mobileNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimCard'
+type MockStore_GetSimCard_Call struct {
+	*mock.Call
+}
+
+// GetSimCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber string
+func (_e *MockStore_Expecter) GetSimCard(ctx interface{}, mobileNumber interface{}) *MockStore_GetSimCard_Call {
+	return &MockStore_GetSimCard_Call{Call: _e.mock.On("GetSimCard", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_GetSimCard_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_GetSimCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_GetSimCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSimCard_Call) RunAndReturn(run func(context.Context, string) (db.SimCard, error)) *MockStore_GetSimCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSmsOutbox provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetSmsOutbox(ctx context.Context, id int64) (db.SmsOutbox, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSmsOutbox")
+	}
+
+	var r0 db.SmsOutbox
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.SmsOutbox, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.SmsOutbox); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.SmsOutbox)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSmsOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSmsOutbox'
+type MockStore_GetSmsOutbox_Call struct {
+	*mock.Call
+}
+
+// GetSmsOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetSmsOutbox(ctx interface{}, id interface{}) *MockStore_GetSmsOutbox_Call {
+	return &MockStore_GetSmsOutbox_Call{Call: _e.mock.On("GetSmsOutbox", ctx, id)}
+}
+
+func (_c *MockStore_GetSmsOutbox_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetSmsOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSmsOutbox_Call) Return(_a0 db.SmsOutbox, _a1 error) *MockStore_GetSmsOutbox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSmsOutbox_Call) RunAndReturn(run func(context.Context, int64) (db.SmsOutbox, error)) *MockStore_GetSmsOutbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetStation(ctx context.Context, id int64) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStation")
+	}
+
+	var r0 db.ObservationsStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsStation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsStation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStation'
+type MockStore_GetStation_Call struct {
+	*mock.Call
+}
+
+// GetStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetStation(ctx interface{}, id interface{}) *MockStore_GetStation_Call {
+	return &MockStore_GetStation_Call{Call: _e.mock.On("GetStation", ctx, id)}
+}
+
+func (_c *MockStore_GetStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStation_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsStation, error)) *MockStore_GetStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationByMobileNumber provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) GetStationByMobileNumber(ctx context.Context, mobileNumber pgtype.Text) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, mobileNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationByMobileNumber")
+	}
+
+	var r0 db.ObservationsStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (db.ObservationsStation, error)); ok {
+		return rf(ctx, mobileNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) db.ObservationsStation); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, mobileNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationByMobileNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationByMobileNumber'
+type MockStore_GetStationByMobileNumber_Call struct {
+	*mock.Call
+}
+
+// GetStationByMobileNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber pgtype.Text
+func (_e *MockStore_Expecter) GetStationByMobileNumber(ctx interface{}, mobileNumber interface{}) *MockStore_GetStationByMobileNumber_Call {
+	return &MockStore_GetStationByMobileNumber_Call{Call: _e.mock.On("GetStationByMobileNumber", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_GetStationByMobileNumber_Call) Run(run func(ctx context.Context, mobileNumber pgtype.Text)) *MockStore_GetStationByMobileNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationByMobileNumber_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetStationByMobileNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationByMobileNumber_Call) RunAndReturn(run func(context.Context, pgtype.Text) (db.ObservationsStation, error)) *MockStore_GetStationByMobileNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationClock provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) GetStationClock(ctx context.Context, stationID int64) (db.StationClock, error) {
+	ret := _m.Called(ctx, stationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationClock")
+	}
+
+	var r0 db.StationClock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.StationClock, error)); ok {
+		return rf(ctx, stationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.StationClock); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Get(0).(db.StationClock)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationClock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationClock'
+type MockStore_GetStationClock_Call struct {
+	*mock.Call
+}
+
+// GetStationClock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) GetStationClock(ctx interface{}, stationID interface{}) *MockStore_GetStationClock_Call {
+	return &MockStore_GetStationClock_Call{Call: _e.mock.On("GetStationClock", ctx, stationID)}
+}
+
+func (_c *MockStore_GetStationClock_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_GetStationClock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationClock_Call) Return(_a0 db.StationClock, _a1 error) *MockStore_GetStationClock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationClock_Call) RunAndReturn(run func(context.Context, int64) (db.StationClock, error)) *MockStore_GetStationClock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationCommand provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetStationCommand(ctx context.Context, id int64) (db.StationCommand, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationCommand")
+	}
+
+	var r0 db.StationCommand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.StationCommand, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.StationCommand); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.StationCommand)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationCommand'
+type MockStore_GetStationCommand_Call struct {
+	*mock.Call
+}
+
+// GetStationCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetStationCommand(ctx interface{}, id interface{}) *MockStore_GetStationCommand_Call {
+	return &MockStore_GetStationCommand_Call{Call: _e.mock.On("GetStationCommand", ctx, id)}
+}
+
+func (_c *MockStore_GetStationCommand_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetStationCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationCommand_Call) Return(_a0 db.StationCommand, _a1 error) *MockStore_GetStationCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationCommand_Call) RunAndReturn(run func(context.Context, int64) (db.StationCommand, error)) *MockStore_GetStationCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationHealth provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationHealth(ctx context.Context, arg db.GetStationHealthParams) (db.ObservationsStationhealth, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationHealth")
+	}
+
+	var r0 db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthParams) db.ObservationsStationhealth); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationHealthParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationHealth'
+type MockStore_GetStationHealth_Call struct {
+	*mock.Call
+}
+
+// GetStationHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationHealthParams
+func (_e *MockStore_Expecter) GetStationHealth(ctx interface{}, arg interface{}) *MockStore_GetStationHealth_Call {
+	return &MockStore_GetStationHealth_Call{Call: _e.mock.On("GetStationHealth", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationHealth_Call) Run(run func(ctx context.Context, arg db.GetStationHealthParams)) *MockStore_GetStationHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationHealthParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_GetStationHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationHealth_Call) RunAndReturn(run func(context.Context, db.GetStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_GetStationHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationHealthSummary provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationHealthSummary(ctx context.Context, arg db.GetStationHealthSummaryParams) (db.GetStationHealthSummaryRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationHealthSummary")
+	}
+
+	var r0 db.GetStationHealthSummaryRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthSummaryParams) (db.GetStationHealthSummaryRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthSummaryParams) db.GetStationHealthSummaryRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GetStationHealthSummaryRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationHealthSummaryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationHealthSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationHealthSummary'
+type MockStore_GetStationHealthSummary_Call struct {
+	*mock.Call
+}
+
+// GetStationHealthSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationHealthSummaryParams
+func (_e *MockStore_Expecter) GetStationHealthSummary(ctx interface{}, arg interface{}) *MockStore_GetStationHealthSummary_Call {
+	return &MockStore_GetStationHealthSummary_Call{Call: _e.mock.On("GetStationHealthSummary", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationHealthSummary_Call) Run(run func(ctx context.Context, arg db.GetStationHealthSummaryParams)) *MockStore_GetStationHealthSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationHealthSummaryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationHealthSummary_Call) Return(_a0 db.GetStationHealthSummaryRow, _a1 error) *MockStore_GetStationHealthSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationHealthSummary_Call) RunAndReturn(run func(context.Context, db.GetStationHealthSummaryParams) (db.GetStationHealthSummaryRow, error)) *MockStore_GetStationHealthSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationMOObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationMOObservation(ctx context.Context, arg db.GetStationMOObservationParams) (db.ObservationsMoObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationMOObservation")
+	}
+
+	var r0 db.ObservationsMoObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationMOObservationParams) (db.ObservationsMoObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationMOObservationParams) db.ObservationsMoObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsMoObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationMOObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationMOObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationMOObservation'
+type MockStore_GetStationMOObservation_Call struct {
+	*mock.Call
+}
+
+// GetStationMOObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationMOObservationParams
+func (_e *MockStore_Expecter) GetStationMOObservation(ctx interface{}, arg interface{}) *MockStore_GetStationMOObservation_Call {
+	return &MockStore_GetStationMOObservation_Call{Call: _e.mock.On("GetStationMOObservation", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationMOObservation_Call) Run(run func(ctx context.Context, arg db.GetStationMOObservationParams)) *MockStore_GetStationMOObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationMOObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationMOObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_GetStationMOObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationMOObservation_Call) RunAndReturn(run func(context.Context, db.GetStationMOObservationParams) (db.ObservationsMoObservation, error)) *MockStore_GetStationMOObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationMaintenance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationMaintenance(ctx context.Context, arg db.GetStationMaintenanceParams) (db.StationMaintenance, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationMaintenance")
+	}
+
+	var r0 db.StationMaintenance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationMaintenanceParams) (db.StationMaintenance, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationMaintenanceParams) db.StationMaintenance); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.StationMaintenance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationMaintenanceParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationMaintenance'
+type MockStore_GetStationMaintenance_Call struct {
+	*mock.Call
+}
+
+// GetStationMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationMaintenanceParams
+func (_e *MockStore_Expecter) GetStationMaintenance(ctx interface{}, arg interface{}) *MockStore_GetStationMaintenance_Call {
+	return &MockStore_GetStationMaintenance_Call{Call: _e.mock.On("GetStationMaintenance", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationMaintenance_Call) Run(run func(ctx context.Context, arg db.GetStationMaintenanceParams)) *MockStore_GetStationMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationMaintenanceParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationMaintenance_Call) Return(_a0 db.StationMaintenance, _a1 error) *MockStore_GetStationMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationMaintenance_Call) RunAndReturn(run func(context.Context, db.GetStationMaintenanceParams) (db.StationMaintenance, error)) *MockStore_GetStationMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationObservation(ctx context.Context, arg db.GetStationObservationParams) (db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationObservation")
+	}
+
+	var r0 db.ObservationsObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationObservationParams) (db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationObservationParams) db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationObservation'
+type MockStore_GetStationObservation_Call struct {
+	*mock.Call
+}
+
+// GetStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationObservationParams
+func (_e *MockStore_Expecter) GetStationObservation(ctx interface{}, arg interface{}) *MockStore_GetStationObservation_Call {
+	return &MockStore_GetStationObservation_Call{Call: _e.mock.On("GetStationObservation", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationObservation_Call) Run(run func(ctx context.Context, arg db.GetStationObservationParams)) *MockStore_GetStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_GetStationObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationObservation_Call) RunAndReturn(run func(context.Context, db.GetStationObservationParams) (db.ObservationsObservation, error)) *MockStore_GetStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationStatusForUpdate provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetStationStatusForUpdate(ctx context.Context, id int64) (pgtype.Text, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStationStatusForUpdate")
+	}
+
+	var r0 pgtype.Text
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (pgtype.Text, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) pgtype.Text); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(pgtype.Text)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationStatusForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationStatusForUpdate'
+type MockStore_GetStationStatusForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetStationStatusForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetStationStatusForUpdate(ctx interface{}, id interface{}) *MockStore_GetStationStatusForUpdate_Call {
+	return &MockStore_GetStationStatusForUpdate_Call{Call: _e.mock.On("GetStationStatusForUpdate", ctx, id)}
+}
+
+func (_c *MockStore_GetStationStatusForUpdate_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetStationStatusForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationStatusForUpdate_Call) Return(_a0 pgtype.Text, _a1 error) *MockStore_GetStationStatusForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationStatusForUpdate_Call) RunAndReturn(run func(context.Context, int64) (pgtype.Text, error)) *MockStore_GetStationStatusForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUploadStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetUploadStation(ctx context.Context, arg db.GetUploadStationParams) (db.UploadStation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUploadStation")
+	}
+
+	var r0 db.UploadStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetUploadStationParams) (db.UploadStation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetUploadStationParams) db.UploadStation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.UploadStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetUploadStationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUploadStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUploadStation'
+type MockStore_GetUploadStation_Call struct {
+	*mock.Call
+}
+
+// GetUploadStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetUploadStationParams
+func (_e *MockStore_Expecter) GetUploadStation(ctx interface{}, arg interface{}) *MockStore_GetUploadStation_Call {
+	return &MockStore_GetUploadStation_Call{Call: _e.mock.On("GetUploadStation", ctx, arg)}
+}
+
+func (_c *MockStore_GetUploadStation_Call) Run(run func(ctx context.Context, arg db.GetUploadStationParams)) *MockStore_GetUploadStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetUploadStationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUploadStation_Call) Return(_a0 db.UploadStation, _a1 error) *MockStore_GetUploadStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUploadStation_Call) RunAndReturn(run func(context.Context, db.GetUploadStationParams) (db.UploadStation, error)) *MockStore_GetUploadStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetUser(ctx context.Context, id int64) (db.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockStore_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetUser(ctx interface{}, id interface{}) *MockStore_GetUser_Call {
+	return &MockStore_GetUser_Call{Call: _e.mock.On("GetUser", ctx, id)}
+}
+
+func (_c *MockStore_GetUser_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUser_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUser_Call) RunAndReturn(run func(context.Context, int64) (db.User, error)) *MockStore_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockStore_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
+	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByUsername provides a mock function with given fields: ctx, username
+func (_m *MockStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByUsername")
+	}
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
+type MockStore_GetUserByUsername_Call struct {
+	*mock.Call
+}
+
+// GetUserByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockStore_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockStore_GetUserByUsername_Call {
+	return &MockStore_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+}
+
+func (_c *MockStore_GetUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockStore_GetUserByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByUsername_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUserByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByUsername_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *MockStore_GetUserByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWeatherlinkChange provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetWeatherlinkChange(ctx context.Context, id int64) (db.WeatherlinkChange, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWeatherlinkChange")
+	}
+
+	var r0 db.WeatherlinkChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.WeatherlinkChange, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.WeatherlinkChange); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.WeatherlinkChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetWeatherlinkChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWeatherlinkChange'
+type MockStore_GetWeatherlinkChange_Call struct {
+	*mock.Call
+}
+
+// GetWeatherlinkChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetWeatherlinkChange(ctx interface{}, id interface{}) *MockStore_GetWeatherlinkChange_Call {
+	return &MockStore_GetWeatherlinkChange_Call{Call: _e.mock.On("GetWeatherlinkChange", ctx, id)}
+}
+
+func (_c *MockStore_GetWeatherlinkChange_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetWeatherlinkChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetWeatherlinkChange_Call) Return(_a0 db.WeatherlinkChange, _a1 error) *MockStore_GetWeatherlinkChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetWeatherlinkChange_Call) RunAndReturn(run func(context.Context, int64) (db.WeatherlinkChange, error)) *MockStore_GetWeatherlinkChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWeatherlinkStation provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) GetWeatherlinkStation(ctx context.Context, stationID int64) (db.Weatherlink, error) {
+	ret := _m.Called(ctx, stationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWeatherlinkStation")
+	}
+
+	var r0 db.Weatherlink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Weatherlink, error)); ok {
+		return rf(ctx, stationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Weatherlink); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Get(0).(db.Weatherlink)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetWeatherlinkStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWeatherlinkStation'
+type MockStore_GetWeatherlinkStation_Call struct {
+	*mock.Call
+}
+
+// GetWeatherlinkStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) GetWeatherlinkStation(ctx interface{}, stationID interface{}) *MockStore_GetWeatherlinkStation_Call {
+	return &MockStore_GetWeatherlinkStation_Call{Call: _e.mock.On("GetWeatherlinkStation", ctx, stationID)}
+}
+
+func (_c *MockStore_GetWeatherlinkStation_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_GetWeatherlinkStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetWeatherlinkStation_Call) Return(_a0 db.Weatherlink, _a1 error) *MockStore_GetWeatherlinkStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetWeatherlinkStation_Call) RunAndReturn(run func(context.Context, int64) (db.Weatherlink, error)) *MockStore_GetWeatherlinkStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWeatherlinkStationByWLID provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetWeatherlinkStationByWLID(ctx context.Context, arg db.GetWeatherlinkStationByWLIDParams) (db.Weatherlink, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWeatherlinkStationByWLID")
+	}
+
+	var r0 db.Weatherlink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetWeatherlinkStationByWLIDParams) (db.Weatherlink, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetWeatherlinkStationByWLIDParams) db.Weatherlink); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Weatherlink)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetWeatherlinkStationByWLIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetWeatherlinkStationByWLID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWeatherlinkStationByWLID'
+type MockStore_GetWeatherlinkStationByWLID_Call struct {
+	*mock.Call
+}
+
+// GetWeatherlinkStationByWLID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetWeatherlinkStationByWLIDParams
+func (_e *MockStore_Expecter) GetWeatherlinkStationByWLID(ctx interface{}, arg interface{}) *MockStore_GetWeatherlinkStationByWLID_Call {
+	return &MockStore_GetWeatherlinkStationByWLID_Call{Call: _e.mock.On("GetWeatherlinkStationByWLID", ctx, arg)}
+}
+
+func (_c *MockStore_GetWeatherlinkStationByWLID_Call) Run(run func(ctx context.Context, arg db.GetWeatherlinkStationByWLIDParams)) *MockStore_GetWeatherlinkStationByWLID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetWeatherlinkStationByWLIDParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetWeatherlinkStationByWLID_Call) Return(_a0 db.Weatherlink, _a1 error) *MockStore_GetWeatherlinkStationByWLID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetWeatherlinkStationByWLID_Call) RunAndReturn(run func(context.Context, db.GetWeatherlinkStationByWLIDParams) (db.Weatherlink, error)) *MockStore_GetWeatherlinkStationByWLID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportStationsTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ImportStationsTx(ctx context.Context, arg db.ImportStationsTxParams) (db.ImportStationsTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportStationsTx")
+	}
+
+	var r0 db.ImportStationsTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ImportStationsTxParams) (db.ImportStationsTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ImportStationsTxParams) db.ImportStationsTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ImportStationsTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ImportStationsTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ImportStationsTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportStationsTx'
+type MockStore_ImportStationsTx_Call struct {
+	*mock.Call
+}
+
+// ImportStationsTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ImportStationsTxParams
+func (_e *MockStore_Expecter) ImportStationsTx(ctx interface{}, arg interface{}) *MockStore_ImportStationsTx_Call {
+	return &MockStore_ImportStationsTx_Call{Call: _e.mock.On("ImportStationsTx", ctx, arg)}
+}
+
+func (_c *MockStore_ImportStationsTx_Call) Run(run func(ctx context.Context, arg db.ImportStationsTxParams)) *MockStore_ImportStationsTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ImportStationsTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ImportStationsTx_Call) Return(_a0 db.ImportStationsTxResult, _a1 error) *MockStore_ImportStationsTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ImportStationsTx_Call) RunAndReturn(run func(context.Context, db.ImportStationsTxParams) (db.ImportStationsTxResult, error)) *MockStore_ImportStationsTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertCurrentMOObservations provides a mock function with given fields: ctx
+func (_m *MockStore) InsertCurrentMOObservations(ctx context.Context) ([]db.ObservationsCurrent, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertCurrentMOObservations")
+	}
+
+	var r0 []db.ObservationsCurrent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ObservationsCurrent, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []db.ObservationsCurrent); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsCurrent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_InsertCurrentMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertCurrentMOObservations'
+type MockStore_InsertCurrentMOObservations_Call struct {
+	*mock.Call
+}
+
+// InsertCurrentMOObservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) InsertCurrentMOObservations(ctx interface{}) *MockStore_InsertCurrentMOObservations_Call {
+	return &MockStore_InsertCurrentMOObservations_Call{Call: _e.mock.On("InsertCurrentMOObservations", ctx)}
+}
+
+func (_c *MockStore_InsertCurrentMOObservations_Call) Run(run func(ctx context.Context)) *MockStore_InsertCurrentMOObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertCurrentMOObservations_Call) Return(_a0 []db.ObservationsCurrent, _a1 error) *MockStore_InsertCurrentMOObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_InsertCurrentMOObservations_Call) RunAndReturn(run func(context.Context) ([]db.ObservationsCurrent, error)) *MockStore_InsertCurrentMOObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertCurrentObservations provides a mock function with given fields: ctx
+func (_m *MockStore) InsertCurrentObservations(ctx context.Context) ([]db.ObservationsCurrent, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertCurrentObservations")
+	}
+
+	var r0 []db.ObservationsCurrent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ObservationsCurrent, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []db.ObservationsCurrent); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsCurrent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_InsertCurrentObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertCurrentObservations'
+type MockStore_InsertCurrentObservations_Call struct {
+	*mock.Call
+}
+
+// InsertCurrentObservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) InsertCurrentObservations(ctx interface{}) *MockStore_InsertCurrentObservations_Call {
+	return &MockStore_InsertCurrentObservations_Call{Call: _e.mock.On("InsertCurrentObservations", ctx)}
+}
+
+func (_c *MockStore_InsertCurrentObservations_Call) Run(run func(ctx context.Context)) *MockStore_InsertCurrentObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertCurrentObservations_Call) Return(_a0 []db.ObservationsCurrent, _a1 error) *MockStore_InsertCurrentObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_InsertCurrentObservations_Call) RunAndReturn(run func(context.Context) ([]db.ObservationsCurrent, error)) *MockStore_InsertCurrentObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLatestObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListLatestObservations(ctx context.Context, arg db.ListLatestObservationsParams) ([]db.ListLatestObservationsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLatestObservations")
+	}
+
+	var r0 []db.ListLatestObservationsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListLatestObservationsParams) ([]db.ListLatestObservationsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListLatestObservationsParams) []db.ListLatestObservationsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListLatestObservationsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListLatestObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListLatestObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLatestObservations'
+type MockStore_ListLatestObservations_Call struct {
+	*mock.Call
+}
+
+// ListLatestObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListLatestObservationsParams
+func (_e *MockStore_Expecter) ListLatestObservations(ctx interface{}, arg interface{}) *MockStore_ListLatestObservations_Call {
+	return &MockStore_ListLatestObservations_Call{Call: _e.mock.On("ListLatestObservations", ctx, arg)}
+}
+
+func (_c *MockStore_ListLatestObservations_Call) Run(run func(ctx context.Context, arg db.ListLatestObservationsParams)) *MockStore_ListLatestObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListLatestObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListLatestObservations_Call) Return(_a0 []db.ListLatestObservationsRow, _a1 error) *MockStore_ListLatestObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListLatestObservations_Call) RunAndReturn(run func(context.Context, db.ListLatestObservationsParams) ([]db.ListLatestObservationsRow, error)) *MockStore_ListLatestObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLufftStationMsg provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListLufftStationMsg(ctx context.Context, arg db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLufftStationMsg")
+	}
+
+	var r0 []db.ListLufftStationMsgRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListLufftStationMsgParams) []db.ListLufftStationMsgRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListLufftStationMsgRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListLufftStationMsgParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListLufftStationMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLufftStationMsg'
+type MockStore_ListLufftStationMsg_Call struct {
+	*mock.Call
+}
+
+// ListLufftStationMsg is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListLufftStationMsgParams
+func (_e *MockStore_Expecter) ListLufftStationMsg(ctx interface{}, arg interface{}) *MockStore_ListLufftStationMsg_Call {
+	return &MockStore_ListLufftStationMsg_Call{Call: _e.mock.On("ListLufftStationMsg", ctx, arg)}
+}
+
+func (_c *MockStore_ListLufftStationMsg_Call) Run(run func(ctx context.Context, arg db.ListLufftStationMsgParams)) *MockStore_ListLufftStationMsg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListLufftStationMsgParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListLufftStationMsg_Call) Return(_a0 []db.ListLufftStationMsgRow, _a1 error) *MockStore_ListLufftStationMsg_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListLufftStationMsg_Call) RunAndReturn(run func(context.Context, db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error)) *MockStore_ListLufftStationMsg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMOObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListMOObservations(ctx context.Context, arg db.ListMOObservationsParams) ([]db.ObservationsMoObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMOObservations")
+	}
+
+	var r0 []db.ObservationsMoObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListMOObservationsParams) ([]db.ObservationsMoObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListMOObservationsParams) []db.ObservationsMoObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsMoObservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListMOObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMOObservations'
+type MockStore_ListMOObservations_Call struct {
+	*mock.Call
+}
+
+// ListMOObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListMOObservationsParams
+func (_e *MockStore_Expecter) ListMOObservations(ctx interface{}, arg interface{}) *MockStore_ListMOObservations_Call {
+	return &MockStore_ListMOObservations_Call{Call: _e.mock.On("ListMOObservations", ctx, arg)}
+}
+
+func (_c *MockStore_ListMOObservations_Call) Run(run func(ctx context.Context, arg db.ListMOObservationsParams)) *MockStore_ListMOObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListMOObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListMOObservations_Call) Return(_a0 []db.ObservationsMoObservation, _a1 error) *MockStore_ListMOObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListMOObservations_Call) RunAndReturn(run func(context.Context, db.ListMOObservationsParams) ([]db.ObservationsMoObservation, error)) *MockStore_ListMOObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMisolStations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListMisolStations(ctx context.Context, arg db.ListMisolStationsParams) ([]db.MisolStation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMisolStations")
+	}
+
+	var r0 []db.MisolStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListMisolStationsParams) ([]db.MisolStation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListMisolStationsParams) []db.MisolStation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.MisolStation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListMisolStationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListMisolStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMisolStations'
+type MockStore_ListMisolStations_Call struct {
+	*mock.Call
+}
+
+// ListMisolStations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListMisolStationsParams
+func (_e *MockStore_Expecter) ListMisolStations(ctx interface{}, arg interface{}) *MockStore_ListMisolStations_Call {
+	return &MockStore_ListMisolStations_Call{Call: _e.mock.On("ListMisolStations", ctx, arg)}
+}
+
+func (_c *MockStore_ListMisolStations_Call) Run(run func(ctx context.Context, arg db.ListMisolStationsParams)) *MockStore_ListMisolStations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListMisolStationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListMisolStations_Call) Return(_a0 []db.MisolStation, _a1 error) *MockStore_ListMisolStations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListMisolStations_Call) RunAndReturn(run func(context.Context, db.ListMisolStationsParams) ([]db.MisolStation, error)) *MockStore_ListMisolStations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListObservations(ctx context.Context, arg db.ListObservationsParams) ([]db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListObservations")
+	}
+
+	var r0 []db.ObservationsObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsParams) ([]db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsParams) []db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsObservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObservations'
+type MockStore_ListObservations_Call struct {
+	*mock.Call
+}
+
+// ListObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListObservationsParams
+func (_e *MockStore_Expecter) ListObservations(ctx interface{}, arg interface{}) *MockStore_ListObservations_Call {
+	return &MockStore_ListObservations_Call{Call: _e.mock.On("ListObservations", ctx, arg)}
+}
+
+func (_c *MockStore_ListObservations_Call) Run(run func(ctx context.Context, arg db.ListObservationsParams)) *MockStore_ListObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListObservations_Call) RunAndReturn(run func(context.Context, db.ListObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingStationMessages provides a mock function with given fields: ctx, pendingStationID
+func (_m *MockStore) ListPendingStationMessages(ctx context.Context, pendingStationID int64) ([]db.PendingStationMessage, error) {
+	ret := _m.Called(ctx, pendingStationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingStationMessages")
+	}
+
+	var r0 []db.PendingStationMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]db.PendingStationMessage, error)); ok {
+		return rf(ctx, pendingStationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []db.PendingStationMessage); ok {
+		r0 = rf(ctx, pendingStationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.PendingStationMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, pendingStationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListPendingStationMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingStationMessages'
+type MockStore_ListPendingStationMessages_Call struct {
+	*mock.Call
+}
+
+// ListPendingStationMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pendingStationID int64
+func (_e *MockStore_Expecter) ListPendingStationMessages(ctx interface{}, pendingStationID interface{}) *MockStore_ListPendingStationMessages_Call {
+	return &MockStore_ListPendingStationMessages_Call{Call: _e.mock.On("ListPendingStationMessages", ctx, pendingStationID)}
+}
+
+func (_c *MockStore_ListPendingStationMessages_Call) Run(run func(ctx context.Context, pendingStationID int64)) *MockStore_ListPendingStationMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPendingStationMessages_Call) Return(_a0 []db.PendingStationMessage, _a1 error) *MockStore_ListPendingStationMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListPendingStationMessages_Call) RunAndReturn(run func(context.Context, int64) ([]db.PendingStationMessage, error)) *MockStore_ListPendingStationMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingStations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListPendingStations(ctx context.Context, arg db.ListPendingStationsParams) ([]db.PendingStation, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingStations")
+	}
+
+	var r0 []db.PendingStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListPendingStationsParams) ([]db.PendingStation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListPendingStationsParams) []db.PendingStation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.PendingStation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListPendingStationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListPendingStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingStations'
+type MockStore_ListPendingStations_Call struct {
+	*mock.Call
+}
+
+// ListPendingStations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListPendingStationsParams
+func (_e *MockStore_Expecter) ListPendingStations(ctx interface{}, arg interface{}) *MockStore_ListPendingStations_Call {
+	return &MockStore_ListPendingStations_Call{Call: _e.mock.On("ListPendingStations", ctx, arg)}
+}
+
+func (_c *MockStore_ListPendingStations_Call) Run(run func(ctx context.Context, arg db.ListPendingStationsParams)) *MockStore_ListPendingStations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListPendingStationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPendingStations_Call) Return(_a0 []db.PendingStation, _a1 error) *MockStore_ListPendingStations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListPendingStations_Call) RunAndReturn(run func(context.Context, db.ListPendingStationsParams) ([]db.PendingStation, error)) *MockStore_ListPendingStations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoles provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListRoles(ctx context.Context, arg db.ListRolesParams) ([]db.Role, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 []db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListRolesParams) ([]db.Role, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListRolesParams) []db.Role); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListRolesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
+type MockStore_ListRoles_Call struct {
+	*mock.Call
+}
+
+// ListRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListRolesParams
+func (_e *MockStore_Expecter) ListRoles(ctx interface{}, arg interface{}) *MockStore_ListRoles_Call {
+	return &MockStore_ListRoles_Call{Call: _e.mock.On("ListRoles", ctx, arg)}
+}
+
+func (_c *MockStore_ListRoles_Call) Run(run func(ctx context.Context, arg db.ListRolesParams)) *MockStore_ListRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListRolesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoles_Call) Return(_a0 []db.Role, _a1 error) *MockStore_ListRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoles_Call) RunAndReturn(run func(context.Context, db.ListRolesParams) ([]db.Role, error)) *MockStore_ListRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSimCardAssignments provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSimCardAssignments(ctx context.Context, arg db.ListSimCardAssignmentsParams) ([]db.SimCardAssignment, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSimCardAssignments")
+	}
+
+	var r0 []db.SimCardAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSimCardAssignmentsParams) ([]db.SimCardAssignment, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSimCardAssignmentsParams) []db.SimCardAssignment); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SimCardAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSimCardAssignmentsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSimCardAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSimCardAssignments'
+type MockStore_ListSimCardAssignments_Call struct {
+	*mock.Call
+}
+
+// ListSimCardAssignments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListSimCardAssignmentsParams
+func (_e *MockStore_Expecter) ListSimCardAssignments(ctx interface{}, arg interface{}) *MockStore_ListSimCardAssignments_Call {
+	return &MockStore_ListSimCardAssignments_Call{Call: _e.mock.On("ListSimCardAssignments", ctx, arg)}
+}
+
+func (_c *MockStore_ListSimCardAssignments_Call) Run(run func(ctx context.Context, arg db.ListSimCardAssignmentsParams)) *MockStore_ListSimCardAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListSimCardAssignmentsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSimCardAssignments_Call) Return(_a0 []db.SimCardAssignment, _a1 error) *MockStore_ListSimCardAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSimCardAssignments_Call) RunAndReturn(run func(context.Context, db.ListSimCardAssignmentsParams) ([]db.SimCardAssignment, error)) *MockStore_ListSimCardAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSimCards provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSimCards(ctx context.Context, arg db.ListSimCardsParams) ([]db.SimCard, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSimCards")
+	}
+
+	var r0 []db.SimCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSimCardsParams) ([]db.SimCard, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSimCardsParams) []db.SimCard); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SimCard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSimCardsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSimCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSimCards'
+type MockStore_ListSimCards_Call struct {
+	*mock.Call
+}
+
+// ListSimCards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListSimCardsParams
+func (_e *MockStore_Expecter) ListSimCards(ctx interface{}, arg interface{}) *MockStore_ListSimCards_Call {
+	return &MockStore_ListSimCards_Call{Call: _e.mock.On("ListSimCards", ctx, arg)}
+}
+
+func (_c *MockStore_ListSimCards_Call) Run(run func(ctx context.Context, arg db.ListSimCardsParams)) *MockStore_ListSimCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListSimCardsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSimCards_Call) Return(_a0 []db.SimCard, _a1 error) *MockStore_ListSimCards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSimCards_Call) RunAndReturn(run func(context.Context, db.ListSimCardsParams) ([]db.SimCard, error)) *MockStore_ListSimCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSimCardsDueForLoad provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSimCardsDueForLoad(ctx context.Context, arg db.ListSimCardsDueForLoadParams) ([]db.SimCard, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSimCardsDueForLoad")
+	}
+
+	var r0 []db.SimCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSimCardsDueForLoadParams) ([]db.SimCard, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSimCardsDueForLoadParams) []db.SimCard); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SimCard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSimCardsDueForLoadParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSimCardsDueForLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSimCardsDueForLoad'
+type MockStore_ListSimCardsDueForLoad_Call struct {
+	*mock.Call
+}
+
+// ListSimCardsDueForLoad is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListSimCardsDueForLoadParams
+func (_e *MockStore_Expecter) ListSimCardsDueForLoad(ctx interface{}, arg interface{}) *MockStore_ListSimCardsDueForLoad_Call {
+	return &MockStore_ListSimCardsDueForLoad_Call{Call: _e.mock.On("ListSimCardsDueForLoad", ctx, arg)}
+}
+
+func (_c *MockStore_ListSimCardsDueForLoad_Call) Run(run func(ctx context.Context, arg db.ListSimCardsDueForLoadParams)) *MockStore_ListSimCardsDueForLoad_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListSimCardsDueForLoadParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSimCardsDueForLoad_Call) Return(_a0 []db.SimCard, _a1 error) *MockStore_ListSimCardsDueForLoad_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSimCardsDueForLoad_Call) RunAndReturn(run func(context.Context, db.ListSimCardsDueForLoadParams) ([]db.SimCard, error)) *MockStore_ListSimCardsDueForLoad_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSmsOutbox provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSmsOutbox(ctx context.Context, arg db.ListSmsOutboxParams) ([]db.SmsOutbox, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSmsOutbox")
+	}
+
+	var r0 []db.SmsOutbox
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsOutboxParams) ([]db.SmsOutbox, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsOutboxParams) []db.SmsOutbox); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SmsOutbox)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSmsOutboxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSmsOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSmsOutbox'
+type MockStore_ListSmsOutbox_Call struct {
+	*mock.Call
+}
+
+// ListSmsOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListSmsOutboxParams
+func (_e *MockStore_Expecter) ListSmsOutbox(ctx interface{}, arg interface{}) *MockStore_ListSmsOutbox_Call {
+	return &MockStore_ListSmsOutbox_Call{Call: _e.mock.On("ListSmsOutbox", ctx, arg)}
+}
+
+func (_c *MockStore_ListSmsOutbox_Call) Run(run func(ctx context.Context, arg db.ListSmsOutboxParams)) *MockStore_ListSmsOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListSmsOutboxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListSmsOutbox_Call) Return(_a0 []db.SmsOutbox, _a1 error) *MockStore_ListSmsOutbox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListSmsOutbox_Call) RunAndReturn(run func(context.Context, db.ListSmsOutboxParams) ([]db.SmsOutbox, error)) *MockStore_ListSmsOutbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSmsParts provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSmsParts(ctx context.Context, arg db.ListSmsPartsParams) ([]db.SmsPart, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSmsParts")
+	}
+
+	var r0 []db.SmsPart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsPartsParams) ([]db.SmsPart, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsPartsParams) []db.SmsPart); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SmsPart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSmsPartsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListSmsParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSmsParts'
+type MockStore_ListSmsParts_Call struct {
+	*mock.Call
+}
+
+// ListSmsParts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateWeatherlinkStationParams
-func (_e *MockStore_Expecter) CreateWeatherlinkStation(ctx interface{}, arg interface{}) *MockStore_CreateWeatherlinkStation_Call {
-	return &MockStore_CreateWeatherlinkStation_Call{Call: _e.mock.On("CreateWeatherlinkStation", ctx, arg)}
+//   - arg db.ListSmsPartsParams
+func (_e *MockStore_Expecter) ListSmsParts(ctx interface{}, arg interface{}) *MockStore_ListSmsParts_Call {
+	return &MockStore_ListSmsParts_Call{Call: _e.mock.On("ListSmsParts", ctx, arg)}
 }
 
-func (_c *MockStore_CreateWeatherlinkStation_Call) Run(run func(ctx context.Context, arg db.CreateWeatherlinkStationParams)) *MockStore_CreateWeatherlinkStation_Call {
+func (_c *MockStore_ListSmsParts_Call) Run(run func(ctx context.Context, arg db.ListSmsPartsParams)) *MockStore_ListSmsParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateWeatherlinkStationParams))
+		run(args[0].(context.Context), args[1].(db.ListSmsPartsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateWeatherlinkStation_Call) Return(_a0 db.Weatherlink, _a1 error) *MockStore_CreateWeatherlinkStation_Call {
+func (_c *MockStore_ListSmsParts_Call) Return(_a0 []db.SmsPart, _a1 error) *MockStore_ListSmsParts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateWeatherlinkStation_Call) RunAndReturn(run func(context.Context, db.CreateWeatherlinkStationParams) (db.Weatherlink, error)) *MockStore_CreateWeatherlinkStation_Call {
+func (_c *MockStore_ListSmsParts_Call) RunAndReturn(run func(context.Context, db.ListSmsPartsParams) ([]db.SmsPart, error)) *MockStore_ListSmsParts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteMisolStation provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteMisolStation(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ListStationBatteryDaily provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationBatteryDaily(ctx context.Context, arg db.ListStationBatteryDailyParams) ([]db.ListStationBatteryDailyRow, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteMisolStation")
+		panic("no return value specified for ListStationBatteryDaily")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.ListStationBatteryDailyRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationBatteryDailyParams) ([]db.ListStationBatteryDailyRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationBatteryDailyParams) []db.ListStationBatteryDailyRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListStationBatteryDailyRow)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationBatteryDailyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteMisolStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMisolStation'
-type MockStore_DeleteMisolStation_Call struct {
+// MockStore_ListStationBatteryDaily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationBatteryDaily'
+type MockStore_ListStationBatteryDaily_Call struct {
 	*mock.Call
 }
 
-// DeleteMisolStation is a helper method to define mock.On call
+// ListStationBatteryDaily is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) DeleteMisolStation(ctx interface{}, id interface{}) *MockStore_DeleteMisolStation_Call {
-	return &MockStore_DeleteMisolStation_Call{Call: _e.mock.On("DeleteMisolStation", ctx, id)}
+//   - arg db.ListStationBatteryDailyParams
+func (_e *MockStore_Expecter) ListStationBatteryDaily(ctx interface{}, arg interface{}) *MockStore_ListStationBatteryDaily_Call {
+	return &MockStore_ListStationBatteryDaily_Call{Call: _e.mock.On("ListStationBatteryDaily", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteMisolStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteMisolStation_Call {
+func (_c *MockStore_ListStationBatteryDaily_Call) Run(run func(ctx context.Context, arg db.ListStationBatteryDailyParams)) *MockStore_ListStationBatteryDaily_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationBatteryDailyParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteMisolStation_Call) Return(_a0 error) *MockStore_DeleteMisolStation_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationBatteryDaily_Call) Return(_a0 []db.ListStationBatteryDailyRow, _a1 error) *MockStore_ListStationBatteryDaily_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteMisolStation_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteMisolStation_Call {
+func (_c *MockStore_ListStationBatteryDaily_Call) RunAndReturn(run func(context.Context, db.ListStationBatteryDailyParams) ([]db.ListStationBatteryDailyRow, error)) *MockStore_ListStationBatteryDaily_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRole provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteRole(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ListStationClockDrift provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationClockDrift(ctx context.Context, arg db.ListStationClockDriftParams) ([]db.ListStationClockDriftRow, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRole")
+		panic("no return value specified for ListStationClockDrift")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.ListStationClockDriftRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationClockDriftParams) ([]db.ListStationClockDriftRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationClockDriftParams) []db.ListStationClockDriftRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListStationClockDriftRow)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationClockDriftParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
-type MockStore_DeleteRole_Call struct {
+// MockStore_ListStationClockDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationClockDrift'
+type MockStore_ListStationClockDrift_Call struct {
 	*mock.Call
 }
 
-// DeleteRole is a helper method to define mock.On call
+// ListStationClockDrift is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) DeleteRole(ctx interface{}, id interface{}) *MockStore_DeleteRole_Call {
-	return &MockStore_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, id)}
+//   - arg db.ListStationClockDriftParams
+func (_e *MockStore_Expecter) ListStationClockDrift(ctx interface{}, arg interface{}) *MockStore_ListStationClockDrift_Call {
+	return &MockStore_ListStationClockDrift_Call{Call: _e.mock.On("ListStationClockDrift", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteRole_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteRole_Call {
+func (_c *MockStore_ListStationClockDrift_Call) Run(run func(ctx context.Context, arg db.ListStationClockDriftParams)) *MockStore_ListStationClockDrift_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationClockDriftParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteRole_Call) Return(_a0 error) *MockStore_DeleteRole_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationClockDrift_Call) Return(_a0 []db.ListStationClockDriftRow, _a1 error) *MockStore_ListStationClockDrift_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteRole_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteRole_Call {
+func (_c *MockStore_ListStationClockDrift_Call) RunAndReturn(run func(context.Context, db.ListStationClockDriftParams) ([]db.ListStationClockDriftRow, error)) *MockStore_ListStationClockDrift_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSession provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteSession(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// ListStationCommands provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationCommands(ctx context.Context, arg db.ListStationCommandsParams) ([]db.ListStationCommandsRow, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSession")
+		panic("no return value specified for ListStationCommands")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.ListStationCommandsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationCommandsParams) ([]db.ListStationCommandsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationCommandsParams) []db.ListStationCommandsRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListStationCommandsRow)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationCommandsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
-type MockStore_DeleteSession_Call struct {
+// MockStore_ListStationCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationCommands'
+type MockStore_ListStationCommands_Call struct {
 	*mock.Call
 }
 
-// DeleteSession is a helper method to define mock.On call
+// ListStationCommands is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) DeleteSession(ctx interface{}, id interface{}) *MockStore_DeleteSession_Call {
-	return &MockStore_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, id)}
+//   - arg db.ListStationCommandsParams
+func (_e *MockStore_Expecter) ListStationCommands(ctx interface{}, arg interface{}) *MockStore_ListStationCommands_Call {
+	return &MockStore_ListStationCommands_Call{Call: _e.mock.On("ListStationCommands", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteSession_Call {
+func (_c *MockStore_ListStationCommands_Call) Run(run func(ctx context.Context, arg db.ListStationCommandsParams)) *MockStore_ListStationCommands_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(db.ListStationCommandsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSession_Call) Return(_a0 error) *MockStore_DeleteSession_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationCommands_Call) Return(_a0 []db.ListStationCommandsRow, _a1 error) *MockStore_ListStationCommands_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteSession_Call {
+func (_c *MockStore_ListStationCommands_Call) RunAndReturn(run func(context.Context, db.ListStationCommandsParams) ([]db.ListStationCommandsRow, error)) *MockStore_ListStationCommands_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSimAccessToken provides a mock function with given fields: ctx, accessToken
-func (_m *MockStore) DeleteSimAccessToken(ctx context.Context, accessToken string) error {
-	ret := _m.Called(ctx, accessToken)
+// ListStationDailyDataStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationDailyDataStatus(ctx context.Context, arg db.ListStationDailyDataStatusParams) ([]db.ListStationDailyDataStatusRow, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSimAccessToken")
+		panic("no return value specified for ListStationDailyDataStatus")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, accessToken)
+	var r0 []db.ListStationDailyDataStatusRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationDailyDataStatusParams) ([]db.ListStationDailyDataStatusRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationDailyDataStatusParams) []db.ListStationDailyDataStatusRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListStationDailyDataStatusRow)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationDailyDataStatusParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSimAccessToken'
-type MockStore_DeleteSimAccessToken_Call struct {
+// MockStore_ListStationDailyDataStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationDailyDataStatus'
+type MockStore_ListStationDailyDataStatus_Call struct {
 	*mock.Call
 }
 
-// DeleteSimAccessToken is a helper method to define mock.On call
+// ListStationDailyDataStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - accessToken string
-func (_e *MockStore_Expecter) DeleteSimAccessToken(ctx interface{}, accessToken interface{}) *MockStore_DeleteSimAccessToken_Call {
-	return &MockStore_DeleteSimAccessToken_Call{Call: _e.mock.On("DeleteSimAccessToken", ctx, accessToken)}
+//   - arg db.ListStationDailyDataStatusParams
+func (_e *MockStore_Expecter) ListStationDailyDataStatus(ctx interface{}, arg interface{}) *MockStore_ListStationDailyDataStatus_Call {
+	return &MockStore_ListStationDailyDataStatus_Call{Call: _e.mock.On("ListStationDailyDataStatus", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteSimAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockStore_DeleteSimAccessToken_Call {
+func (_c *MockStore_ListStationDailyDataStatus_Call) Run(run func(ctx context.Context, arg db.ListStationDailyDataStatusParams)) *MockStore_ListStationDailyDataStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(db.ListStationDailyDataStatusParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSimAccessToken_Call) Return(_a0 error) *MockStore_DeleteSimAccessToken_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationDailyDataStatus_Call) Return(_a0 []db.ListStationDailyDataStatusRow, _a1 error) *MockStore_ListStationDailyDataStatus_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSimAccessToken_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_DeleteSimAccessToken_Call {
+func (_c *MockStore_ListStationDailyDataStatus_Call) RunAndReturn(run func(context.Context, db.ListStationDailyDataStatusParams) ([]db.ListStationDailyDataStatusRow, error)) *MockStore_ListStationDailyDataStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteStation provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteStation(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ListStationDailyRecordCounts provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationDailyRecordCounts(ctx context.Context, arg db.ListStationDailyRecordCountsParams) ([]db.ListStationDailyRecordCountsRow, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteStation")
+		panic("no return value specified for ListStationDailyRecordCounts")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.ListStationDailyRecordCountsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationDailyRecordCountsParams) ([]db.ListStationDailyRecordCountsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationDailyRecordCountsParams) []db.ListStationDailyRecordCountsRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListStationDailyRecordCountsRow)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationDailyRecordCountsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStation'
-type MockStore_DeleteStation_Call struct {
+// MockStore_ListStationDailyRecordCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationDailyRecordCounts'
+type MockStore_ListStationDailyRecordCounts_Call struct {
 	*mock.Call
 }
 
-// DeleteStation is a helper method to define mock.On call
+// ListStationDailyRecordCounts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) DeleteStation(ctx interface{}, id interface{}) *MockStore_DeleteStation_Call {
-	return &MockStore_DeleteStation_Call{Call: _e.mock.On("DeleteStation", ctx, id)}
+//   - arg db.ListStationDailyRecordCountsParams
+func (_e *MockStore_Expecter) ListStationDailyRecordCounts(ctx interface{}, arg interface{}) *MockStore_ListStationDailyRecordCounts_Call {
+	return &MockStore_ListStationDailyRecordCounts_Call{Call: _e.mock.On("ListStationDailyRecordCounts", ctx, arg)}
+}
+
+func (_c *MockStore_ListStationDailyRecordCounts_Call) Run(run func(ctx context.Context, arg db.ListStationDailyRecordCountsParams)) *MockStore_ListStationDailyRecordCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListStationDailyRecordCountsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListStationDailyRecordCounts_Call) Return(_a0 []db.ListStationDailyRecordCountsRow, _a1 error) *MockStore_ListStationDailyRecordCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListStationDailyRecordCounts_Call) RunAndReturn(run func(context.Context, db.ListStationDailyRecordCountsParams) ([]db.ListStationDailyRecordCountsRow, error)) *MockStore_ListStationDailyRecordCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStationHealths provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationHealths(ctx context.Context, arg db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStationHealths")
+	}
+
+	var r0 []db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsParams) []db.ObservationsStationhealth); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStationhealth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationHealthsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListStationHealths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationHealths'
+type MockStore_ListStationHealths_Call struct {
+	*mock.Call
+}
+
+// ListStationHealths is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ListStationHealthsParams
+func (_e *MockStore_Expecter) ListStationHealths(ctx interface{}, arg interface{}) *MockStore_ListStationHealths_Call {
+	return &MockStore_ListStationHealths_Call{Call: _e.mock.On("ListStationHealths", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteStation_Call {
+func (_c *MockStore_ListStationHealths_Call) Run(run func(ctx context.Context, arg db.ListStationHealthsParams)) *MockStore_ListStationHealths_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationHealthsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteStation_Call) Return(_a0 error) *MockStore_DeleteStation_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationHealths_Call) Return(_a0 []db.ObservationsStationhealth, _a1 error) *MockStore_ListStationHealths_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteStation_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteStation_Call {
+func (_c *MockStore_ListStationHealths_Call) RunAndReturn(run func(context.Context, db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error)) *MockStore_ListStationHealths_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteStationHealth provides a mock function with given fields: ctx, arg
-func (_m *MockStore) DeleteStationHealth(ctx context.Context, arg db.DeleteStationHealthParams) error {
+// ListStationMOObservationTimestamps provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationMOObservationTimestamps(ctx context.Context, arg db.ListStationMOObservationTimestampsParams) ([]pgtype.Timestamptz, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteStationHealth")
+		panic("no return value specified for ListStationMOObservationTimestamps")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationHealthParams) error); ok {
+	var r0 []pgtype.Timestamptz
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMOObservationTimestampsParams) ([]pgtype.Timestamptz, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMOObservationTimestampsParams) []pgtype.Timestamptz); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pgtype.Timestamptz)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMOObservationTimestampsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationHealth'
-type MockStore_DeleteStationHealth_Call struct {
+// MockStore_ListStationMOObservationTimestamps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMOObservationTimestamps'
+type MockStore_ListStationMOObservationTimestamps_Call struct {
 	*mock.Call
 }
 
-// DeleteStationHealth is a helper method to define mock.On call
+// ListStationMOObservationTimestamps is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.DeleteStationHealthParams
-func (_e *MockStore_Expecter) DeleteStationHealth(ctx interface{}, arg interface{}) *MockStore_DeleteStationHealth_Call {
-	return &MockStore_DeleteStationHealth_Call{Call: _e.mock.On("DeleteStationHealth", ctx, arg)}
+//   - arg db.ListStationMOObservationTimestampsParams
+func (_e *MockStore_Expecter) ListStationMOObservationTimestamps(ctx interface{}, arg interface{}) *MockStore_ListStationMOObservationTimestamps_Call {
+	return &MockStore_ListStationMOObservationTimestamps_Call{Call: _e.mock.On("ListStationMOObservationTimestamps", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteStationHealth_Call) Run(run func(ctx context.Context, arg db.DeleteStationHealthParams)) *MockStore_DeleteStationHealth_Call {
+func (_c *MockStore_ListStationMOObservationTimestamps_Call) Run(run func(ctx context.Context, arg db.ListStationMOObservationTimestampsParams)) *MockStore_ListStationMOObservationTimestamps_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.DeleteStationHealthParams))
+		run(args[0].(context.Context), args[1].(db.ListStationMOObservationTimestampsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteStationHealth_Call) Return(_a0 error) *MockStore_DeleteStationHealth_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationMOObservationTimestamps_Call) Return(_a0 []pgtype.Timestamptz, _a1 error) *MockStore_ListStationMOObservationTimestamps_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteStationHealth_Call) RunAndReturn(run func(context.Context, db.DeleteStationHealthParams) error) *MockStore_DeleteStationHealth_Call {
+func (_c *MockStore_ListStationMOObservationTimestamps_Call) RunAndReturn(run func(context.Context, db.ListStationMOObservationTimestampsParams) ([]pgtype.Timestamptz, error)) *MockStore_ListStationMOObservationTimestamps_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteStationMOObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) DeleteStationMOObservation(ctx context.Context, arg db.DeleteStationMOObservationParams) error {
+// ListStationMOObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationMOObservations(ctx context.Context, arg db.ListStationMOObservationsParams) ([]db.ObservationsMoObservation, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteStationMOObservation")
+		panic("no return value specified for ListStationMOObservations")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationMOObservationParams) error); ok {
+	var r0 []db.ObservationsMoObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMOObservationsParams) ([]db.ObservationsMoObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMOObservationsParams) []db.ObservationsMoObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsMoObservation)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMOObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteStationMOObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationMOObservation'
-type MockStore_DeleteStationMOObservation_Call struct {
+// MockStore_ListStationMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMOObservations'
+type MockStore_ListStationMOObservations_Call struct {
 	*mock.Call
 }
 
-// DeleteStationMOObservation is a helper method to define mock.On call
+// ListStationMOObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.DeleteStationMOObservationParams
-func (_e *MockStore_Expecter) DeleteStationMOObservation(ctx interface{}, arg interface{}) *MockStore_DeleteStationMOObservation_Call {
-	return &MockStore_DeleteStationMOObservation_Call{Call: _e.mock.On("DeleteStationMOObservation", ctx, arg)}
+//   - arg db.ListStationMOObservationsParams
+func (_e *MockStore_Expecter) ListStationMOObservations(ctx interface{}, arg interface{}) *MockStore_ListStationMOObservations_Call {
+	return &MockStore_ListStationMOObservations_Call{Call: _e.mock.On("ListStationMOObservations", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteStationMOObservation_Call) Run(run func(ctx context.Context, arg db.DeleteStationMOObservationParams)) *MockStore_DeleteStationMOObservation_Call {
+func (_c *MockStore_ListStationMOObservations_Call) Run(run func(ctx context.Context, arg db.ListStationMOObservationsParams)) *MockStore_ListStationMOObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.DeleteStationMOObservationParams))
+		run(args[0].(context.Context), args[1].(db.ListStationMOObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteStationMOObservation_Call) Return(_a0 error) *MockStore_DeleteStationMOObservation_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationMOObservations_Call) Return(_a0 []db.ObservationsMoObservation, _a1 error) *MockStore_ListStationMOObservations_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteStationMOObservation_Call) RunAndReturn(run func(context.Context, db.DeleteStationMOObservationParams) error) *MockStore_DeleteStationMOObservation_Call {
+func (_c *MockStore_ListStationMOObservations_Call) RunAndReturn(run func(context.Context, db.ListStationMOObservationsParams) ([]db.ObservationsMoObservation, error)) *MockStore_ListStationMOObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) DeleteStationObservation(ctx context.Context, arg db.DeleteStationObservationParams) error {
+// ListStationMaintenance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationMaintenance(ctx context.Context, arg db.ListStationMaintenanceParams) ([]db.StationMaintenance, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteStationObservation")
+		panic("no return value specified for ListStationMaintenance")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationObservationParams) error); ok {
+	var r0 []db.StationMaintenance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMaintenanceParams) ([]db.StationMaintenance, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMaintenanceParams) []db.StationMaintenance); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.StationMaintenance)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMaintenanceParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationObservation'
-type MockStore_DeleteStationObservation_Call struct {
+// MockStore_ListStationMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMaintenance'
+type MockStore_ListStationMaintenance_Call struct {
 	*mock.Call
 }
 
-// DeleteStationObservation is a helper method to define mock.On call
+// ListStationMaintenance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.DeleteStationObservationParams
-func (_e *MockStore_Expecter) DeleteStationObservation(ctx interface{}, arg interface{}) *MockStore_DeleteStationObservation_Call {
-	return &MockStore_DeleteStationObservation_Call{Call: _e.mock.On("DeleteStationObservation", ctx, arg)}
+//   - arg db.ListStationMaintenanceParams
+func (_e *MockStore_Expecter) ListStationMaintenance(ctx interface{}, arg interface{}) *MockStore_ListStationMaintenance_Call {
+	return &MockStore_ListStationMaintenance_Call{Call: _e.mock.On("ListStationMaintenance", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteStationObservation_Call) Run(run func(ctx context.Context, arg db.DeleteStationObservationParams)) *MockStore_DeleteStationObservation_Call {
+func (_c *MockStore_ListStationMaintenance_Call) Run(run func(ctx context.Context, arg db.ListStationMaintenanceParams)) *MockStore_ListStationMaintenance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.DeleteStationObservationParams))
+		run(args[0].(context.Context), args[1].(db.ListStationMaintenanceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteStationObservation_Call) Return(_a0 error) *MockStore_DeleteStationObservation_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationMaintenance_Call) Return(_a0 []db.StationMaintenance, _a1 error) *MockStore_ListStationMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteStationObservation_Call) RunAndReturn(run func(context.Context, db.DeleteStationObservationParams) error) *MockStore_DeleteStationObservation_Call {
+func (_c *MockStore_ListStationMaintenance_Call) RunAndReturn(run func(context.Context, db.ListStationMaintenanceParams) ([]db.StationMaintenance, error)) *MockStore_ListStationMaintenance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteUser(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ListStationMaintenanceWindowsInRange provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationMaintenanceWindowsInRange(ctx context.Context, arg db.ListStationMaintenanceWindowsInRangeParams) ([]db.StationMaintenance, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for ListStationMaintenanceWindowsInRange")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.StationMaintenance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMaintenanceWindowsInRangeParams) ([]db.StationMaintenance, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMaintenanceWindowsInRangeParams) []db.StationMaintenance); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.StationMaintenance)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMaintenanceWindowsInRangeParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type MockStore_DeleteUser_Call struct {
+// MockStore_ListStationMaintenanceWindowsInRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMaintenanceWindowsInRange'
+type MockStore_ListStationMaintenanceWindowsInRange_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// ListStationMaintenanceWindowsInRange is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockStore_DeleteUser_Call {
-	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+//   - arg db.ListStationMaintenanceWindowsInRangeParams
+func (_e *MockStore_Expecter) ListStationMaintenanceWindowsInRange(ctx interface{}, arg interface{}) *MockStore_ListStationMaintenanceWindowsInRange_Call {
+	return &MockStore_ListStationMaintenanceWindowsInRange_Call{Call: _e.mock.On("ListStationMaintenanceWindowsInRange", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteUser_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteUser_Call {
+func (_c *MockStore_ListStationMaintenanceWindowsInRange_Call) Run(run func(ctx context.Context, arg db.ListStationMaintenanceWindowsInRangeParams)) *MockStore_ListStationMaintenanceWindowsInRange_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationMaintenanceWindowsInRangeParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) Return(_a0 error) *MockStore_DeleteUser_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationMaintenanceWindowsInRange_Call) Return(_a0 []db.StationMaintenance, _a1 error) *MockStore_ListStationMaintenanceWindowsInRange_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteUser_Call {
+func (_c *MockStore_ListStationMaintenanceWindowsInRange_Call) RunAndReturn(run func(context.Context, db.ListStationMaintenanceWindowsInRangeParams) ([]db.StationMaintenance, error)) *MockStore_ListStationMaintenanceWindowsInRange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FirstOrCreateSimAccessTokenTx provides a mock function with given fields: ctx, arg
-func (_m *MockStore) FirstOrCreateSimAccessTokenTx(ctx context.Context, arg db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error) {
-	ret := _m.Called(ctx, arg)
+// ListStationMaintenanceWindowsToClose provides a mock function with given fields: ctx, now
+func (_m *MockStore) ListStationMaintenanceWindowsToClose(ctx context.Context, now pgtype.Timestamptz) ([]db.StationMaintenance, error) {
+	ret := _m.Called(ctx, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FirstOrCreateSimAccessTokenTx")
+		panic("no return value specified for ListStationMaintenanceWindowsToClose")
 	}
 
-	var r0 db.FirstOrCreateSimAccessTokenTxResult
+	var r0 []db.StationMaintenance
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]db.StationMaintenance, error)); ok {
+		return rf(ctx, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) db.FirstOrCreateSimAccessTokenTxResult); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []db.StationMaintenance); ok {
+		r0 = rf(ctx, now)
 	} else {
-		r0 = ret.Get(0).(db.FirstOrCreateSimAccessTokenTxResult)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.StationMaintenance)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2051,56 +7729,58 @@ func (_m *MockStore) FirstOrCreateSimAccessTokenTx(ctx context.Context, arg db.F
 	return r0, r1
 }
 
-// MockStore_FirstOrCreateSimAccessTokenTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FirstOrCreateSimAccessTokenTx'
-type MockStore_FirstOrCreateSimAccessTokenTx_Call struct {
+// MockStore_ListStationMaintenanceWindowsToClose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMaintenanceWindowsToClose'
+type MockStore_ListStationMaintenanceWindowsToClose_Call struct {
 	*mock.Call
 }
 
-// FirstOrCreateSimAccessTokenTx is a helper method to define mock.On call
+// ListStationMaintenanceWindowsToClose is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.FirstOrCreateSimAccessTokenTxParams
-func (_e *MockStore_Expecter) FirstOrCreateSimAccessTokenTx(ctx interface{}, arg interface{}) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
-	return &MockStore_FirstOrCreateSimAccessTokenTx_Call{Call: _e.mock.On("FirstOrCreateSimAccessTokenTx", ctx, arg)}
+//   - now pgtype.Timestamptz
+func (_e *MockStore_Expecter) ListStationMaintenanceWindowsToClose(ctx interface{}, now interface{}) *MockStore_ListStationMaintenanceWindowsToClose_Call {
+	return &MockStore_ListStationMaintenanceWindowsToClose_Call{Call: _e.mock.On("ListStationMaintenanceWindowsToClose", ctx, now)}
 }
 
-func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) Run(run func(ctx context.Context, arg db.FirstOrCreateSimAccessTokenTxParams)) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+func (_c *MockStore_ListStationMaintenanceWindowsToClose_Call) Run(run func(ctx context.Context, now pgtype.Timestamptz)) *MockStore_ListStationMaintenanceWindowsToClose_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.FirstOrCreateSimAccessTokenTxParams))
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
 	})
 	return _c
 }
 
-func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) Return(_a0 db.FirstOrCreateSimAccessTokenTxResult, _a1 error) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+func (_c *MockStore_ListStationMaintenanceWindowsToClose_Call) Return(_a0 []db.StationMaintenance, _a1 error) *MockStore_ListStationMaintenanceWindowsToClose_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) RunAndReturn(run func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error)) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+func (_c *MockStore_ListStationMaintenanceWindowsToClose_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]db.StationMaintenance, error)) *MockStore_ListStationMaintenanceWindowsToClose_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatestStationObservation provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetLatestStationObservation(ctx context.Context, id int64) (db.GetLatestStationObservationRow, error) {
-	ret := _m.Called(ctx, id)
+// ListStationMaintenanceWindowsToOpen provides a mock function with given fields: ctx, now
+func (_m *MockStore) ListStationMaintenanceWindowsToOpen(ctx context.Context, now pgtype.Timestamptz) ([]db.StationMaintenance, error) {
+	ret := _m.Called(ctx, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatestStationObservation")
+		panic("no return value specified for ListStationMaintenanceWindowsToOpen")
 	}
 
-	var r0 db.GetLatestStationObservationRow
+	var r0 []db.StationMaintenance
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.GetLatestStationObservationRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]db.StationMaintenance, error)); ok {
+		return rf(ctx, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.GetLatestStationObservationRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []db.StationMaintenance); ok {
+		r0 = rf(ctx, now)
 	} else {
-		r0 = ret.Get(0).(db.GetLatestStationObservationRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.StationMaintenance)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2108,56 +7788,58 @@ func (_m *MockStore) GetLatestStationObservation(ctx context.Context, id int64)
 	return r0, r1
 }
 
-// MockStore_GetLatestStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStationObservation'
-type MockStore_GetLatestStationObservation_Call struct {
+// MockStore_ListStationMaintenanceWindowsToOpen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMaintenanceWindowsToOpen'
+type MockStore_ListStationMaintenanceWindowsToOpen_Call struct {
 	*mock.Call
 }
 
-// GetLatestStationObservation is a helper method to define mock.On call
+// ListStationMaintenanceWindowsToOpen is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetLatestStationObservation(ctx interface{}, id interface{}) *MockStore_GetLatestStationObservation_Call {
-	return &MockStore_GetLatestStationObservation_Call{Call: _e.mock.On("GetLatestStationObservation", ctx, id)}
+//   - now pgtype.Timestamptz
+func (_e *MockStore_Expecter) ListStationMaintenanceWindowsToOpen(ctx interface{}, now interface{}) *MockStore_ListStationMaintenanceWindowsToOpen_Call {
+	return &MockStore_ListStationMaintenanceWindowsToOpen_Call{Call: _e.mock.On("ListStationMaintenanceWindowsToOpen", ctx, now)}
 }
 
-func (_c *MockStore_GetLatestStationObservation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetLatestStationObservation_Call {
+func (_c *MockStore_ListStationMaintenanceWindowsToOpen_Call) Run(run func(ctx context.Context, now pgtype.Timestamptz)) *MockStore_ListStationMaintenanceWindowsToOpen_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetLatestStationObservation_Call) Return(_a0 db.GetLatestStationObservationRow, _a1 error) *MockStore_GetLatestStationObservation_Call {
+func (_c *MockStore_ListStationMaintenanceWindowsToOpen_Call) Return(_a0 []db.StationMaintenance, _a1 error) *MockStore_ListStationMaintenanceWindowsToOpen_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetLatestStationObservation_Call) RunAndReturn(run func(context.Context, int64) (db.GetLatestStationObservationRow, error)) *MockStore_GetLatestStationObservation_Call {
+func (_c *MockStore_ListStationMaintenanceWindowsToOpen_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]db.StationMaintenance, error)) *MockStore_ListStationMaintenanceWindowsToOpen_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMisolStation provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetMisolStation(ctx context.Context, id int64) (db.MisolStation, error) {
-	ret := _m.Called(ctx, id)
+// ListStationMetadataHistory provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationMetadataHistory(ctx context.Context, arg db.ListStationMetadataHistoryParams) ([]db.StationMetadataHistory, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMisolStation")
+		panic("no return value specified for ListStationMetadataHistory")
 	}
 
-	var r0 db.MisolStation
+	var r0 []db.StationMetadataHistory
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.MisolStation, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMetadataHistoryParams) ([]db.StationMetadataHistory, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.MisolStation); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMetadataHistoryParams) []db.StationMetadataHistory); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.MisolStation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.StationMetadataHistory)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMetadataHistoryParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2165,55 +7847,57 @@ func (_m *MockStore) GetMisolStation(ctx context.Context, id int64) (db.MisolSta
 	return r0, r1
 }
 
-// MockStore_GetMisolStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMisolStation'
-type MockStore_GetMisolStation_Call struct {
+// MockStore_ListStationMetadataHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMetadataHistory'
+type MockStore_ListStationMetadataHistory_Call struct {
 	*mock.Call
 }
 
-// GetMisolStation is a helper method to define mock.On call
+// ListStationMetadataHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetMisolStation(ctx interface{}, id interface{}) *MockStore_GetMisolStation_Call {
-	return &MockStore_GetMisolStation_Call{Call: _e.mock.On("GetMisolStation", ctx, id)}
+//   - arg db.ListStationMetadataHistoryParams
+func (_e *MockStore_Expecter) ListStationMetadataHistory(ctx interface{}, arg interface{}) *MockStore_ListStationMetadataHistory_Call {
+	return &MockStore_ListStationMetadataHistory_Call{Call: _e.mock.On("ListStationMetadataHistory", ctx, arg)}
 }
 
-func (_c *MockStore_GetMisolStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetMisolStation_Call {
+func (_c *MockStore_ListStationMetadataHistory_Call) Run(run func(ctx context.Context, arg db.ListStationMetadataHistoryParams)) *MockStore_ListStationMetadataHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationMetadataHistoryParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetMisolStation_Call) Return(_a0 db.MisolStation, _a1 error) *MockStore_GetMisolStation_Call {
+func (_c *MockStore_ListStationMetadataHistory_Call) Return(_a0 []db.StationMetadataHistory, _a1 error) *MockStore_ListStationMetadataHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetMisolStation_Call) RunAndReturn(run func(context.Context, int64) (db.MisolStation, error)) *MockStore_GetMisolStation_Call {
+func (_c *MockStore_ListStationMetadataHistory_Call) RunAndReturn(run func(context.Context, db.ListStationMetadataHistoryParams) ([]db.StationMetadataHistory, error)) *MockStore_ListStationMetadataHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNearestLatestStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) GetNearestLatestStationObservation(ctx context.Context, arg db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error) {
+// ListStationMetadataInRange provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationMetadataInRange(ctx context.Context, arg db.ListStationMetadataInRangeParams) ([]db.StationMetadataHistory, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNearestLatestStationObservation")
+		panic("no return value specified for ListStationMetadataInRange")
 	}
 
-	var r0 db.GetNearestLatestStationObservationRow
+	var r0 []db.StationMetadataHistory
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMetadataInRangeParams) ([]db.StationMetadataHistory, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetNearestLatestStationObservationParams) db.GetNearestLatestStationObservationRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMetadataInRangeParams) []db.StationMetadataHistory); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.GetNearestLatestStationObservationRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.StationMetadataHistory)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.GetNearestLatestStationObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMetadataInRangeParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2222,56 +7906,58 @@ func (_m *MockStore) GetNearestLatestStationObservation(ctx context.Context, arg
 	return r0, r1
 }
 
-// MockStore_GetNearestLatestStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNearestLatestStationObservation'
-type MockStore_GetNearestLatestStationObservation_Call struct {
+// MockStore_ListStationMetadataInRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMetadataInRange'
+type MockStore_ListStationMetadataInRange_Call struct {
 	*mock.Call
 }
 
-// GetNearestLatestStationObservation is a helper method to define mock.On call
+// ListStationMetadataInRange is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetNearestLatestStationObservationParams
-func (_e *MockStore_Expecter) GetNearestLatestStationObservation(ctx interface{}, arg interface{}) *MockStore_GetNearestLatestStationObservation_Call {
-	return &MockStore_GetNearestLatestStationObservation_Call{Call: _e.mock.On("GetNearestLatestStationObservation", ctx, arg)}
+//   - arg db.ListStationMetadataInRangeParams
+func (_e *MockStore_Expecter) ListStationMetadataInRange(ctx interface{}, arg interface{}) *MockStore_ListStationMetadataInRange_Call {
+	return &MockStore_ListStationMetadataInRange_Call{Call: _e.mock.On("ListStationMetadataInRange", ctx, arg)}
 }
 
-func (_c *MockStore_GetNearestLatestStationObservation_Call) Run(run func(ctx context.Context, arg db.GetNearestLatestStationObservationParams)) *MockStore_GetNearestLatestStationObservation_Call {
+func (_c *MockStore_ListStationMetadataInRange_Call) Run(run func(ctx context.Context, arg db.ListStationMetadataInRangeParams)) *MockStore_ListStationMetadataInRange_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.GetNearestLatestStationObservationParams))
+		run(args[0].(context.Context), args[1].(db.ListStationMetadataInRangeParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetNearestLatestStationObservation_Call) Return(_a0 db.GetNearestLatestStationObservationRow, _a1 error) *MockStore_GetNearestLatestStationObservation_Call {
+func (_c *MockStore_ListStationMetadataInRange_Call) Return(_a0 []db.StationMetadataHistory, _a1 error) *MockStore_ListStationMetadataInRange_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetNearestLatestStationObservation_Call) RunAndReturn(run func(context.Context, db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error)) *MockStore_GetNearestLatestStationObservation_Call {
+func (_c *MockStore_ListStationMetadataInRange_Call) RunAndReturn(run func(context.Context, db.ListStationMetadataInRangeParams) ([]db.StationMetadataHistory, error)) *MockStore_ListStationMetadataInRange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRole provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetRole(ctx context.Context, id int64) (db.Role, error) {
-	ret := _m.Called(ctx, id)
+// ListStationObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationObservations(ctx context.Context, arg db.ListStationObservationsParams) ([]db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRole")
+		panic("no return value specified for ListStationObservations")
 	}
 
-	var r0 db.Role
+	var r0 []db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Role, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsParams) ([]db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Role); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsParams) []db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Role)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsObservation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2279,56 +7965,58 @@ func (_m *MockStore) GetRole(ctx context.Context, id int64) (db.Role, error) {
 	return r0, r1
 }
 
-// MockStore_GetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRole'
-type MockStore_GetRole_Call struct {
+// MockStore_ListStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationObservations'
+type MockStore_ListStationObservations_Call struct {
 	*mock.Call
 }
 
-// GetRole is a helper method to define mock.On call
+// ListStationObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetRole(ctx interface{}, id interface{}) *MockStore_GetRole_Call {
-	return &MockStore_GetRole_Call{Call: _e.mock.On("GetRole", ctx, id)}
+//   - arg db.ListStationObservationsParams
+func (_e *MockStore_Expecter) ListStationObservations(ctx interface{}, arg interface{}) *MockStore_ListStationObservations_Call {
+	return &MockStore_ListStationObservations_Call{Call: _e.mock.On("ListStationObservations", ctx, arg)}
 }
 
-func (_c *MockStore_GetRole_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetRole_Call {
+func (_c *MockStore_ListStationObservations_Call) Run(run func(ctx context.Context, arg db.ListStationObservationsParams)) *MockStore_ListStationObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_GetRole_Call {
+func (_c *MockStore_ListStationObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListStationObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRole_Call) RunAndReturn(run func(context.Context, int64) (db.Role, error)) *MockStore_GetRole_Call {
+func (_c *MockStore_ListStationObservations_Call) RunAndReturn(run func(context.Context, db.ListStationObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListStationObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRoleByName provides a mock function with given fields: ctx, name
-func (_m *MockStore) GetRoleByName(ctx context.Context, name string) (db.Role, error) {
-	ret := _m.Called(ctx, name)
+// ListStationStatusHistory provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationStatusHistory(ctx context.Context, arg db.ListStationStatusHistoryParams) ([]db.StationStatusHistory, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRoleByName")
+		panic("no return value specified for ListStationStatusHistory")
 	}
 
-	var r0 db.Role
+	var r0 []db.StationStatusHistory
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.Role, error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationStatusHistoryParams) ([]db.StationStatusHistory, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.Role); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationStatusHistoryParams) []db.StationStatusHistory); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Role)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.StationStatusHistory)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationStatusHistoryParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2336,56 +8024,58 @@ func (_m *MockStore) GetRoleByName(ctx context.Context, name string) (db.Role, e
 	return r0, r1
 }
 
-// MockStore_GetRoleByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByName'
-type MockStore_GetRoleByName_Call struct {
+// MockStore_ListStationStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationStatusHistory'
+type MockStore_ListStationStatusHistory_Call struct {
 	*mock.Call
 }
 
-// GetRoleByName is a helper method to define mock.On call
+// ListStationStatusHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockStore_Expecter) GetRoleByName(ctx interface{}, name interface{}) *MockStore_GetRoleByName_Call {
-	return &MockStore_GetRoleByName_Call{Call: _e.mock.On("GetRoleByName", ctx, name)}
+//   - arg db.ListStationStatusHistoryParams
+func (_e *MockStore_Expecter) ListStationStatusHistory(ctx interface{}, arg interface{}) *MockStore_ListStationStatusHistory_Call {
+	return &MockStore_ListStationStatusHistory_Call{Call: _e.mock.On("ListStationStatusHistory", ctx, arg)}
 }
 
-func (_c *MockStore_GetRoleByName_Call) Run(run func(ctx context.Context, name string)) *MockStore_GetRoleByName_Call {
+func (_c *MockStore_ListStationStatusHistory_Call) Run(run func(ctx context.Context, arg db.ListStationStatusHistoryParams)) *MockStore_ListStationStatusHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(db.ListStationStatusHistoryParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRoleByName_Call) Return(_a0 db.Role, _a1 error) *MockStore_GetRoleByName_Call {
+func (_c *MockStore_ListStationStatusHistory_Call) Return(_a0 []db.StationStatusHistory, _a1 error) *MockStore_ListStationStatusHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRoleByName_Call) RunAndReturn(run func(context.Context, string) (db.Role, error)) *MockStore_GetRoleByName_Call {
+func (_c *MockStore_ListStationStatusHistory_Call) RunAndReturn(run func(context.Context, db.ListStationStatusHistoryParams) ([]db.StationStatusHistory, error)) *MockStore_ListStationStatusHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSession provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
-	ret := _m.Called(ctx, id)
+// ListStations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStations(ctx context.Context, arg db.ListStationsParams) ([]db.ObservationsStation, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSession")
+		panic("no return value specified for ListStations")
 	}
 
-	var r0 db.Session
+	var r0 []db.ObservationsStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsParams) ([]db.ObservationsStation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsParams) []db.ObservationsStation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Session)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2393,56 +8083,58 @@ func (_m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session,
 	return r0, r1
 }
 
-// MockStore_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
-type MockStore_GetSession_Call struct {
+// MockStore_ListStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStations'
+type MockStore_ListStations_Call struct {
 	*mock.Call
 }
 
-// GetSession is a helper method to define mock.On call
+// ListStations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) GetSession(ctx interface{}, id interface{}) *MockStore_GetSession_Call {
-	return &MockStore_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+//   - arg db.ListStationsParams
+func (_e *MockStore_Expecter) ListStations(ctx interface{}, arg interface{}) *MockStore_ListStations_Call {
+	return &MockStore_ListStations_Call{Call: _e.mock.On("ListStations", ctx, arg)}
 }
 
-func (_c *MockStore_GetSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetSession_Call {
+func (_c *MockStore_ListStations_Call) Run(run func(ctx context.Context, arg db.ListStationsParams)) *MockStore_ListStations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(db.ListStationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSession_Call) Return(_a0 db.Session, _a1 error) *MockStore_GetSession_Call {
+func (_c *MockStore_ListStations_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *MockStore_GetSession_Call {
+func (_c *MockStore_ListStations_Call) RunAndReturn(run func(context.Context, db.ListStationsParams) ([]db.ObservationsStation, error)) *MockStore_ListStations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSimAccessToken provides a mock function with given fields: ctx, accessToken
-func (_m *MockStore) GetSimAccessToken(ctx context.Context, accessToken string) (db.SimAccessToken, error) {
-	ret := _m.Called(ctx, accessToken)
+// ListStationsByMobileNumbers provides a mock function with given fields: ctx, mobileNumbers
+func (_m *MockStore) ListStationsByMobileNumbers(ctx context.Context, mobileNumbers []string) ([]db.ObservationsStation, error) {
+	ret := _m.Called(ctx, mobileNumbers)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSimAccessToken")
+		panic("no return value specified for ListStationsByMobileNumbers")
 	}
 
-	var r0 db.SimAccessToken
+	var r0 []db.ObservationsStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimAccessToken, error)); ok {
-		return rf(ctx, accessToken)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]db.ObservationsStation, error)); ok {
+		return rf(ctx, mobileNumbers)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimAccessToken); ok {
-		r0 = rf(ctx, accessToken)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []db.ObservationsStation); ok {
+		r0 = rf(ctx, mobileNumbers)
 	} else {
-		r0 = ret.Get(0).(db.SimAccessToken)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, accessToken)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, mobileNumbers)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2450,56 +8142,58 @@ func (_m *MockStore) GetSimAccessToken(ctx context.Context, accessToken string)
 	return r0, r1
 }
 
-// MockStore_GetSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimAccessToken'
-type MockStore_GetSimAccessToken_Call struct {
+// MockStore_ListStationsByMobileNumbers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsByMobileNumbers'
+type MockStore_ListStationsByMobileNumbers_Call struct {
 	*mock.Call
 }
 
-// GetSimAccessToken is a helper method to define mock.On call
+// ListStationsByMobileNumbers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - accessToken string
-func (_e *MockStore_Expecter) GetSimAccessToken(ctx interface{}, accessToken interface{}) *MockStore_GetSimAccessToken_Call {
-	return &MockStore_GetSimAccessToken_Call{Call: _e.mock.On("GetSimAccessToken", ctx, accessToken)}
+//   - mobileNumbers []string
+func (_e *MockStore_Expecter) ListStationsByMobileNumbers(ctx interface{}, mobileNumbers interface{}) *MockStore_ListStationsByMobileNumbers_Call {
+	return &MockStore_ListStationsByMobileNumbers_Call{Call: _e.mock.On("ListStationsByMobileNumbers", ctx, mobileNumbers)}
 }
 
-func (_c *MockStore_GetSimAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockStore_GetSimAccessToken_Call {
+func (_c *MockStore_ListStationsByMobileNumbers_Call) Run(run func(ctx context.Context, mobileNumbers []string)) *MockStore_ListStationsByMobileNumbers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_GetSimAccessToken_Call {
+func (_c *MockStore_ListStationsByMobileNumbers_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStationsByMobileNumbers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSimAccessToken_Call) RunAndReturn(run func(context.Context, string) (db.SimAccessToken, error)) *MockStore_GetSimAccessToken_Call {
+func (_c *MockStore_ListStationsByMobileNumbers_Call) RunAndReturn(run func(context.Context, []string) ([]db.ObservationsStation, error)) *MockStore_ListStationsByMobileNumbers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSimCard provides a mock function with given fields: ctx, mobileNumber
-func (_m *MockStore) GetSimCard(ctx context.Context, mobileNumber string) (db.SimCard, error) {
-	ret := _m.Called(ctx, mobileNumber)
+// ListStationsLastObserved provides a mock function with given fields: ctx
+func (_m *MockStore) ListStationsLastObserved(ctx context.Context) ([]db.ListStationsLastObservedRow, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSimCard")
+		panic("no return value specified for ListStationsLastObserved")
 	}
 
-	var r0 db.SimCard
+	var r0 []db.ListStationsLastObservedRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimCard, error)); ok {
-		return rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ListStationsLastObservedRow, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimCard); ok {
-		r0 = rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(0).(func(context.Context) []db.ListStationsLastObservedRow); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(db.SimCard)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListStationsLastObservedRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2507,56 +8201,57 @@ func (_m *MockStore) GetSimCard(ctx context.Context, mobileNumber string) (db.Si
 	return r0, r1
 }
 
-// MockStore_GetSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimCard'
-type MockStore_GetSimCard_Call struct {
+// MockStore_ListStationsLastObserved_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsLastObserved'
+type MockStore_ListStationsLastObserved_Call struct {
 	*mock.Call
 }
 
-// GetSimCard is a helper method to define mock.On call
+// ListStationsLastObserved is a helper method to define mock.On call
 //   - ctx context.Context
-//   - mobileNumber string
-func (_e *MockStore_Expecter) GetSimCard(ctx interface{}, mobileNumber interface{}) *MockStore_GetSimCard_Call {
-	return &MockStore_GetSimCard_Call{Call: _e.mock.On("GetSimCard", ctx, mobileNumber)}
+func (_e *MockStore_Expecter) ListStationsLastObserved(ctx interface{}) *MockStore_ListStationsLastObserved_Call {
+	return &MockStore_ListStationsLastObserved_Call{Call: _e.mock.On("ListStationsLastObserved", ctx)}
 }
 
-func (_c *MockStore_GetSimCard_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_GetSimCard_Call {
+func (_c *MockStore_ListStationsLastObserved_Call) Run(run func(ctx context.Context)) *MockStore_ListStationsLastObserved_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_GetSimCard_Call {
+func (_c *MockStore_ListStationsLastObserved_Call) Return(_a0 []db.ListStationsLastObservedRow, _a1 error) *MockStore_ListStationsLastObserved_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSimCard_Call) RunAndReturn(run func(context.Context, string) (db.SimCard, error)) *MockStore_GetSimCard_Call {
+func (_c *MockStore_ListStationsLastObserved_Call) RunAndReturn(run func(context.Context) ([]db.ListStationsLastObservedRow, error)) *MockStore_ListStationsLastObserved_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStation provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetStation(ctx context.Context, id int64) (db.ObservationsStation, error) {
-	ret := _m.Called(ctx, id)
+// ListUploadStations provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) ListUploadStations(ctx context.Context, stationID int64) ([]db.UploadStation, error) {
+	ret := _m.Called(ctx, stationID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStation")
+		panic("no return value specified for ListUploadStations")
 	}
 
-	var r0 db.ObservationsStation
+	var r0 []db.UploadStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsStation, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]db.UploadStation, error)); ok {
+		return rf(ctx, stationID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsStation); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []db.UploadStation); ok {
+		r0 = rf(ctx, stationID)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.UploadStation)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, stationID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2564,56 +8259,58 @@ func (_m *MockStore) GetStation(ctx context.Context, id int64) (db.ObservationsS
 	return r0, r1
 }
 
-// MockStore_GetStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStation'
-type MockStore_GetStation_Call struct {
+// MockStore_ListUploadStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUploadStations'
+type MockStore_ListUploadStations_Call struct {
 	*mock.Call
 }
 
-// GetStation is a helper method to define mock.On call
+// ListUploadStations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetStation(ctx interface{}, id interface{}) *MockStore_GetStation_Call {
-	return &MockStore_GetStation_Call{Call: _e.mock.On("GetStation", ctx, id)}
+//   - stationID int64
+func (_e *MockStore_Expecter) ListUploadStations(ctx interface{}, stationID interface{}) *MockStore_ListUploadStations_Call {
+	return &MockStore_ListUploadStations_Call{Call: _e.mock.On("ListUploadStations", ctx, stationID)}
 }
 
-func (_c *MockStore_GetStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetStation_Call {
+func (_c *MockStore_ListUploadStations_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_ListUploadStations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetStation_Call {
+func (_c *MockStore_ListUploadStations_Call) Return(_a0 []db.UploadStation, _a1 error) *MockStore_ListUploadStations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetStation_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsStation, error)) *MockStore_GetStation_Call {
+func (_c *MockStore_ListUploadStations_Call) RunAndReturn(run func(context.Context, int64) ([]db.UploadStation, error)) *MockStore_ListUploadStations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStationByMobileNumber provides a mock function with given fields: ctx, mobileNumber
-func (_m *MockStore) GetStationByMobileNumber(ctx context.Context, mobileNumber pgtype.Text) (db.ObservationsStation, error) {
-	ret := _m.Called(ctx, mobileNumber)
+// ListUserRoles provides a mock function with given fields: ctx, userID
+func (_m *MockStore) ListUserRoles(ctx context.Context, userID int64) ([]string, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStationByMobileNumber")
+		panic("no return value specified for ListUserRoles")
 	}
 
-	var r0 db.ObservationsStation
+	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (db.ObservationsStation, error)); ok {
-		return rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]string, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) db.ObservationsStation); ok {
-		r0 = rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
+		r0 = rf(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
-		r1 = rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2621,55 +8318,57 @@ func (_m *MockStore) GetStationByMobileNumber(ctx context.Context, mobileNumber
 	return r0, r1
 }
 
-// MockStore_GetStationByMobileNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationByMobileNumber'
-type MockStore_GetStationByMobileNumber_Call struct {
+// MockStore_ListUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRoles'
+type MockStore_ListUserRoles_Call struct {
 	*mock.Call
 }
 
-// GetStationByMobileNumber is a helper method to define mock.On call
+// ListUserRoles is a helper method to define mock.On call
 //   - ctx context.Context
-//   - mobileNumber pgtype.Text
-func (_e *MockStore_Expecter) GetStationByMobileNumber(ctx interface{}, mobileNumber interface{}) *MockStore_GetStationByMobileNumber_Call {
-	return &MockStore_GetStationByMobileNumber_Call{Call: _e.mock.On("GetStationByMobileNumber", ctx, mobileNumber)}
+//   - userID int64
+func (_e *MockStore_Expecter) ListUserRoles(ctx interface{}, userID interface{}) *MockStore_ListUserRoles_Call {
+	return &MockStore_ListUserRoles_Call{Call: _e.mock.On("ListUserRoles", ctx, userID)}
 }
 
-func (_c *MockStore_GetStationByMobileNumber_Call) Run(run func(ctx context.Context, mobileNumber pgtype.Text)) *MockStore_GetStationByMobileNumber_Call {
+func (_c *MockStore_ListUserRoles_Call) Run(run func(ctx context.Context, userID int64)) *MockStore_ListUserRoles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStationByMobileNumber_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetStationByMobileNumber_Call {
+func (_c *MockStore_ListUserRoles_Call) Return(_a0 []string, _a1 error) *MockStore_ListUserRoles_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetStationByMobileNumber_Call) RunAndReturn(run func(context.Context, pgtype.Text) (db.ObservationsStation, error)) *MockStore_GetStationByMobileNumber_Call {
+func (_c *MockStore_ListUserRoles_Call) RunAndReturn(run func(context.Context, int64) ([]string, error)) *MockStore_ListUserRoles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStationHealth provides a mock function with given fields: ctx, arg
-func (_m *MockStore) GetStationHealth(ctx context.Context, arg db.GetStationHealthParams) (db.ObservationsStationhealth, error) {
+// ListUsers provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStationHealth")
+		panic("no return value specified for ListUsers")
 	}
 
-	var r0 db.ObservationsStationhealth
+	var r0 []db.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListUsersParams) ([]db.User, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthParams) db.ObservationsStationhealth); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListUsersParams) []db.User); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStationhealth)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.User)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationHealthParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListUsersParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2678,55 +8377,57 @@ func (_m *MockStore) GetStationHealth(ctx context.Context, arg db.GetStationHeal
 	return r0, r1
 }
 
-// MockStore_GetStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationHealth'
-type MockStore_GetStationHealth_Call struct {
+// MockStore_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockStore_ListUsers_Call struct {
 	*mock.Call
 }
 
-// GetStationHealth is a helper method to define mock.On call
+// ListUsers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetStationHealthParams
-func (_e *MockStore_Expecter) GetStationHealth(ctx interface{}, arg interface{}) *MockStore_GetStationHealth_Call {
-	return &MockStore_GetStationHealth_Call{Call: _e.mock.On("GetStationHealth", ctx, arg)}
+//   - arg db.ListUsersParams
+func (_e *MockStore_Expecter) ListUsers(ctx interface{}, arg interface{}) *MockStore_ListUsers_Call {
+	return &MockStore_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, arg)}
 }
 
-func (_c *MockStore_GetStationHealth_Call) Run(run func(ctx context.Context, arg db.GetStationHealthParams)) *MockStore_GetStationHealth_Call {
+func (_c *MockStore_ListUsers_Call) Run(run func(ctx context.Context, arg db.ListUsersParams)) *MockStore_ListUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.GetStationHealthParams))
+		run(args[0].(context.Context), args[1].(db.ListUsersParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_GetStationHealth_Call {
+func (_c *MockStore_ListUsers_Call) Return(_a0 []db.User, _a1 error) *MockStore_ListUsers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetStationHealth_Call) RunAndReturn(run func(context.Context, db.GetStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_GetStationHealth_Call {
+func (_c *MockStore_ListUsers_Call) RunAndReturn(run func(context.Context, db.ListUsersParams) ([]db.User, error)) *MockStore_ListUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStationMOObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) GetStationMOObservation(ctx context.Context, arg db.GetStationMOObservationParams) (db.ObservationsMoObservation, error) {
+// ListWeatherlinkChanges provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListWeatherlinkChanges(ctx context.Context, arg db.ListWeatherlinkChangesParams) ([]db.WeatherlinkChange, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStationMOObservation")
+		panic("no return value specified for ListWeatherlinkChanges")
 	}
 
-	var r0 db.ObservationsMoObservation
+	var r0 []db.WeatherlinkChange
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationMOObservationParams) (db.ObservationsMoObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListWeatherlinkChangesParams) ([]db.WeatherlinkChange, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationMOObservationParams) db.ObservationsMoObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListWeatherlinkChangesParams) []db.WeatherlinkChange); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsMoObservation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.WeatherlinkChange)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationMOObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListWeatherlinkChangesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2735,55 +8436,57 @@ func (_m *MockStore) GetStationMOObservation(ctx context.Context, arg db.GetStat
 	return r0, r1
 }
 
-// MockStore_GetStationMOObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationMOObservation'
-type MockStore_GetStationMOObservation_Call struct {
+// MockStore_ListWeatherlinkChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWeatherlinkChanges'
+type MockStore_ListWeatherlinkChanges_Call struct {
 	*mock.Call
 }
 
-// GetStationMOObservation is a helper method to define mock.On call
+// ListWeatherlinkChanges is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetStationMOObservationParams
-func (_e *MockStore_Expecter) GetStationMOObservation(ctx interface{}, arg interface{}) *MockStore_GetStationMOObservation_Call {
-	return &MockStore_GetStationMOObservation_Call{Call: _e.mock.On("GetStationMOObservation", ctx, arg)}
+//   - arg db.ListWeatherlinkChangesParams
+func (_e *MockStore_Expecter) ListWeatherlinkChanges(ctx interface{}, arg interface{}) *MockStore_ListWeatherlinkChanges_Call {
+	return &MockStore_ListWeatherlinkChanges_Call{Call: _e.mock.On("ListWeatherlinkChanges", ctx, arg)}
 }
 
-func (_c *MockStore_GetStationMOObservation_Call) Run(run func(ctx context.Context, arg db.GetStationMOObservationParams)) *MockStore_GetStationMOObservation_Call {
+func (_c *MockStore_ListWeatherlinkChanges_Call) Run(run func(ctx context.Context, arg db.ListWeatherlinkChangesParams)) *MockStore_ListWeatherlinkChanges_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.GetStationMOObservationParams))
+		run(args[0].(context.Context), args[1].(db.ListWeatherlinkChangesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStationMOObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_GetStationMOObservation_Call {
+func (_c *MockStore_ListWeatherlinkChanges_Call) Return(_a0 []db.WeatherlinkChange, _a1 error) *MockStore_ListWeatherlinkChanges_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetStationMOObservation_Call) RunAndReturn(run func(context.Context, db.GetStationMOObservationParams) (db.ObservationsMoObservation, error)) *MockStore_GetStationMOObservation_Call {
+func (_c *MockStore_ListWeatherlinkChanges_Call) RunAndReturn(run func(context.Context, db.ListWeatherlinkChangesParams) ([]db.WeatherlinkChange, error)) *MockStore_ListWeatherlinkChanges_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) GetStationObservation(ctx context.Context, arg db.GetStationObservationParams) (db.ObservationsObservation, error) {
+// ListWeatherlinkStations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListWeatherlinkStations(ctx context.Context, arg db.ListWeatherlinkStationsParams) ([]db.Weatherlink, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStationObservation")
+		panic("no return value specified for ListWeatherlinkStations")
 	}
 
-	var r0 db.ObservationsObservation
+	var r0 []db.Weatherlink
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationObservationParams) (db.ObservationsObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListWeatherlinkStationsParams) ([]db.Weatherlink, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationObservationParams) db.ObservationsObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListWeatherlinkStationsParams) []db.Weatherlink); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsObservation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Weatherlink)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListWeatherlinkStationsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2792,170 +8495,150 @@ func (_m *MockStore) GetStationObservation(ctx context.Context, arg db.GetStatio
 	return r0, r1
 }
 
-// MockStore_GetStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationObservation'
-type MockStore_GetStationObservation_Call struct {
+// MockStore_ListWeatherlinkStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWeatherlinkStations'
+type MockStore_ListWeatherlinkStations_Call struct {
 	*mock.Call
 }
 
-// GetStationObservation is a helper method to define mock.On call
+// ListWeatherlinkStations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetStationObservationParams
-func (_e *MockStore_Expecter) GetStationObservation(ctx interface{}, arg interface{}) *MockStore_GetStationObservation_Call {
-	return &MockStore_GetStationObservation_Call{Call: _e.mock.On("GetStationObservation", ctx, arg)}
+//   - arg db.ListWeatherlinkStationsParams
+func (_e *MockStore_Expecter) ListWeatherlinkStations(ctx interface{}, arg interface{}) *MockStore_ListWeatherlinkStations_Call {
+	return &MockStore_ListWeatherlinkStations_Call{Call: _e.mock.On("ListWeatherlinkStations", ctx, arg)}
 }
 
-func (_c *MockStore_GetStationObservation_Call) Run(run func(ctx context.Context, arg db.GetStationObservationParams)) *MockStore_GetStationObservation_Call {
+func (_c *MockStore_ListWeatherlinkStations_Call) Run(run func(ctx context.Context, arg db.ListWeatherlinkStationsParams)) *MockStore_ListWeatherlinkStations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.GetStationObservationParams))
+		run(args[0].(context.Context), args[1].(db.ListWeatherlinkStationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_GetStationObservation_Call {
+func (_c *MockStore_ListWeatherlinkStations_Call) Return(_a0 []db.Weatherlink, _a1 error) *MockStore_ListWeatherlinkStations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetStationObservation_Call) RunAndReturn(run func(context.Context, db.GetStationObservationParams) (db.ObservationsObservation, error)) *MockStore_GetStationObservation_Call {
+func (_c *MockStore_ListWeatherlinkStations_Call) RunAndReturn(run func(context.Context, db.ListWeatherlinkStationsParams) ([]db.Weatherlink, error)) *MockStore_ListWeatherlinkStations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetUser(ctx context.Context, id int64) (db.User, error) {
-	ret := _m.Called(ctx, id)
+// MarkSimCardLoadRequested provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) MarkSimCardLoadRequested(ctx context.Context, mobileNumber string) error {
+	ret := _m.Called(ctx, mobileNumber)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUser")
-	}
-
-	var r0 db.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.User, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.User); ok {
-		r0 = rf(ctx, id)
-	} else {
-		r0 = ret.Get(0).(db.User)
+		panic("no return value specified for MarkSimCardLoadRequested")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, mobileNumber)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type MockStore_GetUser_Call struct {
+// MockStore_MarkSimCardLoadRequested_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSimCardLoadRequested'
+type MockStore_MarkSimCardLoadRequested_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// MarkSimCardLoadRequested is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetUser(ctx interface{}, id interface{}) *MockStore_GetUser_Call {
-	return &MockStore_GetUser_Call{Call: _e.mock.On("GetUser", ctx, id)}
+//   - mobileNumber string
+func (_e *MockStore_Expecter) MarkSimCardLoadRequested(ctx interface{}, mobileNumber interface{}) *MockStore_MarkSimCardLoadRequested_Call {
+	return &MockStore_MarkSimCardLoadRequested_Call{Call: _e.mock.On("MarkSimCardLoadRequested", ctx, mobileNumber)}
 }
 
-func (_c *MockStore_GetUser_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetUser_Call {
+func (_c *MockStore_MarkSimCardLoadRequested_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_MarkSimCardLoadRequested_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUser_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUser_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_MarkSimCardLoadRequested_Call) Return(_a0 error) *MockStore_MarkSimCardLoadRequested_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetUser_Call) RunAndReturn(run func(context.Context, int64) (db.User, error)) *MockStore_GetUser_Call {
+func (_c *MockStore_MarkSimCardLoadRequested_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_MarkSimCardLoadRequested_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByEmail provides a mock function with given fields: ctx, email
-func (_m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
-	ret := _m.Called(ctx, email)
+// PurgeStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) PurgeStation(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByEmail")
-	}
-
-	var r0 db.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
-		return rf(ctx, email)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
-		r0 = rf(ctx, email)
-	} else {
-		r0 = ret.Get(0).(db.User)
+		panic("no return value specified for PurgeStation")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, email)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
-type MockStore_GetUserByEmail_Call struct {
+// MockStore_PurgeStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeStation'
+type MockStore_PurgeStation_Call struct {
 	*mock.Call
 }
 
-// GetUserByEmail is a helper method to define mock.On call
+// PurgeStation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
-	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+//   - id int64
+func (_e *MockStore_Expecter) PurgeStation(ctx interface{}, id interface{}) *MockStore_PurgeStation_Call {
+	return &MockStore_PurgeStation_Call{Call: _e.mock.On("PurgeStation", ctx, id)}
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_PurgeStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_PurgeStation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUserByEmail_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_PurgeStation_Call) Return(_a0 error) *MockStore_PurgeStation_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_PurgeStation_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_PurgeStation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByUsername provides a mock function with given fields: ctx, username
-func (_m *MockStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
-	ret := _m.Called(ctx, username)
+// RestoreStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) RestoreStation(ctx context.Context, id int64) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByUsername")
+		panic("no return value specified for RestoreStation")
 	}
 
-	var r0 db.User
+	var r0 db.ObservationsStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
-		return rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsStation, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
-		r0 = rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsStation); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(db.ObservationsStation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, username)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2963,174 +8646,160 @@ func (_m *MockStore) GetUserByUsername(ctx context.Context, username string) (db
 	return r0, r1
 }
 
-// MockStore_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
-type MockStore_GetUserByUsername_Call struct {
+// MockStore_RestoreStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreStation'
+type MockStore_RestoreStation_Call struct {
 	*mock.Call
 }
 
-// GetUserByUsername is a helper method to define mock.On call
+// RestoreStation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockStore_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockStore_GetUserByUsername_Call {
-	return &MockStore_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+//   - id int64
+func (_e *MockStore_Expecter) RestoreStation(ctx interface{}, id interface{}) *MockStore_RestoreStation_Call {
+	return &MockStore_RestoreStation_Call{Call: _e.mock.On("RestoreStation", ctx, id)}
 }
 
-func (_c *MockStore_GetUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_RestoreStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_RestoreStation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByUsername_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_RestoreStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_RestoreStation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByUsername_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_RestoreStation_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsStation, error)) *MockStore_RestoreStation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertCurrentMOObservations provides a mock function with given fields: ctx
-func (_m *MockStore) InsertCurrentMOObservations(ctx context.Context) ([]db.ObservationsCurrent, error) {
-	ret := _m.Called(ctx)
+// UnassignSimCardTx provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) UnassignSimCardTx(ctx context.Context, mobileNumber string) error {
+	ret := _m.Called(ctx, mobileNumber)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertCurrentMOObservations")
-	}
-
-	var r0 []db.ObservationsCurrent
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ObservationsCurrent, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) []db.ObservationsCurrent); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsCurrent)
-		}
+		panic("no return value specified for UnassignSimCardTx")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, mobileNumber)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_InsertCurrentMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertCurrentMOObservations'
-type MockStore_InsertCurrentMOObservations_Call struct {
+// MockStore_UnassignSimCardTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignSimCardTx'
+type MockStore_UnassignSimCardTx_Call struct {
 	*mock.Call
 }
 
-// InsertCurrentMOObservations is a helper method to define mock.On call
+// UnassignSimCardTx is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) InsertCurrentMOObservations(ctx interface{}) *MockStore_InsertCurrentMOObservations_Call {
-	return &MockStore_InsertCurrentMOObservations_Call{Call: _e.mock.On("InsertCurrentMOObservations", ctx)}
+//   - mobileNumber string
+func (_e *MockStore_Expecter) UnassignSimCardTx(ctx interface{}, mobileNumber interface{}) *MockStore_UnassignSimCardTx_Call {
+	return &MockStore_UnassignSimCardTx_Call{Call: _e.mock.On("UnassignSimCardTx", ctx, mobileNumber)}
 }
 
-func (_c *MockStore_InsertCurrentMOObservations_Call) Run(run func(ctx context.Context)) *MockStore_InsertCurrentMOObservations_Call {
+func (_c *MockStore_UnassignSimCardTx_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_UnassignSimCardTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_InsertCurrentMOObservations_Call) Return(_a0 []db.ObservationsCurrent, _a1 error) *MockStore_InsertCurrentMOObservations_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UnassignSimCardTx_Call) Return(_a0 error) *MockStore_UnassignSimCardTx_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_InsertCurrentMOObservations_Call) RunAndReturn(run func(context.Context) ([]db.ObservationsCurrent, error)) *MockStore_InsertCurrentMOObservations_Call {
+func (_c *MockStore_UnassignSimCardTx_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_UnassignSimCardTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertCurrentObservations provides a mock function with given fields: ctx
-func (_m *MockStore) InsertCurrentObservations(ctx context.Context) ([]db.ObservationsCurrent, error) {
-	ret := _m.Called(ctx)
+// UpdateMisolStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateMisolStation(ctx context.Context, arg db.UpdateMisolStationParams) (db.MisolStation, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertCurrentObservations")
+		panic("no return value specified for UpdateMisolStation")
 	}
 
-	var r0 []db.ObservationsCurrent
+	var r0 db.MisolStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ObservationsCurrent, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateMisolStationParams) (db.MisolStation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []db.ObservationsCurrent); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateMisolStationParams) db.MisolStation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsCurrent)
-		}
+		r0 = ret.Get(0).(db.MisolStation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateMisolStationParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
-
-// MockStore_InsertCurrentObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertCurrentObservations'
-type MockStore_InsertCurrentObservations_Call struct {
+
+// MockStore_UpdateMisolStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMisolStation'
+type MockStore_UpdateMisolStation_Call struct {
 	*mock.Call
 }
 
-// InsertCurrentObservations is a helper method to define mock.On call
+// UpdateMisolStation is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) InsertCurrentObservations(ctx interface{}) *MockStore_InsertCurrentObservations_Call {
-	return &MockStore_InsertCurrentObservations_Call{Call: _e.mock.On("InsertCurrentObservations", ctx)}
+//   - arg db.UpdateMisolStationParams
+func (_e *MockStore_Expecter) UpdateMisolStation(ctx interface{}, arg interface{}) *MockStore_UpdateMisolStation_Call {
+	return &MockStore_UpdateMisolStation_Call{Call: _e.mock.On("UpdateMisolStation", ctx, arg)}
 }
 
-func (_c *MockStore_InsertCurrentObservations_Call) Run(run func(ctx context.Context)) *MockStore_InsertCurrentObservations_Call {
+func (_c *MockStore_UpdateMisolStation_Call) Run(run func(ctx context.Context, arg db.UpdateMisolStationParams)) *MockStore_UpdateMisolStation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(db.UpdateMisolStationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_InsertCurrentObservations_Call) Return(_a0 []db.ObservationsCurrent, _a1 error) *MockStore_InsertCurrentObservations_Call {
+func (_c *MockStore_UpdateMisolStation_Call) Return(_a0 db.MisolStation, _a1 error) *MockStore_UpdateMisolStation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_InsertCurrentObservations_Call) RunAndReturn(run func(context.Context) ([]db.ObservationsCurrent, error)) *MockStore_InsertCurrentObservations_Call {
+func (_c *MockStore_UpdateMisolStation_Call) RunAndReturn(run func(context.Context, db.UpdateMisolStationParams) (db.MisolStation, error)) *MockStore_UpdateMisolStation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListLatestObservations provides a mock function with given fields: ctx
-func (_m *MockStore) ListLatestObservations(ctx context.Context) ([]db.ListLatestObservationsRow, error) {
-	ret := _m.Called(ctx)
+// UpdateRole provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateRole(ctx context.Context, arg db.UpdateRoleParams) (db.Role, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListLatestObservations")
+		panic("no return value specified for UpdateRole")
 	}
 
-	var r0 []db.ListLatestObservationsRow
+	var r0 db.Role
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ListLatestObservationsRow, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRoleParams) (db.Role, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []db.ListLatestObservationsRow); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRoleParams) db.Role); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ListLatestObservationsRow)
-		}
+		r0 = ret.Get(0).(db.Role)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateRoleParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3138,56 +8807,55 @@ func (_m *MockStore) ListLatestObservations(ctx context.Context) ([]db.ListLates
 	return r0, r1
 }
 
-// MockStore_ListLatestObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLatestObservations'
-type MockStore_ListLatestObservations_Call struct {
+// MockStore_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockStore_UpdateRole_Call struct {
 	*mock.Call
 }
 
-// ListLatestObservations is a helper method to define mock.On call
+// UpdateRole is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) ListLatestObservations(ctx interface{}) *MockStore_ListLatestObservations_Call {
-	return &MockStore_ListLatestObservations_Call{Call: _e.mock.On("ListLatestObservations", ctx)}
+//   - arg db.UpdateRoleParams
+func (_e *MockStore_Expecter) UpdateRole(ctx interface{}, arg interface{}) *MockStore_UpdateRole_Call {
+	return &MockStore_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, arg)}
 }
 
-func (_c *MockStore_ListLatestObservations_Call) Run(run func(ctx context.Context)) *MockStore_ListLatestObservations_Call {
+func (_c *MockStore_UpdateRole_Call) Run(run func(ctx context.Context, arg db.UpdateRoleParams)) *MockStore_UpdateRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(db.UpdateRoleParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListLatestObservations_Call) Return(_a0 []db.ListLatestObservationsRow, _a1 error) *MockStore_ListLatestObservations_Call {
+func (_c *MockStore_UpdateRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_UpdateRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListLatestObservations_Call) RunAndReturn(run func(context.Context) ([]db.ListLatestObservationsRow, error)) *MockStore_ListLatestObservations_Call {
+func (_c *MockStore_UpdateRole_Call) RunAndReturn(run func(context.Context, db.UpdateRoleParams) (db.Role, error)) *MockStore_UpdateRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListLufftStationMsg provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListLufftStationMsg(ctx context.Context, arg db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error) {
+// UpdateSimCard provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateSimCard(ctx context.Context, arg db.UpdateSimCardParams) (db.SimCard, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListLufftStationMsg")
+		panic("no return value specified for UpdateSimCard")
 	}
 
-	var r0 []db.ListLufftStationMsgRow
+	var r0 db.SimCard
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSimCardParams) (db.SimCard, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListLufftStationMsgParams) []db.ListLufftStationMsgRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSimCardParams) db.SimCard); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ListLufftStationMsgRow)
-		}
+		r0 = ret.Get(0).(db.SimCard)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListLufftStationMsgParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateSimCardParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3196,57 +8864,55 @@ func (_m *MockStore) ListLufftStationMsg(ctx context.Context, arg db.ListLufftSt
 	return r0, r1
 }
 
-// MockStore_ListLufftStationMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLufftStationMsg'
-type MockStore_ListLufftStationMsg_Call struct {
+// MockStore_UpdateSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSimCard'
+type MockStore_UpdateSimCard_Call struct {
 	*mock.Call
 }
 
-// ListLufftStationMsg is a helper method to define mock.On call
+// UpdateSimCard is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListLufftStationMsgParams
-func (_e *MockStore_Expecter) ListLufftStationMsg(ctx interface{}, arg interface{}) *MockStore_ListLufftStationMsg_Call {
-	return &MockStore_ListLufftStationMsg_Call{Call: _e.mock.On("ListLufftStationMsg", ctx, arg)}
+//   - arg db.UpdateSimCardParams
+func (_e *MockStore_Expecter) UpdateSimCard(ctx interface{}, arg interface{}) *MockStore_UpdateSimCard_Call {
+	return &MockStore_UpdateSimCard_Call{Call: _e.mock.On("UpdateSimCard", ctx, arg)}
 }
 
-func (_c *MockStore_ListLufftStationMsg_Call) Run(run func(ctx context.Context, arg db.ListLufftStationMsgParams)) *MockStore_ListLufftStationMsg_Call {
+func (_c *MockStore_UpdateSimCard_Call) Run(run func(ctx context.Context, arg db.UpdateSimCardParams)) *MockStore_UpdateSimCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListLufftStationMsgParams))
+		run(args[0].(context.Context), args[1].(db.UpdateSimCardParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListLufftStationMsg_Call) Return(_a0 []db.ListLufftStationMsgRow, _a1 error) *MockStore_ListLufftStationMsg_Call {
+func (_c *MockStore_UpdateSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_UpdateSimCard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListLufftStationMsg_Call) RunAndReturn(run func(context.Context, db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error)) *MockStore_ListLufftStationMsg_Call {
+func (_c *MockStore_UpdateSimCard_Call) RunAndReturn(run func(context.Context, db.UpdateSimCardParams) (db.SimCard, error)) *MockStore_UpdateSimCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListMOObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListMOObservations(ctx context.Context, arg db.ListMOObservationsParams) ([]db.ObservationsMoObservation, error) {
+// UpdateSimCardLoad provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateSimCardLoad(ctx context.Context, arg db.UpdateSimCardLoadParams) (db.SimCard, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListMOObservations")
+		panic("no return value specified for UpdateSimCardLoad")
 	}
 
-	var r0 []db.ObservationsMoObservation
+	var r0 db.SimCard
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListMOObservationsParams) ([]db.ObservationsMoObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSimCardLoadParams) (db.SimCard, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListMOObservationsParams) []db.ObservationsMoObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSimCardLoadParams) db.SimCard); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsMoObservation)
-		}
+		r0 = ret.Get(0).(db.SimCard)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListMOObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateSimCardLoadParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3255,57 +8921,55 @@ func (_m *MockStore) ListMOObservations(ctx context.Context, arg db.ListMOObserv
 	return r0, r1
 }
 
-// MockStore_ListMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMOObservations'
-type MockStore_ListMOObservations_Call struct {
+// MockStore_UpdateSimCardLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSimCardLoad'
+type MockStore_UpdateSimCardLoad_Call struct {
 	*mock.Call
 }
 
-// ListMOObservations is a helper method to define mock.On call
+// UpdateSimCardLoad is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListMOObservationsParams
-func (_e *MockStore_Expecter) ListMOObservations(ctx interface{}, arg interface{}) *MockStore_ListMOObservations_Call {
-	return &MockStore_ListMOObservations_Call{Call: _e.mock.On("ListMOObservations", ctx, arg)}
+//   - arg db.UpdateSimCardLoadParams
+func (_e *MockStore_Expecter) UpdateSimCardLoad(ctx interface{}, arg interface{}) *MockStore_UpdateSimCardLoad_Call {
+	return &MockStore_UpdateSimCardLoad_Call{Call: _e.mock.On("UpdateSimCardLoad", ctx, arg)}
 }
 
-func (_c *MockStore_ListMOObservations_Call) Run(run func(ctx context.Context, arg db.ListMOObservationsParams)) *MockStore_ListMOObservations_Call {
+func (_c *MockStore_UpdateSimCardLoad_Call) Run(run func(ctx context.Context, arg db.UpdateSimCardLoadParams)) *MockStore_UpdateSimCardLoad_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListMOObservationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateSimCardLoadParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListMOObservations_Call) Return(_a0 []db.ObservationsMoObservation, _a1 error) *MockStore_ListMOObservations_Call {
+func (_c *MockStore_UpdateSimCardLoad_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_UpdateSimCardLoad_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListMOObservations_Call) RunAndReturn(run func(context.Context, db.ListMOObservationsParams) ([]db.ObservationsMoObservation, error)) *MockStore_ListMOObservations_Call {
+func (_c *MockStore_UpdateSimCardLoad_Call) RunAndReturn(run func(context.Context, db.UpdateSimCardLoadParams) (db.SimCard, error)) *MockStore_UpdateSimCardLoad_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListObservations(ctx context.Context, arg db.ListObservationsParams) ([]db.ObservationsObservation, error) {
+// UpdateSmsOutboxStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateSmsOutboxStatus(ctx context.Context, arg db.UpdateSmsOutboxStatusParams) (db.SmsOutbox, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListObservations")
+		panic("no return value specified for UpdateSmsOutboxStatus")
 	}
 
-	var r0 []db.ObservationsObservation
+	var r0 db.SmsOutbox
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsParams) ([]db.ObservationsObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSmsOutboxStatusParams) (db.SmsOutbox, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsParams) []db.ObservationsObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSmsOutboxStatusParams) db.SmsOutbox); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsObservation)
-		}
+		r0 = ret.Get(0).(db.SmsOutbox)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateSmsOutboxStatusParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3314,57 +8978,55 @@ func (_m *MockStore) ListObservations(ctx context.Context, arg db.ListObservatio
 	return r0, r1
 }
 
-// MockStore_ListObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObservations'
-type MockStore_ListObservations_Call struct {
+// MockStore_UpdateSmsOutboxStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSmsOutboxStatus'
+type MockStore_UpdateSmsOutboxStatus_Call struct {
 	*mock.Call
 }
 
-// ListObservations is a helper method to define mock.On call
+// UpdateSmsOutboxStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListObservationsParams
-func (_e *MockStore_Expecter) ListObservations(ctx interface{}, arg interface{}) *MockStore_ListObservations_Call {
-	return &MockStore_ListObservations_Call{Call: _e.mock.On("ListObservations", ctx, arg)}
+//   - arg db.UpdateSmsOutboxStatusParams
+func (_e *MockStore_Expecter) UpdateSmsOutboxStatus(ctx interface{}, arg interface{}) *MockStore_UpdateSmsOutboxStatus_Call {
+	return &MockStore_UpdateSmsOutboxStatus_Call{Call: _e.mock.On("UpdateSmsOutboxStatus", ctx, arg)}
 }
 
-func (_c *MockStore_ListObservations_Call) Run(run func(ctx context.Context, arg db.ListObservationsParams)) *MockStore_ListObservations_Call {
+func (_c *MockStore_UpdateSmsOutboxStatus_Call) Run(run func(ctx context.Context, arg db.UpdateSmsOutboxStatusParams)) *MockStore_UpdateSmsOutboxStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListObservationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateSmsOutboxStatusParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListObservations_Call {
+func (_c *MockStore_UpdateSmsOutboxStatus_Call) Return(_a0 db.SmsOutbox, _a1 error) *MockStore_UpdateSmsOutboxStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListObservations_Call) RunAndReturn(run func(context.Context, db.ListObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListObservations_Call {
+func (_c *MockStore_UpdateSmsOutboxStatus_Call) RunAndReturn(run func(context.Context, db.UpdateSmsOutboxStatusParams) (db.SmsOutbox, error)) *MockStore_UpdateSmsOutboxStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListRoles provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListRoles(ctx context.Context, arg db.ListRolesParams) ([]db.Role, error) {
+// UpdateStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStation(ctx context.Context, arg db.UpdateStationParams) (db.ObservationsStation, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListRoles")
+		panic("no return value specified for UpdateStation")
 	}
 
-	var r0 []db.Role
+	var r0 db.ObservationsStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListRolesParams) ([]db.Role, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationParams) (db.ObservationsStation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListRolesParams) []db.Role); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationParams) db.ObservationsStation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Role)
-		}
+		r0 = ret.Get(0).(db.ObservationsStation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListRolesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3373,57 +9035,55 @@ func (_m *MockStore) ListRoles(ctx context.Context, arg db.ListRolesParams) ([]d
 	return r0, r1
 }
 
-// MockStore_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
-type MockStore_ListRoles_Call struct {
+// MockStore_UpdateStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStation'
+type MockStore_UpdateStation_Call struct {
 	*mock.Call
 }
 
-// ListRoles is a helper method to define mock.On call
+// UpdateStation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListRolesParams
-func (_e *MockStore_Expecter) ListRoles(ctx interface{}, arg interface{}) *MockStore_ListRoles_Call {
-	return &MockStore_ListRoles_Call{Call: _e.mock.On("ListRoles", ctx, arg)}
+//   - arg db.UpdateStationParams
+func (_e *MockStore_Expecter) UpdateStation(ctx interface{}, arg interface{}) *MockStore_UpdateStation_Call {
+	return &MockStore_UpdateStation_Call{Call: _e.mock.On("UpdateStation", ctx, arg)}
 }
 
-func (_c *MockStore_ListRoles_Call) Run(run func(ctx context.Context, arg db.ListRolesParams)) *MockStore_ListRoles_Call {
+func (_c *MockStore_UpdateStation_Call) Run(run func(ctx context.Context, arg db.UpdateStationParams)) *MockStore_UpdateStation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListRolesParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListRoles_Call) Return(_a0 []db.Role, _a1 error) *MockStore_ListRoles_Call {
+func (_c *MockStore_UpdateStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_UpdateStation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListRoles_Call) RunAndReturn(run func(context.Context, db.ListRolesParams) ([]db.Role, error)) *MockStore_ListRoles_Call {
+func (_c *MockStore_UpdateStation_Call) RunAndReturn(run func(context.Context, db.UpdateStationParams) (db.ObservationsStation, error)) *MockStore_UpdateStation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationHealths provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationHealths(ctx context.Context, arg db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error) {
+// UpdateStationCommandMessage provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationCommandMessage(ctx context.Context, arg db.UpdateStationCommandMessageParams) (db.StationCommand, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListStationHealths")
+		panic("no return value specified for UpdateStationCommandMessage")
 	}
 
-	var r0 []db.ObservationsStationhealth
+	var r0 db.StationCommand
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationCommandMessageParams) (db.StationCommand, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsParams) []db.ObservationsStationhealth); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationCommandMessageParams) db.StationCommand); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsStationhealth)
-		}
+		r0 = ret.Get(0).(db.StationCommand)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationHealthsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationCommandMessageParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3432,57 +9092,55 @@ func (_m *MockStore) ListStationHealths(ctx context.Context, arg db.ListStationH
 	return r0, r1
 }
 
-// MockStore_ListStationHealths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationHealths'
-type MockStore_ListStationHealths_Call struct {
+// MockStore_UpdateStationCommandMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationCommandMessage'
+type MockStore_UpdateStationCommandMessage_Call struct {
 	*mock.Call
 }
 
-// ListStationHealths is a helper method to define mock.On call
+// UpdateStationCommandMessage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationHealthsParams
-func (_e *MockStore_Expecter) ListStationHealths(ctx interface{}, arg interface{}) *MockStore_ListStationHealths_Call {
-	return &MockStore_ListStationHealths_Call{Call: _e.mock.On("ListStationHealths", ctx, arg)}
+//   - arg db.UpdateStationCommandMessageParams
+func (_e *MockStore_Expecter) UpdateStationCommandMessage(ctx interface{}, arg interface{}) *MockStore_UpdateStationCommandMessage_Call {
+	return &MockStore_UpdateStationCommandMessage_Call{Call: _e.mock.On("UpdateStationCommandMessage", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationHealths_Call) Run(run func(ctx context.Context, arg db.ListStationHealthsParams)) *MockStore_ListStationHealths_Call {
+func (_c *MockStore_UpdateStationCommandMessage_Call) Run(run func(ctx context.Context, arg db.UpdateStationCommandMessageParams)) *MockStore_UpdateStationCommandMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationHealthsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationCommandMessageParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationHealths_Call) Return(_a0 []db.ObservationsStationhealth, _a1 error) *MockStore_ListStationHealths_Call {
+func (_c *MockStore_UpdateStationCommandMessage_Call) Return(_a0 db.StationCommand, _a1 error) *MockStore_UpdateStationCommandMessage_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStationHealths_Call) RunAndReturn(run func(context.Context, db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error)) *MockStore_ListStationHealths_Call {
+func (_c *MockStore_UpdateStationCommandMessage_Call) RunAndReturn(run func(context.Context, db.UpdateStationCommandMessageParams) (db.StationCommand, error)) *MockStore_UpdateStationCommandMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationMOObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationMOObservations(ctx context.Context, arg db.ListStationMOObservationsParams) ([]db.ObservationsMoObservation, error) {
+// UpdateStationHealth provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationHealth(ctx context.Context, arg db.UpdateStationHealthParams) (db.ObservationsStationhealth, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListStationMOObservations")
+		panic("no return value specified for UpdateStationHealth")
 	}
 
-	var r0 []db.ObservationsMoObservation
+	var r0 db.ObservationsStationhealth
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMOObservationsParams) ([]db.ObservationsMoObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationHealthParams) (db.ObservationsStationhealth, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMOObservationsParams) []db.ObservationsMoObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationHealthParams) db.ObservationsStationhealth); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsMoObservation)
-		}
+		r0 = ret.Get(0).(db.ObservationsStationhealth)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMOObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationHealthParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3491,57 +9149,55 @@ func (_m *MockStore) ListStationMOObservations(ctx context.Context, arg db.ListS
 	return r0, r1
 }
 
-// MockStore_ListStationMOObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMOObservations'
-type MockStore_ListStationMOObservations_Call struct {
+// MockStore_UpdateStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationHealth'
+type MockStore_UpdateStationHealth_Call struct {
 	*mock.Call
 }
 
-// ListStationMOObservations is a helper method to define mock.On call
+// UpdateStationHealth is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationMOObservationsParams
-func (_e *MockStore_Expecter) ListStationMOObservations(ctx interface{}, arg interface{}) *MockStore_ListStationMOObservations_Call {
-	return &MockStore_ListStationMOObservations_Call{Call: _e.mock.On("ListStationMOObservations", ctx, arg)}
+//   - arg db.UpdateStationHealthParams
+func (_e *MockStore_Expecter) UpdateStationHealth(ctx interface{}, arg interface{}) *MockStore_UpdateStationHealth_Call {
+	return &MockStore_UpdateStationHealth_Call{Call: _e.mock.On("UpdateStationHealth", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationMOObservations_Call) Run(run func(ctx context.Context, arg db.ListStationMOObservationsParams)) *MockStore_ListStationMOObservations_Call {
+func (_c *MockStore_UpdateStationHealth_Call) Run(run func(ctx context.Context, arg db.UpdateStationHealthParams)) *MockStore_UpdateStationHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationMOObservationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationHealthParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationMOObservations_Call) Return(_a0 []db.ObservationsMoObservation, _a1 error) *MockStore_ListStationMOObservations_Call {
+func (_c *MockStore_UpdateStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_UpdateStationHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStationMOObservations_Call) RunAndReturn(run func(context.Context, db.ListStationMOObservationsParams) ([]db.ObservationsMoObservation, error)) *MockStore_ListStationMOObservations_Call {
+func (_c *MockStore_UpdateStationHealth_Call) RunAndReturn(run func(context.Context, db.UpdateStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_UpdateStationHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationObservations(ctx context.Context, arg db.ListStationObservationsParams) ([]db.ObservationsObservation, error) {
+// UpdateStationMOObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationMOObservation(ctx context.Context, arg db.UpdateStationMOObservationParams) (db.ObservationsMoObservation, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListStationObservations")
+		panic("no return value specified for UpdateStationMOObservation")
 	}
 
-	var r0 []db.ObservationsObservation
+	var r0 db.ObservationsMoObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsParams) ([]db.ObservationsObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMOObservationParams) (db.ObservationsMoObservation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsParams) []db.ObservationsObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMOObservationParams) db.ObservationsMoObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsObservation)
-		}
+		r0 = ret.Get(0).(db.ObservationsMoObservation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationMOObservationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3550,57 +9206,55 @@ func (_m *MockStore) ListStationObservations(ctx context.Context, arg db.ListSta
 	return r0, r1
 }
 
-// MockStore_ListStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationObservations'
-type MockStore_ListStationObservations_Call struct {
+// MockStore_UpdateStationMOObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationMOObservation'
+type MockStore_UpdateStationMOObservation_Call struct {
 	*mock.Call
 }
 
-// ListStationObservations is a helper method to define mock.On call
+// UpdateStationMOObservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationObservationsParams
-func (_e *MockStore_Expecter) ListStationObservations(ctx interface{}, arg interface{}) *MockStore_ListStationObservations_Call {
-	return &MockStore_ListStationObservations_Call{Call: _e.mock.On("ListStationObservations", ctx, arg)}
+//   - arg db.UpdateStationMOObservationParams
+func (_e *MockStore_Expecter) UpdateStationMOObservation(ctx interface{}, arg interface{}) *MockStore_UpdateStationMOObservation_Call {
+	return &MockStore_UpdateStationMOObservation_Call{Call: _e.mock.On("UpdateStationMOObservation", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationObservations_Call) Run(run func(ctx context.Context, arg db.ListStationObservationsParams)) *MockStore_ListStationObservations_Call {
+func (_c *MockStore_UpdateStationMOObservation_Call) Run(run func(ctx context.Context, arg db.UpdateStationMOObservationParams)) *MockStore_UpdateStationMOObservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationObservationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationMOObservationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListStationObservations_Call {
+func (_c *MockStore_UpdateStationMOObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_UpdateStationMOObservation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStationObservations_Call) RunAndReturn(run func(context.Context, db.ListStationObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListStationObservations_Call {
+func (_c *MockStore_UpdateStationMOObservation_Call) RunAndReturn(run func(context.Context, db.UpdateStationMOObservationParams) (db.ObservationsMoObservation, error)) *MockStore_UpdateStationMOObservation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStations(ctx context.Context, arg db.ListStationsParams) ([]db.ObservationsStation, error) {
+// UpdateStationMaintenance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationMaintenance(ctx context.Context, arg db.UpdateStationMaintenanceParams) (db.StationMaintenance, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListStations")
+		panic("no return value specified for UpdateStationMaintenance")
 	}
 
-	var r0 []db.ObservationsStation
+	var r0 db.StationMaintenance
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsParams) ([]db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMaintenanceParams) (db.StationMaintenance, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsParams) []db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMaintenanceParams) db.StationMaintenance); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsStation)
-		}
+		r0 = ret.Get(0).(db.StationMaintenance)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationMaintenanceParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3609,116 +9263,102 @@ func (_m *MockStore) ListStations(ctx context.Context, arg db.ListStationsParams
 	return r0, r1
 }
 
-// MockStore_ListStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStations'
-type MockStore_ListStations_Call struct {
+// MockStore_UpdateStationMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationMaintenance'
+type MockStore_UpdateStationMaintenance_Call struct {
 	*mock.Call
 }
 
-// ListStations is a helper method to define mock.On call
+// UpdateStationMaintenance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationsParams
-func (_e *MockStore_Expecter) ListStations(ctx interface{}, arg interface{}) *MockStore_ListStations_Call {
-	return &MockStore_ListStations_Call{Call: _e.mock.On("ListStations", ctx, arg)}
+//   - arg db.UpdateStationMaintenanceParams
+func (_e *MockStore_Expecter) UpdateStationMaintenance(ctx interface{}, arg interface{}) *MockStore_UpdateStationMaintenance_Call {
+	return &MockStore_UpdateStationMaintenance_Call{Call: _e.mock.On("UpdateStationMaintenance", ctx, arg)}
 }
 
-func (_c *MockStore_ListStations_Call) Run(run func(ctx context.Context, arg db.ListStationsParams)) *MockStore_ListStations_Call {
+func (_c *MockStore_UpdateStationMaintenance_Call) Run(run func(ctx context.Context, arg db.UpdateStationMaintenanceParams)) *MockStore_UpdateStationMaintenance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationMaintenanceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStations_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStations_Call {
+func (_c *MockStore_UpdateStationMaintenance_Call) Return(_a0 db.StationMaintenance, _a1 error) *MockStore_UpdateStationMaintenance_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStations_Call) RunAndReturn(run func(context.Context, db.ListStationsParams) ([]db.ObservationsStation, error)) *MockStore_ListStations_Call {
+func (_c *MockStore_UpdateStationMaintenance_Call) RunAndReturn(run func(context.Context, db.UpdateStationMaintenanceParams) (db.StationMaintenance, error)) *MockStore_UpdateStationMaintenance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationsWithinBBox provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationsWithinBBox(ctx context.Context, arg db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error) {
+// UpdateStationMaintenanceWindowStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationMaintenanceWindowStatus(ctx context.Context, arg db.UpdateStationMaintenanceWindowStatusParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListStationsWithinBBox")
+		panic("no return value specified for UpdateStationMaintenanceWindowStatus")
 	}
 
-	var r0 []db.ObservationsStation
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinBBoxParams) []db.ObservationsStation); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMaintenanceWindowStatusParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsStation)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsWithinBBoxParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_ListStationsWithinBBox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsWithinBBox'
-type MockStore_ListStationsWithinBBox_Call struct {
+// MockStore_UpdateStationMaintenanceWindowStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationMaintenanceWindowStatus'
+type MockStore_UpdateStationMaintenanceWindowStatus_Call struct {
 	*mock.Call
 }
 
-// ListStationsWithinBBox is a helper method to define mock.On call
+// UpdateStationMaintenanceWindowStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationsWithinBBoxParams
-func (_e *MockStore_Expecter) ListStationsWithinBBox(ctx interface{}, arg interface{}) *MockStore_ListStationsWithinBBox_Call {
-	return &MockStore_ListStationsWithinBBox_Call{Call: _e.mock.On("ListStationsWithinBBox", ctx, arg)}
+//   - arg db.UpdateStationMaintenanceWindowStatusParams
+func (_e *MockStore_Expecter) UpdateStationMaintenanceWindowStatus(ctx interface{}, arg interface{}) *MockStore_UpdateStationMaintenanceWindowStatus_Call {
+	return &MockStore_UpdateStationMaintenanceWindowStatus_Call{Call: _e.mock.On("UpdateStationMaintenanceWindowStatus", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationsWithinBBox_Call) Run(run func(ctx context.Context, arg db.ListStationsWithinBBoxParams)) *MockStore_ListStationsWithinBBox_Call {
+func (_c *MockStore_UpdateStationMaintenanceWindowStatus_Call) Run(run func(ctx context.Context, arg db.UpdateStationMaintenanceWindowStatusParams)) *MockStore_UpdateStationMaintenanceWindowStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationsWithinBBoxParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationMaintenanceWindowStatusParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationsWithinBBox_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStationsWithinBBox_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateStationMaintenanceWindowStatus_Call) Return(_a0 error) *MockStore_UpdateStationMaintenanceWindowStatus_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_ListStationsWithinBBox_Call) RunAndReturn(run func(context.Context, db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error)) *MockStore_ListStationsWithinBBox_Call {
+func (_c *MockStore_UpdateStationMaintenanceWindowStatus_Call) RunAndReturn(run func(context.Context, db.UpdateStationMaintenanceWindowStatusParams) error) *MockStore_UpdateStationMaintenanceWindowStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationsWithinRadius provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationsWithinRadius(ctx context.Context, arg db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error) {
+// UpdateStationMetadataTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationMetadataTx(ctx context.Context, arg db.UpdateStationMetadataTxParams) (db.UpdateStationMetadataTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListStationsWithinRadius")
+		panic("no return value specified for UpdateStationMetadataTx")
 	}
 
-	var r0 []db.ObservationsStation
+	var r0 db.UpdateStationMetadataTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMetadataTxParams) (db.UpdateStationMetadataTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinRadiusParams) []db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMetadataTxParams) db.UpdateStationMetadataTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsStation)
-		}
+		r0 = ret.Get(0).(db.UpdateStationMetadataTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsWithinRadiusParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationMetadataTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3727,58 +9367,56 @@ func (_m *MockStore) ListStationsWithinRadius(ctx context.Context, arg db.ListSt
 	return r0, r1
 }
 
-// MockStore_ListStationsWithinRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsWithinRadius'
-type MockStore_ListStationsWithinRadius_Call struct {
+// MockStore_UpdateStationMetadataTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationMetadataTx'
+type MockStore_UpdateStationMetadataTx_Call struct {
 	*mock.Call
 }
 
-// ListStationsWithinRadius is a helper method to define mock.On call
+// UpdateStationMetadataTx is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationsWithinRadiusParams
-func (_e *MockStore_Expecter) ListStationsWithinRadius(ctx interface{}, arg interface{}) *MockStore_ListStationsWithinRadius_Call {
-	return &MockStore_ListStationsWithinRadius_Call{Call: _e.mock.On("ListStationsWithinRadius", ctx, arg)}
+//   - arg db.UpdateStationMetadataTxParams
+func (_e *MockStore_Expecter) UpdateStationMetadataTx(ctx interface{}, arg interface{}) *MockStore_UpdateStationMetadataTx_Call {
+	return &MockStore_UpdateStationMetadataTx_Call{Call: _e.mock.On("UpdateStationMetadataTx", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationsWithinRadius_Call) Run(run func(ctx context.Context, arg db.ListStationsWithinRadiusParams)) *MockStore_ListStationsWithinRadius_Call {
+func (_c *MockStore_UpdateStationMetadataTx_Call) Run(run func(ctx context.Context, arg db.UpdateStationMetadataTxParams)) *MockStore_UpdateStationMetadataTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationsWithinRadiusParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationMetadataTxParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationsWithinRadius_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStationsWithinRadius_Call {
+func (_c *MockStore_UpdateStationMetadataTx_Call) Return(_a0 db.UpdateStationMetadataTxResult, _a1 error) *MockStore_UpdateStationMetadataTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStationsWithinRadius_Call) RunAndReturn(run func(context.Context, db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error)) *MockStore_ListStationsWithinRadius_Call {
+func (_c *MockStore_UpdateStationMetadataTx_Call) RunAndReturn(run func(context.Context, db.UpdateStationMetadataTxParams) (db.UpdateStationMetadataTxResult, error)) *MockStore_UpdateStationMetadataTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUserRoles provides a mock function with given fields: ctx, userID
-func (_m *MockStore) ListUserRoles(ctx context.Context, userID int64) ([]string, error) {
-	ret := _m.Called(ctx, userID)
+// UpdateStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationObservation(ctx context.Context, arg db.UpdateStationObservationParams) (db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListUserRoles")
+		panic("no return value specified for UpdateStationObservation")
 	}
 
-	var r0 []string
+	var r0 db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]string, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationObservationParams) (db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationObservationParams) db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(db.ObservationsObservation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationObservationParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3786,57 +9424,55 @@ func (_m *MockStore) ListUserRoles(ctx context.Context, userID int64) ([]string,
 	return r0, r1
 }
 
-// MockStore_ListUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRoles'
-type MockStore_ListUserRoles_Call struct {
+// MockStore_UpdateStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationObservation'
+type MockStore_UpdateStationObservation_Call struct {
 	*mock.Call
 }
 
-// ListUserRoles is a helper method to define mock.On call
+// UpdateStationObservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-func (_e *MockStore_Expecter) ListUserRoles(ctx interface{}, userID interface{}) *MockStore_ListUserRoles_Call {
-	return &MockStore_ListUserRoles_Call{Call: _e.mock.On("ListUserRoles", ctx, userID)}
+//   - arg db.UpdateStationObservationParams
+func (_e *MockStore_Expecter) UpdateStationObservation(ctx interface{}, arg interface{}) *MockStore_UpdateStationObservation_Call {
+	return &MockStore_UpdateStationObservation_Call{Call: _e.mock.On("UpdateStationObservation", ctx, arg)}
 }
 
-func (_c *MockStore_ListUserRoles_Call) Run(run func(ctx context.Context, userID int64)) *MockStore_ListUserRoles_Call {
+func (_c *MockStore_UpdateStationObservation_Call) Run(run func(ctx context.Context, arg db.UpdateStationObservationParams)) *MockStore_UpdateStationObservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.UpdateStationObservationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListUserRoles_Call) Return(_a0 []string, _a1 error) *MockStore_ListUserRoles_Call {
+func (_c *MockStore_UpdateStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_UpdateStationObservation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListUserRoles_Call) RunAndReturn(run func(context.Context, int64) ([]string, error)) *MockStore_ListUserRoles_Call {
+func (_c *MockStore_UpdateStationObservation_Call) RunAndReturn(run func(context.Context, db.UpdateStationObservationParams) (db.ObservationsObservation, error)) *MockStore_UpdateStationObservation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUsers provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+// UpdateStationStatusTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationStatusTx(ctx context.Context, arg db.UpdateStationStatusTxParams) (db.UpdateStationStatusTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListUsers")
+		panic("no return value specified for UpdateStationStatusTx")
 	}
 
-	var r0 []db.User
+	var r0 db.UpdateStationStatusTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListUsersParams) ([]db.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationStatusTxParams) (db.UpdateStationStatusTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListUsersParams) []db.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationStatusTxParams) db.UpdateStationStatusTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.User)
-		}
+		r0 = ret.Get(0).(db.UpdateStationStatusTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListUsersParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationStatusTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3845,57 +9481,55 @@ func (_m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]d
 	return r0, r1
 }
 
-// MockStore_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
-type MockStore_ListUsers_Call struct {
+// MockStore_UpdateStationStatusTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationStatusTx'
+type MockStore_UpdateStationStatusTx_Call struct {
 	*mock.Call
 }
 
-// ListUsers is a helper method to define mock.On call
+// UpdateStationStatusTx is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListUsersParams
-func (_e *MockStore_Expecter) ListUsers(ctx interface{}, arg interface{}) *MockStore_ListUsers_Call {
-	return &MockStore_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, arg)}
+//   - arg db.UpdateStationStatusTxParams
+func (_e *MockStore_Expecter) UpdateStationStatusTx(ctx interface{}, arg interface{}) *MockStore_UpdateStationStatusTx_Call {
+	return &MockStore_UpdateStationStatusTx_Call{Call: _e.mock.On("UpdateStationStatusTx", ctx, arg)}
 }
 
-func (_c *MockStore_ListUsers_Call) Run(run func(ctx context.Context, arg db.ListUsersParams)) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpdateStationStatusTx_Call) Run(run func(ctx context.Context, arg db.UpdateStationStatusTxParams)) *MockStore_UpdateStationStatusTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListUsersParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationStatusTxParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListUsers_Call) Return(_a0 []db.User, _a1 error) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpdateStationStatusTx_Call) Return(_a0 db.UpdateStationStatusTxResult, _a1 error) *MockStore_UpdateStationStatusTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListUsers_Call) RunAndReturn(run func(context.Context, db.ListUsersParams) ([]db.User, error)) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpdateStationStatusTx_Call) RunAndReturn(run func(context.Context, db.UpdateStationStatusTxParams) (db.UpdateStationStatusTxResult, error)) *MockStore_UpdateStationStatusTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListWeatherlinkStations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListWeatherlinkStations(ctx context.Context, arg db.ListWeatherlinkStationsParams) ([]db.Weatherlink, error) {
+// UpdateStationTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationTx(ctx context.Context, arg db.UpdateStationTxParams) (db.UpdateStationTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListWeatherlinkStations")
+		panic("no return value specified for UpdateStationTx")
 	}
 
-	var r0 []db.Weatherlink
+	var r0 db.UpdateStationTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListWeatherlinkStationsParams) ([]db.Weatherlink, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationTxParams) (db.UpdateStationTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListWeatherlinkStationsParams) []db.Weatherlink); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationTxParams) db.UpdateStationTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Weatherlink)
-		}
+		r0 = ret.Get(0).(db.UpdateStationTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListWeatherlinkStationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3904,55 +9538,55 @@ func (_m *MockStore) ListWeatherlinkStations(ctx context.Context, arg db.ListWea
 	return r0, r1
 }
 
-// MockStore_ListWeatherlinkStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWeatherlinkStations'
-type MockStore_ListWeatherlinkStations_Call struct {
+// MockStore_UpdateStationTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationTx'
+type MockStore_UpdateStationTx_Call struct {
 	*mock.Call
 }
 
-// ListWeatherlinkStations is a helper method to define mock.On call
+// UpdateStationTx is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListWeatherlinkStationsParams
-func (_e *MockStore_Expecter) ListWeatherlinkStations(ctx interface{}, arg interface{}) *MockStore_ListWeatherlinkStations_Call {
-	return &MockStore_ListWeatherlinkStations_Call{Call: _e.mock.On("ListWeatherlinkStations", ctx, arg)}
+//   - arg db.UpdateStationTxParams
+func (_e *MockStore_Expecter) UpdateStationTx(ctx interface{}, arg interface{}) *MockStore_UpdateStationTx_Call {
+	return &MockStore_UpdateStationTx_Call{Call: _e.mock.On("UpdateStationTx", ctx, arg)}
 }
 
-func (_c *MockStore_ListWeatherlinkStations_Call) Run(run func(ctx context.Context, arg db.ListWeatherlinkStationsParams)) *MockStore_ListWeatherlinkStations_Call {
+func (_c *MockStore_UpdateStationTx_Call) Run(run func(ctx context.Context, arg db.UpdateStationTxParams)) *MockStore_UpdateStationTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListWeatherlinkStationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationTxParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListWeatherlinkStations_Call) Return(_a0 []db.Weatherlink, _a1 error) *MockStore_ListWeatherlinkStations_Call {
+func (_c *MockStore_UpdateStationTx_Call) Return(_a0 db.UpdateStationTxResult, _a1 error) *MockStore_UpdateStationTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListWeatherlinkStations_Call) RunAndReturn(run func(context.Context, db.ListWeatherlinkStationsParams) ([]db.Weatherlink, error)) *MockStore_ListWeatherlinkStations_Call {
+func (_c *MockStore_UpdateStationTx_Call) RunAndReturn(run func(context.Context, db.UpdateStationTxParams) (db.UpdateStationTxResult, error)) *MockStore_UpdateStationTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRole provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateRole(ctx context.Context, arg db.UpdateRoleParams) (db.Role, error) {
+// UpdateUser provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRole")
+		panic("no return value specified for UpdateUser")
 	}
 
-	var r0 db.Role
+	var r0 db.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRoleParams) (db.Role, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) (db.User, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRoleParams) db.Role); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) db.User); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Role)
+		r0 = ret.Get(0).(db.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateRoleParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateUserParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3961,55 +9595,55 @@ func (_m *MockStore) UpdateRole(ctx context.Context, arg db.UpdateRoleParams) (d
 	return r0, r1
 }
 
-// MockStore_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
-type MockStore_UpdateRole_Call struct {
+// MockStore_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockStore_UpdateUser_Call struct {
 	*mock.Call
 }
 
-// UpdateRole is a helper method to define mock.On call
+// UpdateUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateRoleParams
-func (_e *MockStore_Expecter) UpdateRole(ctx interface{}, arg interface{}) *MockStore_UpdateRole_Call {
-	return &MockStore_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, arg)}
+//   - arg db.UpdateUserParams
+func (_e *MockStore_Expecter) UpdateUser(ctx interface{}, arg interface{}) *MockStore_UpdateUser_Call {
+	return &MockStore_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateRole_Call) Run(run func(ctx context.Context, arg db.UpdateRoleParams)) *MockStore_UpdateRole_Call {
+func (_c *MockStore_UpdateUser_Call) Run(run func(ctx context.Context, arg db.UpdateUserParams)) *MockStore_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateRoleParams))
+		run(args[0].(context.Context), args[1].(db.UpdateUserParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_UpdateRole_Call {
+func (_c *MockStore_UpdateUser_Call) Return(_a0 db.User, _a1 error) *MockStore_UpdateUser_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateRole_Call) RunAndReturn(run func(context.Context, db.UpdateRoleParams) (db.Role, error)) *MockStore_UpdateRole_Call {
+func (_c *MockStore_UpdateUser_Call) RunAndReturn(run func(context.Context, db.UpdateUserParams) (db.User, error)) *MockStore_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateStation(ctx context.Context, arg db.UpdateStationParams) (db.ObservationsStation, error) {
+// UpdateWeatherlinkChangeStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateWeatherlinkChangeStatus(ctx context.Context, arg db.UpdateWeatherlinkChangeStatusParams) (db.WeatherlinkChange, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStation")
+		panic("no return value specified for UpdateWeatherlinkChangeStatus")
 	}
 
-	var r0 db.ObservationsStation
+	var r0 db.WeatherlinkChange
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationParams) (db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateWeatherlinkChangeStatusParams) (db.WeatherlinkChange, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationParams) db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateWeatherlinkChangeStatusParams) db.WeatherlinkChange); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStation)
+		r0 = ret.Get(0).(db.WeatherlinkChange)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateWeatherlinkChangeStatusParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -4018,55 +9652,55 @@ func (_m *MockStore) UpdateStation(ctx context.Context, arg db.UpdateStationPara
 	return r0, r1
 }
 
-// MockStore_UpdateStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStation'
-type MockStore_UpdateStation_Call struct {
+// MockStore_UpdateWeatherlinkChangeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWeatherlinkChangeStatus'
+type MockStore_UpdateWeatherlinkChangeStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateStation is a helper method to define mock.On call
+// UpdateWeatherlinkChangeStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateStationParams
-func (_e *MockStore_Expecter) UpdateStation(ctx interface{}, arg interface{}) *MockStore_UpdateStation_Call {
-	return &MockStore_UpdateStation_Call{Call: _e.mock.On("UpdateStation", ctx, arg)}
+//   - arg db.UpdateWeatherlinkChangeStatusParams
+func (_e *MockStore_Expecter) UpdateWeatherlinkChangeStatus(ctx interface{}, arg interface{}) *MockStore_UpdateWeatherlinkChangeStatus_Call {
+	return &MockStore_UpdateWeatherlinkChangeStatus_Call{Call: _e.mock.On("UpdateWeatherlinkChangeStatus", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateStation_Call) Run(run func(ctx context.Context, arg db.UpdateStationParams)) *MockStore_UpdateStation_Call {
+func (_c *MockStore_UpdateWeatherlinkChangeStatus_Call) Run(run func(ctx context.Context, arg db.UpdateWeatherlinkChangeStatusParams)) *MockStore_UpdateWeatherlinkChangeStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateStationParams))
+		run(args[0].(context.Context), args[1].(db.UpdateWeatherlinkChangeStatusParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_UpdateStation_Call {
+func (_c *MockStore_UpdateWeatherlinkChangeStatus_Call) Return(_a0 db.WeatherlinkChange, _a1 error) *MockStore_UpdateWeatherlinkChangeStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateStation_Call) RunAndReturn(run func(context.Context, db.UpdateStationParams) (db.ObservationsStation, error)) *MockStore_UpdateStation_Call {
+func (_c *MockStore_UpdateWeatherlinkChangeStatus_Call) RunAndReturn(run func(context.Context, db.UpdateWeatherlinkChangeStatusParams) (db.WeatherlinkChange, error)) *MockStore_UpdateWeatherlinkChangeStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStationHealth provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateStationHealth(ctx context.Context, arg db.UpdateStationHealthParams) (db.ObservationsStationhealth, error) {
+// UpdateWeatherlinkStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateWeatherlinkStation(ctx context.Context, arg db.UpdateWeatherlinkStationParams) (db.Weatherlink, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStationHealth")
+		panic("no return value specified for UpdateWeatherlinkStation")
 	}
 
-	var r0 db.ObservationsStationhealth
+	var r0 db.Weatherlink
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateWeatherlinkStationParams) (db.Weatherlink, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationHealthParams) db.ObservationsStationhealth); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateWeatherlinkStationParams) db.Weatherlink); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStationhealth)
+		r0 = ret.Get(0).(db.Weatherlink)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationHealthParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateWeatherlinkStationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -4075,55 +9709,55 @@ func (_m *MockStore) UpdateStationHealth(ctx context.Context, arg db.UpdateStati
 	return r0, r1
 }
 
-// MockStore_UpdateStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationHealth'
-type MockStore_UpdateStationHealth_Call struct {
+// MockStore_UpdateWeatherlinkStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWeatherlinkStation'
+type MockStore_UpdateWeatherlinkStation_Call struct {
 	*mock.Call
 }
 
-// UpdateStationHealth is a helper method to define mock.On call
+// UpdateWeatherlinkStation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateStationHealthParams
-func (_e *MockStore_Expecter) UpdateStationHealth(ctx interface{}, arg interface{}) *MockStore_UpdateStationHealth_Call {
-	return &MockStore_UpdateStationHealth_Call{Call: _e.mock.On("UpdateStationHealth", ctx, arg)}
+//   - arg db.UpdateWeatherlinkStationParams
+func (_e *MockStore_Expecter) UpdateWeatherlinkStation(ctx interface{}, arg interface{}) *MockStore_UpdateWeatherlinkStation_Call {
+	return &MockStore_UpdateWeatherlinkStation_Call{Call: _e.mock.On("UpdateWeatherlinkStation", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateStationHealth_Call) Run(run func(ctx context.Context, arg db.UpdateStationHealthParams)) *MockStore_UpdateStationHealth_Call {
+func (_c *MockStore_UpdateWeatherlinkStation_Call) Run(run func(ctx context.Context, arg db.UpdateWeatherlinkStationParams)) *MockStore_UpdateWeatherlinkStation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateStationHealthParams))
+		run(args[0].(context.Context), args[1].(db.UpdateWeatherlinkStationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_UpdateStationHealth_Call {
+func (_c *MockStore_UpdateWeatherlinkStation_Call) Return(_a0 db.Weatherlink, _a1 error) *MockStore_UpdateWeatherlinkStation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateStationHealth_Call) RunAndReturn(run func(context.Context, db.UpdateStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_UpdateStationHealth_Call {
+func (_c *MockStore_UpdateWeatherlinkStation_Call) RunAndReturn(run func(context.Context, db.UpdateWeatherlinkStationParams) (db.Weatherlink, error)) *MockStore_UpdateWeatherlinkStation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStationMOObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateStationMOObservation(ctx context.Context, arg db.UpdateStationMOObservationParams) (db.ObservationsMoObservation, error) {
+// UpdateWeatherlinkStationMetadata provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateWeatherlinkStationMetadata(ctx context.Context, arg db.UpdateWeatherlinkStationMetadataParams) (db.Weatherlink, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStationMOObservation")
+		panic("no return value specified for UpdateWeatherlinkStationMetadata")
 	}
 
-	var r0 db.ObservationsMoObservation
+	var r0 db.Weatherlink
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMOObservationParams) (db.ObservationsMoObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateWeatherlinkStationMetadataParams) (db.Weatherlink, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMOObservationParams) db.ObservationsMoObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateWeatherlinkStationMetadataParams) db.Weatherlink); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsMoObservation)
+		r0 = ret.Get(0).(db.Weatherlink)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationMOObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateWeatherlinkStationMetadataParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -4132,56 +9766,56 @@ func (_m *MockStore) UpdateStationMOObservation(ctx context.Context, arg db.Upda
 	return r0, r1
 }
 
-// MockStore_UpdateStationMOObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationMOObservation'
-type MockStore_UpdateStationMOObservation_Call struct {
+// MockStore_UpdateWeatherlinkStationMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWeatherlinkStationMetadata'
+type MockStore_UpdateWeatherlinkStationMetadata_Call struct {
 	*mock.Call
 }
 
-// UpdateStationMOObservation is a helper method to define mock.On call
+// UpdateWeatherlinkStationMetadata is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateStationMOObservationParams
-func (_e *MockStore_Expecter) UpdateStationMOObservation(ctx interface{}, arg interface{}) *MockStore_UpdateStationMOObservation_Call {
-	return &MockStore_UpdateStationMOObservation_Call{Call: _e.mock.On("UpdateStationMOObservation", ctx, arg)}
+//   - arg db.UpdateWeatherlinkStationMetadataParams
+func (_e *MockStore_Expecter) UpdateWeatherlinkStationMetadata(ctx interface{}, arg interface{}) *MockStore_UpdateWeatherlinkStationMetadata_Call {
+	return &MockStore_UpdateWeatherlinkStationMetadata_Call{Call: _e.mock.On("UpdateWeatherlinkStationMetadata", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateStationMOObservation_Call) Run(run func(ctx context.Context, arg db.UpdateStationMOObservationParams)) *MockStore_UpdateStationMOObservation_Call {
+func (_c *MockStore_UpdateWeatherlinkStationMetadata_Call) Run(run func(ctx context.Context, arg db.UpdateWeatherlinkStationMetadataParams)) *MockStore_UpdateWeatherlinkStationMetadata_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateStationMOObservationParams))
+		run(args[0].(context.Context), args[1].(db.UpdateWeatherlinkStationMetadataParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateStationMOObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_UpdateStationMOObservation_Call {
+func (_c *MockStore_UpdateWeatherlinkStationMetadata_Call) Return(_a0 db.Weatherlink, _a1 error) *MockStore_UpdateWeatherlinkStationMetadata_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateStationMOObservation_Call) RunAndReturn(run func(context.Context, db.UpdateStationMOObservationParams) (db.ObservationsMoObservation, error)) *MockStore_UpdateStationMOObservation_Call {
+func (_c *MockStore_UpdateWeatherlinkStationMetadata_Call) RunAndReturn(run func(context.Context, db.UpdateWeatherlinkStationMetadataParams) (db.Weatherlink, error)) *MockStore_UpdateWeatherlinkStationMetadata_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateStationObservation(ctx context.Context, arg db.UpdateStationObservationParams) (db.ObservationsObservation, error) {
-	ret := _m.Called(ctx, arg)
+// UpsertPendingStation provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) UpsertPendingStation(ctx context.Context, mobileNumber string) (db.PendingStation, error) {
+	ret := _m.Called(ctx, mobileNumber)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStationObservation")
+		panic("no return value specified for UpsertPendingStation")
 	}
 
-	var r0 db.ObservationsObservation
+	var r0 db.PendingStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationObservationParams) (db.ObservationsObservation, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.PendingStation, error)); ok {
+		return rf(ctx, mobileNumber)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationObservationParams) db.ObservationsObservation); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.PendingStation); ok {
+		r0 = rf(ctx, mobileNumber)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsObservation)
+		r0 = ret.Get(0).(db.PendingStation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationObservationParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mobileNumber)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4189,55 +9823,55 @@ func (_m *MockStore) UpdateStationObservation(ctx context.Context, arg db.Update
 	return r0, r1
 }
 
-// MockStore_UpdateStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationObservation'
-type MockStore_UpdateStationObservation_Call struct {
+// MockStore_UpsertPendingStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPendingStation'
+type MockStore_UpsertPendingStation_Call struct {
 	*mock.Call
 }
 
-// UpdateStationObservation is a helper method to define mock.On call
+// UpsertPendingStation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateStationObservationParams
-func (_e *MockStore_Expecter) UpdateStationObservation(ctx interface{}, arg interface{}) *MockStore_UpdateStationObservation_Call {
-	return &MockStore_UpdateStationObservation_Call{Call: _e.mock.On("UpdateStationObservation", ctx, arg)}
+//   - mobileNumber string
+func (_e *MockStore_Expecter) UpsertPendingStation(ctx interface{}, mobileNumber interface{}) *MockStore_UpsertPendingStation_Call {
+	return &MockStore_UpsertPendingStation_Call{Call: _e.mock.On("UpsertPendingStation", ctx, mobileNumber)}
 }
 
-func (_c *MockStore_UpdateStationObservation_Call) Run(run func(ctx context.Context, arg db.UpdateStationObservationParams)) *MockStore_UpdateStationObservation_Call {
+func (_c *MockStore_UpsertPendingStation_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_UpsertPendingStation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateStationObservationParams))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_UpdateStationObservation_Call {
+func (_c *MockStore_UpsertPendingStation_Call) Return(_a0 db.PendingStation, _a1 error) *MockStore_UpsertPendingStation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateStationObservation_Call) RunAndReturn(run func(context.Context, db.UpdateStationObservationParams) (db.ObservationsObservation, error)) *MockStore_UpdateStationObservation_Call {
+func (_c *MockStore_UpsertPendingStation_Call) RunAndReturn(run func(context.Context, string) (db.PendingStation, error)) *MockStore_UpsertPendingStation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
+// UpsertStationClock provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertStationClock(ctx context.Context, arg db.UpsertStationClockParams) (db.StationClock, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
+		panic("no return value specified for UpsertStationClock")
 	}
 
-	var r0 db.User
+	var r0 db.StationClock
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) (db.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertStationClockParams) (db.StationClock, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) db.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertStationClockParams) db.StationClock); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(db.StationClock)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpsertStationClockParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -4246,31 +9880,31 @@ func (_m *MockStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (d
 	return r0, r1
 }
 
-// MockStore_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type MockStore_UpdateUser_Call struct {
+// MockStore_UpsertStationClock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertStationClock'
+type MockStore_UpsertStationClock_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
+// UpsertStationClock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateUserParams
-func (_e *MockStore_Expecter) UpdateUser(ctx interface{}, arg interface{}) *MockStore_UpdateUser_Call {
-	return &MockStore_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, arg)}
+//   - arg db.UpsertStationClockParams
+func (_e *MockStore_Expecter) UpsertStationClock(ctx interface{}, arg interface{}) *MockStore_UpsertStationClock_Call {
+	return &MockStore_UpsertStationClock_Call{Call: _e.mock.On("UpsertStationClock", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateUser_Call) Run(run func(ctx context.Context, arg db.UpdateUserParams)) *MockStore_UpdateUser_Call {
+func (_c *MockStore_UpsertStationClock_Call) Run(run func(ctx context.Context, arg db.UpsertStationClockParams)) *MockStore_UpsertStationClock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateUserParams))
+		run(args[0].(context.Context), args[1].(db.UpsertStationClockParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateUser_Call) Return(_a0 db.User, _a1 error) *MockStore_UpdateUser_Call {
+func (_c *MockStore_UpsertStationClock_Call) Return(_a0 db.StationClock, _a1 error) *MockStore_UpsertStationClock_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateUser_Call) RunAndReturn(run func(context.Context, db.UpdateUserParams) (db.User, error)) *MockStore_UpdateUser_Call {
+func (_c *MockStore_UpsertStationClock_Call) RunAndReturn(run func(context.Context, db.UpsertStationClockParams) (db.StationClock, error)) *MockStore_UpsertStationClock_Call {
 	_c.Call.Return(run)
 	return _c
 }
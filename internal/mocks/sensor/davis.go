@@ -3,8 +3,12 @@
 package mocksensor
 
 import (
+	context "context"
+
 	sensor "github.com/emiliogozo/panahon-api-go/internal/sensor"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockDavisSensor is an autogenerated mock type for the DavisSensor type
@@ -20,9 +24,69 @@ func (_m *MockDavisSensor) EXPECT() *MockDavisSensor_Expecter {
 	return &MockDavisSensor_Expecter{mock: &_m.Mock}
 }
 
-// FetchLatest provides a mock function with no fields
-func (_m *MockDavisSensor) FetchLatest() ([]sensor.DavisCurrentObservation, error) {
-	ret := _m.Called()
+// FetchHistoric provides a mock function with given fields: ctx, start, end
+func (_m *MockDavisSensor) FetchHistoric(ctx context.Context, start time.Time, end time.Time) ([]sensor.DavisCurrentObservation, error) {
+	ret := _m.Called(ctx, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchHistoric")
+	}
+
+	var r0 []sensor.DavisCurrentObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]sensor.DavisCurrentObservation, error)); ok {
+		return rf(ctx, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []sensor.DavisCurrentObservation); ok {
+		r0 = rf(ctx, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sensor.DavisCurrentObservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDavisSensor_FetchHistoric_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchHistoric'
+type MockDavisSensor_FetchHistoric_Call struct {
+	*mock.Call
+}
+
+// FetchHistoric is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start time.Time
+//   - end time.Time
+func (_e *MockDavisSensor_Expecter) FetchHistoric(ctx interface{}, start interface{}, end interface{}) *MockDavisSensor_FetchHistoric_Call {
+	return &MockDavisSensor_FetchHistoric_Call{Call: _e.mock.On("FetchHistoric", ctx, start, end)}
+}
+
+func (_c *MockDavisSensor_FetchHistoric_Call) Run(run func(ctx context.Context, start time.Time, end time.Time)) *MockDavisSensor_FetchHistoric_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDavisSensor_FetchHistoric_Call) Return(_a0 []sensor.DavisCurrentObservation, _a1 error) *MockDavisSensor_FetchHistoric_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDavisSensor_FetchHistoric_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]sensor.DavisCurrentObservation, error)) *MockDavisSensor_FetchHistoric_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchLatest provides a mock function with given fields: ctx
+func (_m *MockDavisSensor) FetchLatest(ctx context.Context) ([]sensor.DavisCurrentObservation, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FetchLatest")
@@ -30,19 +94,19 @@ func (_m *MockDavisSensor) FetchLatest() ([]sensor.DavisCurrentObservation, erro
 
 	var r0 []sensor.DavisCurrentObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]sensor.DavisCurrentObservation, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]sensor.DavisCurrentObservation, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []sensor.DavisCurrentObservation); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []sensor.DavisCurrentObservation); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]sensor.DavisCurrentObservation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,13 +120,14 @@ type MockDavisSensor_FetchLatest_Call struct {
 }
 
 // FetchLatest is a helper method to define mock.On call
-func (_e *MockDavisSensor_Expecter) FetchLatest() *MockDavisSensor_FetchLatest_Call {
-	return &MockDavisSensor_FetchLatest_Call{Call: _e.mock.On("FetchLatest")}
+//   - ctx context.Context
+func (_e *MockDavisSensor_Expecter) FetchLatest(ctx interface{}) *MockDavisSensor_FetchLatest_Call {
+	return &MockDavisSensor_FetchLatest_Call{Call: _e.mock.On("FetchLatest", ctx)}
 }
 
-func (_c *MockDavisSensor_FetchLatest_Call) Run(run func()) *MockDavisSensor_FetchLatest_Call {
+func (_c *MockDavisSensor_FetchLatest_Call) Run(run func(ctx context.Context)) *MockDavisSensor_FetchLatest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -72,7 +137,65 @@ func (_c *MockDavisSensor_FetchLatest_Call) Return(_a0 []sensor.DavisCurrentObse
 	return _c
 }
 
-func (_c *MockDavisSensor_FetchLatest_Call) RunAndReturn(run func() ([]sensor.DavisCurrentObservation, error)) *MockDavisSensor_FetchLatest_Call {
+func (_c *MockDavisSensor_FetchLatest_Call) RunAndReturn(run func(context.Context) ([]sensor.DavisCurrentObservation, error)) *MockDavisSensor_FetchLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchStations provides a mock function with given fields: ctx
+func (_m *MockDavisSensor) FetchStations(ctx context.Context) ([]sensor.DavisStation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchStations")
+	}
+
+	var r0 []sensor.DavisStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]sensor.DavisStation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []sensor.DavisStation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sensor.DavisStation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDavisSensor_FetchStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchStations'
+type MockDavisSensor_FetchStations_Call struct {
+	*mock.Call
+}
+
+// FetchStations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDavisSensor_Expecter) FetchStations(ctx interface{}) *MockDavisSensor_FetchStations_Call {
+	return &MockDavisSensor_FetchStations_Call{Call: _e.mock.On("FetchStations", ctx)}
+}
+
+func (_c *MockDavisSensor_FetchStations_Call) Run(run func(ctx context.Context)) *MockDavisSensor_FetchStations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDavisSensor_FetchStations_Call) Return(_a0 []sensor.DavisStation, _a1 error) *MockDavisSensor_FetchStations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDavisSensor_FetchStations_Call) RunAndReturn(run func(context.Context) ([]sensor.DavisStation, error)) *MockDavisSensor_FetchStations_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -19,6 +19,8 @@ type BaseStation struct {
 	Province      util.Province `json:"province"`
 	Region        util.Region   `json:"region"`
 	Address       string        `json:"address"`
+	// minutes between records sent by the station
+	ReportingInterval *int32 `json:"reporting_interval,omitempty" binding:"omitempty,min=1,max=1440" fake:"{number:1,60}"`
 }
 
 type Station struct {
@@ -62,6 +64,9 @@ func NewStation(station db.ObservationsStation, simple bool) Station {
 		if station.Status.Valid {
 			res.DateInstalled = util.Date{Time: station.DateInstalled.Time}
 		}
+		if station.ReportingInterval.Valid {
+			res.ReportingInterval = &station.ReportingInterval.Int32
+		}
 	}
 	if station.Province.Valid {
 		res.Province = util.Province(station.Province.String)
@@ -113,14 +118,15 @@ func transformStation[T StationParams](req BaseStation, extraParams T) T {
 			Time:  req.DateInstalled.Time,
 			Valid: !req.DateInstalled.IsZero(),
 		},
-		MobileNumber: util.ToPgText(req.MobileNumber),
-		StationType:  util.ToPgText(req.StationType),
-		StationType2: util.ToPgText(req.StationType2),
-		StationUrl:   util.ToPgText(req.StationUrl),
-		Status:       util.ToPgText(req.Status),
-		Province:     util.ToPgText(string(req.Province)),
-		Region:       util.ToPgText(string(req.Region)),
-		Address:      util.ToPgText(req.Address),
+		MobileNumber:      util.ToPgText(req.MobileNumber),
+		StationType:       util.ToPgText(req.StationType),
+		StationType2:      util.ToPgText(req.StationType2),
+		StationUrl:        util.ToPgText(req.StationUrl),
+		Status:            util.ToPgText(req.Status),
+		Province:          util.ToPgText(string(req.Province)),
+		Region:            util.ToPgText(string(req.Region)),
+		Address:           util.ToPgText(req.Address),
+		ReportingInterval: util.ToInt4(req.ReportingInterval),
 	}
 
 	switch v := any(extraParams).(type) {
@@ -129,20 +135,21 @@ func transformStation[T StationParams](req BaseStation, extraParams T) T {
 		return any(arg).(T)
 	case db.UpdateStationParams:
 		return any(db.UpdateStationParams{
-			ID:            v.ID,
-			Name:          v.Name,
-			Lat:           arg.Lat,
-			Lon:           arg.Lon,
-			Elevation:     arg.Elevation,
-			DateInstalled: arg.DateInstalled,
-			MobileNumber:  arg.MobileNumber,
-			StationType:   arg.StationType,
-			StationType2:  arg.StationType2,
-			StationUrl:    arg.StationUrl,
-			Status:        arg.Status,
-			Province:      arg.Province,
-			Region:        arg.Region,
-			Address:       arg.Address,
+			ID:                v.ID,
+			Name:              v.Name,
+			Lat:               arg.Lat,
+			Lon:               arg.Lon,
+			Elevation:         arg.Elevation,
+			DateInstalled:     arg.DateInstalled,
+			MobileNumber:      arg.MobileNumber,
+			StationType:       arg.StationType,
+			StationType2:      arg.StationType2,
+			StationUrl:        arg.StationUrl,
+			Status:            arg.Status,
+			Province:          arg.Province,
+			Region:            arg.Region,
+			Address:           arg.Address,
+			ReportingInterval: arg.ReportingInterval,
 		}).(T)
 	default:
 		panic("Unsupported type")
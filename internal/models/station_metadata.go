@@ -0,0 +1,163 @@
+package models
+
+import (
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type BaseStationMetadata struct {
+	Lat           *float32 `json:"lat" fake:"{latitude}"`
+	Lon           *float32 `json:"lon" fake:"{longitude}"`
+	Elevation     *float32 `json:"elevation" fake:"{float32range:0,30}"`
+	StationType   string   `json:"station_type,omitempty"`
+	StationType2  string   `json:"station_type2,omitempty"`
+	LoggerVersion string   `json:"logger_version,omitempty"`
+	LoggerSerial  string   `json:"logger_serial,omitempty"`
+	// sensor heights are in meters above the ground
+	TempHeight *float32 `json:"temp_height,omitempty" binding:"omitempty,min=0" fake:"{float32range:1,3}"`
+	TempSerial string   `json:"temp_serial,omitempty"`
+	WindHeight *float32 `json:"wind_height,omitempty" binding:"omitempty,min=0" fake:"{float32range:2,10}"`
+	WindSerial string   `json:"wind_serial,omitempty"`
+	RainHeight *float32 `json:"rain_height,omitempty" binding:"omitempty,min=0" fake:"{float32range:0,2}"`
+	RainSerial string   `json:"rain_serial,omitempty"`
+	PresHeight *float32 `json:"pres_height,omitempty" binding:"omitempty,min=0" fake:"{float32range:0,2}"`
+	PresSerial string   `json:"pres_serial,omitempty"`
+}
+
+type StationMetadata struct {
+	ID        int64 `json:"id,omitempty"`
+	StationID int64 `json:"station_id"`
+	BaseStationMetadata
+	Reason string `json:"reason,omitempty"`
+	// not set on the first version of the station
+	EffectiveFrom *time.Time `json:"effective_from,omitempty"`
+	// not set on the current version of the station
+	EffectiveTo *time.Time `json:"effective_to,omitempty"`
+} //@name StationMetadata
+
+// NewStationMetadata creates new StationMetadata from db.StationMetadataHistory
+func NewStationMetadata(m db.StationMetadataHistory) StationMetadata {
+	res := StationMetadata{
+		ID:        m.ID,
+		StationID: m.StationID,
+		BaseStationMetadata: BaseStationMetadata{
+			StationType:   m.StationType.String,
+			StationType2:  m.StationType2.String,
+			LoggerVersion: m.LoggerVersion.String,
+			LoggerSerial:  m.LoggerSerial.String,
+			TempSerial:    m.TempSerial.String,
+			WindSerial:    m.WindSerial.String,
+			RainSerial:    m.RainSerial.String,
+			PresSerial:    m.PresSerial.String,
+		},
+		Reason: m.Reason,
+	}
+
+	if m.Lat.Valid {
+		res.Lat = &m.Lat.Float32
+	}
+	if m.Lon.Valid {
+		res.Lon = &m.Lon.Float32
+	}
+	if m.Elevation.Valid {
+		res.Elevation = &m.Elevation.Float32
+	}
+	if m.TempHeight.Valid {
+		res.TempHeight = &m.TempHeight.Float32
+	}
+	if m.WindHeight.Valid {
+		res.WindHeight = &m.WindHeight.Float32
+	}
+	if m.RainHeight.Valid {
+		res.RainHeight = &m.RainHeight.Float32
+	}
+	if m.PresHeight.Valid {
+		res.PresHeight = &m.PresHeight.Float32
+	}
+	if m.EffectiveFrom.Valid && m.EffectiveFrom.Time.Year() > 1 {
+		res.EffectiveFrom = &m.EffectiveFrom.Time
+	}
+	if m.EffectiveTo.Valid {
+		res.EffectiveTo = &m.EffectiveTo.Time
+	}
+
+	return res
+}
+
+// NewStationMetadataFromStation creates the StationMetadata of a station without metadata history
+func NewStationMetadataFromStation(station db.ObservationsStation) StationMetadata {
+	res := StationMetadata{
+		StationID: station.ID,
+		BaseStationMetadata: BaseStationMetadata{
+			StationType:   station.StationType.String,
+			StationType2:  station.StationType2.String,
+			LoggerVersion: station.LoggerVersion.String,
+		},
+	}
+
+	if station.Lat.Valid {
+		res.Lat = &station.Lat.Float32
+	}
+	if station.Lon.Valid {
+		res.Lon = &station.Lon.Float32
+	}
+	if station.Elevation.Valid {
+		res.Elevation = &station.Elevation.Float32
+	}
+
+	return res
+}
+
+// FindStationMetadata returns the version valid at ts, versions must be of the same station
+func FindStationMetadata(versions []db.StationMetadataHistory, ts time.Time) *StationMetadata {
+	for _, v := range versions {
+		if v.EffectiveFrom.Time.After(ts) {
+			continue
+		}
+		if v.EffectiveTo.Valid && !v.EffectiveTo.Time.After(ts) {
+			continue
+		}
+		res := NewStationMetadata(v)
+		return &res
+	}
+	return nil
+}
+
+type UpdateStationMetadataReq struct {
+	StationID int64 `json:"-"`
+	BaseStationMetadata
+	Reason string `json:"reason"`
+	// defaults to now
+	EffectiveFrom string `json:"effective_from" binding:"omitempty,date_time"`
+} //@name UpdateStationMetadataReq
+
+func (r UpdateStationMetadataReq) Transform() db.UpdateStationMetadataTxParams {
+	arg := db.UpdateStationMetadataTxParams{
+		CreateStationMetadataHistoryParams: db.CreateStationMetadataHistoryParams{
+			StationID:     r.StationID,
+			Lat:           util.ToFloat4(r.Lat),
+			Lon:           util.ToFloat4(r.Lon),
+			Elevation:     util.ToFloat4(r.Elevation),
+			StationType:   util.ToPgText(r.StationType),
+			StationType2:  util.ToPgText(r.StationType2),
+			LoggerVersion: util.ToPgText(r.LoggerVersion),
+			LoggerSerial:  util.ToPgText(r.LoggerSerial),
+			TempHeight:    util.ToFloat4(r.TempHeight),
+			TempSerial:    util.ToPgText(r.TempSerial),
+			WindHeight:    util.ToFloat4(r.WindHeight),
+			WindSerial:    util.ToPgText(r.WindSerial),
+			RainHeight:    util.ToFloat4(r.RainHeight),
+			RainSerial:    util.ToPgText(r.RainSerial),
+			PresHeight:    util.ToFloat4(r.PresHeight),
+			PresSerial:    util.ToPgText(r.PresSerial),
+			Reason:        r.Reason,
+		},
+	}
+	if ts, ok := util.ParseDateTime(r.EffectiveFrom); ok {
+		arg.EffectiveFrom = pgtype.Timestamptz{Time: ts, Valid: true}
+	}
+	return arg
+}
@@ -29,6 +29,10 @@ type StationObservation struct {
 	StationID int64 `json:"station_id" fake:"{number:1,250}"`
 	QcLevel   int32 `json:"qc_level"`
 	BaseStationObs
+	// station metadata valid at the observation time
+	Metadata *StationMetadata `json:"metadata,omitempty"`
+	// observed while the station was under maintenance
+	Maintenance bool `json:"maintenance,omitempty"`
 } //@name StationObservation
 
 // NewStationObservation creates new StationObservation from db.ObservationsObservation
@@ -0,0 +1,147 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	DefaultGLabsAPIURL = "https://devapi.globelabs.com.ph"
+	// GLabsAccessTokenType is the sim_access_tokens type of the subscriber tokens from the Globe Labs opt-in
+	GLabsAccessTokenType = "GLABS"
+	// DeliverySubmitted is recorded when the API accepted the message without a delivery status
+	DeliverySubmitted = "Submitted"
+)
+
+// Message is a single SMS addressed to a subscriber
+type Message struct {
+	Address          string // mobile number in 63XXXXXXXXXX format
+	AccessToken      string
+	Text             string
+	ClientCorrelator string
+}
+
+type DeliveryReport struct {
+	Status      string
+	ResourceURL string
+}
+
+// Sender delivers SMS messages
+type Sender interface {
+	Send(ctx context.Context, msg Message) (DeliveryReport, error)
+}
+
+// APIError is a non-success response of the SMS API
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("sms api error: status %d: %s", e.StatusCode, e.Body)
+}
+
+// Temporary reports whether the request may succeed when retried
+func (e *APIError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// GLabsClient sends SMS through the Globe Labs SMS MT API
+type GLabsClient struct {
+	baseURL   string
+	shortCode string
+	client    *http.Client
+}
+
+func NewGLabsClient(baseURL, shortCode string, client *http.Client) *GLabsClient {
+	if baseURL == "" {
+		baseURL = DefaultGLabsAPIURL
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &GLabsClient{
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		shortCode: shortCode,
+		client:    client,
+	}
+}
+
+type gLabsOutboundReq struct {
+	OutboundSMSMessageRequest struct {
+		ClientCorrelator       string `json:"clientCorrelator,omitempty"`
+		SenderAddress          string `json:"senderAddress"`
+		OutboundSMSTextMessage struct {
+			Message string `json:"message"`
+		} `json:"outboundSMSTextMessage"`
+		Address string `json:"address"`
+	} `json:"outboundSMSMessageRequest"`
+}
+
+type gLabsOutboundRes struct {
+	OutboundSMSMessageRequest struct {
+		DeliveryInfoList struct {
+			DeliveryInfo []struct {
+				Address        string `json:"address"`
+				DeliveryStatus string `json:"deliveryStatus"`
+			} `json:"deliveryInfo"`
+			ResourceURL string `json:"resourceURL"`
+		} `json:"deliveryInfoList"`
+		ResourceURL string `json:"resourceURL"`
+	} `json:"outboundSMSMessageRequest"`
+}
+
+func (c *GLabsClient) Send(ctx context.Context, msg Message) (DeliveryReport, error) {
+	var reqBody gLabsOutboundReq
+	reqBody.OutboundSMSMessageRequest.ClientCorrelator = msg.ClientCorrelator
+	reqBody.OutboundSMSMessageRequest.SenderAddress = c.shortCode
+	reqBody.OutboundSMSMessageRequest.OutboundSMSTextMessage.Message = msg.Text
+	reqBody.OutboundSMSMessageRequest.Address = "+" + strings.TrimPrefix(msg.Address, "+")
+
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return DeliveryReport{}, err
+	}
+
+	endpoint := fmt.Sprintf("%s/smsmessaging/v1/outbound/%s/requests?access_token=%s",
+		c.baseURL, url.PathEscape(c.shortCode), url.QueryEscape(msg.AccessToken))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return DeliveryReport{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return DeliveryReport{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return DeliveryReport{}, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return DeliveryReport{}, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var res gLabsOutboundRes
+	if err := json.Unmarshal(body, &res); err != nil {
+		return DeliveryReport{}, fmt.Errorf("invalid sms api response: %w", err)
+	}
+
+	report := DeliveryReport{
+		Status:      DeliverySubmitted,
+		ResourceURL: res.OutboundSMSMessageRequest.ResourceURL,
+	}
+	if infos := res.OutboundSMSMessageRequest.DeliveryInfoList.DeliveryInfo; len(infos) > 0 && infos[0].DeliveryStatus != "" {
+		report.Status = infos[0].DeliveryStatus
+	}
+	return report, nil
+}
@@ -0,0 +1,54 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/notify/sms/smstest"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGLabsClientSend(t *testing.T) {
+	server := smstest.NewServer()
+	defer server.Close()
+
+	client := NewGLabsClient(server.URL, "1234", server.Client())
+	msg := Message{
+		Address:          "639171234567",
+		AccessToken:      util.RandomString(32),
+		Text:             util.RandomString(40),
+		ClientCorrelator: "17",
+	}
+
+	report, err := client.Send(context.Background(), msg)
+	require.NoError(t, err)
+	require.Equal(t, "DeliveredToNetwork", report.Status)
+	require.NotEmpty(t, report.ResourceURL)
+
+	reqs := server.Requests()
+	require.Len(t, reqs, 1)
+	require.Equal(t, smstest.Request{
+		ShortCode:        "1234",
+		AccessToken:      msg.AccessToken,
+		Address:          "+639171234567",
+		Message:          msg.Text,
+		ClientCorrelator: "17",
+	}, reqs[0])
+
+	server.FailNext(http.StatusServiceUnavailable, http.StatusUnauthorized)
+
+	_, err = client.Send(context.Background(), msg)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.True(t, apiErr.Temporary())
+
+	_, err = client.Send(context.Background(), msg)
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	require.False(t, apiErr.Temporary())
+
+	require.Len(t, server.Requests(), 1)
+}
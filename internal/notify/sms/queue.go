@@ -0,0 +1,152 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+const (
+	StatusQueued  = "QUEUED"
+	StatusSending = "SENDING"
+	StatusSent    = "SENT"
+	StatusFailed  = "FAILED"
+)
+
+var (
+	ErrNoAccessToken = errors.New("mobile number has no globe labs access token")
+	ErrExpired       = errors.New("message expired before it was sent")
+)
+
+type QueueConfig struct {
+	// BatchSize is the number of due messages sent per dispatch
+	BatchSize int32
+	// MaxAttempts is the number of sends before a message is marked as failed
+	MaxAttempts int32
+	// Backoff is the delay before the first retry, doubled on every attempt
+	Backoff time.Duration
+}
+
+var DefaultQueueConfig = QueueConfig{
+	BatchSize:   50,
+	MaxAttempts: 5,
+	Backoff:     time.Minute,
+}
+
+// Enqueue adds a message to the outbox, it is sent on the next dispatch
+func Enqueue(ctx context.Context, store db.Store, mobileNumber, text string) (db.SmsOutbox, error) {
+	number, ok := util.ParseMobileNumber(mobileNumber)
+	if !ok {
+		return db.SmsOutbox{}, fmt.Errorf("invalid mobile number: %s", mobileNumber)
+	}
+	return store.CreateSmsOutbox(ctx, db.CreateSmsOutboxParams{
+		MobileNumber: number,
+		Message:      text,
+	})
+}
+
+// Queue sends the queued outbox messages with retries
+type Queue struct {
+	store  db.Store
+	sender Sender
+	config QueueConfig
+	logger *zerolog.Logger
+}
+
+func NewQueue(store db.Store, sender Sender, config QueueConfig, logger *zerolog.Logger) *Queue {
+	return &Queue{
+		store:  store,
+		sender: sender,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Dispatch sends the messages that are due.
+// The messages are claimed first so that concurrent dispatches do not send them twice.
+func (q *Queue) Dispatch(ctx context.Context) {
+	serviceName := "SMSQueue"
+	msgs, err := q.store.ClaimDueSmsOutbox(ctx, q.config.BatchSize)
+	if err != nil {
+		q.logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return
+	}
+
+	for _, m := range msgs {
+		arg := q.send(ctx, m)
+		if _, err := q.store.UpdateSmsOutboxStatus(ctx, arg); err != nil {
+			q.logger.Error().Err(err).Str("service", serviceName).Int64("id", m.ID).Msg("cannot update message status")
+			continue
+		}
+		if arg.Status != StatusSent {
+			q.logger.Warn().Str("service", serviceName).
+				Int64("id", m.ID).
+				Str("mobile_number", m.MobileNumber).
+				Str("status", arg.Status).
+				Int32("attempts", arg.Attempts).
+				Str("error", arg.LastError.String).
+				Msg("cannot send message")
+		}
+	}
+}
+
+func (q *Queue) send(ctx context.Context, m db.SmsOutbox) db.UpdateSmsOutboxStatusParams {
+	arg := db.UpdateSmsOutboxStatusParams{
+		ID:       m.ID,
+		Attempts: m.Attempts + 1,
+	}
+
+	// a time sensitive message is dropped instead of being sent late
+	if m.ExpiresAt.Valid && time.Now().After(m.ExpiresAt.Time) {
+		arg.Attempts = m.Attempts
+		return q.failed(arg, ErrExpired, false)
+	}
+
+	token, err := q.store.GetLatestSimAccessToken(ctx, db.GetLatestSimAccessTokenParams{
+		MobileNumber: m.MobileNumber,
+		Type:         GLabsAccessTokenType,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			err = ErrNoAccessToken
+		}
+		return q.failed(arg, err, !errors.Is(err, ErrNoAccessToken))
+	}
+
+	report, err := q.sender.Send(ctx, Message{
+		Address:          m.MobileNumber,
+		AccessToken:      token.AccessToken,
+		Text:             m.Message,
+		ClientCorrelator: strconv.FormatInt(m.ID, 10),
+	})
+	if err != nil {
+		var apiErr *APIError
+		return q.failed(arg, err, !errors.As(err, &apiErr) || apiErr.Temporary())
+	}
+
+	arg.Status = StatusSent
+	arg.DeliveryStatus = util.ToPgText(report.Status)
+	arg.SentAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	return arg
+}
+
+// failed schedules a retry with exponential backoff, or marks the message as failed
+func (q *Queue) failed(arg db.UpdateSmsOutboxStatusParams, err error, retry bool) db.UpdateSmsOutboxStatusParams {
+	arg.LastError = util.ToPgText(err.Error())
+	if !retry || arg.Attempts >= q.config.MaxAttempts {
+		arg.Status = StatusFailed
+		return arg
+	}
+
+	arg.Status = StatusQueued
+	delay := q.config.Backoff << (arg.Attempts - 1)
+	arg.NextAttemptAt = pgtype.Timestamptz{Time: time.Now().Add(delay), Valid: true}
+	return arg
+}
@@ -0,0 +1,157 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/notify/sms/smstest"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnqueue(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().CreateSmsOutbox(mock.AnythingOfType("backgroundCtx"), db.CreateSmsOutboxParams{
+		MobileNumber: "639171234567",
+		Message:      "hello",
+	}).Return(db.SmsOutbox{ID: 1, MobileNumber: "639171234567", Message: "hello", Status: StatusQueued}, nil)
+
+	m, err := Enqueue(context.Background(), store, "09171234567", "hello")
+	require.NoError(t, err)
+	require.Equal(t, StatusQueued, m.Status)
+
+	_, err = Enqueue(context.Background(), store, "1234", "hello")
+	require.Error(t, err)
+}
+
+func TestQueueDispatch(t *testing.T) {
+	config := QueueConfig{BatchSize: 10, MaxAttempts: 3, Backoff: time.Minute}
+	token := db.SimAccessToken{AccessToken: util.RandomString(32), Type: GLabsAccessTokenType}
+
+	testCases := []struct {
+		name       string
+		msg        db.SmsOutbox
+		failures   []int
+		noToken    bool
+		checkParam func(t *testing.T, arg db.UpdateSmsOutboxStatusParams)
+	}{
+		{
+			name: "Sent",
+			msg:  db.SmsOutbox{ID: 1, MobileNumber: "639171234567", Message: "hello", Status: StatusQueued},
+			checkParam: func(t *testing.T, arg db.UpdateSmsOutboxStatusParams) {
+				require.Equal(t, StatusSent, arg.Status)
+				require.Equal(t, int32(1), arg.Attempts)
+				require.Equal(t, "DeliveredToNetwork", arg.DeliveryStatus.String)
+				require.True(t, arg.SentAt.Valid)
+			},
+		},
+		{
+			name:     "Retry",
+			msg:      db.SmsOutbox{ID: 2, MobileNumber: "639171234567", Message: "hello", Status: StatusQueued, Attempts: 1},
+			failures: []int{http.StatusBadGateway},
+			checkParam: func(t *testing.T, arg db.UpdateSmsOutboxStatusParams) {
+				require.Equal(t, StatusQueued, arg.Status)
+				require.Equal(t, int32(2), arg.Attempts)
+				require.True(t, arg.LastError.Valid)
+				// the second retry waits twice the backoff
+				require.WithinDuration(t, time.Now().Add(2*time.Minute), arg.NextAttemptAt.Time, 5*time.Second)
+			},
+		},
+		{
+			name:     "MaxAttempts",
+			msg:      db.SmsOutbox{ID: 3, MobileNumber: "639171234567", Message: "hello", Status: StatusQueued, Attempts: 2},
+			failures: []int{http.StatusBadGateway},
+			checkParam: func(t *testing.T, arg db.UpdateSmsOutboxStatusParams) {
+				require.Equal(t, StatusFailed, arg.Status)
+				require.Equal(t, int32(3), arg.Attempts)
+			},
+		},
+		{
+			name:     "PermanentError",
+			msg:      db.SmsOutbox{ID: 4, MobileNumber: "639171234567", Message: "hello", Status: StatusQueued},
+			failures: []int{http.StatusUnauthorized},
+			checkParam: func(t *testing.T, arg db.UpdateSmsOutboxStatusParams) {
+				require.Equal(t, StatusFailed, arg.Status)
+				require.Equal(t, int32(1), arg.Attempts)
+			},
+		},
+		{
+			name:    "NoAccessToken",
+			msg:     db.SmsOutbox{ID: 5, MobileNumber: "639171234567", Message: "hello", Status: StatusQueued},
+			noToken: true,
+			checkParam: func(t *testing.T, arg db.UpdateSmsOutboxStatusParams) {
+				require.Equal(t, StatusFailed, arg.Status)
+				require.Equal(t, ErrNoAccessToken.Error(), arg.LastError.String)
+			},
+		},
+		{
+			name: "Expired",
+			msg: db.SmsOutbox{ID: 6, MobileNumber: "639171234567", Message: "hello", Status: StatusQueued, Attempts: 1,
+				ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}},
+			checkParam: func(t *testing.T, arg db.UpdateSmsOutboxStatusParams) {
+				require.Equal(t, StatusFailed, arg.Status)
+				require.Equal(t, int32(1), arg.Attempts)
+				require.Equal(t, ErrExpired.Error(), arg.LastError.String)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := smstest.NewServer()
+			defer server.Close()
+			server.FailNext(tc.failures...)
+
+			store := mockdb.NewMockStore(t)
+			store.EXPECT().ClaimDueSmsOutbox(mock.AnythingOfType("backgroundCtx"), config.BatchSize).
+				Return([]db.SmsOutbox{tc.msg}, nil)
+			expired := tc.msg.ExpiresAt.Valid
+			if !expired {
+				tokenCall := store.EXPECT().GetLatestSimAccessToken(mock.AnythingOfType("backgroundCtx"), db.GetLatestSimAccessTokenParams{
+					MobileNumber: tc.msg.MobileNumber,
+					Type:         GLabsAccessTokenType,
+				})
+				if tc.noToken {
+					tokenCall.Return(db.SimAccessToken{}, db.ErrRecordNotFound)
+				} else {
+					tokenCall.Return(token, nil)
+				}
+			}
+			store.EXPECT().UpdateSmsOutboxStatus(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.UpdateSmsOutboxStatusParams) bool {
+				return arg.ID == tc.msg.ID
+			})).Run(func(ctx context.Context, arg db.UpdateSmsOutboxStatusParams) {
+				tc.checkParam(t, arg)
+			}).Return(db.SmsOutbox{}, nil)
+
+			logger := util.NewLogger(util.Config{EnableFileLogging: false})
+			queue := NewQueue(store, NewGLabsClient(server.URL, "1234", server.Client()), config, logger)
+			queue.Dispatch(context.Background())
+
+			store.AssertExpectations(t)
+			if tc.noToken || expired {
+				require.Empty(t, server.Requests())
+			}
+		})
+	}
+}
+
+func TestQueueDispatchDatabaseError(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().ClaimDueSmsOutbox(mock.AnythingOfType("backgroundCtx"), mock.Anything).
+		Return(nil, errors.New("connection refused"))
+
+	logger := util.NewLogger(util.Config{EnableFileLogging: false})
+	queue := NewQueue(store, NewGLabsClient("http://localhost", "1234", nil), DefaultQueueConfig, logger)
+	queue.Dispatch(context.Background())
+
+	store.AssertNotCalled(t, "UpdateSmsOutboxStatus", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,122 @@
+// Package smstest provides a local stand-in for the Globe Labs SMS MT API
+package smstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// Request is an SMS received by the stand-in
+type Request struct {
+	ShortCode        string
+	AccessToken      string
+	Address          string
+	Message          string
+	ClientCorrelator string
+}
+
+// Server records the SMS sent to it and answers like the Globe Labs API
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []Request
+	failures []int
+}
+
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Requests returns the SMS accepted so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// FailNext makes the next requests fail with the status codes, in order
+func (s *Server) FailNext(statusCodes ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, statusCodes...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/smsmessaging/v1/outbound/")
+	shortCode, ok := strings.CutSuffix(path, "/requests")
+	if r.Method != http.MethodPost || path == r.URL.Path || !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	if len(s.failures) > 0 {
+		code := s.failures[0]
+		s.failures = s.failures[1:]
+		s.mu.Unlock()
+		http.Error(w, http.StatusText(code), code)
+		return
+	}
+	s.mu.Unlock()
+
+	accessToken := r.URL.Query().Get("access_token")
+	if accessToken == "" {
+		http.Error(w, `{"error":"Invalid access token"}`, http.StatusUnauthorized)
+		return
+	}
+
+	var body struct {
+		OutboundSMSMessageRequest struct {
+			ClientCorrelator       string `json:"clientCorrelator"`
+			SenderAddress          string `json:"senderAddress"`
+			OutboundSMSTextMessage struct {
+				Message string `json:"message"`
+			} `json:"outboundSMSTextMessage"`
+			Address string `json:"address"`
+		} `json:"outboundSMSMessageRequest"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := body.OutboundSMSMessageRequest
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		ShortCode:        shortCode,
+		AccessToken:      accessToken,
+		Address:          req.Address,
+		Message:          req.OutboundSMSTextMessage.Message,
+		ClientCorrelator: req.ClientCorrelator,
+	})
+	id := len(s.requests)
+	s.mu.Unlock()
+
+	resourceURL := fmt.Sprintf("%s/smsmessaging/v1/outbound/%s/requests/%d/deliveryInfos", s.URL, shortCode, id)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]any{
+		"outboundSMSMessageRequest": map[string]any{
+			"address":          "tel:" + req.Address,
+			"senderAddress":    req.SenderAddress,
+			"clientCorrelator": req.ClientCorrelator,
+			"outboundSMSTextMessage": map[string]any{
+				"message": req.OutboundSMSTextMessage.Message,
+			},
+			"deliveryInfoList": map[string]any{
+				"deliveryInfo": []map[string]any{
+					{"address": "tel:" + req.Address, "deliveryStatus": "DeliveredToNetwork"},
+				},
+				"resourceURL": resourceURL,
+			},
+			"resourceURL": resourceURL,
+		},
+	})
+}
@@ -43,9 +43,16 @@ func NewDefaultRouter(config util.Config, handler *handlers.DefaultHandler, toke
 	r.stationRouter(api)
 	r.observationRouter(api)
 	r.glabsRouter(api)
+	r.smsRouter(api)
+	r.simCardRouter(api)
 	r.ptexterRouter(api)
+	r.pendingStationRouter(api)
 	r.lufftRouter(api)
 	r.csiRouter(api)
+	r.misolRouter(api)
+	r.uploadRouter(api)
+	r.reportRouter(api)
+	r.weatherlinkRouter(api)
 
 	api.POST("/tokens/renew", r.handler.RenewAccessToken)
 
@@ -10,5 +10,6 @@ func (r *DefaultRouter) glabsRouter(gr *gin.RouterGroup) {
 		glabs.GET("", r.handler.GLabsOptIn)
 		glabs.POST("", r.handler.GLabsUnsubscribe)
 		glabs.POST("/load", r.handler.CreateGLabsLoad)
+		glabs.POST("/sms", r.handler.GLabsInboundSMS)
 	}
 }
@@ -0,0 +1,20 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) misolRouter(gr *gin.RouterGroup) {
+	misol := gr.Group("/misol")
+	{
+		misolAuth := addMiddleware(misol,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		misolAuth.GET("", r.handler.ListMisolStations)
+		misolAuth.POST("", r.handler.CreateMisolStation)
+		misolAuth.PUT(":id", r.handler.UpdateMisolStation)
+		misolAuth.DELETE(":id", r.handler.DeleteMisolStation)
+		misolAuth.POST(":id/approve", r.handler.ApproveMisolStation)
+	}
+}
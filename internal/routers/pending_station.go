@@ -0,0 +1,19 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) pendingStationRouter(gr *gin.RouterGroup) {
+	pending := gr.Group("/pending-stations")
+	{
+		pendingAuth := addMiddleware(pending,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		pendingAuth.GET("", r.handler.ListPendingStations)
+		pendingAuth.GET(":mobile_number/messages", r.handler.ListPendingStationMessages)
+		pendingAuth.POST(":mobile_number/claim", r.handler.ClaimPendingStation)
+		pendingAuth.DELETE(":mobile_number", r.handler.DeletePendingStation)
+	}
+}
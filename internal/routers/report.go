@@ -0,0 +1,18 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) reportRouter(gr *gin.RouterGroup) {
+	reports := gr.Group("/reports")
+	{
+		reportsAuth := addMiddleware(reports,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		reportsAuth.GET("/availability", r.handler.AvailabilityReport)
+		reportsAuth.GET("/battery", r.handler.BatteryReport)
+		reportsAuth.GET("/drift", r.handler.ClockDriftReport)
+	}
+}
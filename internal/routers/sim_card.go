@@ -0,0 +1,24 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) simCardRouter(gr *gin.RouterGroup) {
+	sims := gr.Group("/sims")
+	{
+		simsAuth := addMiddleware(sims,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		simsAuth.GET("", r.handler.ListSimCards)
+		simsAuth.POST("", r.handler.CreateSimCard)
+		simsAuth.GET(":mobile_number", r.handler.GetSimCard)
+		simsAuth.PUT(":mobile_number", r.handler.UpdateSimCard)
+		simsAuth.DELETE(":mobile_number", r.handler.DeleteSimCard)
+		simsAuth.GET(":mobile_number/assignments", r.handler.ListSimCardAssignments)
+		simsAuth.POST(":mobile_number/assign", r.handler.AssignSimCard)
+		simsAuth.POST(":mobile_number/unassign", r.handler.UnassignSimCard)
+		simsAuth.PUT(":mobile_number/load", r.handler.UpdateSimCardLoad)
+	}
+}
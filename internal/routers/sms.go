@@ -0,0 +1,18 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) smsRouter(gr *gin.RouterGroup) {
+	sms := gr.Group("/sms")
+	{
+		smsAuth := addMiddleware(sms,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		smsAuth.GET("", r.handler.ListSmsOutbox)
+		smsAuth.POST("", r.handler.CreateSmsOutbox)
+		smsAuth.GET(":id", r.handler.GetSmsOutbox)
+	}
+}
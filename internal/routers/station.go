@@ -2,6 +2,7 @@ package routers
 
 import (
 	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
 	"github.com/gin-gonic/gin"
 )
 
@@ -12,6 +13,11 @@ func (r *DefaultRouter) stationRouter(gr *gin.RouterGroup) {
 		stations.GET(":station_id", r.handler.GetStation)
 		stations.GET("/nearest/observations/latest", r.handler.GetNearestLatestStationObservation)
 
+		stations.GET(":station_id/health", r.handler.ListStationHealth)
+		stations.GET(":station_id/health/:id", r.handler.GetStationHealth)
+		stations.GET(":station_id/status-history", r.handler.ListStationStatusHistory)
+		stations.GET(":station_id/metadata", r.handler.ListStationMetadataHistory)
+
 		stnObs := stations.Group(":station_id/observations")
 		{
 			stnObs.GET("", r.handler.ListStationObservations)
@@ -23,8 +29,36 @@ func (r *DefaultRouter) stationRouter(gr *gin.RouterGroup) {
 			mw.AuthMiddleware(r.tokenMaker, false),
 			mw.AdminMiddleware())
 		stnAuth.POST("", r.handler.CreateStation)
+		stnAuth.POST("/import", r.handler.ImportStations)
+		stnAuth.GET("/export", r.handler.ExportStations)
 		stnAuth.PUT(":station_id", r.handler.UpdateStation)
 		stnAuth.DELETE(":station_id", r.handler.DeleteStation)
+		stnAuth.POST(":station_id/restore", r.handler.RestoreStation)
+		stnAuth.PUT(":station_id/status", r.handler.UpdateStationStatus)
+		stnAuth.POST(":station_id/metadata", r.handler.UpdateStationMetadata)
+		stnAuth.GET(":station_id/maintenance", r.handler.ListStationMaintenance)
+		stnAuth.GET(":station_id/maintenance/:id", r.handler.GetStationMaintenance)
+		stnAuth.POST(":station_id/maintenance", r.handler.CreateStationMaintenance)
+		stnAuth.PUT(":station_id/maintenance/:id", r.handler.UpdateStationMaintenance)
+		stnAuth.DELETE(":station_id/maintenance/:id", r.handler.DeleteStationMaintenance)
+		stnAuth.GET(":station_id/clock", r.handler.GetStationClock)
+		stnAuth.PUT(":station_id/clock", r.handler.UpdateStationClock)
+		stnAuth.DELETE(":station_id/clock", r.handler.DeleteStationClock)
+		stnAuth.GET(":station_id/commands", r.handler.ListStationCommands)
+		stnAuth.POST(":station_id/commands", r.handler.CreateStationCommand)
+		stnAuth.GET(":station_id/uploads", r.handler.ListUploadStations)
+		stnAuth.POST(":station_id/uploads", r.handler.CreateUploadStation)
+		stnAuth.DELETE(":station_id/uploads/:id", r.handler.DeleteUploadStation)
+		stnAuth.GET(":station_id/weatherlink", r.handler.GetWeatherlinkStation)
+		stnAuth.POST(":station_id/weatherlink", r.handler.CreateWeatherlinkStation)
+		stnAuth.PUT(":station_id/weatherlink", r.handler.UpdateWeatherlinkStation)
+		stnAuth.DELETE(":station_id/weatherlink", r.handler.DeleteWeatherlinkStation)
+		stnAuth.POST(":station_id/weatherlink/test", r.handler.TestWeatherlinkStation)
+
+		stnSuperAuth := addMiddleware(stations.Group(""),
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.RoleMiddleware(string(models.SuperAdminRole)))
+		stnSuperAuth.DELETE(":station_id/purge", r.handler.PurgeStation)
 
 		stnObsAuth := addMiddleware(stnObs,
 			mw.AuthMiddleware(r.tokenMaker, false),
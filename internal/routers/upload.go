@@ -0,0 +1,10 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) uploadRouter(gr *gin.RouterGroup) {
+	gr.GET("/weatherstation/updateweatherstation.php", r.handler.WUStoreObservation)
+	gr.POST("/ecowitt/:key", r.handler.EcowittStoreObservation)
+}
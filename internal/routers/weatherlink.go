@@ -0,0 +1,17 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) weatherlinkRouter(gr *gin.RouterGroup) {
+	weatherlink := gr.Group("/weatherlink")
+	{
+		wlAuth := addMiddleware(weatherlink,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		wlAuth.GET("/changes", r.handler.ListWeatherlinkChanges)
+		wlAuth.PUT("/changes/:id", r.handler.ReviewWeatherlinkChange)
+	}
+}
@@ -0,0 +1,52 @@
+package sensor
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// DefaultTimezone is the timezone assumed for logger clocks without station specific settings
+const DefaultTimezone = "Asia/Manila"
+
+// ClockSettings describes how the timestamps sent by a station logger are interpreted
+type ClockSettings struct {
+	// Timezone is the IANA timezone of the logger clock
+	Timezone string
+	// ClockOffset is the number of minutes the logger clock lags behind the true time
+	ClockOffset int32
+}
+
+func newClockSettings(settings []ClockSettings) ClockSettings {
+	cs := ClockSettings{Timezone: DefaultTimezone}
+	if len(settings) > 0 {
+		cs.ClockOffset = settings[0].ClockOffset
+		if settings[0].Timezone != "" {
+			cs.Timezone = settings[0].Timezone
+		}
+	}
+	return cs
+}
+
+// correctTimestamp applies the known clock offset to the logger timestamp.
+// The returned minutes difference is measured against the raw logger clock so that drift stays visible,
+// while the range check is done on the corrected timestamp.
+// Out of range timestamps are kept and only flagged in the error message so that bad logger clocks can be fixed.
+func (cs ClockSettings) correctTimestamp(timestamp, timeNow time.Time) (time.Time, float64, string) {
+	if timestamp.IsZero() {
+		return timestamp, 0.0, ""
+	}
+
+	minutesDiff := timeNow.Sub(timestamp).Minutes()
+
+	timestamp = timestamp.Add(time.Duration(cs.ClockOffset) * time.Minute)
+	errMsg := ""
+	correctedDiff := timeNow.Sub(timestamp).Minutes()
+	if correctedDiff < minMinutesThresh {
+		errMsg = fmt.Sprintf("timestamp is %f minutes behind", math.Abs(correctedDiff))
+	} else if correctedDiff > maxMinutesThresh {
+		errMsg = fmt.Sprintf("timestamp is %f minutes ahead", correctedDiff)
+	}
+
+	return timestamp, minutesDiff, errMsg
+}
@@ -0,0 +1,30 @@
+package sensor
+
+// dataStatusFields is the sensor order of the data_status bitmap written by the loggers and upload stations
+var dataStatusFields = []string{
+	"temp", "rh", "pres", "wspd", "wspdx",
+	"wdir", "srad", "td", "wchill", "rr",
+}
+
+// misolDataStatusFields is the bitmap order of Misol stations, which report rain as tips
+var misolDataStatusFields = []string{
+	"temp", "rh", "pres", "wspd", "wspdx",
+	"wdir", "srad", "td", "wchill", "rain_tips", "rain_cumulative_tips",
+}
+
+// ParseDataStatus maps each sensor in the data_status bitmap to whether it is reporting
+func ParseDataStatus(dataStatus string) map[string]bool {
+	fields := dataStatusFields
+	if len(dataStatus) == len(misolDataStatusFields) {
+		fields = misolDataStatusFields
+	}
+
+	sensors := make(map[string]bool, len(fields))
+	for i, f := range fields {
+		if i >= len(dataStatus) {
+			break
+		}
+		sensors[f] = dataStatus[i] == '1'
+	}
+	return sensors
+}
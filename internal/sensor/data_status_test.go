@@ -0,0 +1,49 @@
+package sensor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDataStatus(t *testing.T) {
+	testCases := []struct {
+		name       string
+		dataStatus string
+		check      func(sensors map[string]bool)
+	}{
+		{
+			name:       "Lufft",
+			dataStatus: "1101111110",
+			check: func(sensors map[string]bool) {
+				require.Len(t, sensors, 10)
+				require.True(t, sensors["temp"])
+				require.False(t, sensors["pres"])
+				require.False(t, sensors["rr"])
+			},
+		},
+		{
+			name:       "Misol",
+			dataStatus: "11111111101",
+			check: func(sensors map[string]bool) {
+				require.Len(t, sensors, 11)
+				require.False(t, sensors["rain_tips"])
+				require.True(t, sensors["rain_cumulative_tips"])
+			},
+		},
+		{
+			name:       "Empty",
+			dataStatus: "",
+			check: func(sensors map[string]bool) {
+				require.Empty(t, sensors)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(ParseDataStatus(tc.dataStatus))
+		})
+	}
+}
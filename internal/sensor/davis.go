@@ -1,7 +1,9 @@
 package sensor
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -17,11 +19,15 @@ const (
 	HTTPUserAgent     = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/133.0.0.0 Safari/537.36"
 )
 
+var ErrDavisInvalidCredentials = errors.New("API credentials are invalid")
+
 type DavisSensor interface {
-	FetchLatest() ([]DavisCurrentObservation, error)
+	FetchLatest(ctx context.Context) ([]DavisCurrentObservation, error)
+	FetchHistoric(ctx context.Context, start, end time.Time) ([]DavisCurrentObservation, error)
+	FetchStations(ctx context.Context) ([]DavisStation, error)
 }
 
-type DavisFactory func(cred DavisAPICredentials, sleepDuration time.Duration) DavisSensor
+type DavisFactory func(cred DavisAPICredentials) DavisSensor
 
 type DavisAPICredentials struct {
 	User     string
@@ -30,6 +36,8 @@ type DavisAPICredentials struct {
 
 	APIKey    string
 	APISecret string
+	// StationID is the WeatherLink station id of the linked station, used by the v2 historic API
+	StationID int
 
 	StnUUID string
 }
@@ -52,6 +60,21 @@ type DavisCurrentObservation struct {
 	Tn            pgtype.Float4      `json:"tn"`
 	Tx            pgtype.Float4      `json:"tx"`
 	Hi            pgtype.Float4      `json:"hi"`
+	Td            pgtype.Float4      `json:"td"`
+	Wchill        pgtype.Float4      `json:"wchill"`
+	Thwi          pgtype.Float4      `json:"thwi"`
+	Thswi         pgtype.Float4      `json:"thswi"`
+	Rain          pgtype.Float4      `json:"rain_interval"`
+	Wrun          pgtype.Float4      `json:"wrun"`
+	Wdirx         pgtype.Float4      `json:"wdirx"`
+	Sradx         pgtype.Float4      `json:"sradx"`
+	Senergy       pgtype.Float4      `json:"senergy"`
+	Uvi           pgtype.Float4      `json:"uvi"`
+	Uvx           pgtype.Float4      `json:"uvx"`
+	Uvdose        pgtype.Float4      `json:"uvdose"`
+	Et            pgtype.Float4      `json:"et"`
+	Hdd           pgtype.Float4      `json:"hdd"`
+	Cdd           pgtype.Float4      `json:"cdd"`
 	RainAccum     pgtype.Float4      `json:"rain_accum"`
 	TnTimestamp   pgtype.Timestamptz `json:"tn_timestamp"`
 	TxTimestamp   pgtype.Timestamptz `json:"tx_timestamp"`
@@ -72,13 +95,21 @@ func NewDavis(apiCredentials DavisAPICredentials, sleep time.Duration) *Davis {
 	}
 }
 
-func (d Davis) FetchLatest() ([]DavisCurrentObservation, error) {
+// NewDavisWithClient creates a Davis sensor sharing the given client, e.g. a rate limited one
+func NewDavisWithClient(apiCredentials DavisAPICredentials, client Fetcher) *Davis {
+	return &Davis{
+		api:    apiCredentials,
+		client: client,
+	}
+}
+
+func (d Davis) FetchLatest(ctx context.Context) ([]DavisCurrentObservation, error) {
 	isV1 := d.api.User != "" && d.api.Pass != "" && d.api.APIToken != ""
 	isV2 := d.api.APIKey != "" && d.api.APISecret != ""
 	isDashboard := d.api.StnUUID != ""
 
 	if !isV1 && !isV2 && !isDashboard {
-		return nil, fmt.Errorf("API credentials are invalid")
+		return nil, ErrDavisInvalidCredentials
 	}
 
 	var (
@@ -110,7 +141,7 @@ func (d Davis) FetchLatest() ([]DavisCurrentObservation, error) {
 	baseURL.RawQuery = qParams.Encode()
 	encodedURL := baseURL.String()
 
-	req, err := http.NewRequest("GET", encodedURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", encodedURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +181,7 @@ func (d Davis) FetchLatest() ([]DavisCurrentObservation, error) {
 			baseURL.RawQuery = qParams.Encode()
 			encodedURL := baseURL.String()
 
-			req, err := http.NewRequest("GET", encodedURL, nil)
+			req, err := http.NewRequestWithContext(ctx, "GET", encodedURL, nil)
 			if err != nil {
 				return nil, err
 			}
@@ -30,11 +30,8 @@ type davisRawSensorDataResponseDashboard struct {
 }
 
 func (r davisRawWeatherDataResponseDashboard) ToDavisCurrentObservation() *DavisCurrentObservation {
-	obs := DavisCurrentObservation{
-		TxTimestamp:   pgtype.Timestamptz{Time: time.Time{}, Valid: true},
-		TnTimestamp:   pgtype.Timestamptz{Time: time.Time{}, Valid: true},
-		GustTimestamp: pgtype.Timestamptz{Time: time.Time{}, Valid: true},
-	}
+	obs := DavisCurrentObservation{}
+	namedFields := davisDashboardFields(&obs)
 	for _, cur := range r.CurrConditionValues {
 		if cur.SensorDataTypeID == nil {
 			continue
@@ -74,7 +71,10 @@ func (r davisRawWeatherDataResponseDashboard) ToDavisCurrentObservation() *Davis
 		// 	obs.Gust = util.ToFloat4(cur.Value)
 
 		default:
-			continue
+			// the remaining values are only identified by name
+			if field := namedFields[cur.SensorDataName]; field != nil {
+				*field = util.ToFloat4(cur.Value)
+			}
 		}
 	}
 
@@ -97,15 +97,15 @@ func (r davisRawWeatherDataResponseDashboard) ToDavisCurrentObservation() *Davis
 			switch hl.SensorDataName {
 			case "High Temp Time":
 				if dt, err := convertToTime(hl.Value); err == nil {
-					obs.TxTimestamp.Time = dt
+					obs.TxTimestamp = pgtype.Timestamptz{Time: dt, Valid: true}
 				}
 			case "Low Temp Time":
 				if dt, err := convertToTime(hl.Value); err == nil {
-					obs.TnTimestamp.Time = dt
+					obs.TnTimestamp = pgtype.Timestamptz{Time: dt, Valid: true}
 				}
 			case "High Wind Speed Time":
 				if dt, err := convertToTime(hl.Value); err == nil {
-					obs.GustTimestamp.Time = dt
+					obs.GustTimestamp = pgtype.Timestamptz{Time: dt, Valid: true}
 				}
 			}
 		}
@@ -126,6 +126,17 @@ func (r davisRawWeatherDataResponseDashboard) ToDavisCurrentObservation() *Davis
 	return &obs
 }
 
+// daily totals like ET are left out, the MO columns hold per record values
+func davisDashboardFields(obs *DavisCurrentObservation) map[string]*pgtype.Float4 {
+	return map[string]*pgtype.Float4{
+		"Dew Point":  &obs.Td,
+		"Wind Chill": &obs.Wchill,
+		"THW Index":  &obs.Thwi,
+		"THSW Index": &obs.Thswi,
+		"UV Index":   &obs.Uvi,
+	}
+}
+
 func convertToTime(hhmm *float32) (time.Time, error) {
 	if hhmm == nil {
 		return time.Time{}, fmt.Errorf("invalid time format")
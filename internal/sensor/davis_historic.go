@@ -0,0 +1,226 @@
+package sensor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DavisHistoricWindow is the longest time range accepted by the historic endpoint
+const DavisHistoricWindow = 24 * time.Hour
+
+type davisRawHistoricResponseV2 struct {
+	StationID     int                                `json:"station_id"`
+	StationIDUUID string                             `json:"station_id_uuid"`
+	Sensors       []davisRawHistoricSensorResponseV2 `json:"sensors"`
+	GeneratedAt   int64                              `json:"generated_at"`
+}
+
+type davisRawHistoricSensorResponseV2 struct {
+	LSID              int                              `json:"lsid"`
+	SensorType        int                              `json:"sensor_type"`
+	DataStructureType int                              `json:"data_structure_type"`
+	Data              []davisRawHistoricDataResponseV2 `json:"data"`
+}
+
+// davisRawHistoricDataResponseV2 is an archive record, the field names differ between
+// the Vantage consoles (e.g. temp_out) and the WeatherLink Live ISS (e.g. temp_avg)
+type davisRawHistoricDataResponseV2 struct {
+	TS               int64    `json:"ts"`
+	ArchInt          int      `json:"arch_int"`
+	TempOut          *float32 `json:"temp_out"`
+	TempAvg          *float32 `json:"temp_avg"`
+	HumOut           *float32 `json:"hum_out"`
+	HumLast          *float32 `json:"hum_last"`
+	DewPointOut      *float32 `json:"dew_point_out"`
+	DewPointLast     *float32 `json:"dew_point_last"`
+	WindChill        *float32 `json:"wind_chill"`
+	WindChillLast    *float32 `json:"wind_chill_last"`
+	ThwIndex         *float32 `json:"thw_index"`
+	ThwLast          *float32 `json:"thw_last"`
+	ThswIndex        *float32 `json:"thsw_index"`
+	ThswLast         *float32 `json:"thsw_last"`
+	WindSpeedAvg     *float32 `json:"wind_speed_avg"`
+	WindSpeedHi      *float32 `json:"wind_speed_hi"`
+	WindDirOfPrevail *float32 `json:"wind_dir_of_prevail"`
+	WindDirOfHi      *float32 `json:"wind_dir_of_hi"`
+	WindRun          *float32 `json:"wind_run"`
+	RainRateHiMM     *float32 `json:"rain_rate_hi_mm"`
+	RainfallMM       *float32 `json:"rainfall_mm"`
+	SolarRadAvg      *float32 `json:"solar_rad_avg"`
+	SolarRadHi       *float32 `json:"solar_rad_hi"`
+	SolarEnergy      *float32 `json:"solar_energy"`
+	UVIndexAvg       *float32 `json:"uv_index_avg"`
+	UVIndexHi        *float32 `json:"uv_index_hi"`
+	UVDose           *float32 `json:"uv_dose"`
+	ET               *float32 `json:"et"`
+	HDD              *float32 `json:"hdd"`
+	DegDaysHeat      *float32 `json:"deg_days_heat"`
+	CDD              *float32 `json:"cdd"`
+	DegDaysCool      *float32 `json:"deg_days_cool"`
+	Bar              *float32 `json:"bar"`
+	BarSeaLevel      *float32 `json:"bar_sea_level"`
+	HeatIndexOut     *float32 `json:"heat_index_out"`
+	HeatIndexLast    *float32 `json:"heat_index_last"`
+}
+
+// ToDavisObservations merges the archive records of all sensors by timestamp
+func (r davisRawHistoricResponseV2) ToDavisObservations() []DavisCurrentObservation {
+	obsMap := make(map[int64]*DavisCurrentObservation)
+	for _, rawSensor := range r.Sensors {
+		for _, rawData := range rawSensor.Data {
+			if rawData.TS <= 0 {
+				continue
+			}
+			obs, ok := obsMap[rawData.TS]
+			if !ok {
+				obs = &DavisCurrentObservation{
+					Timestamp: pgtype.Timestamptz{Time: time.Unix(rawData.TS, 0), Valid: true},
+				}
+				obsMap[rawData.TS] = obs
+			}
+			rawData.merge(obs)
+		}
+	}
+
+	obsSlice := make([]DavisCurrentObservation, 0, len(obsMap))
+	for _, obs := range obsMap {
+		obsSlice = append(obsSlice, *obs)
+	}
+	slices.SortFunc(obsSlice, func(a, b DavisCurrentObservation) int {
+		return a.Timestamp.Time.Compare(b.Timestamp.Time)
+	})
+
+	return obsSlice
+}
+
+func (d davisRawHistoricDataResponseV2) merge(obs *DavisCurrentObservation) {
+	setFloat4 := func(dst *pgtype.Float4, conv func(float32) float32, vals ...*float32) {
+		for _, v := range vals {
+			if v != nil && !dst.Valid {
+				*dst = pgtype.Float4{Float32: conv(*v), Valid: true}
+			}
+		}
+	}
+	mphToMps := func(v float32) float32 { return v * 0.44704 }
+	noConv := func(v float32) float32 { return v }
+
+	inToMM := func(v float32) float32 { return v * 25.4 }
+	miToKm := func(v float32) float32 { return v * 1.609344 }
+	// degree days are reported in °F·day
+	fDegToC := func(v float32) float32 { return v * 5 / 9 }
+
+	setFloat4(&obs.Temp, util.FahrenheitToCelsius, d.TempOut, d.TempAvg)
+	setFloat4(&obs.Rh, noConv, d.HumOut, d.HumLast)
+	setFloat4(&obs.Td, util.FahrenheitToCelsius, d.DewPointOut, d.DewPointLast)
+	setFloat4(&obs.Wchill, util.FahrenheitToCelsius, d.WindChill, d.WindChillLast)
+	setFloat4(&obs.Thwi, util.FahrenheitToCelsius, d.ThwIndex, d.ThwLast)
+	setFloat4(&obs.Thswi, util.FahrenheitToCelsius, d.ThswIndex, d.ThswLast)
+	setFloat4(&obs.Wspd, mphToMps, d.WindSpeedAvg)
+	setFloat4(&obs.Wspdx, mphToMps, d.WindSpeedHi)
+	setFloat4(&obs.Wdir, noConv, d.WindDirOfPrevail)
+	setFloat4(&obs.Wdirx, noConv, d.WindDirOfHi)
+	setFloat4(&obs.Wrun, miToKm, d.WindRun)
+	setFloat4(&obs.Rr, noConv, d.RainRateHiMM)
+	setFloat4(&obs.Rain, noConv, d.RainfallMM)
+	setFloat4(&obs.Srad, noConv, d.SolarRadAvg)
+	setFloat4(&obs.Sradx, noConv, d.SolarRadHi)
+	setFloat4(&obs.Senergy, noConv, d.SolarEnergy)
+	setFloat4(&obs.Uvi, noConv, d.UVIndexAvg)
+	setFloat4(&obs.Uvx, noConv, d.UVIndexHi)
+	setFloat4(&obs.Uvdose, noConv, d.UVDose)
+	setFloat4(&obs.Et, inToMM, d.ET)
+	setFloat4(&obs.Hdd, fDegToC, d.HDD, d.DegDaysHeat)
+	setFloat4(&obs.Cdd, fDegToC, d.CDD, d.DegDaysCool)
+	setFloat4(&obs.Pres, util.InHgToMbar, d.BarSeaLevel, d.Bar)
+	setFloat4(&obs.Hi, util.FahrenheitToCelsius, d.HeatIndexOut, d.HeatIndexLast)
+}
+
+// FetchHistoric fetches the archive records between start and end using the v2 API.
+// The range is split into 24h windows, the linked station must be one of the stations of the API key.
+func (d Davis) FetchHistoric(ctx context.Context, start, end time.Time) ([]DavisCurrentObservation, error) {
+	if d.api.APIKey == "" || d.api.APISecret == "" {
+		return nil, ErrDavisInvalidCredentials
+	}
+	if d.api.StationID == 0 {
+		return nil, fmt.Errorf("weatherlink station id is not set")
+	}
+
+	var rawStations davisRawStationsResponseV2
+	if err := d.getV2(ctx, "/stations", nil, &rawStations); err != nil {
+		return nil, err
+	}
+	stnID := d.api.StationID
+	if !slices.ContainsFunc(rawStations.Stations, func(s davisRawStationResponseV2) bool {
+		return s.StationID == stnID
+	}) {
+		return nil, fmt.Errorf("weatherlink station %d not found for api key", stnID)
+	}
+
+	obsSlice := make([]DavisCurrentObservation, 0)
+	for wStart := start; wStart.Before(end); wStart = wStart.Add(DavisHistoricWindow) {
+		wEnd := wStart.Add(DavisHistoricWindow)
+		if wEnd.After(end) {
+			wEnd = end
+		}
+		qParams := url.Values{
+			"start-timestamp": {strconv.FormatInt(wStart.Unix(), 10)},
+			"end-timestamp":   {strconv.FormatInt(wEnd.Unix(), 10)},
+		}
+
+		var rawObs davisRawHistoricResponseV2
+		if err := d.getV2(ctx, fmt.Sprintf("/historic/%d", stnID), qParams, &rawObs); err != nil {
+			return nil, err
+		}
+		obsSlice = append(obsSlice, rawObs.ToDavisObservations()...)
+	}
+
+	return obsSlice, nil
+}
+
+func (d Davis) getV2(ctx context.Context, path string, qParams url.Values, v any) error {
+	baseURL, err := url.Parse(DavisAPIV2URL + path)
+	if err != nil {
+		return err
+	}
+	if qParams == nil {
+		qParams = url.Values{}
+	}
+	qParams.Set("api-key", d.api.APIKey)
+	baseURL.RawQuery = qParams.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("User-Agent", HTTPUserAgent)
+	req.Header.Set("X-Api-Secret", d.api.APISecret)
+
+	time.Sleep(d.sleep)
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized {
+		return ErrDavisInvalidCredentials
+	}
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("%s returned %d: %s", path, res.StatusCode, body)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
@@ -0,0 +1,191 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchHistoric(t *testing.T) {
+	end := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	start := end.Add(-36 * time.Hour)
+	historicURL := DavisAPIV2URL + "/historic/111"
+
+	testCases := []struct {
+		name          string
+		api           DavisAPICredentials
+		buildStubs    func()
+		checkResponse func(obsSlice []DavisCurrentObservation, err error)
+	}{
+		{
+			name: "Default",
+			api: DavisAPICredentials{
+				APIKey:    "123DAV15890456ZXCARSLUY",
+				APISecret: "xxxx123DAV15890456ZXCARSLUYxxxx",
+				StationID: 111,
+			},
+			buildStubs: func() {
+				httpmock.RegisterResponder("GET", DavisAPIV2URL+"/stations",
+					httpmock.NewStringResponder(http.StatusOK, `{"stations":[{"station_id":111},{"station_id":121}]}`))
+				httpmock.RegisterResponder("GET", historicURL,
+					func(req *http.Request) (*http.Response, error) {
+						if req.Header.Get("X-Api-Secret") == "" || req.URL.Query().Get("api-key") == "" {
+							return httpmock.NewStringResponse(http.StatusUnauthorized, ""), nil
+						}
+						ts := req.URL.Query().Get("start-timestamp")
+						body := fmt.Sprintf(`{"station_id":111,"sensors":[`+
+							`{"lsid":1,"sensor_type":45,"data_structure_type":11,"data":[{"ts":%[1]s,"temp_avg":86.0,"hum_last":70,"dew_point_last":77.0,"thw_last":95.0,"wind_speed_avg":10,"wind_speed_hi":20,"wind_dir_of_prevail":90,"wind_run":2.5,"rain_rate_hi_mm":1.2,"rainfall_mm":0.4,"solar_rad_avg":500,"solar_energy":43.1,"uv_index_avg":6.5,"et":0.01,"cdd":0.09}]},`+
+							`{"lsid":2,"sensor_type":242,"data_structure_type":13,"data":[{"ts":%[1]s,"bar_sea_level":29.92}]}`+
+							`]}`, ts)
+						return httpmock.NewStringResponse(http.StatusOK, body), nil
+					})
+			},
+			checkResponse: func(obsSlice []DavisCurrentObservation, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, httpmock.GetCallCountInfo()["GET "+DavisAPIV2URL+"/stations"])
+				require.Equal(t, 2, httpmock.GetCallCountInfo()["GET "+historicURL])
+				require.Len(t, obsSlice, 2)
+
+				require.True(t, obsSlice[0].Timestamp.Time.Equal(start))
+				require.True(t, obsSlice[1].Timestamp.Time.Equal(start.Add(DavisHistoricWindow)))
+				for _, obs := range obsSlice {
+					require.InDelta(t, 30.0, obs.Temp.Float32, 0.001)
+					require.InDelta(t, 70.0, obs.Rh.Float32, 0.001)
+					require.InDelta(t, 4.4704, obs.Wspd.Float32, 0.001)
+					require.InDelta(t, 8.9408, obs.Wspdx.Float32, 0.001)
+					require.InDelta(t, 90.0, obs.Wdir.Float32, 0.001)
+					require.InDelta(t, 1.2, obs.Rr.Float32, 0.001)
+					require.InDelta(t, 500.0, obs.Srad.Float32, 0.001)
+					require.InDelta(t, util.InHgToMbar(29.92), obs.Pres.Float32, 0.001)
+					require.InDelta(t, 25.0, obs.Td.Float32, 0.001)
+					require.InDelta(t, 35.0, obs.Thwi.Float32, 0.001)
+					require.InDelta(t, 4.02336, obs.Wrun.Float32, 0.001)
+					require.InDelta(t, 0.4, obs.Rain.Float32, 0.001)
+					require.InDelta(t, 43.1, obs.Senergy.Float32, 0.001)
+					require.InDelta(t, 6.5, obs.Uvi.Float32, 0.001)
+					require.InDelta(t, 0.254, obs.Et.Float32, 0.001)
+					require.InDelta(t, 0.05, obs.Cdd.Float32, 0.001)
+					require.False(t, obs.Hdd.Valid)
+					require.False(t, obs.Hi.Valid)
+				}
+			},
+		},
+		{
+			name: "Unauthorized",
+			api: DavisAPICredentials{
+				APIKey:    "123DAV15890456ZXCARSLUY",
+				APISecret: "wrongsecret",
+				StationID: 111,
+			},
+			buildStubs: func() {
+				httpmock.RegisterResponder("GET", DavisAPIV2URL+"/stations",
+					httpmock.NewStringResponder(http.StatusUnauthorized, `{"code":401}`))
+			},
+			checkResponse: func(obsSlice []DavisCurrentObservation, err error) {
+				require.ErrorIs(t, err, ErrDavisInvalidCredentials)
+				require.Empty(t, obsSlice)
+			},
+		},
+		{
+			name: "NoStations",
+			api: DavisAPICredentials{
+				APIKey:    "123DAV15890456ZXCARSLUY",
+				APISecret: "xxxx123DAV15890456ZXCARSLUYxxxx",
+				StationID: 111,
+			},
+			buildStubs: func() {
+				httpmock.RegisterResponder("GET", DavisAPIV2URL+"/stations",
+					httpmock.NewStringResponder(http.StatusOK, `{"stations":[]}`))
+			},
+			checkResponse: func(obsSlice []DavisCurrentObservation, err error) {
+				require.Error(t, err)
+				require.Zero(t, httpmock.GetCallCountInfo()["GET "+historicURL])
+				require.Empty(t, obsSlice)
+			},
+		},
+		{
+			name: "StationNotFound",
+			api: DavisAPICredentials{
+				APIKey:    "123DAV15890456ZXCARSLUY",
+				APISecret: "xxxx123DAV15890456ZXCARSLUYxxxx",
+				StationID: 131,
+			},
+			buildStubs: func() {
+				httpmock.RegisterResponder("GET", DavisAPIV2URL+"/stations",
+					httpmock.NewStringResponder(http.StatusOK, `{"stations":[{"station_id":111},{"station_id":121}]}`))
+			},
+			checkResponse: func(obsSlice []DavisCurrentObservation, err error) {
+				require.Error(t, err)
+				require.Zero(t, httpmock.GetCallCountInfo()["GET "+DavisAPIV2URL+"/historic/131"])
+				require.Empty(t, obsSlice)
+			},
+		},
+		{
+			name: "MissingStationID",
+			api: DavisAPICredentials{
+				APIKey:    "123DAV15890456ZXCARSLUY",
+				APISecret: "xxxx123DAV15890456ZXCARSLUYxxxx",
+			},
+			buildStubs: func() {},
+			checkResponse: func(obsSlice []DavisCurrentObservation, err error) {
+				require.Error(t, err)
+				require.Zero(t, httpmock.GetTotalCallCount())
+				require.Empty(t, obsSlice)
+			},
+		},
+		{
+			name: "HistoricError",
+			api: DavisAPICredentials{
+				APIKey:    "123DAV15890456ZXCARSLUY",
+				APISecret: "xxxx123DAV15890456ZXCARSLUYxxxx",
+				StationID: 111,
+			},
+			buildStubs: func() {
+				httpmock.RegisterResponder("GET", DavisAPIV2URL+"/stations",
+					httpmock.NewStringResponder(http.StatusOK, `{"stations":[{"station_id":111}]}`))
+				httpmock.RegisterResponder("GET", historicURL,
+					httpmock.NewStringResponder(http.StatusForbidden, `{"code":403,"message":"subscription required"}`))
+			},
+			checkResponse: func(obsSlice []DavisCurrentObservation, err error) {
+				require.Error(t, err)
+				require.NotErrorIs(t, err, ErrDavisInvalidCredentials)
+				require.Equal(t, 1, httpmock.GetCallCountInfo()["GET "+historicURL])
+				require.Empty(t, obsSlice)
+			},
+		},
+		{
+			name: "MissingApiSecret",
+			api: DavisAPICredentials{
+				APIKey: "123DAV15890456ZXCARSLUY",
+			},
+			buildStubs: func() {},
+			checkResponse: func(obsSlice []DavisCurrentObservation, err error) {
+				require.ErrorIs(t, err, ErrDavisInvalidCredentials)
+				require.Zero(t, httpmock.GetTotalCallCount())
+				require.Empty(t, obsSlice)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			client := &http.Client{}
+			httpmock.ActivateNonDefault(client)
+			defer httpmock.DeactivateAndReset()
+
+			tc.buildStubs()
+
+			testSensor := NewDavisWithClient(tc.api, client)
+			obsSlice, err := testSensor.FetchHistoric(context.Background(), start, end)
+
+			tc.checkResponse(obsSlice, err)
+		})
+	}
+}
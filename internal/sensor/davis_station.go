@@ -0,0 +1,38 @@
+package sensor
+
+import (
+	"context"
+)
+
+// DavisStation is the metadata of a station visible to a WeatherLink v2 API key
+type DavisStation struct {
+	StationID         int     `json:"station_id"`
+	GatewayID         string  `json:"gateway_id"`
+	Name              string  `json:"name"`
+	Lat               float32 `json:"lat"`
+	Lon               float32 `json:"lon"`
+	Elevation         float32 `json:"elevation"`
+	FirmwareVersion   string  `json:"firmware_version"`
+	RecordingInterval int     `json:"recording_interval"`
+	TimeZone          string  `json:"time_zone"`
+	Region            string  `json:"region"`
+	Active            bool    `json:"active"`
+}
+
+// FetchStations lists the stations of the API key using the v2 API
+func (d Davis) FetchStations(ctx context.Context) ([]DavisStation, error) {
+	if d.api.APIKey == "" || d.api.APISecret == "" {
+		return nil, ErrDavisInvalidCredentials
+	}
+
+	var rawStations davisRawStationsResponseV2
+	if err := d.getV2(ctx, "/stations", nil, &rawStations); err != nil {
+		return nil, err
+	}
+
+	stations := make([]DavisStation, len(rawStations.Stations))
+	for i, rawStn := range rawStations.Stations {
+		stations[i] = rawStn.ToDavisStation()
+	}
+	return stations, nil
+}
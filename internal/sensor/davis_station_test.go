@@ -0,0 +1,92 @@
+package sensor
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchStations(t *testing.T) {
+	testCases := []struct {
+		name          string
+		api           DavisAPICredentials
+		buildStubs    func()
+		checkResponse func(stations []DavisStation, err error)
+	}{
+		{
+			name: "Default",
+			api: DavisAPICredentials{
+				APIKey:    "123DAV15890456ZXCARSLUY",
+				APISecret: "xxxx123DAV15890456ZXCARSLUYxxxx",
+			},
+			buildStubs: func() {
+				httpmock.RegisterResponder("GET", DavisAPIV2URL+"/stations",
+					httpmock.NewStringResponder(http.StatusOK, `{"stations":[`+
+						`{"station_id":111,"station_name":"Los Banos","latitude":14.167,"longitude":121.241,"elevation":21.5,"firmware_version":"1.4.2","recording_interval":5,"active":true},`+
+						`{"station_id":121,"station_name":"Baguio","latitude":16.402,"longitude":120.596,"active":false}`+
+						`]}`))
+			},
+			checkResponse: func(stations []DavisStation, err error) {
+				require.NoError(t, err)
+				require.Len(t, stations, 2)
+				require.Equal(t, DavisStation{
+					StationID:         111,
+					Name:              "Los Banos",
+					Lat:               14.167,
+					Lon:               121.241,
+					Elevation:         21.5,
+					FirmwareVersion:   "1.4.2",
+					RecordingInterval: 5,
+					Active:            true,
+				}, stations[0])
+				require.Equal(t, 121, stations[1].StationID)
+				require.False(t, stations[1].Active)
+			},
+		},
+		{
+			name: "Unauthorized",
+			api: DavisAPICredentials{
+				APIKey:    "123DAV15890456ZXCARSLUY",
+				APISecret: "wrongsecret",
+			},
+			buildStubs: func() {
+				httpmock.RegisterResponder("GET", DavisAPIV2URL+"/stations",
+					httpmock.NewStringResponder(http.StatusUnauthorized, `{"code":401}`))
+			},
+			checkResponse: func(stations []DavisStation, err error) {
+				require.ErrorIs(t, err, ErrDavisInvalidCredentials)
+				require.Empty(t, stations)
+			},
+		},
+		{
+			name: "MissingApiKey",
+			api: DavisAPICredentials{
+				APISecret: "xxxx123DAV15890456ZXCARSLUYxxxx",
+			},
+			buildStubs: func() {},
+			checkResponse: func(stations []DavisStation, err error) {
+				require.ErrorIs(t, err, ErrDavisInvalidCredentials)
+				require.Zero(t, httpmock.GetTotalCallCount())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			client := &http.Client{}
+			httpmock.ActivateNonDefault(client)
+			defer httpmock.DeactivateAndReset()
+
+			tc.buildStubs()
+
+			testSensor := NewDavisWithClient(tc.api, client)
+			stations, err := testSensor.FetchStations(context.Background())
+
+			tc.checkResponse(stations, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package sensor
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -308,7 +309,7 @@ func TestFetchLatest(t *testing.T) {
 			}
 
 			rawObsSlice := tc.builStubs(testFetcher)
-			obs, err := testSensor.FetchLatest()
+			obs, err := testSensor.FetchLatest(context.Background())
 
 			tc.checkResponse(testFetcher, rawObsSlice, obs, err)
 		})
@@ -344,6 +345,15 @@ func requireDavisEqualV1(t *testing.T, rawObs davisRawCurrentResponseV1, obs Dav
 	if rawObs.WindMPH != nil {
 		require.InDelta(t, *rawObs.WindMPH*0.44704, obs.Wspd.Float32, 0.001)
 	}
+	if rawObs.TdC != nil {
+		require.InDelta(t, *rawObs.TdC, obs.Td.Float32, 0.001)
+	}
+	if rawObs.WindChillC != nil {
+		require.InDelta(t, *rawObs.WindChillC, obs.Wchill.Float32, 0.001)
+	}
+	if rawObs.Obs.UVIndex != nil {
+		require.InDelta(t, *rawObs.Obs.UVIndex, obs.Uvi.Float32, 0.001)
+	}
 	if rawObs.Obs.WindDayHighMPH != nil {
 		require.InDelta(t, *rawObs.Obs.WindDayHighMPH*0.44704, obs.Wspdx.Float32, 0.001)
 	}
@@ -364,11 +374,29 @@ func requireDavisEqualV2(t *testing.T, rawObs davisRawCurrentResponseV2, obs Dav
 	if rawObsData.TempOut != nil {
 		require.InDelta(t, util.FahrenheitToCelsius(*rawObsData.TempOut), obs.Temp.Float32, 0.001)
 	}
+	if rawObsData.DewPoint != nil {
+		require.InDelta(t, util.FahrenheitToCelsius(*rawObsData.DewPoint), obs.Td.Float32, 0.001)
+	}
+	if rawObsData.WindChill != nil {
+		require.InDelta(t, util.FahrenheitToCelsius(*rawObsData.WindChill), obs.Wchill.Float32, 0.001)
+	}
+	if rawObsData.ThwIndex != nil {
+		require.InDelta(t, util.FahrenheitToCelsius(*rawObsData.ThwIndex), obs.Thwi.Float32, 0.001)
+	}
+	if rawObsData.ThswIndex != nil {
+		require.InDelta(t, util.FahrenheitToCelsius(*rawObsData.ThswIndex), obs.Thswi.Float32, 0.001)
+	}
+	if rawObsData.UV != nil {
+		require.InDelta(t, *rawObsData.UV, obs.Uvi.Float32, 0.001)
+	}
 }
 
 func requireDavisEqualDashboard(t *testing.T, rawObs davisRawWeatherDataResponseDashboard, obs DavisCurrentObservation) {
 	for _, cur := range rawObs.CurrConditionValues {
 		if cur.SensorDataTypeID == nil {
+			if cur.SensorDataName == "THW Index" {
+				require.InDelta(t, *cur.Value, obs.Thwi.Float32, 0.001)
+			}
 			continue
 		}
 
@@ -417,6 +445,7 @@ func randomDavisRawResponseV1() davisRawCurrentResponseV1 {
 		WindDeg:    util.RandomFloatPtr[float32](0, 360),
 		WindMPH:    util.RandomFloatPtr[float32](0.0, 10.0),
 		HeatIndexC: util.RandomFloatPtr[float32](30.0, 50.0),
+		WindChillC: util.RandomFloatPtr[float32](25.0, 33.0),
 		Obs: davisRawCurrentObservationV1{
 			RRInPerHr:       util.RandomFloatPtr[float32](0.0, 5.0),
 			RainDayIn:       util.RandomFloatPtr[float32](0.0, 100.0),
@@ -441,9 +470,14 @@ func randomDavisRawResponseV2() davisRawCurrentResponseV2 {
 				LSID: util.RandomInt(100000, 999999),
 				Data: []davisRawCurrentDataResponseV2{
 					{
-						Bar:     util.RandomFloatPtr[float32](990.0, 1100.),
-						TempOut: util.RandomFloatPtr[float32](25.0, 33.0),
-						HumOut:  util.RandomFloatPtr[float32](0.0, 100.0),
+						Bar:       util.RandomFloatPtr[float32](990.0, 1100.),
+						TempOut:   util.RandomFloatPtr[float32](25.0, 33.0),
+						HumOut:    util.RandomFloatPtr[float32](0.0, 100.0),
+						DewPoint:  util.RandomFloatPtr[float32](70.0, 80.0),
+						WindChill: util.RandomFloatPtr[float32](77.0, 91.0),
+						ThwIndex:  util.RandomFloatPtr[float32](77.0, 104.0),
+						ThswIndex: util.RandomFloatPtr[float32](77.0, 110.0),
+						UV:        util.RandomFloatPtr[float32](0.0, 12.0),
 					},
 				},
 			},
@@ -469,6 +503,10 @@ func randomDavisRawWeatherDataResponseDashboard() davisRawWeatherDataResponseDas
 				SensorDataTypeID: &rainID,
 				Value:            util.RandomFloatPtr[float32](0.0, 12.5),
 			},
+			{
+				SensorDataName: "THW Index",
+				Value:          util.RandomFloatPtr[float32](25.0, 40.0),
+			},
 		},
 		HighLowValues: []davisRawSensorDataResponseDashboard{
 			{
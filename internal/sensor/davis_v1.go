@@ -19,6 +19,7 @@ type davisRawCurrentResponseV1 struct {
 	WindDeg    *float32                     `json:"wind_degrees"`
 	WindMPH    *float32                     `json:"wind_mph"`
 	HeatIndexC *float32                     `json:"heat_index_c"`
+	WindChillC *float32                     `json:"windchill_c"`
 	Obs        davisRawCurrentObservationV1 `json:"davis_current_observation"`
 }
 
@@ -37,28 +38,28 @@ type davisRawCurrentObservationV1 struct {
 
 func (r davisRawCurrentResponseV1) ToDavisCurrentObservation() *DavisCurrentObservation {
 	obs := DavisCurrentObservation{
-		Rr:            util.ToFloat4(r.Obs.RRInPerHr),
-		RainAccum:     util.ToFloat4(r.Obs.RainDayIn),
-		Temp:          util.ToFloat4(r.TempC),
-		Rh:            util.ToFloat4(r.Rh),
-		Wdir:          util.ToFloat4(r.WindDeg),
-		Wspd:          util.ToFloat4(r.WindMPH),
-		Srad:          util.ToFloat4(r.Obs.Srad),
-		Pres:          util.ToFloat4(r.PressureMb),
-		Tx:            util.ToFloat4(r.Obs.TempDayHighF),
-		Tn:            util.ToFloat4(r.Obs.TempDayLowF),
-		Wspdx:         util.ToFloat4(r.Obs.WindDayHighMPH),
-		Hi:            util.ToFloat4(r.HeatIndexC),
-		TxTimestamp:   pgtype.Timestamptz{Time: time.Time{}, Valid: true},
-		TnTimestamp:   pgtype.Timestamptz{Time: time.Time{}, Valid: true},
-		GustTimestamp: pgtype.Timestamptz{Time: time.Time{}, Valid: true},
+		Rr:        util.ToFloat4(r.Obs.RRInPerHr),
+		RainAccum: util.ToFloat4(r.Obs.RainDayIn),
+		Temp:      util.ToFloat4(r.TempC),
+		Rh:        util.ToFloat4(r.Rh),
+		Wdir:      util.ToFloat4(r.WindDeg),
+		Wspd:      util.ToFloat4(r.WindMPH),
+		Srad:      util.ToFloat4(r.Obs.Srad),
+		Pres:      util.ToFloat4(r.PressureMb),
+		Tx:        util.ToFloat4(r.Obs.TempDayHighF),
+		Tn:        util.ToFloat4(r.Obs.TempDayLowF),
+		Wspdx:     util.ToFloat4(r.Obs.WindDayHighMPH),
+		Hi:        util.ToFloat4(r.HeatIndexC),
+		Td:        util.ToFloat4(r.TdC),
+		Wchill:    util.ToFloat4(r.WindChillC),
+		Uvi:       util.ToFloat4(r.Obs.UVIndex),
 	}
 
 	if obs.Rr.Valid {
 		obs.Rr.Float32 = obs.Rr.Float32 * 25.4
 	}
 	if obs.RainAccum.Valid {
-		obs.RainAccum.Float32 = obs.Rr.Float32 * 25.4
+		obs.RainAccum.Float32 = obs.RainAccum.Float32 * 25.4
 	}
 	if obs.Wspd.Valid {
 		obs.Wspd.Float32 = obs.Wspd.Float32 * 0.44704
@@ -66,19 +67,19 @@ func (r davisRawCurrentResponseV1) ToDavisCurrentObservation() *DavisCurrentObse
 	if obs.Tx.Valid {
 		obs.Tx.Float32 = util.FahrenheitToCelsius(obs.Tx.Float32)
 		if dt, err := parseTimeStrToDateTime(r.Obs.TempDayHighTime); err == nil {
-			obs.TxTimestamp.Time = dt
+			obs.TxTimestamp = pgtype.Timestamptz{Time: dt, Valid: true}
 		}
 	}
 	if obs.Tn.Valid {
 		obs.Tn.Float32 = util.FahrenheitToCelsius(obs.Tn.Float32)
 		if dt, err := parseTimeStrToDateTime(r.Obs.TempDayLowTime); err == nil {
-			obs.TnTimestamp.Time = dt
+			obs.TnTimestamp = pgtype.Timestamptz{Time: dt, Valid: true}
 		}
 	}
 	if obs.Wspdx.Valid {
 		obs.Wspdx.Float32 = obs.Wspdx.Float32 * 0.44704
 		if dt, err := parseTimeStrToDateTime(r.Obs.WindDayHighTime); err == nil {
-			obs.GustTimestamp.Time = dt
+			obs.GustTimestamp = pgtype.Timestamptz{Time: dt, Valid: true}
 		}
 	}
 
@@ -39,6 +39,22 @@ type davisRawStationsResponseV2 struct {
 	GeneratedAt int64                       `json:"generated_at"`
 }
 
+func (r davisRawStationResponseV2) ToDavisStation() DavisStation {
+	return DavisStation{
+		StationID:         r.StationID,
+		GatewayID:         r.GatewayIDHex,
+		Name:              r.StationName,
+		Lat:               r.Latitude,
+		Lon:               r.Longitude,
+		Elevation:         r.Elevation,
+		FirmwareVersion:   r.FirmwareVersion,
+		RecordingInterval: r.RecordingInterval,
+		TimeZone:          r.TimeZone,
+		Region:            r.Region,
+		Active:            r.Active,
+	}
+}
+
 type davisRawCurrentResponseV2 struct {
 	StationID     int                               `json:"station_id"`
 	StationIDUUID string                            `json:"station_id_uuid"`
@@ -98,6 +114,9 @@ type davisRawCurrentDataResponseV2 struct {
 	HeatIndex          *float32    `json:"heat_index"`
 	WindChill          *float32    `json:"wind_chill"`
 	WindGust10Min      *float32    `json:"wind_gust_10_min"`
+	ThwIndex           *float32    `json:"thw_index"`
+	ThswIndex          *float32    `json:"thsw_index"`
+	UVIndex            *float32    `json:"uv_index"`
 }
 
 func (r davisRawCurrentResponseV2) ToDavisCurrentObservation() *DavisCurrentObservation {
@@ -117,11 +136,15 @@ func (r davisRawCurrentResponseV2) ToDavisCurrentObservation() *DavisCurrentObse
 		Pres:      util.ToFloat4(rawData.Bar),
 		// Tx:        util.ToFloat4(rawObs.Obs.TempDayHighF),
 		// Tn:        util.ToFloat4(rawObs.Obs.TempDayLowF),
-		Wspdx:         util.ToFloat4(rawData.WindGust10Min),
-		Hi:            util.ToFloat4(rawData.HeatIndex),
-		TxTimestamp:   pgtype.Timestamptz{Time: time.Time{}, Valid: true},
-		TnTimestamp:   pgtype.Timestamptz{Time: time.Time{}, Valid: true},
-		GustTimestamp: pgtype.Timestamptz{Time: time.Time{}, Valid: true},
+		Wspdx:  util.ToFloat4(rawData.WindGust10Min),
+		Hi:     util.ToFloat4(rawData.HeatIndex),
+		Td:     util.ToFloat4(rawData.DewPoint),
+		Wchill: util.ToFloat4(rawData.WindChill),
+		Thwi:   util.ToFloat4(rawData.ThwIndex),
+		Thswi:  util.ToFloat4(rawData.ThswIndex),
+		Uvi:    util.ToFloat4(rawData.UV),
+		// et_day is a running daily total, like the dashboard ET it does not
+		// fit the per record Et column so it is left out
 	}
 
 	if obs.Temp.Valid {
@@ -136,8 +159,13 @@ func (r davisRawCurrentResponseV2) ToDavisCurrentObservation() *DavisCurrentObse
 	if obs.Wspdx.Valid {
 		obs.Wspdx.Float32 = obs.Wspdx.Float32 * 0.44704
 	}
-	if obs.Hi.Valid {
-		obs.Hi.Float32 = util.FahrenheitToCelsius(obs.Hi.Float32)
+	if !obs.Uvi.Valid {
+		obs.Uvi = util.ToFloat4(rawData.UVIndex)
+	}
+	for _, t := range []*pgtype.Float4{&obs.Hi, &obs.Td, &obs.Wchill, &obs.Thwi, &obs.Thswi} {
+		if t.Valid {
+			t.Float32 = util.FahrenheitToCelsius(t.Float32)
+		}
 	}
 
 	if rawData.TS > 0 {
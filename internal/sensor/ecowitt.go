@@ -0,0 +1,67 @@
+package sensor
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+)
+
+// Ecowitt is an observation pushed using the Ecowitt custom server protocol
+type Ecowitt struct {
+	PassKey string
+	Obs     StationObservation
+	Health  StationHealth
+}
+
+// EcowittPassKey returns the passkey identifying the station of an Ecowitt upload
+func EcowittPassKey(vals url.Values) (string, error) {
+	passKey := vals.Get("PASSKEY")
+	if passKey == "" {
+		return "", fmt.Errorf("invalid request: missing passkey")
+	}
+	return passKey, nil
+}
+
+// NewEcowittFromValues parses the form of an Ecowitt custom server upload.
+// Imperial values are converted to metric.
+func NewEcowittFromValues(vals url.Values, settings ...ClockSettings) (e *Ecowitt, err error) {
+	e = new(Ecowitt)
+	e.PassKey, err = EcowittPassKey(vals)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, minutesDiff, errMsg, err := parseUploadTimestamp(vals.Get("dateutc"), newClockSettings(settings))
+	if err != nil {
+		return nil, err
+	}
+
+	e.Obs = StationObservation{
+		Temp:      parseFahrenheit(vals.Get("tempf")),
+		Rh:        util.NewWrappedFloat[float32](vals.Get("humidity")).Round(2).GetRef(),
+		Pres:      util.NewWrappedFloat[float32](vals.Get("baromabsin")).Convert(inHgToMbar).Round(2).GetRef(),
+		Mslp:      util.NewWrappedFloat[float32](vals.Get("baromrelin")).Convert(inHgToMbar).Round(2).GetRef(),
+		Wspd:      util.NewWrappedFloat[float32](vals.Get("windspeedmph")).Convert(mphToMps).Round(2).GetRef(),
+		Wspdx:     util.NewWrappedFloat[float32](vals.Get("windgustmph")).Convert(mphToMps).Round(2).GetRef(),
+		Wdir:      util.NewWrappedFloat[float32](vals.Get("winddir")).Round(2).GetRef(),
+		Srad:      util.NewWrappedFloat[float32](vals.Get("solarradiation")).Round(2).GetRef(),
+		Rr:        util.NewWrappedFloat[float32](vals.Get("rainratein")).Convert(inchToMm).Round(2).GetRef(),
+		RainAccum: util.NewWrappedFloat[float32](vals.Get("hourlyrainin")).Convert(inchToMm).Round(2).GetRef(),
+		Timestamp: timestamp,
+	}
+
+	dataCount, dataStatus := uploadDataStatus(e.Obs)
+	e.Health = StationHealth{
+		TempArq:           parseFahrenheit(vals.Get("tempinf")),
+		RhArq:             util.NewWrappedFloat[float32](vals.Get("humidityin")).Round(2).GetRef(),
+		Message:           EncodeUploadMessage(vals, ""),
+		ErrorMsg:          errMsg,
+		MinutesDifference: int32(minutesDiff),
+		DataCount:         dataCount,
+		DataStatus:        dataStatus,
+		Timestamp:         timestamp,
+	}
+
+	return e, nil
+}
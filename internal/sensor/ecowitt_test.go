@@ -0,0 +1,89 @@
+package sensor
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEcowitt(t *testing.T) {
+	timeNow := time.Now().UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name        string
+		buildArg    func() (url.Values, *Ecowitt, error)
+		checkResult func(vals url.Values, e *Ecowitt, err error)
+	}{
+		{
+			name: "Default",
+			buildArg: func() (url.Values, *Ecowitt, error) {
+				vals := url.Values{
+					"PASSKEY":        {"0123456789ABCDEF0123456789ABCDEF"},
+					"stationtype":    {"GW1000B_V1.7.3"},
+					"dateutc":        {timeNow.Format(uploadTimeFormat)},
+					"tempinf":        {"77"},
+					"humidityin":     {"60"},
+					"baromrelin":     {"29.92"},
+					"baromabsin":     {"29.50"},
+					"tempf":          {"86"},
+					"humidity":       {"75"},
+					"winddir":        {"90"},
+					"windspeedmph":   {"10"},
+					"windgustmph":    {"20"},
+					"solarradiation": {"500"},
+					"rainratein":     {"0.5"},
+					"hourlyrainin":   {"0.1"},
+				}
+				e, err := NewEcowittFromValues(vals)
+				return vals, e, err
+			},
+			checkResult: func(vals url.Values, e *Ecowitt, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "0123456789ABCDEF0123456789ABCDEF", e.PassKey)
+
+				require.InDelta(t, 30.0, *e.Obs.Temp, 0.01)
+				require.InDelta(t, 75.0, *e.Obs.Rh, 0.01)
+				require.InDelta(t, 1013.21, *e.Obs.Mslp, 0.01)
+				require.InDelta(t, 998.99, *e.Obs.Pres, 0.01)
+				require.InDelta(t, 90.0, *e.Obs.Wdir, 0.01)
+				require.InDelta(t, 4.47, *e.Obs.Wspd, 0.01)
+				require.InDelta(t, 8.94, *e.Obs.Wspdx, 0.01)
+				require.InDelta(t, 12.7, *e.Obs.Rr, 0.01)
+				require.InDelta(t, 2.54, *e.Obs.RainAccum, 0.01)
+				require.InDelta(t, 500.0, *e.Obs.Srad, 0.01)
+				require.Nil(t, e.Obs.Td)
+				require.True(t, timeNow.Equal(e.Obs.Timestamp))
+
+				require.InDelta(t, 25.0, *e.Health.TempArq, 0.01)
+				require.InDelta(t, 60.0, *e.Health.RhArq, 0.01)
+				require.Equal(t, int32(8), e.Health.DataCount)
+				require.Equal(t, "1111111001", e.Health.DataStatus)
+				require.Empty(t, e.Health.ErrorMsg)
+			},
+		},
+		{
+			name: "MissingPassKey",
+			buildArg: func() (url.Values, *Ecowitt, error) {
+				vals := url.Values{
+					"tempf": {"86"},
+				}
+				e, err := NewEcowittFromValues(vals)
+				return vals, e, err
+			},
+			checkResult: func(vals url.Values, e *Ecowitt, err error) {
+				require.Error(t, err)
+				require.Empty(t, e)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			vals, e, err := tc.buildArg()
+			tc.checkResult(vals, e, err)
+		})
+	}
+}
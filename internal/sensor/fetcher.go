@@ -1,8 +1,41 @@
 package sensor
 
-import "net/http"
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
 
 type Fetcher interface {
 	Get(url string) (*http.Response, error)
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// RateLimitedFetcher waits for the limiter before each request.
+// A single instance is shared to keep all requests within the API limits.
+type RateLimitedFetcher struct {
+	client  Fetcher
+	limiter *rate.Limiter
+}
+
+func NewRateLimitedFetcher(client Fetcher, limiter *rate.Limiter) *RateLimitedFetcher {
+	return &RateLimitedFetcher{
+		client:  client,
+		limiter: limiter,
+	}
+}
+
+func (f *RateLimitedFetcher) Get(url string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return f.Do(req)
+}
+
+func (f *RateLimitedFetcher) Do(req *http.Request) (*http.Response, error) {
+	if err := f.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return f.client.Do(req)
+}
@@ -0,0 +1,34 @@
+package sensor
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	mocksensor "github.com/emiliogozo/panahon-api-go/internal/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitedFetcher(t *testing.T) {
+	client := mocksensor.NewMockFetcher(t)
+	client.EXPECT().Do(mock.Anything).Return(&http.Response{StatusCode: http.StatusOK}, nil).Once()
+
+	fetcher := NewRateLimitedFetcher(client, rate.NewLimiter(rate.Every(time.Hour), 1))
+
+	res, err := fetcher.Get("http://api.com/current")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	// the next token is an hour away, the request gives up once its context ends
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", "http://api.com/current", nil)
+	require.NoError(t, err)
+
+	_, err = fetcher.Do(req)
+	require.Error(t, err)
+	client.AssertNumberOfCalls(t, "Do", 1)
+}
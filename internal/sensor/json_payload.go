@@ -0,0 +1,176 @@
+package sensor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+)
+
+// JSONTimestampField is the mapped field holding the observation timestamp
+const JSONTimestampField = "timestamp"
+
+var (
+	jsonFloatObsFields    = []string{"pres", "rr", "rh", "temp", "td", "wdir", "wspd", "wspdx", "srad", "mslp", "hi", "wchill", "rain_accum"}
+	jsonIntObsFields      = []string{"rain_tips", "rain_cumulative_tips"}
+	jsonFloatHealthFields = []string{"vb1", "vb2", "curr", "bp1", "bp2", "temp_arq", "rh_arq"}
+	jsonIntHealthFields   = []string{"ss"}
+	jsonTextHealthFields  = []string{"cm", "fpm"}
+)
+
+// JSONPayload is an observation published as JSON, e.g. by ESP32 stations over MQTT
+type JSONPayload struct {
+	Obs    StationObservation
+	Health StationHealth
+}
+
+// ValidateJSONFields checks that the field mapping only targets known observation and health columns
+func ValidateJSONFields(fields map[string]string) error {
+	known := slices.Concat(
+		jsonFloatObsFields, jsonIntObsFields,
+		jsonFloatHealthFields, jsonIntHealthFields, jsonTextHealthFields,
+		[]string{JSONTimestampField},
+	)
+
+	for col, key := range fields {
+		if !slices.Contains(known, col) {
+			return fmt.Errorf("unknown column: %s", col)
+		}
+		if key == "" {
+			return fmt.Errorf("empty payload key for column: %s", col)
+		}
+	}
+	return nil
+}
+
+// NewJSONPayload maps the payload keys to observation and health columns.
+// Fields maps a column name to a payload key, nested keys are separated by dots.
+// The timestamp may be a unix epoch in seconds or milliseconds, or an RFC3339 string.
+// A missing timestamp defaults to the time of receipt.
+func NewJSONPayload(data []byte, fields map[string]string, settings ...ClockSettings) (p *JSONPayload, err error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	var payload map[string]any
+	if err := d.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	vals := make(map[string]string)
+	for col, key := range fields {
+		if v, ok := lookupJSONKey(payload, key); ok {
+			vals[col] = v
+		}
+	}
+
+	timestamp, minutesDiff, errMsg, err := parseJSONTimestamp(vals[JSONTimestampField], newClockSettings(settings))
+	if err != nil {
+		return nil, err
+	}
+
+	floatVals := make(map[string]*float32)
+	for _, col := range slices.Concat(jsonFloatObsFields, jsonFloatHealthFields) {
+		floatVals[col] = util.NewWrappedFloat[float32](vals[col]).Round(2).GetRef()
+	}
+	intVals := make(map[string]*int32)
+	for _, col := range slices.Concat(jsonIntObsFields, jsonIntHealthFields) {
+		intVals[col] = util.NewWrappedInt[int32](vals[col]).GetRef()
+	}
+
+	p = new(JSONPayload)
+	p.Obs = StationObservation{
+		Pres:               floatVals["pres"],
+		Rr:                 floatVals["rr"],
+		RainTips:           intVals["rain_tips"],
+		RainCumulativeTips: intVals["rain_cumulative_tips"],
+		RainAccum:          floatVals["rain_accum"],
+		Rh:                 floatVals["rh"],
+		Temp:               floatVals["temp"],
+		Td:                 floatVals["td"],
+		Wdir:               floatVals["wdir"],
+		Wspd:               floatVals["wspd"],
+		Wspdx:              floatVals["wspdx"],
+		Srad:               floatVals["srad"],
+		Mslp:               floatVals["mslp"],
+		Hi:                 floatVals["hi"],
+		Wchill:             floatVals["wchill"],
+		Timestamp:          timestamp,
+	}
+
+	dataCount, dataStatus := uploadDataStatus(p.Obs)
+	p.Health = StationHealth{
+		Vb1:               floatVals["vb1"],
+		Vb2:               floatVals["vb2"],
+		Curr:              floatVals["curr"],
+		Bp1:               floatVals["bp1"],
+		Bp2:               floatVals["bp2"],
+		Cm:                vals["cm"],
+		Ss:                intVals["ss"],
+		TempArq:           floatVals["temp_arq"],
+		RhArq:             floatVals["rh_arq"],
+		Fpm:               vals["fpm"],
+		Message:           string(data),
+		ErrorMsg:          errMsg,
+		MinutesDifference: int32(minutesDiff),
+		DataCount:         dataCount,
+		DataStatus:        dataStatus,
+		Timestamp:         timestamp,
+	}
+
+	return p, nil
+}
+
+// lookupJSONKey walks a dotted key and returns the value as a string
+func lookupJSONKey(payload map[string]any, key string) (string, bool) {
+	var v any = payload
+	for _, k := range strings.Split(key, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if v, ok = m[k]; !ok {
+			return "", false
+		}
+	}
+
+	switch val := v.(type) {
+	case json.Number:
+		return val.String(), true
+	case string:
+		return val, true
+	case bool:
+		return fmt.Sprintf("%t", val), true
+	default:
+		return "", false
+	}
+}
+
+func parseJSONTimestamp(s string, cs ClockSettings) (time.Time, float64, string, error) {
+	timeNow := time.Now()
+	if s == "" {
+		return timeNow, 0.0, "", nil
+	}
+
+	var timestamp time.Time
+	if epoch := util.NewWrappedInt[int64](s); epoch.Valid {
+		// assume milliseconds beyond the year 33658 in seconds
+		if epoch.Value > 1e12 {
+			timestamp = time.UnixMilli(epoch.Value)
+		} else {
+			timestamp = time.Unix(epoch.Value, 0)
+		}
+	} else {
+		var err error
+		timestamp, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return time.Time{}, 0.0, "", fmt.Errorf("invalid timestamp: %s", s)
+		}
+	}
+
+	timestamp, minutesDiff, errMsg := cs.correctTimestamp(timestamp, timeNow)
+	return timestamp, minutesDiff, errMsg, nil
+}
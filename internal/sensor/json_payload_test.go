@@ -0,0 +1,131 @@
+package sensor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPayload(t *testing.T) {
+	timeNow := time.Now().Truncate(time.Second)
+	fields := map[string]string{
+		"temp":      "bme.t",
+		"rh":        "bme.h",
+		"pres":      "bme.p",
+		"rain_tips": "tips",
+		"vb1":       "batt",
+		"ss":        "rssi",
+		"fpm":       "fw",
+		"timestamp": "ts",
+	}
+
+	testCases := []struct {
+		name        string
+		buildArg    func() (*JSONPayload, error)
+		checkResult func(p *JSONPayload, err error)
+	}{
+		{
+			name: "Default",
+			buildArg: func() (*JSONPayload, error) {
+				data := fmt.Sprintf(`{"bme":{"t":28.456,"h":"81.5","p":1008.2},"tips":3,"batt":3.71,"rssi":-67,"fw":"1.0.2","ts":%d}`, timeNow.Unix())
+				return NewJSONPayload([]byte(data), fields)
+			},
+			checkResult: func(p *JSONPayload, err error) {
+				require.NoError(t, err)
+
+				require.Equal(t, StationObservation{
+					Temp:      util.ToRef(float32(28.46)),
+					Rh:        util.ToRef(float32(81.5)),
+					Pres:      util.ToRef(float32(1008.2)),
+					RainTips:  util.ToRef(int32(3)),
+					Timestamp: timeNow,
+				}, p.Obs)
+				require.Equal(t, util.ToRef(float32(3.71)), p.Health.Vb1)
+				require.Equal(t, util.ToRef(int32(-67)), p.Health.Ss)
+				require.Equal(t, "1.0.2", p.Health.Fpm)
+				require.Equal(t, int32(3), p.Health.DataCount)
+				require.Equal(t, "1110000000", p.Health.DataStatus)
+				require.Empty(t, p.Health.ErrorMsg)
+			},
+		},
+		{
+			name: "MillisecondTimestamp",
+			buildArg: func() (*JSONPayload, error) {
+				data := fmt.Sprintf(`{"bme":{"t":28},"ts":%d}`, timeNow.UnixMilli())
+				return NewJSONPayload([]byte(data), fields)
+			},
+			checkResult: func(p *JSONPayload, err error) {
+				require.NoError(t, err)
+				require.True(t, timeNow.Equal(p.Obs.Timestamp))
+			},
+		},
+		{
+			name: "RFC3339Timestamp",
+			buildArg: func() (*JSONPayload, error) {
+				data := fmt.Sprintf(`{"bme":{"t":28},"ts":"%s"}`, timeNow.UTC().Format(time.RFC3339))
+				return NewJSONPayload([]byte(data), fields)
+			},
+			checkResult: func(p *JSONPayload, err error) {
+				require.NoError(t, err)
+				require.True(t, timeNow.Equal(p.Obs.Timestamp))
+			},
+		},
+		{
+			name: "ClockOffset",
+			buildArg: func() (*JSONPayload, error) {
+				data := fmt.Sprintf(`{"bme":{"t":28},"ts":%d}`, timeNow.Add(-time.Hour).Unix())
+				return NewJSONPayload([]byte(data), fields, ClockSettings{ClockOffset: 60})
+			},
+			checkResult: func(p *JSONPayload, err error) {
+				require.NoError(t, err)
+				require.True(t, timeNow.Equal(p.Obs.Timestamp))
+				require.Equal(t, int32(60), p.Health.MinutesDifference)
+			},
+		},
+		{
+			name: "MissingTimestamp",
+			buildArg: func() (*JSONPayload, error) {
+				return NewJSONPayload([]byte(`{"bme":{"t":28}}`), fields)
+			},
+			checkResult: func(p *JSONPayload, err error) {
+				require.NoError(t, err)
+				require.WithinDuration(t, time.Now(), p.Obs.Timestamp, 5*time.Second)
+			},
+		},
+		{
+			name: "InvalidTimestamp",
+			buildArg: func() (*JSONPayload, error) {
+				return NewJSONPayload([]byte(`{"bme":{"t":28},"ts":"yesterday"}`), fields)
+			},
+			checkResult: func(p *JSONPayload, err error) {
+				require.EqualError(t, err, "invalid timestamp: yesterday")
+			},
+		},
+		{
+			name: "InvalidPayload",
+			buildArg: func() (*JSONPayload, error) {
+				return NewJSONPayload([]byte(`t=28`), fields)
+			},
+			checkResult: func(p *JSONPayload, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.checkResult(tc.buildArg())
+		})
+	}
+}
+
+func TestValidateJSONFields(t *testing.T) {
+	require.NoError(t, ValidateJSONFields(map[string]string{"temp": "t", "cm": "carrier", "timestamp": "ts"}))
+	require.EqualError(t, ValidateJSONFields(map[string]string{"temperature": "t"}), "unknown column: temperature")
+	require.EqualError(t, ValidateJSONFields(map[string]string{"temp": ""}), "empty payload key for column: temp")
+}
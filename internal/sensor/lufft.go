@@ -2,7 +2,6 @@ package sensor
 
 import (
 	"fmt"
-	"math"
 	"strings"
 	"time"
 
@@ -26,6 +25,7 @@ type StationObservation struct {
 	Rr                 *float32  `json:"rr"`
 	RainTips           *int32    `json:"rain_tips"`
 	RainCumulativeTips *int32    `json:"rain_cumulative_tips"`
+	RainAccum          *float32  `json:"rain_accum"`
 	Rh                 *float32  `json:"rh"`
 	Temp               *float32  `json:"temp"`
 	Td                 *float32  `json:"td"`
@@ -167,7 +167,15 @@ func (l Lufft) String(nVal int) string {
 	return ""
 }
 
-func NewLufftFromString(valStr string) (l *Lufft, err error) {
+// NewLufftFromString parses a Lufft SMS message.
+// The optional clock settings define the logger timezone and the known clock offset.
+func NewLufftFromString(valStr string, settings ...ClockSettings) (l *Lufft, err error) {
+	return NewLufftFromStringAt(valStr, time.Now(), settings...)
+}
+
+// NewLufftFromStringAt parses a Lufft SMS message received at the given time.
+// The logger clock is checked against receivedAt instead of the current time, used for buffered messages.
+func NewLufftFromStringAt(valStr string, receivedAt time.Time, settings ...ClockSettings) (l *Lufft, err error) {
 	valStr = strings.ReplaceAll(valStr, ">", "")
 	valStr = strings.ReplaceAll(valStr, "%20", "+")
 	valStr = strings.TrimSpace(valStr)
@@ -182,21 +190,8 @@ func NewLufftFromString(valStr string) (l *Lufft, err error) {
 		return nil, fmt.Errorf("invalid string")
 	}
 
-	timeNow := time.Now()
-	timestamp := parseTimestampTz(valStrs[nVal-2], "Asia/Manila")
-	errMsg := ""
-	minutesDiff := 0.0
-
-	if !timestamp.IsZero() {
-		minutesDiff = timeNow.Sub(timestamp).Minutes()
-		if minutesDiff < minMinutesThresh {
-			errMsg = fmt.Sprintf("timestamp is %f minutes behind", math.Abs(minutesDiff))
-			timestamp = timeNow
-		} else if minutesDiff > maxMinutesThresh {
-			errMsg = fmt.Sprintf("timestamp is %f minutes ahead", minutesDiff)
-			timestamp = timeNow
-		}
-	}
+	cs := newClockSettings(settings)
+	timestamp, minutesDiff, errMsg := cs.correctTimestamp(parseTimestampTz(valStrs[nVal-2], cs.Timezone), receivedAt)
 
 	if nVal == 20 || nVal == 24 {
 		_v := make([]string, 0)
@@ -314,6 +309,22 @@ func RandomLufft(timestamp time.Time) Lufft {
 	return Lufft{Obs: obs, Health: health}
 }
 
+// IsCompleteLufftString reports whether the string has the field count of a known Lufft format
+// and ends with a valid timestamp, i.e. it is not the beginning of a message that is cut off.
+func IsCompleteLufftString(valStr string) bool {
+	valStr = strings.ReplaceAll(valStr, ">", "")
+	valStr = strings.ReplaceAll(valStr, "%20", "+")
+	valStr = strings.TrimSpace(valStr)
+
+	valStrs := strings.Split(valStr, "+")
+	switch len(valStrs) {
+	case 19, 20, 23, 24:
+	default:
+		return false
+	}
+	return !parseTimestampTz(valStrs[len(valStrs)-1], "UTC").IsZero()
+}
+
 func parseTimestampTz(dateStr string, tz string) time.Time {
 	formats := []string{
 		"06:01:02:15:04:05",   // YY:MM:DD:HH:MM:SS
@@ -323,7 +334,7 @@ func parseTimestampTz(dateStr string, tz string) time.Time {
 	}
 
 	if tz == "" {
-		tz = "Asia/Manila"
+		tz = DefaultTimezone
 	}
 
 	loc, err := time.LoadLocation(tz)
@@ -0,0 +1,113 @@
+package sensor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	LufftCommandInterval = "INTERVAL"
+	LufftCommandReboot   = "REBOOT"
+	LufftCommandTimeSync = "TIMESYNC"
+
+	lufftCommandTimeFormat = "2006:01:02:15:04:05" // YYYY:MM:DD:HH:MM:SS
+	minLufftInterval       = 1
+	maxLufftInterval       = 60
+)
+
+// LufftCommand is a configuration command sent to a Lufft logger by SMS.
+// The CMD/ACK messages are not part of the Lufft protocol, they are a convention that the logger
+// firmware has to implement. The commands are only sent when LUFFT_COMMANDS_ENABLED is set.
+type LufftCommand struct {
+	// ID is echoed back in the acknowledgement by loggers starting with version 2
+	ID   int64
+	Name string
+	// Interval is the reporting interval in minutes of an INTERVAL command
+	Interval int32
+	// Time is the clock value of a TIMESYNC command
+	Time time.Time
+}
+
+// LufftAck is the acknowledgement a logger sends after executing a command
+type LufftAck struct {
+	// ID is zero when the logger does not echo the command id
+	ID      int64
+	Name    string
+	OK      bool
+	Message string
+}
+
+// lufftCommandHasID reports whether the logger version echoes command ids.
+// Versions are written as "2", "2.1" or "v2.1", unknown versions are treated as version 1.
+func lufftCommandHasID(loggerVersion string) bool {
+	v := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(loggerVersion)), "v")
+	major, err := strconv.Atoi(strings.SplitN(v, ".", 2)[0])
+	if err != nil {
+		return false
+	}
+	return major >= 2
+}
+
+// FormatLufftCommand renders the SMS for the logger version.
+// The clock value of a TIMESYNC command is written in the timezone of the clock settings.
+func FormatLufftCommand(loggerVersion string, cmd LufftCommand, settings ...ClockSettings) (string, error) {
+	fields := []string{"CMD"}
+	if lufftCommandHasID(loggerVersion) {
+		fields = append(fields, strconv.FormatInt(cmd.ID, 10))
+	}
+	fields = append(fields, cmd.Name)
+
+	switch cmd.Name {
+	case LufftCommandInterval:
+		if cmd.Interval < minLufftInterval || cmd.Interval > maxLufftInterval {
+			return "", fmt.Errorf("interval must be between %d and %d minutes", minLufftInterval, maxLufftInterval)
+		}
+		fields = append(fields, strconv.Itoa(int(cmd.Interval)))
+	case LufftCommandTimeSync:
+		loc, err := time.LoadLocation(newClockSettings(settings).Timezone)
+		if err != nil {
+			return "", err
+		}
+		fields = append(fields, cmd.Time.In(loc).Format(lufftCommandTimeFormat))
+	case LufftCommandReboot:
+	default:
+		return "", fmt.Errorf("unknown command: %s", cmd.Name)
+	}
+
+	return strings.Join(fields, ","), nil
+}
+
+// ParseLufftAck parses a command acknowledgement, ok is false for any other message.
+// Version 1 loggers reply "ACK,<name>,<OK|ERR>[,<message>]",
+// later versions put the command id after ACK.
+func ParseLufftAck(msg string) (ack LufftAck, ok bool) {
+	fields := strings.Split(strings.TrimSpace(msg), ",")
+	if len(fields) < 3 || !strings.EqualFold(fields[0], "ACK") {
+		return ack, false
+	}
+	fields = fields[1:]
+
+	if id, err := strconv.ParseInt(fields[0], 10, 64); err == nil {
+		if len(fields) < 3 {
+			return ack, false
+		}
+		ack.ID = id
+		fields = fields[1:]
+	}
+
+	ack.Name = strings.ToUpper(strings.TrimSpace(fields[0]))
+	switch strings.ToUpper(strings.TrimSpace(fields[1])) {
+	case "OK":
+		ack.OK = true
+	case "ERR":
+	default:
+		return LufftAck{}, false
+	}
+	if len(fields) > 2 {
+		ack.Message = strings.TrimSpace(strings.Join(fields[2:], ","))
+	}
+
+	return ack, true
+}
@@ -0,0 +1,122 @@
+package sensor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatLufftCommand(t *testing.T) {
+	ts := time.Date(2024, 3, 1, 4, 5, 6, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		loggerVersion string
+		cmd           LufftCommand
+		settings      []ClockSettings
+		want          string
+		wantErr       bool
+	}{
+		{
+			name: "IntervalV1",
+			cmd:  LufftCommand{ID: 7, Name: LufftCommandInterval, Interval: 10},
+			want: "CMD,INTERVAL,10",
+		},
+		{
+			name:          "IntervalV2",
+			loggerVersion: "v2.1",
+			cmd:           LufftCommand{ID: 7, Name: LufftCommandInterval, Interval: 10},
+			want:          "CMD,7,INTERVAL,10",
+		},
+		{
+			name:          "InvalidInterval",
+			loggerVersion: "2",
+			cmd:           LufftCommand{ID: 7, Name: LufftCommandInterval, Interval: 0},
+			wantErr:       true,
+		},
+		{
+			name:          "Reboot",
+			loggerVersion: "1.4",
+			cmd:           LufftCommand{ID: 7, Name: LufftCommandReboot},
+			want:          "CMD,REBOOT",
+		},
+		{
+			name: "TimeSyncDefaultTimezone",
+			cmd:  LufftCommand{ID: 7, Name: LufftCommandTimeSync, Time: ts},
+			want: "CMD,TIMESYNC,2024:03:01:12:05:06",
+		},
+		{
+			name:          "TimeSyncStationTimezone",
+			loggerVersion: "3",
+			cmd:           LufftCommand{ID: 7, Name: LufftCommandTimeSync, Time: ts},
+			settings:      []ClockSettings{{Timezone: "UTC"}},
+			want:          "CMD,7,TIMESYNC,2024:03:01:04:05:06",
+		},
+		{
+			name:    "UnknownCommand",
+			cmd:     LufftCommand{ID: 7, Name: "FORMAT"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FormatLufftCommand(tc.loggerVersion, tc.cmd, tc.settings...)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseLufftAck(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    string
+		want   LufftAck
+		wantOK bool
+	}{
+		{
+			name:   "V1",
+			msg:    "ACK,INTERVAL,OK",
+			want:   LufftAck{Name: LufftCommandInterval, OK: true},
+			wantOK: true,
+		},
+		{
+			name:   "V2",
+			msg:    "ack,12,reboot,OK\n",
+			want:   LufftAck{ID: 12, Name: LufftCommandReboot, OK: true},
+			wantOK: true,
+		},
+		{
+			name:   "Error",
+			msg:    "ACK,12,TIMESYNC,ERR,rtc fault, retry",
+			want:   LufftAck{ID: 12, Name: LufftCommandTimeSync, Message: "rtc fault, retry"},
+			wantOK: true,
+		},
+		{
+			name: "MissingResult",
+			msg:  "ACK,12,REBOOT",
+		},
+		{
+			name: "UnknownResult",
+			msg:  "ACK,REBOOT,MAYBE",
+		},
+		{
+			name: "Observation",
+			msg:  "0+25.5+80+1008+1.2+2.4+180+500+21+25+0+999.9+12.8+13.1+0.1+11+12+0+34+70+OK+24:03:01:12:00:00",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := ParseLufftAck(tc.msg)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
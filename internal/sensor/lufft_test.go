@@ -96,6 +96,61 @@ func TestLufft(t *testing.T) {
 				requireLufftHealthNoError(t, *lufft2)
 			},
 		},
+		{
+			name: "ClockSettings",
+			buildArg: func() (string, *Lufft, error) {
+				lufftUTC := lufft
+				lufftUTC.Obs.Timestamp = lufft.Obs.Timestamp.UTC().Add(-3 * time.Hour)
+				valStr := lufftUTC.String(23)
+				lufft2, err := NewLufftFromString(valStr, ClockSettings{Timezone: "UTC", ClockOffset: 180})
+
+				return valStr, lufft2, err
+			},
+			checkResult: func(valStr string, lufft2 *Lufft, err error) {
+				require.NoError(t, err)
+				requireLufftEqual(t, lufft, *lufft2)
+
+				require.WithinDuration(t, lufft.Obs.Timestamp, lufft2.Obs.Timestamp, time.Second)
+				require.Equal(t, lufft2.Obs.Timestamp, lufft2.Health.Timestamp)
+				require.Equal(t, int32(180), lufft2.Health.MinutesDifference)
+				require.Empty(t, lufft2.Health.ErrorMsg)
+			},
+		},
+		{
+			name: "TimestampOutOfRange",
+			buildArg: func() (string, *Lufft, error) {
+				lufftAhead := lufft
+				lufftAhead.Obs.Timestamp = lufft.Obs.Timestamp.Add(-48 * time.Hour)
+				valStr := lufftAhead.String(23)
+				lufft2, err := NewLufftFromString(valStr)
+
+				return valStr, lufft2, err
+			},
+			checkResult: func(valStr string, lufft2 *Lufft, err error) {
+				require.NoError(t, err)
+
+				require.WithinDuration(t, lufft.Obs.Timestamp.Add(-48*time.Hour), lufft2.Obs.Timestamp, time.Second)
+				require.Equal(t, lufft2.Obs.Timestamp, lufft2.Health.Timestamp)
+				require.Contains(t, lufft2.Health.ErrorMsg, "minutes ahead")
+			},
+		},
+		{
+			name: "ReceivedAt",
+			buildArg: func() (string, *Lufft, error) {
+				lufftOld := lufft
+				lufftOld.Obs.Timestamp = lufft.Obs.Timestamp.Add(-48 * time.Hour)
+				valStr := lufftOld.String(23)
+				lufft2, err := NewLufftFromStringAt(valStr, lufftOld.Obs.Timestamp)
+
+				return valStr, lufft2, err
+			},
+			checkResult: func(valStr string, lufft2 *Lufft, err error) {
+				require.NoError(t, err)
+
+				require.WithinDuration(t, lufft.Obs.Timestamp.Add(-48*time.Hour), lufft2.Obs.Timestamp, time.Second)
+				require.Empty(t, lufft2.Health.ErrorMsg)
+			},
+		},
 		{
 			name: "InvalidString",
 			buildArg: func() (string, *Lufft, error) {
@@ -172,3 +227,14 @@ func requireLufftHealthNoError(t *testing.T, l Lufft) {
 	require.Equal(t, l.Health.DataCount, int32(10))
 	require.Equal(t, l.Health.DataStatus, "1111111111")
 }
+
+func TestIsCompleteLufftString(t *testing.T) {
+	lufft := RandomLufft(time.Now())
+
+	for _, nVal := range []int{19, 20, 23, 24} {
+		valStr := lufft.String(nVal)
+		require.True(t, IsCompleteLufftString(valStr), valStr)
+		require.False(t, IsCompleteLufftString(valStr[:len(valStr)/2]), valStr)
+		require.False(t, IsCompleteLufftString(valStr[:len(valStr)-3]), valStr)
+	}
+}
@@ -2,7 +2,6 @@ package sensor
 
 import (
 	"fmt"
-	"math"
 	"strings"
 	"time"
 
@@ -17,22 +16,32 @@ type Misol struct {
 	Health StationHealth
 }
 
-func NewMisolFromString(valStr string) (m *Misol, err error) {
+// MisolStationID returns the station id of a Misol message without parsing the values
+func MisolStationID(valStr string) (int64, error) {
+	valStrs := strings.Split(strings.TrimSpace(valStr), ",")
+	if len(valStrs) < 27 {
+		return 0, fmt.Errorf("invalid string: wrong length")
+	}
+
+	stnID := util.NewWrappedInt[int64](valStrs[0])
+	if !stnID.Valid {
+		return 0, fmt.Errorf("invalid string: wrong length")
+	}
+	return stnID.Value, nil
+}
+
+// NewMisolFromString parses a Misol weather string.
+// Misol timestamps are unix epochs, only the clock offset of the optional clock settings is applied.
+func NewMisolFromString(valStr string, settings ...ClockSettings) (m *Misol, err error) {
 	valStr = strings.TrimSpace(valStr)
 
 	valStrs := strings.Split(valStr, ",")
-	nVal := len(valStrs)
 
 	m = new(Misol)
-	if nVal < 27 {
-		return nil, fmt.Errorf("invalid string: wrong length")
+	m.StnID, err = MisolStationID(valStr)
+	if err != nil {
+		return nil, err
 	}
-
-	stnID := util.NewWrappedInt[int64](valStrs[0])
-	if !stnID.Valid {
-		return nil, fmt.Errorf("invalid string: wrong length")
-	}
-	m.StnID = stnID.Value
 	m.Lon = util.NewWrappedFloat[float32](valStrs[1]).Round(2).Value
 	m.Lat = util.NewWrappedFloat[float32](valStrs[2]).Round(2).Value
 
@@ -40,20 +49,8 @@ func NewMisolFromString(valStr string) (m *Misol, err error) {
 	if !timeInt.Valid {
 		return nil, fmt.Errorf("invalid string: wrong length")
 	}
-	timeNow := time.Now()
-	timestamp := time.Unix(timeInt.Value, 0)
-	errMsg := ""
-	minutesDiff := 0.0
-	if !timestamp.IsZero() {
-		minutesDiff = timeNow.Sub(timestamp).Minutes()
-		if minutesDiff < minMinutesThresh {
-			errMsg = fmt.Sprintf("timestamp is %f minutes behind", math.Abs(minutesDiff))
-			timestamp = timeNow
-		} else if minutesDiff > maxMinutesThresh {
-			errMsg = fmt.Sprintf("timestamp is %f minutes ahead", minutesDiff)
-			timestamp = timeNow
-		}
-	}
+	cs := newClockSettings(settings)
+	timestamp, minutesDiff, errMsg := cs.correctTimestamp(time.Unix(timeInt.Value, 0), time.Now())
 
 	obsSlice := valStrs[4:15]
 	m.Obs = StationObservation{
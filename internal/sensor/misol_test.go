@@ -59,6 +59,23 @@ func TestMisol(t *testing.T) {
 				requireMisolHealthNoError(t, *m2)
 			},
 		},
+		{
+			name: "ClockOffset",
+			buildArg: func() (string, *Misol, error) {
+				loggerTime := timeNow.Add(-120 * 24 * time.Hour)
+				valStr := fmt.Sprintf("75112112108101,123.8854,10.3157,%d,31,91,1007,6.7,13.4,105,1718,77,30.0001,2,23,3.7,3.8,0.012,17.8,0.065,50,10,25.2,54.4,0,90,1", loggerTime.Unix())
+				misol2, err := NewMisolFromString(valStr, ClockSettings{ClockOffset: 120 * 24 * 60})
+				return valStr, misol2, err
+			},
+			checkResult: func(valStr string, m2 *Misol, err error) {
+				require.NoError(t, err)
+
+				require.Equal(t, timeNow, m2.Obs.Timestamp)
+				require.Equal(t, timeNow, m2.Health.Timestamp)
+				require.Equal(t, int32(120*24*60), m2.Health.MinutesDifference)
+				require.Empty(t, m2.Health.ErrorMsg)
+			},
+		},
 		{
 			name: "InvalidString",
 			buildArg: func() (string, *Misol, error) {
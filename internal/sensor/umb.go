@@ -0,0 +1,325 @@
+package sensor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+)
+
+// UMB binary protocol framing characters
+const (
+	UMBSoh byte = 0x01
+	UMBStx byte = 0x02
+	UMBEtx byte = 0x03
+	UMBEot byte = 0x04
+
+	UMBVersion byte = 0x10
+
+	// UMBHeaderLen is the number of bytes from SOH up to and including the length byte
+	UMBHeaderLen = 7
+	// UMBTrailerLen is the number of bytes following the payload: ETX, CRC16 and EOT
+	UMBTrailerLen = 4
+)
+
+// UMB commands carrying measurement values
+const (
+	UMBCmdOnlineData      byte = 0x23
+	UMBCmdMultiOnlineData byte = 0x2F
+)
+
+// UMB data types
+const (
+	umbTypeUChar  byte = 0x10
+	umbTypeSChar  byte = 0x11
+	umbTypeUShort byte = 0x12
+	umbTypeSShort byte = 0x13
+	umbTypeULong  byte = 0x14
+	umbTypeSLong  byte = 0x15
+	umbTypeFloat  byte = 0x16
+	umbTypeDouble byte = 0x17
+)
+
+// UMBChannels maps the default channels of the Lufft WS series to observation fields
+var UMBChannels = map[uint16]string{
+	100: "temp",
+	110: "td",
+	111: "wchill",
+	200: "rh",
+	300: "pres",
+	305: "mslp",
+	400: "wspd",
+	420: "wspdx",
+	500: "wdir",
+	820: "rr",
+	900: "srad",
+}
+
+// UMBFrame is a decoded UMB binary frame
+type UMBFrame struct {
+	To      uint16
+	From    uint16
+	Cmd     byte
+	Verc    byte
+	Payload []byte
+}
+
+// UMBChannelValue is a single channel measurement of an online data response
+type UMBChannelValue struct {
+	Channel uint16
+	Status  byte
+	Value   float64
+}
+
+// UMBCRC16 computes the CRC-CCITT checksum used by UMB frames
+func UMBCRC16(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		x := uint16(b)
+		for range 8 {
+			if (crc^x)&0x0001 != 0 {
+				crc = (crc >> 1) ^ 0x8408
+			} else {
+				crc >>= 1
+			}
+			x >>= 1
+		}
+	}
+	return crc
+}
+
+// DecodeUMBFrame decodes a complete UMB frame starting with SOH and ending with EOT
+func DecodeUMBFrame(data []byte) (f *UMBFrame, err error) {
+	if len(data) < UMBHeaderLen+UMBTrailerLen+2 {
+		return nil, fmt.Errorf("invalid frame: too short")
+	}
+	if data[0] != UMBSoh || data[7] != UMBStx {
+		return nil, fmt.Errorf("invalid frame: missing SOH/STX")
+	}
+	if data[1] != UMBVersion {
+		return nil, fmt.Errorf("invalid frame: unsupported version %#x", data[1])
+	}
+
+	n := int(data[6])
+	if n < 2 || len(data) != UMBHeaderLen+1+n+UMBTrailerLen {
+		return nil, fmt.Errorf("invalid frame: wrong length")
+	}
+
+	etxIdx := UMBHeaderLen + 1 + n
+	if data[etxIdx] != UMBEtx || data[len(data)-1] != UMBEot {
+		return nil, fmt.Errorf("invalid frame: missing ETX/EOT")
+	}
+
+	crc := binary.LittleEndian.Uint16(data[etxIdx+1 : etxIdx+3])
+	if crc != UMBCRC16(data[:etxIdx+1]) {
+		return nil, fmt.Errorf("invalid frame: crc mismatch")
+	}
+
+	f = &UMBFrame{
+		To:      binary.LittleEndian.Uint16(data[2:4]),
+		From:    binary.LittleEndian.Uint16(data[4:6]),
+		Cmd:     data[8],
+		Verc:    data[9],
+		Payload: data[10:etxIdx],
+	}
+
+	return f, nil
+}
+
+// EncodeUMBFrame builds a UMB frame, used for replies and fake loggers
+func EncodeUMBFrame(f UMBFrame) []byte {
+	n := 2 + len(f.Payload)
+	data := make([]byte, 0, UMBHeaderLen+1+n+UMBTrailerLen)
+	data = append(data, UMBSoh, UMBVersion)
+	data = binary.LittleEndian.AppendUint16(data, f.To)
+	data = binary.LittleEndian.AppendUint16(data, f.From)
+	data = append(data, byte(n), UMBStx, f.Cmd, f.Verc)
+	data = append(data, f.Payload...)
+	data = append(data, UMBEtx)
+	data = binary.LittleEndian.AppendUint16(data, UMBCRC16(data))
+	data = append(data, UMBEot)
+	return data
+}
+
+// NewUMBOnlineDataFrame builds a multi channel online data response sent by a device.
+// Values are encoded as floats, channels with a non-zero status carry no value.
+func NewUMBOnlineDataFrame(from uint16, values []UMBChannelValue) UMBFrame {
+	payload := []byte{0x00, byte(len(values))}
+	for _, v := range values {
+		block := []byte{v.Status}
+		block = binary.LittleEndian.AppendUint16(block, v.Channel)
+		if v.Status == 0 {
+			block = append(block, umbTypeFloat)
+			block = binary.LittleEndian.AppendUint32(block, math.Float32bits(float32(v.Value)))
+		}
+		payload = append(payload, byte(len(block)))
+		payload = append(payload, block...)
+	}
+
+	return UMBFrame{
+		To:      0xF001,
+		From:    from,
+		Cmd:     UMBCmdMultiOnlineData,
+		Verc:    UMBVersion,
+		Payload: payload,
+	}
+}
+
+// DeviceID returns the sender address as hex, e.g. 7001 for a weather station with device id 1
+func (f UMBFrame) DeviceID() string {
+	return fmt.Sprintf("%04X", f.From)
+}
+
+// ChannelValues decodes the measurements of an online data response.
+// Channels with a non-zero status are returned with their status and a zero value.
+func (f UMBFrame) ChannelValues() ([]UMBChannelValue, error) {
+	switch f.Cmd {
+	case UMBCmdOnlineData:
+		if len(f.Payload) < 1 {
+			return nil, fmt.Errorf("invalid payload: too short")
+		}
+		v, err := decodeUMBChannel(f.Payload[0], f.Payload[1:])
+		if err != nil {
+			return nil, err
+		}
+		return []UMBChannelValue{v}, nil
+	case UMBCmdMultiOnlineData:
+		if len(f.Payload) < 2 {
+			return nil, fmt.Errorf("invalid payload: too short")
+		}
+		if f.Payload[0] != 0 {
+			return nil, fmt.Errorf("device error status %#x", f.Payload[0])
+		}
+
+		nChannels := int(f.Payload[1])
+		values := make([]UMBChannelValue, 0, nChannels)
+		buf := f.Payload[2:]
+		for range nChannels {
+			if len(buf) < 1 {
+				return nil, fmt.Errorf("invalid payload: too short")
+			}
+			subLen := int(buf[0])
+			if subLen < 1 || len(buf) < 1+subLen {
+				return nil, fmt.Errorf("invalid payload: wrong channel length")
+			}
+			v, err := decodeUMBChannel(buf[1], buf[2:1+subLen])
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+			buf = buf[1+subLen:]
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported command %#x", f.Cmd)
+	}
+}
+
+// decodeUMBChannel decodes the channel, data type and value following the status byte
+func decodeUMBChannel(status byte, buf []byte) (UMBChannelValue, error) {
+	if len(buf) < 2 {
+		return UMBChannelValue{}, fmt.Errorf("invalid channel: too short")
+	}
+	v := UMBChannelValue{
+		Channel: binary.LittleEndian.Uint16(buf[0:2]),
+		Status:  status,
+	}
+	if status != 0 {
+		return v, nil
+	}
+	if len(buf) < 3 {
+		return UMBChannelValue{}, fmt.Errorf("invalid channel %d: missing data type", v.Channel)
+	}
+
+	dataType, val := buf[2], buf[3:]
+	size := map[byte]int{
+		umbTypeUChar: 1, umbTypeSChar: 1,
+		umbTypeUShort: 2, umbTypeSShort: 2,
+		umbTypeULong: 4, umbTypeSLong: 4, umbTypeFloat: 4,
+		umbTypeDouble: 8,
+	}[dataType]
+	if size == 0 {
+		return UMBChannelValue{}, fmt.Errorf("invalid channel %d: unknown data type %#x", v.Channel, dataType)
+	}
+	if len(val) < size {
+		return UMBChannelValue{}, fmt.Errorf("invalid channel %d: value too short", v.Channel)
+	}
+
+	switch dataType {
+	case umbTypeUChar:
+		v.Value = float64(val[0])
+	case umbTypeSChar:
+		v.Value = float64(int8(val[0]))
+	case umbTypeUShort:
+		v.Value = float64(binary.LittleEndian.Uint16(val))
+	case umbTypeSShort:
+		v.Value = float64(int16(binary.LittleEndian.Uint16(val)))
+	case umbTypeULong:
+		v.Value = float64(binary.LittleEndian.Uint32(val))
+	case umbTypeSLong:
+		v.Value = float64(int32(binary.LittleEndian.Uint32(val)))
+	case umbTypeFloat:
+		v.Value = float64(math.Float32frombits(binary.LittleEndian.Uint32(val)))
+	case umbTypeDouble:
+		v.Value = math.Float64frombits(binary.LittleEndian.Uint64(val))
+	}
+
+	return v, nil
+}
+
+// NewLufftFromUMB maps the channel values of an online data frame to an observation.
+// UMB values carry no timestamp so the time of receipt is used.
+func NewLufftFromUMB(f *UMBFrame, timestamp time.Time) (l *Lufft, err error) {
+	values, err := f.ChannelValues()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]*float32)
+	var errChannels []string
+	for _, v := range values {
+		field, ok := UMBChannels[v.Channel]
+		if !ok {
+			continue
+		}
+		if v.Status != 0 {
+			errChannels = append(errChannels, fmt.Sprintf("%d:%#x", v.Channel, v.Status))
+			continue
+		}
+		fields[field] = util.WrappedFloat[float32]{Value: float32(v.Value), Valid: true}.Round(2).GetRef()
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("invalid frame: no supported channels")
+	}
+
+	l = new(Lufft)
+	l.Obs = StationObservation{
+		Temp:      fields["temp"],
+		Rh:        fields["rh"],
+		Pres:      fields["pres"],
+		Mslp:      fields["mslp"],
+		Wspd:      fields["wspd"],
+		Wspdx:     fields["wspdx"],
+		Wdir:      fields["wdir"],
+		Srad:      fields["srad"],
+		Td:        fields["td"],
+		Wchill:    fields["wchill"],
+		Rr:        fields["rr"],
+		Timestamp: timestamp,
+	}
+
+	dataCount, dataStatus := uploadDataStatus(l.Obs)
+	l.Health = StationHealth{
+		Message:    fmt.Sprintf("%X", EncodeUMBFrame(*f)),
+		DataCount:  dataCount,
+		DataStatus: dataStatus,
+		Timestamp:  timestamp,
+	}
+	if len(errChannels) > 0 {
+		l.Health.ErrorMsg = fmt.Sprintf("channel error: %v", errChannels)
+	}
+
+	return l, nil
+}
@@ -0,0 +1,149 @@
+package sensor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUMBCRC16(t *testing.T) {
+	require.Equal(t, uint16(0x6F91), UMBCRC16([]byte("123456789")))
+}
+
+func TestUMB(t *testing.T) {
+	timeNow := time.Now().Truncate(time.Second)
+	testCases := []struct {
+		name        string
+		buildArg    func() []byte
+		checkResult func(l *Lufft, err error)
+	}{
+		{
+			name: "Default",
+			buildArg: func() []byte {
+				f := NewUMBOnlineDataFrame(0x7001, []UMBChannelValue{
+					{Channel: 100, Value: 28.5},
+					{Channel: 200, Value: 81.25},
+					{Channel: 300, Value: 1008.5},
+					{Channel: 400, Value: 3.5},
+					{Channel: 420, Value: 7.25},
+					{Channel: 500, Value: 270},
+					{Channel: 820, Value: 1.2},
+					{Channel: 900, Value: 512},
+					{Channel: 4610, Value: 99},
+				})
+				return EncodeUMBFrame(f)
+			},
+			checkResult: func(l *Lufft, err error) {
+				require.NoError(t, err)
+
+				l1 := Lufft{
+					Obs: StationObservation{
+						Temp:      util.ToRef(float32(28.5)),
+						Rh:        util.ToRef(float32(81.25)),
+						Pres:      util.ToRef(float32(1008.5)),
+						Wspd:      util.ToRef(float32(3.5)),
+						Wspdx:     util.ToRef(float32(7.25)),
+						Wdir:      util.ToRef(float32(270)),
+						Rr:        util.ToRef(float32(1.2)),
+						Srad:      util.ToRef(float32(512)),
+						Timestamp: timeNow,
+					},
+				}
+				require.Equal(t, l1.Obs, l.Obs)
+				require.Equal(t, int32(8), l.Health.DataCount)
+				require.Equal(t, "1111111001", l.Health.DataStatus)
+				require.Equal(t, timeNow, l.Health.Timestamp)
+				require.Empty(t, l.Health.ErrorMsg)
+			},
+		},
+		{
+			name: "ChannelError",
+			buildArg: func() []byte {
+				f := NewUMBOnlineDataFrame(0x7001, []UMBChannelValue{
+					{Channel: 100, Value: 28.5},
+					{Channel: 200, Status: 0x28},
+				})
+				return EncodeUMBFrame(f)
+			},
+			checkResult: func(l *Lufft, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, l.Obs.Temp)
+				require.Nil(t, l.Obs.Rh)
+				require.Equal(t, "channel error: [200:0x28]", l.Health.ErrorMsg)
+			},
+		},
+		{
+			name: "CRCMismatch",
+			buildArg: func() []byte {
+				data := EncodeUMBFrame(NewUMBOnlineDataFrame(0x7001, []UMBChannelValue{{Channel: 100, Value: 28.5}}))
+				data[len(data)-2] ^= 0xFF
+				return data
+			},
+			checkResult: func(l *Lufft, err error) {
+				require.EqualError(t, err, "invalid frame: crc mismatch")
+			},
+		},
+		{
+			name: "WrongLength",
+			buildArg: func() []byte {
+				data := EncodeUMBFrame(NewUMBOnlineDataFrame(0x7001, []UMBChannelValue{{Channel: 100, Value: 28.5}}))
+				return data[:len(data)-1]
+			},
+			checkResult: func(l *Lufft, err error) {
+				require.EqualError(t, err, "invalid frame: wrong length")
+			},
+		},
+		{
+			name: "UnsupportedChannels",
+			buildArg: func() []byte {
+				return EncodeUMBFrame(NewUMBOnlineDataFrame(0x7001, []UMBChannelValue{{Channel: 4610, Value: 99}}))
+			},
+			checkResult: func(l *Lufft, err error) {
+				require.EqualError(t, err, "invalid frame: no supported channels")
+			},
+		},
+		{
+			name: "UnsupportedCommand",
+			buildArg: func() []byte {
+				return EncodeUMBFrame(UMBFrame{To: 0x7001, From: 0xF001, Cmd: 0x26, Verc: UMBVersion})
+			},
+			checkResult: func(l *Lufft, err error) {
+				require.EqualError(t, err, "unsupported command 0x26")
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			var l *Lufft
+			f, err := DecodeUMBFrame(tc.buildArg())
+			if err == nil {
+				l, err = NewLufftFromUMB(f, timeNow)
+			}
+			tc.checkResult(l, err)
+		})
+	}
+}
+
+func TestUMBOnlineData(t *testing.T) {
+	// single channel response of channel 100 as a signed short
+	f := UMBFrame{
+		To:      0xF001,
+		From:    0x7001,
+		Cmd:     UMBCmdOnlineData,
+		Verc:    UMBVersion,
+		Payload: []byte{0x00, 0x64, 0x00, 0x13, 0xF6, 0xFF},
+	}
+
+	gotFrame, err := DecodeUMBFrame(EncodeUMBFrame(f))
+	require.NoError(t, err)
+	require.Equal(t, "7001", gotFrame.DeviceID())
+
+	values, err := gotFrame.ChannelValues()
+	require.NoError(t, err)
+	require.Equal(t, []UMBChannelValue{{Channel: 100, Value: -10}}, values)
+}
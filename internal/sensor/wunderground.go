@@ -0,0 +1,138 @@
+package sensor
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+)
+
+const (
+	mphToMps   = 0.44704
+	inchToMm   = 25.4
+	inHgToMbar = 33.8639
+
+	uploadTimeFormat = "2006-01-02 15:04:05"
+)
+
+// WUnderground is an observation pushed using the Weather Underground upload protocol
+type WUnderground struct {
+	ID       string
+	Password string
+	Obs      StationObservation
+	Health   StationHealth
+}
+
+// WUndergroundCredentials returns the station id and password of an updateweatherstation.php request
+func WUndergroundCredentials(vals url.Values) (id, password string, err error) {
+	id = vals.Get("ID")
+	password = vals.Get("PASSWORD")
+	if id == "" || password == "" {
+		return "", "", fmt.Errorf("invalid request: missing station id or password")
+	}
+	return id, password, nil
+}
+
+// NewWUndergroundFromValues parses the query of an updateweatherstation.php request.
+// Imperial values are converted to metric.
+func NewWUndergroundFromValues(vals url.Values, settings ...ClockSettings) (w *WUnderground, err error) {
+	w = new(WUnderground)
+	w.ID, w.Password, err = WUndergroundCredentials(vals)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, minutesDiff, errMsg, err := parseUploadTimestamp(vals.Get("dateutc"), newClockSettings(settings))
+	if err != nil {
+		return nil, err
+	}
+
+	w.Obs = StationObservation{
+		Temp:      parseFahrenheit(vals.Get("tempf")),
+		Rh:        util.NewWrappedFloat[float32](vals.Get("humidity")).Round(2).GetRef(),
+		Mslp:      util.NewWrappedFloat[float32](vals.Get("baromin")).Convert(inHgToMbar).Round(2).GetRef(),
+		Wspd:      util.NewWrappedFloat[float32](vals.Get("windspeedmph")).Convert(mphToMps).Round(2).GetRef(),
+		Wspdx:     util.NewWrappedFloat[float32](vals.Get("windgustmph")).Convert(mphToMps).Round(2).GetRef(),
+		Wdir:      util.NewWrappedFloat[float32](vals.Get("winddir")).Round(2).GetRef(),
+		Srad:      util.NewWrappedFloat[float32](vals.Get("solarradiation")).Round(2).GetRef(),
+		Td:        parseFahrenheit(vals.Get("dewptf")),
+		Wchill:    parseFahrenheit(vals.Get("windchillf")),
+		RainAccum: util.NewWrappedFloat[float32](vals.Get("rainin")).Convert(inchToMm).Round(2).GetRef(),
+		Timestamp: timestamp,
+	}
+
+	dataCount, dataStatus := uploadDataStatus(w.Obs)
+	w.Health = StationHealth{
+		Message:           EncodeUploadMessage(vals, "PASSWORD"),
+		ErrorMsg:          errMsg,
+		MinutesDifference: int32(minutesDiff),
+		DataCount:         dataCount,
+		DataStatus:        dataStatus,
+		Timestamp:         timestamp,
+	}
+
+	return w, nil
+}
+
+// parseUploadTimestamp parses the dateutc parameter shared by the Weather Underground and Ecowitt protocols
+func parseUploadTimestamp(dateStr string, cs ClockSettings) (time.Time, float64, string, error) {
+	timeNow := time.Now()
+	if dateStr == "" || strings.EqualFold(dateStr, "now") {
+		return timeNow, 0.0, "", nil
+	}
+
+	timestamp, err := time.ParseInLocation(uploadTimeFormat, dateStr, time.UTC)
+	if err != nil {
+		return time.Time{}, 0.0, "", fmt.Errorf("invalid dateutc: %s", dateStr)
+	}
+
+	timestamp, minutesDiff, errMsg := cs.correctTimestamp(timestamp, timeNow)
+	return timestamp, minutesDiff, errMsg, nil
+}
+
+func parseFahrenheit(s string) *float32 {
+	return util.NewWrappedFloat[float32](s).Apply(func(v float32) float32 {
+		return (v - 32.0) * (5.0 / 9.0)
+	}).Round(2).GetRef()
+}
+
+func uploadDataStatus(obs StationObservation) (int32, string) {
+	pres := obs.Pres
+	if pres == nil {
+		pres = obs.Mslp
+	}
+	rain := obs.Rr
+	if rain == nil {
+		rain = obs.RainAccum
+	}
+
+	var dataCount int32
+	dataStatus := ""
+	for _, v := range []*float32{
+		obs.Temp, obs.Rh, pres, obs.Wspd, obs.Wspdx,
+		obs.Wdir, obs.Srad, obs.Td, obs.Wchill, rain,
+	} {
+		b := 0
+		if v != nil {
+			dataCount++
+			b = 1
+		}
+
+		dataStatus += fmt.Sprintf("%d", b)
+	}
+	return dataCount, dataStatus
+}
+
+// EncodeUploadMessage encodes the request values without the secret, for storing or logging
+func EncodeUploadMessage(vals url.Values, secretKey string) string {
+	msg := url.Values{}
+	for k, v := range vals {
+		if k == secretKey {
+			continue
+		}
+		msg[k] = v
+	}
+	return msg.Encode()
+}
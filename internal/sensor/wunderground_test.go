@@ -0,0 +1,143 @@
+package sensor
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWUnderground(t *testing.T) {
+	timeNow := time.Now().UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name        string
+		buildArg    func() (url.Values, *WUnderground, error)
+		checkResult func(vals url.Values, w *WUnderground, err error)
+	}{
+		{
+			name: "Default",
+			buildArg: func() (url.Values, *WUnderground, error) {
+				vals := url.Values{
+					"ID":             {"KXXX001"},
+					"PASSWORD":       {"secret"},
+					"dateutc":        {timeNow.Format(uploadTimeFormat)},
+					"tempf":          {"86"},
+					"humidity":       {"75"},
+					"dewptf":         {"77"},
+					"windchillf":     {"86"},
+					"winddir":        {"180"},
+					"windspeedmph":   {"10"},
+					"windgustmph":    {"20"},
+					"rainin":         {"0.1"},
+					"baromin":        {"29.92"},
+					"solarradiation": {"500"},
+					"action":         {"updateraw"},
+				}
+				w, err := NewWUndergroundFromValues(vals)
+				return vals, w, err
+			},
+			checkResult: func(vals url.Values, w *WUnderground, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "KXXX001", w.ID)
+				require.Equal(t, "secret", w.Password)
+
+				require.InDelta(t, 30.0, *w.Obs.Temp, 0.01)
+				require.InDelta(t, 75.0, *w.Obs.Rh, 0.01)
+				require.InDelta(t, 25.0, *w.Obs.Td, 0.01)
+				require.InDelta(t, 30.0, *w.Obs.Wchill, 0.01)
+				require.InDelta(t, 180.0, *w.Obs.Wdir, 0.01)
+				require.InDelta(t, 4.47, *w.Obs.Wspd, 0.01)
+				require.InDelta(t, 8.94, *w.Obs.Wspdx, 0.01)
+				require.Nil(t, w.Obs.Rr)
+				require.InDelta(t, 2.54, *w.Obs.RainAccum, 0.01)
+				require.InDelta(t, 1013.21, *w.Obs.Mslp, 0.01)
+				require.InDelta(t, 500.0, *w.Obs.Srad, 0.01)
+				require.Nil(t, w.Obs.Pres)
+				require.True(t, timeNow.Equal(w.Obs.Timestamp))
+
+				require.NotContains(t, w.Health.Message, "secret")
+				require.Equal(t, int32(0), w.Health.MinutesDifference)
+				require.Empty(t, w.Health.ErrorMsg)
+				require.Equal(t, int32(10), w.Health.DataCount)
+				require.Equal(t, "1111111111", w.Health.DataStatus)
+			},
+		},
+		{
+			name: "Now",
+			buildArg: func() (url.Values, *WUnderground, error) {
+				vals := url.Values{
+					"ID":       {"KXXX001"},
+					"PASSWORD": {"secret"},
+					"dateutc":  {"now"},
+					"tempf":    {"86"},
+				}
+				w, err := NewWUndergroundFromValues(vals)
+				return vals, w, err
+			},
+			checkResult: func(vals url.Values, w *WUnderground, err error) {
+				require.NoError(t, err)
+				require.WithinDuration(t, time.Now(), w.Obs.Timestamp, time.Second)
+				require.Equal(t, int32(1), w.Health.DataCount)
+				require.Equal(t, "1000000000", w.Health.DataStatus)
+			},
+		},
+		{
+			name: "ClockOffset",
+			buildArg: func() (url.Values, *WUnderground, error) {
+				vals := url.Values{
+					"ID":       {"KXXX001"},
+					"PASSWORD": {"secret"},
+					"dateutc":  {timeNow.Add(-30 * time.Minute).Format(uploadTimeFormat)},
+				}
+				w, err := NewWUndergroundFromValues(vals, ClockSettings{ClockOffset: 30})
+				return vals, w, err
+			},
+			checkResult: func(vals url.Values, w *WUnderground, err error) {
+				require.NoError(t, err)
+				require.True(t, timeNow.Equal(w.Obs.Timestamp))
+				require.Equal(t, int32(30), w.Health.MinutesDifference)
+			},
+		},
+		{
+			name: "MissingPassword",
+			buildArg: func() (url.Values, *WUnderground, error) {
+				vals := url.Values{
+					"ID":    {"KXXX001"},
+					"tempf": {"86"},
+				}
+				w, err := NewWUndergroundFromValues(vals)
+				return vals, w, err
+			},
+			checkResult: func(vals url.Values, w *WUnderground, err error) {
+				require.Error(t, err)
+				require.Empty(t, w)
+			},
+		},
+		{
+			name: "InvalidDate",
+			buildArg: func() (url.Values, *WUnderground, error) {
+				vals := url.Values{
+					"ID":       {"KXXX001"},
+					"PASSWORD": {"secret"},
+					"dateutc":  {"yesterday"},
+				}
+				w, err := NewWUndergroundFromValues(vals)
+				return vals, w, err
+			},
+			checkResult: func(vals url.Values, w *WUnderground, err error) {
+				require.Error(t, err)
+				require.Empty(t, w)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			vals, w, err := tc.buildArg()
+			tc.checkResult(vals, w, err)
+		})
+	}
+}
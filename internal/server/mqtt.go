@@ -0,0 +1,227 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/handlers"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	mqttDefaultClientID   = "panahon-api"
+	mqttDefaultMinBackoff = 1 * time.Second
+	mqttDefaultMaxBackoff = 2 * time.Minute
+	mqttDeviceSegment     = "{device}"
+	mqttStoreTimeout      = 30 * time.Second
+	mqttDisconnectQuiesce = 250
+)
+
+// MQTTSubscriber stores JSON observations published by stations to an MQTT broker
+type MQTTSubscriber struct {
+	config util.MQTTConfig
+	store  db.Store
+	logger *zerolog.Logger
+	topics []mqttTopic
+	opts   *mqtt.ClientOptions
+	msgs   sync.WaitGroup
+	// closed stops new messages from being tracked once shutdown waits for msgs
+	mu     sync.Mutex
+	closed bool
+}
+
+type mqttTopic struct {
+	util.MQTTTopic
+	filter    string
+	deviceIdx int
+}
+
+// NewMQTTSubscriber validates the topic mappings and creates a new MQTT subscriber
+func NewMQTTSubscriber(config util.MQTTConfig, username, password string, store db.Store, logger *zerolog.Logger) (*MQTTSubscriber, error) {
+	if config.Broker == "" {
+		return nil, errors.New("missing mqtt broker")
+	}
+	if len(config.Topics) == 0 {
+		return nil, errors.New("missing mqtt topics")
+	}
+	if config.ClientID == "" {
+		config.ClientID = mqttDefaultClientID
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = mqttDefaultMinBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = max(mqttDefaultMaxBackoff, config.MinBackoff)
+	}
+
+	s := &MQTTSubscriber{
+		config: config,
+		store:  store,
+		logger: logger,
+	}
+
+	for _, t := range config.Topics {
+		topic, err := newMQTTTopic(t)
+		if err != nil {
+			return nil, err
+		}
+		s.topics = append(s.topics, topic)
+	}
+
+	s.opts = mqtt.NewClientOptions().
+		AddBroker(config.Broker).
+		SetClientID(config.ClientID).
+		SetUsername(username).
+		SetPassword(password).
+		SetCleanSession(true).
+		SetOrderMatters(false).
+		SetAutoReconnect(true).
+		SetMaxReconnectInterval(config.MaxBackoff).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			s.logger.Warn().Err(err).Msg("[MQTT] connection lost")
+		}).
+		SetReconnectingHandler(func(_ mqtt.Client, _ *mqtt.ClientOptions) {
+			s.logger.Info().Msg("[MQTT] reconnecting")
+		})
+
+	return s, nil
+}
+
+func newMQTTTopic(t util.MQTTTopic) (mqttTopic, error) {
+	if err := sensor.ValidateJSONFields(t.Fields); err != nil {
+		return mqttTopic{}, fmt.Errorf("invalid mqtt topic %s: %w", t.Pattern, err)
+	}
+
+	topic := mqttTopic{MQTTTopic: t, deviceIdx: -1}
+	segments := strings.Split(t.Pattern, "/")
+	for i, seg := range segments {
+		if seg == mqttDeviceSegment {
+			if topic.deviceIdx >= 0 {
+				return mqttTopic{}, fmt.Errorf("invalid mqtt topic %s: multiple device segments", t.Pattern)
+			}
+			topic.deviceIdx = i
+			segments[i] = "+"
+		}
+	}
+	if topic.deviceIdx < 0 && t.StationID == 0 {
+		return mqttTopic{}, fmt.Errorf("invalid mqtt topic %s: missing device segment or station id", t.Pattern)
+	}
+	topic.filter = strings.Join(segments, "/")
+
+	return topic, nil
+}
+
+func (s *MQTTSubscriber) Start(ctx context.Context, g *errgroup.Group) {
+	// subscriptions are lost on reconnect with a clean session
+	s.opts.SetOnConnectHandler(func(c mqtt.Client) {
+		s.logger.Info().Msgf("connected to mqtt broker: %s", s.config.Broker)
+		for _, t := range s.topics {
+			token := c.Subscribe(t.filter, s.config.QoS, func(_ mqtt.Client, msg mqtt.Message) {
+				if !s.beginMessage() {
+					return
+				}
+				defer s.msgs.Done()
+				s.handleMessage(ctx, t, msg.Topic(), msg.Payload())
+			})
+			if token.Wait() && token.Error() != nil {
+				s.logger.Error().Err(token.Error()).Str("topic", t.filter).Msg("[MQTT] subscribe error")
+			}
+		}
+	})
+	client := mqtt.NewClient(s.opts)
+
+	g.Go(func() error {
+		s.logger.Info().Msgf("starting mqtt subscriber: %s", s.config.Broker)
+		backoff := s.config.MinBackoff
+		for {
+			token := client.Connect()
+			token.Wait()
+			err := token.Error()
+			if err == nil {
+				break
+			}
+
+			s.logger.Error().Err(err).Msgf("[MQTT] connect error, retrying in %s", backoff)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, s.config.MaxBackoff)
+		}
+
+		<-ctx.Done()
+		s.logger.Info().Msg("shutting down mqtt subscriber")
+		client.Disconnect(mqttDisconnectQuiesce)
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+		s.msgs.Wait()
+		return nil
+	})
+}
+
+// beginMessage tracks a message until it is stored, it reports false once the subscriber is shutting down
+func (s *MQTTSubscriber) beginMessage() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.msgs.Add(1)
+	return true
+}
+
+func (s *MQTTSubscriber) handleMessage(ctx context.Context, t mqttTopic, topic string, payload []byte) {
+	// a message received before shutdown is still stored
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), mqttStoreTimeout)
+	defer cancel()
+
+	stationID := t.StationID
+	if t.deviceIdx >= 0 {
+		deviceID := strings.Split(topic, "/")[t.deviceIdx]
+		uStn, err := s.store.GetUploadStation(ctx, db.GetUploadStationParams{
+			Protocol: handlers.UploadProtocolMQTT,
+			UploadID: deviceID,
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				s.logger.Warn().Str("topic", topic).Msg("[MQTT] unknown device")
+				return
+			}
+			s.logger.Error().Err(err).Str("topic", topic).Msg("[MQTT] store error")
+			return
+		}
+		stationID = uStn.StationID
+	}
+
+	clockSettings, _, err := service.GetClockSettings(ctx, s.store, stationID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("topic", topic).Msg("[MQTT] store error")
+		return
+	}
+
+	p, err := sensor.NewJSONPayload(payload, t.Fields, clockSettings)
+	if err != nil {
+		s.logger.Error().Err(err).Str("topic", topic).Msg("[MQTT] parse error")
+		return
+	}
+
+	_, _, err = service.StoreStationObservation(ctx, s.store, stationID, p.Obs, p.Health)
+	if err != nil {
+		s.logger.Error().Err(err).Str("topic", topic).Msg("[MQTT] store error")
+		return
+	}
+
+	s.logger.Debug().Int64("station_id", stationID).Str("topic", topic).Msg("[MQTT] observation stored")
+}
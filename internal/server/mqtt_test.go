@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/handlers"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	mochi "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestMQTTSubscriber(t *testing.T) {
+	fields := map[string]string{
+		"temp": "t",
+		"rh":   "h",
+		"vb1":  "batt",
+	}
+	payload := []byte(`{"t":28.5,"h":81,"batt":3.7}`)
+
+	testCases := []struct {
+		name         string
+		topic        util.MQTTTopic
+		publishTopic string
+		delayBroker  bool
+		buildStubs   func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams)
+		checkResult  func(t *testing.T, stored <-chan db.CreateStationObservationParams)
+	}{
+		{
+			name:         "DeviceTopic",
+			topic:        util.MQTTTopic{Pattern: "esp32/{device}/obs", Fields: fields},
+			publishTopic: "esp32/dev01/obs",
+			buildStubs: func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams) {
+				store.EXPECT().GetUploadStation(mock.Anything, db.GetUploadStationParams{
+					Protocol: handlers.UploadProtocolMQTT,
+					UploadID: "dev01",
+				}).Return(db.UploadStation{StationID: 42}, nil)
+				store.EXPECT().GetStationClock(mock.Anything, int64(42)).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+				stubStoreObservation(store, stored)
+			},
+			checkResult: func(t *testing.T, stored <-chan db.CreateStationObservationParams) {
+				arg := requireStored(t, stored)
+				require.Equal(t, int64(42), arg.StationID)
+				require.Equal(t, float32(28.5), arg.Temp.Float32)
+				require.Equal(t, float32(81), arg.Rh.Float32)
+			},
+		},
+		{
+			name:         "FixedStation",
+			topic:        util.MQTTTopic{Pattern: "panahon/tower", StationID: 7, Fields: fields},
+			publishTopic: "panahon/tower",
+			buildStubs: func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams) {
+				store.EXPECT().GetStationClock(mock.Anything, int64(7)).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+				stubStoreObservation(store, stored)
+			},
+			checkResult: func(t *testing.T, stored <-chan db.CreateStationObservationParams) {
+				arg := requireStored(t, stored)
+				require.Equal(t, int64(7), arg.StationID)
+			},
+		},
+		{
+			name:         "BrokerStartsLater",
+			topic:        util.MQTTTopic{Pattern: "panahon/tower", StationID: 7, Fields: fields},
+			publishTopic: "panahon/tower",
+			delayBroker:  true,
+			buildStubs: func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams) {
+				store.EXPECT().GetStationClock(mock.Anything, int64(7)).
+					Return(db.StationClock{}, db.ErrRecordNotFound)
+				stubStoreObservation(store, stored)
+			},
+			checkResult: func(t *testing.T, stored <-chan db.CreateStationObservationParams) {
+				arg := requireStored(t, stored)
+				require.Equal(t, int64(7), arg.StationID)
+			},
+		},
+		{
+			name:         "UnknownDevice",
+			topic:        util.MQTTTopic{Pattern: "esp32/{device}/obs", Fields: fields},
+			publishTopic: "esp32/dev02/obs",
+			buildStubs: func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams) {
+				store.EXPECT().GetUploadStation(mock.Anything, mock.Anything).
+					Return(db.UploadStation{}, db.ErrRecordNotFound).
+					Run(func(_ context.Context, _ db.GetUploadStationParams) {
+						stored <- db.CreateStationObservationParams{}
+					})
+			},
+			checkResult: func(t *testing.T, stored <-chan db.CreateStationObservationParams) {
+				arg := requireStored(t, stored)
+				require.Zero(t, arg.StationID)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			stored := make(chan db.CreateStationObservationParams, 4)
+			tc.buildStubs(store, stored)
+
+			address := freeAddress(t)
+			if !tc.delayBroker {
+				startTestBroker(t, address, tc.publishTopic, payload)
+			}
+
+			logger := zerolog.Nop()
+			subscriber, err := NewMQTTSubscriber(util.MQTTConfig{
+				Broker:     "tcp://" + address,
+				ClientID:   "test-" + tc.name,
+				QoS:        1,
+				MinBackoff: 50 * time.Millisecond,
+				MaxBackoff: 200 * time.Millisecond,
+				Topics:     []util.MQTTTopic{tc.topic},
+			}, "", "", store, &logger)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			g, ctx := errgroup.WithContext(ctx)
+			subscriber.Start(ctx, g)
+
+			if tc.delayBroker {
+				time.Sleep(200 * time.Millisecond)
+				startTestBroker(t, address, tc.publishTopic, payload)
+			}
+
+			tc.checkResult(t, stored)
+
+			cancel()
+			require.NoError(t, g.Wait())
+		})
+	}
+}
+
+func TestNewMQTTSubscriber(t *testing.T) {
+	fields := map[string]string{"temp": "t"}
+
+	testCases := []struct {
+		name   string
+		config util.MQTTConfig
+		errMsg string
+	}{
+		{
+			name:   "MissingBroker",
+			config: util.MQTTConfig{Topics: []util.MQTTTopic{{Pattern: "a/{device}", Fields: fields}}},
+			errMsg: "missing mqtt broker",
+		},
+		{
+			name:   "MissingTopics",
+			config: util.MQTTConfig{Broker: "tcp://localhost:1883"},
+			errMsg: "missing mqtt topics",
+		},
+		{
+			name: "MissingStation",
+			config: util.MQTTConfig{
+				Broker: "tcp://localhost:1883",
+				Topics: []util.MQTTTopic{{Pattern: "a/+", Fields: fields}},
+			},
+			errMsg: "invalid mqtt topic a/+: missing device segment or station id",
+		},
+		{
+			name: "MultipleDeviceSegments",
+			config: util.MQTTConfig{
+				Broker: "tcp://localhost:1883",
+				Topics: []util.MQTTTopic{{Pattern: "{device}/{device}", Fields: fields}},
+			},
+			errMsg: "invalid mqtt topic {device}/{device}: multiple device segments",
+		},
+		{
+			name: "UnknownColumn",
+			config: util.MQTTConfig{
+				Broker: "tcp://localhost:1883",
+				Topics: []util.MQTTTopic{{Pattern: "a/{device}", Fields: map[string]string{"temperature": "t"}}},
+			},
+			errMsg: "invalid mqtt topic a/{device}: unknown column: temperature",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			_, err := NewMQTTSubscriber(tc.config, "", "", mockdb.NewMockStore(t), &logger)
+			require.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
+
+// startTestBroker starts an embedded broker holding a retained message for the subscriber
+func startTestBroker(t *testing.T, address, topic string, payload []byte) {
+	broker := mochi.New(&mochi.Options{
+		InlineClient: true,
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	require.NoError(t, broker.AddHook(new(auth.AllowHook), nil))
+	require.NoError(t, broker.AddListener(listeners.NewTCP(listeners.Config{ID: "test", Address: address})))
+	require.NoError(t, broker.Serve())
+	t.Cleanup(func() {
+		broker.Close()
+	})
+
+	require.NoError(t, broker.Publish(topic, payload, true, 1))
+}
+
+func freeAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	return l.Addr().String()
+}
+
+func requireStored(t *testing.T, stored <-chan db.CreateStationObservationParams) db.CreateStationObservationParams {
+	select {
+	case arg := <-stored:
+		return arg
+	case <-time.After(5 * time.Second):
+		t.Fatal("observation not stored")
+	}
+	return db.CreateStationObservationParams{}
+}
+
+func TestMQTTSubscriberBeginMessage(t *testing.T) {
+	s := &MQTTSubscriber{}
+
+	require.True(t, s.beginMessage())
+	s.msgs.Done()
+
+	s.closed = true
+	require.False(t, s.beginMessage())
+	s.msgs.Wait()
+}
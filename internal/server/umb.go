@@ -0,0 +1,242 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/handlers"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	umbIdleTimeout  = 30 * time.Minute
+	umbStoreTimeout = 30 * time.Second
+)
+
+// UMBServer receives UMB binary frames pushed by Lufft loggers over TCP.
+// UMB frames carry no credentials, so only connections from the allowed sources are read.
+type UMBServer struct {
+	store          db.Store
+	logger         *zerolog.Logger
+	listener       net.Listener
+	allowedSources []netip.Prefix
+	conns          sync.WaitGroup
+}
+
+// NewUMBServer creates a new UMB listener on the given address.
+// allowedSources is a comma-separated list of IP addresses or CIDR ranges the loggers connect from.
+func NewUMBServer(address, allowedSources string, store db.Store, logger *zerolog.Logger) (*UMBServer, error) {
+	allowed, err := parseAllowedSources(allowedSources)
+	if err != nil {
+		return nil, err
+	}
+	if len(allowed) == 0 {
+		return nil, errors.New("umb server requires at least one allowed source")
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UMBServer{
+		store:          store,
+		logger:         logger,
+		listener:       listener,
+		allowedSources: allowed,
+	}, nil
+}
+
+// Addr returns the address the server is listening on
+func (s *UMBServer) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+func (s *UMBServer) Start(ctx context.Context, g *errgroup.Group) {
+	g.Go(func() error {
+		s.logger.Info().Msgf("starting umb server: %s", s.listener.Addr())
+		for {
+			conn, err := s.listener.Accept()
+			if err != nil {
+				if errors.Is(err, net.ErrClosed) {
+					s.conns.Wait()
+					return nil
+				}
+				s.logger.Error().Err(err).Msg("failed to run umb server")
+				return err
+			}
+
+			s.conns.Add(1)
+			go func() {
+				defer s.conns.Done()
+				s.handleConn(ctx, conn)
+			}()
+		}
+	})
+
+	g.Go(func() error {
+		<-ctx.Done()
+		s.logger.Info().Msg("shutting down umb server")
+		return s.listener.Close()
+	})
+}
+
+// handleConn reads frames until the logger disconnects, stays idle or the server shuts down
+func (s *UMBServer) handleConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	remoteAddr := conn.RemoteAddr().String()
+	if !s.isAllowed(conn.RemoteAddr()) {
+		s.logger.Warn().Str("remote_addr", remoteAddr).Msg("[UMB] source not allowed")
+		return
+	}
+
+	r := bufio.NewReader(conn)
+	for {
+		conn.SetReadDeadline(time.Now().Add(umbIdleTimeout))
+
+		data, err := readUMBFrame(r)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				s.logger.Error().Err(err).Str("remote_addr", remoteAddr).Msg("[UMB] read error")
+			}
+			return
+		}
+
+		if err := s.storeFrame(ctx, data); err != nil {
+			s.logger.Error().Err(err).Str("remote_addr", remoteAddr).Msg("[UMB] store error")
+		}
+	}
+}
+
+func (s *UMBServer) storeFrame(ctx context.Context, data []byte) error {
+	frame, err := sensor.DecodeUMBFrame(data)
+	if err != nil {
+		return err
+	}
+
+	lufft, err := sensor.NewLufftFromUMB(frame, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, umbStoreTimeout)
+	defer cancel()
+
+	uStn, err := s.store.GetUploadStation(ctx, db.GetUploadStationParams{
+		Protocol: handlers.UploadProtocolUMB,
+		UploadID: frame.DeviceID(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			s.logger.Warn().Str("device_id", frame.DeviceID()).Msg("[UMB] unknown device")
+			return nil
+		}
+		return err
+	}
+
+	_, _, err = service.StoreStationObservation(ctx, s.store, uStn.StationID, lufft.Obs, lufft.Health)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Debug().Int64("station_id", uStn.StationID).Str("device_id", frame.DeviceID()).Msg("[UMB] observation stored")
+	return nil
+}
+
+// isAllowed reports whether the connection comes from one of the allowed sources
+func (s *UMBServer) isAllowed(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	ip, ok := netip.AddrFromSlice(tcpAddr.IP)
+	if !ok {
+		return false
+	}
+	ip = ip.Unmap()
+
+	for _, p := range s.allowedSources {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAllowedSources parses a comma-separated list of IP addresses and CIDR ranges
+func parseAllowedSources(s string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, src := range strings.Split(s, ",") {
+		src = strings.TrimSpace(src)
+		if src == "" {
+			continue
+		}
+
+		if strings.Contains(src, "/") {
+			p, err := netip.ParsePrefix(src)
+			if err != nil {
+				return nil, fmt.Errorf("invalid umb allowed source %q: %w", src, err)
+			}
+			prefixes = append(prefixes, p.Masked())
+			continue
+		}
+
+		ip, err := netip.ParseAddr(src)
+		if err != nil {
+			return nil, fmt.Errorf("invalid umb allowed source %q: %w", src, err)
+		}
+		ip = ip.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(ip, ip.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// readUMBFrame skips bytes until SOH and reads one complete frame
+func readUMBFrame(r *bufio.Reader) ([]byte, error) {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if b == sensor.UMBSoh {
+			break
+		}
+	}
+
+	header := make([]byte, sensor.UMBHeaderLen)
+	header[0] = sensor.UMBSoh
+	if _, err := io.ReadFull(r, header[1:]); err != nil {
+		return nil, err
+	}
+
+	// STX, payload length bytes, ETX, CRC16 and EOT
+	rest := make([]byte, 1+int(header[sensor.UMBHeaderLen-1])+sensor.UMBTrailerLen)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return nil, err
+	}
+
+	return append(header, rest...), nil
+}
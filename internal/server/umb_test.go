@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/handlers"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestUMBServer(t *testing.T) {
+	uStn := db.UploadStation{
+		ID:        1,
+		StationID: 42,
+		Protocol:  handlers.UploadProtocolUMB,
+		UploadID:  "7001",
+	}
+	frame := sensor.EncodeUMBFrame(sensor.NewUMBOnlineDataFrame(0x7001, []sensor.UMBChannelValue{
+		{Channel: 100, Value: 28.5},
+		{Channel: 200, Value: 81},
+	}))
+
+	testCases := []struct {
+		name       string
+		buildData  func() [][]byte
+		buildStubs func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams)
+		nStored    int
+	}{
+		{
+			name: "OK",
+			buildData: func() [][]byte {
+				return [][]byte{frame}
+			},
+			buildStubs: func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams) {
+				store.EXPECT().GetUploadStation(mock.Anything, db.GetUploadStationParams{
+					Protocol: handlers.UploadProtocolUMB,
+					UploadID: "7001",
+				}).Return(uStn, nil)
+				stubStoreObservation(store, stored)
+			},
+			nStored: 1,
+		},
+		{
+			name: "SplitAndNoise",
+			buildData: func() [][]byte {
+				data := append([]byte{0x00, 0xFF}, frame...)
+				data = append(data, frame...)
+				return [][]byte{data[:5], data[5:20], data[20:]}
+			},
+			buildStubs: func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams) {
+				store.EXPECT().GetUploadStation(mock.Anything, mock.Anything).Return(uStn, nil)
+				stubStoreObservation(store, stored)
+			},
+			nStored: 2,
+		},
+		{
+			name: "InvalidFrame",
+			buildData: func() [][]byte {
+				badFrame := append([]byte{}, frame...)
+				badFrame[len(badFrame)-2] ^= 0xFF
+				return [][]byte{badFrame, frame}
+			},
+			buildStubs: func(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams) {
+				store.EXPECT().GetUploadStation(mock.Anything, mock.Anything).Return(uStn, nil).Once()
+				stubStoreObservation(store, stored)
+			},
+			nStored: 1,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			stored := make(chan db.CreateStationObservationParams, 4)
+			tc.buildStubs(store, stored)
+
+			logger := zerolog.Nop()
+			server, err := NewUMBServer("127.0.0.1:0", "127.0.0.1", store, &logger)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			g, ctx := errgroup.WithContext(ctx)
+			server.Start(ctx, g)
+
+			// fake logger pushing frames
+			conn, err := net.Dial("tcp", server.Addr().String())
+			require.NoError(t, err)
+			for _, data := range tc.buildData() {
+				_, err = conn.Write(data)
+				require.NoError(t, err)
+			}
+
+			for range tc.nStored {
+				select {
+				case arg := <-stored:
+					require.Equal(t, uStn.StationID, arg.StationID)
+					require.Equal(t, float32(28.5), arg.Temp.Float32)
+					require.Equal(t, float32(81), arg.Rh.Float32)
+				case <-time.After(5 * time.Second):
+					t.Fatal("observation not stored")
+				}
+			}
+
+			cancel()
+			require.NoError(t, g.Wait())
+			conn.Close()
+		})
+	}
+}
+
+func TestUMBServerUnknownDevice(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	looked := make(chan struct{}, 1)
+	store.EXPECT().GetUploadStation(mock.Anything, mock.Anything).
+		Return(db.UploadStation{}, db.ErrRecordNotFound).
+		Run(func(_ context.Context, _ db.GetUploadStationParams) {
+			looked <- struct{}{}
+		})
+
+	logger := zerolog.Nop()
+	server, err := NewUMBServer("127.0.0.1:0", "127.0.0.1", store, &logger)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+	server.Start(ctx, g)
+
+	conn, err := net.Dial("tcp", server.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write(sensor.EncodeUMBFrame(sensor.NewUMBOnlineDataFrame(0x7002, []sensor.UMBChannelValue{
+		{Channel: 100, Value: 28.5},
+	})))
+	require.NoError(t, err)
+
+	select {
+	case <-looked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("device not looked up")
+	}
+
+	cancel()
+	require.NoError(t, g.Wait())
+	store.AssertNotCalled(t, "CreateStationObservation", mock.Anything, mock.Anything)
+}
+
+func TestUMBServerSourceNotAllowed(t *testing.T) {
+	logger := zerolog.Nop()
+	_, err := NewUMBServer("127.0.0.1:0", "", mockdb.NewMockStore(t), &logger)
+	require.Error(t, err)
+	_, err = NewUMBServer("127.0.0.1:0", "10.0.0.0/33", mockdb.NewMockStore(t), &logger)
+	require.Error(t, err)
+
+	store := mockdb.NewMockStore(t)
+	server, err := NewUMBServer("127.0.0.1:0", "10.0.0.0/8, 192.168.1.10", store, &logger)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+	server.Start(ctx, g)
+
+	conn, err := net.Dial("tcp", server.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write(sensor.EncodeUMBFrame(sensor.NewUMBOnlineDataFrame(0x7001, []sensor.UMBChannelValue{
+		{Channel: 100, Value: 28.5},
+	})))
+	require.NoError(t, err)
+
+	// the server hangs up without reading any frame
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	require.Error(t, err)
+	require.False(t, errors.Is(err, os.ErrDeadlineExceeded))
+
+	cancel()
+	require.NoError(t, g.Wait())
+	store.AssertNotCalled(t, "GetUploadStation", mock.Anything, mock.Anything)
+}
+
+func stubStoreObservation(store *mockdb.MockStore, stored chan<- db.CreateStationObservationParams) {
+	store.EXPECT().CreateStationObservation(mock.Anything, mock.Anything).
+		Return(db.ObservationsObservation{}, nil).
+		Run(func(_ context.Context, arg db.CreateStationObservationParams) {
+			stored <- arg
+		})
+	store.EXPECT().CreateStationHealth(mock.Anything, mock.Anything).
+		Return(db.ObservationsStationhealth{}, nil)
+}
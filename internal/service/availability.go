@@ -0,0 +1,305 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	AvailabilityGroupByStation  = "station"
+	AvailabilityGroupByProvince = "province"
+	AvailabilityGroupByProvider = "provider"
+)
+
+const (
+	// DefaultReportingInterval is the minutes between records of stations without a reporting interval
+	DefaultReportingInterval = 10
+	// availabilityUnknownGroup is the group key of stations without a province or provider
+	availabilityUnknownGroup = "UNKNOWN"
+	availabilityDayLayout    = "2006-01-02"
+)
+
+type AvailabilityDay struct {
+	Day          string             `json:"day"`
+	Stations     int                `json:"stations"`    // stations expected to report
+	Maintenance  int                `json:"maintenance"` // stations with a maintenance window
+	Expected     int64              `json:"expected"`
+	Received     int64              `json:"received"`
+	Availability float64            `json:"availability"`             // percent of the expected records received
+	AvgDataCount *float64           `json:"avg_data_count,omitempty"` // average number of variables per record
+	Variables    map[string]float64 `json:"variables,omitempty"`      // percent of the expected records reporting each variable
+} //@name AvailabilityDay
+
+type AvailabilityGroup struct {
+	Key          string             `json:"key"`
+	Name         string             `json:"name,omitempty"`
+	Stations     int                `json:"stations"`
+	Maintenance  int                `json:"maintenance"` // station days with a maintenance window
+	Expected     int64              `json:"expected"`
+	Received     int64              `json:"received"`
+	Availability float64            `json:"availability"`
+	AvgDataCount *float64           `json:"avg_data_count,omitempty"`
+	Variables    map[string]float64 `json:"variables,omitempty"`
+	Days         []AvailabilityDay  `json:"days"`
+} //@name AvailabilityGroup
+
+// availabilityCount accumulates the record counts of station days
+type availabilityCount struct {
+	stations    int
+	maintenance int
+	expected    int64
+	received    int64
+	counted     int64 // received records capped at the expected records of each station day
+	health      int64 // health records
+	dataCount   int64
+	variables   map[string]int64
+}
+
+func (c *availabilityCount) add(o availabilityCount) {
+	c.stations += o.stations
+	c.maintenance += o.maintenance
+	c.expected += o.expected
+	c.received += o.received
+	c.counted += o.counted
+	c.health += o.health
+	c.dataCount += o.dataCount
+	for k, v := range o.variables {
+		if c.variables == nil {
+			c.variables = make(map[string]int64)
+		}
+		c.variables[k] += v
+	}
+}
+
+func (c availabilityCount) availability() float64 {
+	return percent(c.counted, c.expected)
+}
+
+func (c availabilityCount) avgDataCount() *float64 {
+	if c.health == 0 {
+		return nil
+	}
+	avg := math.Round(float64(c.dataCount)/float64(c.health)*100) / 100
+	return &avg
+}
+
+func (c availabilityCount) variablesCompleteness() map[string]float64 {
+	if len(c.variables) == 0 {
+		return nil
+	}
+	res := make(map[string]float64, len(c.variables))
+	for k, v := range c.variables {
+		res[k] = percent(v, c.expected)
+	}
+	return res
+}
+
+func percent(n, d int64) float64 {
+	if d == 0 {
+		return 0
+	}
+	p := math.Min(float64(n)/float64(d), 1) * 100
+	return math.Round(p*100) / 100
+}
+
+// ExpectedDailyRecords is the number of records a station sends in a day
+func ExpectedDailyRecords(reportingInterval pgtype.Int4) int64 {
+	interval := int32(DefaultReportingInterval)
+	if reportingInterval.Valid && reportingInterval.Int32 > 0 {
+		interval = reportingInterval.Int32
+	}
+	return int64(24 * 60 / interval)
+}
+
+// BuildAvailabilityReport compares the records received by each station per day with the records expected
+// from its reporting interval and groups them by station, province or provider.
+// start and end are the first and last days of the report. Inactive stations and the days before a
+// station was installed are not expected to report, nor are stations during their maintenance windows.
+func BuildAvailabilityReport(stations []db.ObservationsStation, records []db.ListStationDailyRecordCountsRow, dataStatus []db.ListStationDailyDataStatusRow, maintenance []db.StationMaintenance, start, end time.Time, groupBy string) []AvailabilityGroup {
+	type stationDay struct {
+		stationID int64
+		day       string
+	}
+
+	received := make(map[stationDay]int64, len(records))
+	for _, r := range records {
+		received[stationDay{r.StationID, r.Day.Time.Format(availabilityDayLayout)}] += r.RecordCount
+	}
+	health := make(map[stationDay]availabilityCount)
+	for _, r := range dataStatus {
+		key := stationDay{r.StationID, r.Day.Time.Format(availabilityDayLayout)}
+		c := availabilityCount{
+			health:    r.RecordCount,
+			dataCount: r.DataCount,
+			variables: make(map[string]int64),
+		}
+		for k, ok := range sensor.ParseDataStatus(r.DataStatus) {
+			if ok {
+				c.variables[k] = r.RecordCount
+			}
+		}
+		h := health[key]
+		h.add(c)
+		health[key] = h
+	}
+
+	windows := make(map[int64][]db.StationMaintenance)
+	for _, m := range maintenance {
+		windows[m.StationID] = append(windows[m.StationID], m)
+	}
+
+	var days []time.Time
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+
+	type group struct {
+		AvailabilityGroup
+		total availabilityCount
+		days  []availabilityCount
+	}
+	groups := make(map[string]*group)
+	var order []string
+	for _, stn := range stations {
+		if stn.Status.String == StationStatusInactive {
+			continue
+		}
+
+		key, name := availabilityGroupKey(stn, groupBy)
+		g, ok := groups[key]
+		if !ok {
+			g = &group{
+				AvailabilityGroup: AvailabilityGroup{Key: key, Name: name},
+				days:              make([]availabilityCount, len(days)),
+			}
+			groups[key] = g
+			order = append(order, key)
+		}
+
+		expected := ExpectedDailyRecords(stn.ReportingInterval)
+		counted := false
+		installed := ""
+		if stn.DateInstalled.Valid {
+			installed = stn.DateInstalled.Time.Format(availabilityDayLayout)
+		}
+		for i, d := range days {
+			key := stationDay{stn.ID, d.Format(availabilityDayLayout)}
+			if key.day < installed {
+				continue
+			}
+			c := health[key]
+			c.stations = 1
+			c.expected = expected
+			if off := maintenanceDuration(windows[stn.ID], d, d.AddDate(0, 0, 1)); off > 0 {
+				c.maintenance = 1
+				c.expected -= int64(math.Round(float64(expected) * off.Hours() / 24))
+			}
+			c.received = received[key]
+			c.counted = min(c.received, c.expected)
+			for k, v := range c.variables {
+				c.variables[k] = min(v, c.expected)
+			}
+
+			g.days[i].add(c)
+			g.total.add(c)
+			counted = true
+		}
+		if counted {
+			g.Stations++
+		}
+	}
+
+	if groupBy != AvailabilityGroupByStation {
+		sort.Strings(order)
+	}
+
+	res := make([]AvailabilityGroup, 0, len(order))
+	for _, key := range order {
+		g := groups[key]
+		if g.Stations == 0 {
+			continue
+		}
+		g.Maintenance = g.total.maintenance
+		g.Expected = g.total.expected
+		g.Received = g.total.received
+		g.Availability = g.total.availability()
+		g.AvgDataCount = g.total.avgDataCount()
+		g.Variables = g.total.variablesCompleteness()
+		g.Days = make([]AvailabilityDay, len(days))
+		for i, d := range days {
+			c := g.days[i]
+			g.Days[i] = AvailabilityDay{
+				Day:          d.Format(availabilityDayLayout),
+				Stations:     c.stations,
+				Maintenance:  c.maintenance,
+				Expected:     c.expected,
+				Received:     c.received,
+				Availability: c.availability(),
+				AvgDataCount: c.avgDataCount(),
+				Variables:    c.variablesCompleteness(),
+			}
+		}
+		res = append(res, g.AvailabilityGroup)
+	}
+	return res
+}
+
+// maintenanceDuration is the time between from and to covered by the maintenance windows
+func maintenanceDuration(windows []db.StationMaintenance, from, to time.Time) time.Duration {
+	var d time.Duration
+	for _, m := range windows {
+		if !m.WindowStart.Valid || !m.WindowEnd.Valid {
+			continue
+		}
+		start := m.WindowStart.Time
+		if start.Before(from) {
+			start = from
+		}
+		end := m.WindowEnd.Time
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			d += end.Sub(start)
+		}
+	}
+	return min(d, to.Sub(from))
+}
+
+func availabilityGroupKey(stn db.ObservationsStation, groupBy string) (string, string) {
+	var key string
+	switch groupBy {
+	case AvailabilityGroupByProvince:
+		key = stn.Province.String
+	case AvailabilityGroupByProvider:
+		key = stn.ProviderID.String
+	default:
+		return strconv.FormatInt(stn.ID, 10), stn.Name
+	}
+	if key == "" {
+		key = availabilityUnknownGroup
+	}
+	return key, ""
+}
+
+// AvailabilityVariables lists the variables reported in the groups in a stable order
+func AvailabilityVariables(groups []AvailabilityGroup) []string {
+	seen := make(map[string]bool)
+	var vars []string
+	for _, g := range groups {
+		for k := range g.Variables {
+			if !seen[k] {
+				seen[k] = true
+				vars = append(vars, k)
+			}
+		}
+	}
+	sort.Strings(vars)
+	return vars
+}
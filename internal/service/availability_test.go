@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAvailabilityReport(t *testing.T) {
+	loc := time.FixedZone("PHT", 8*60*60)
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, loc)
+	end := start.AddDate(0, 0, 2)
+	day := func(i int) pgtype.Date {
+		return pgtype.Date{Time: time.Date(2024, 3, 1+i, 0, 0, 0, 0, time.UTC), Valid: true}
+	}
+
+	stations := []db.ObservationsStation{
+		// default 10 minute interval, 144 records a day
+		{ID: 1, ProviderID: util.ToPgText("PAGASA")},
+		// installed on the second day of the report
+		{ID: 2, ProviderID: util.ToPgText("PAGASA"), ReportingInterval: pgtype.Int4{Int32: 60, Valid: true}, DateInstalled: day(1)},
+		{ID: 3, ReportingInterval: pgtype.Int4{Int32: 60, Valid: true}},
+	}
+	records := []db.ListStationDailyRecordCountsRow{
+		{StationID: 1, Day: day(0), RecordCount: 144},
+		{StationID: 1, Day: day(1), RecordCount: 72},
+		// duplicates do not push the availability past 100%
+		{StationID: 2, Day: day(1), RecordCount: 30},
+		{StationID: 3, Day: day(2), RecordCount: 12},
+	}
+	dataStatus := []db.ListStationDailyDataStatusRow{
+		{StationID: 1, Day: day(0), DataStatus: "1111111110", RecordCount: 100, DataCount: 900},
+		{StationID: 1, Day: day(0), DataStatus: "0111111111", RecordCount: 44, DataCount: 396},
+	}
+
+	t.Run("ByStation", func(t *testing.T) {
+		groups := BuildAvailabilityReport(stations, records, dataStatus, nil, start, end, AvailabilityGroupByStation)
+		require.Len(t, groups, 3)
+
+		g := groups[0]
+		require.Equal(t, "1", g.Key)
+		require.Equal(t, int64(3*144), g.Expected)
+		require.Equal(t, int64(216), g.Received)
+		require.InDelta(t, 50, g.Availability, 0.01)
+		require.Len(t, g.Days, 3)
+		require.InDelta(t, 100, g.Days[0].Availability, 0.01)
+		require.InDelta(t, 69.44, g.Days[0].Variables["temp"], 0.01)
+		require.InDelta(t, 100, g.Days[0].Variables["rh"], 0.01)
+		require.InDelta(t, 9, *g.Days[0].AvgDataCount, 0.01)
+		require.Nil(t, g.Days[1].AvgDataCount)
+
+		g = groups[1]
+		require.Equal(t, int64(2*24), g.Expected)
+		require.Zero(t, g.Days[0].Expected)
+		require.InDelta(t, 100, g.Days[1].Availability, 0.01)
+		require.InDelta(t, 50, g.Availability, 0.01)
+	})
+
+	t.Run("Maintenance", func(t *testing.T) {
+		maintenance := []db.StationMaintenance{
+			{
+				StationID:   1,
+				WindowStart: pgtype.Timestamptz{Time: start.Add(-time.Hour), Valid: true},
+				WindowEnd:   pgtype.Timestamptz{Time: start.Add(12 * time.Hour), Valid: true},
+			},
+		}
+		groups := BuildAvailabilityReport(stations, records, dataStatus, maintenance, start, end, AvailabilityGroupByStation)
+		g := groups[0]
+		require.Equal(t, 1, g.Maintenance)
+		require.Equal(t, int64(72), g.Days[0].Expected)
+		require.Equal(t, 1, g.Days[0].Maintenance)
+		// records received during the maintenance do not push the availability past 100%
+		require.InDelta(t, 100, g.Days[0].Availability, 0.01)
+		require.Zero(t, g.Days[1].Maintenance)
+		require.Equal(t, int64(144), g.Days[1].Expected)
+	})
+
+	t.Run("ByProvider", func(t *testing.T) {
+		groups := BuildAvailabilityReport(stations, records, dataStatus, nil, start, end, AvailabilityGroupByProvider)
+		require.Len(t, groups, 2)
+		require.Equal(t, "PAGASA", groups[0].Key)
+		require.Equal(t, 2, groups[0].Stations)
+		require.Equal(t, 2, groups[0].Days[1].Stations)
+		require.Equal(t, availabilityUnknownGroup, groups[1].Key)
+		require.InDelta(t, 16.67, groups[1].Availability, 0.01)
+	})
+
+	require.Equal(t, []string{"pres", "rh", "rr", "srad", "td", "temp", "wchill", "wdir", "wspd", "wspdx"},
+		AvailabilityVariables(BuildAvailabilityReport(stations, records, dataStatus, nil, start, end, AvailabilityGroupByStation)))
+}
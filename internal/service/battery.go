@@ -0,0 +1,189 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+)
+
+const (
+	BatteryStatusInsufficientData = "INSUFFICIENT_DATA"
+	BatteryStatusStable           = "STABLE"
+	BatteryStatusDeclining        = "DECLINING"
+	BatteryStatusCritical         = "CRITICAL"
+)
+
+const (
+	// DefaultBatteryCutoff is the battery voltage at which the loggers stop transmitting
+	DefaultBatteryCutoff float32 = 11.5
+	// DefaultBatteryWindowDays is the number of days of health history used for a forecast
+	DefaultBatteryWindowDays = 14
+	// minBatteryDays is the number of days needed before a trend is fitted
+	minBatteryDays = 3
+	// batteryCriticalDays is how close to the cutoff a station is flagged critical
+	batteryCriticalDays = 3
+	// batteryDecliningSlope is the daily drop in volts below which a battery is considered declining
+	batteryDecliningSlope = -0.01
+)
+
+// BatteryDay is the battery and solar panel voltage range of a station in a day
+type BatteryDay struct {
+	Day      time.Time
+	Min      float32
+	Max      float32
+	SolarMax *float32
+}
+
+type BatteryForecast struct {
+	StationID     int64      `json:"station_id"`
+	Name          string     `json:"name,omitempty"`
+	Status        string     `json:"status"`
+	Days          int        `json:"days"`                    // days of history used
+	LastMin       float32    `json:"last_min"`                // latest daily minimum vb1
+	LastMax       float32    `json:"last_max"`                // latest daily maximum vb1
+	TrendPerDay   *float64   `json:"trend_per_day,omitempty"` // change of the daily minimum vb1 in volts per day
+	AvgDailyMin   float32    `json:"avg_daily_min"`
+	AvgDailyMax   float32    `json:"avg_daily_max"`
+	AvgDailySwing float32    `json:"avg_daily_swing"`          // average daily charge/discharge range
+	AvgSolarPeak  *float32   `json:"avg_solar_peak,omitempty"` // average daily maximum bp1
+	Cutoff        float32    `json:"cutoff"`
+	DaysToCutoff  *float64   `json:"days_to_cutoff,omitempty"`
+	CutoffAt      *time.Time `json:"cutoff_at,omitempty"`
+} //@name BatteryForecast
+
+// ForecastBattery fits a linear trend to the daily minimum battery voltage, which is taken
+// before sunrise after a full discharge, and projects when it will reach the cutoff voltage.
+// days must be sorted by day.
+func ForecastBattery(days []BatteryDay, cutoff float32, now time.Time) BatteryForecast {
+	f := BatteryForecast{
+		Status: BatteryStatusInsufficientData,
+		Days:   len(days),
+		Cutoff: cutoff,
+	}
+	if len(days) == 0 {
+		return f
+	}
+
+	last := days[len(days)-1]
+	f.LastMin = last.Min
+	f.LastMax = last.Max
+
+	var sumMin, sumMax, sumSolar float64
+	var solarDays int
+	for _, d := range days {
+		sumMin += float64(d.Min)
+		sumMax += float64(d.Max)
+		if d.SolarMax != nil {
+			sumSolar += float64(*d.SolarMax)
+			solarDays++
+		}
+	}
+	n := float64(len(days))
+	f.AvgDailyMin = float32(sumMin / n)
+	f.AvgDailyMax = float32(sumMax / n)
+	f.AvgDailySwing = f.AvgDailyMax - f.AvgDailyMin
+	if solarDays > 0 {
+		avg := float32(sumSolar / float64(solarDays))
+		f.AvgSolarPeak = &avg
+	}
+
+	if len(days) < minBatteryDays {
+		return f
+	}
+
+	first := days[0].Day
+	var sumX, sumXX, sumXY float64
+	for _, d := range days {
+		x := d.Day.Sub(first).Hours() / 24
+		sumX += x
+		sumXX += x * x
+		sumXY += x * float64(d.Min)
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return f
+	}
+	slope := (n*sumXY - sumX*sumMin) / denom
+	intercept := (sumMin - slope*sumX) / n
+	f.TrendPerDay = &slope
+
+	lastX := last.Day.Sub(first).Hours() / 24
+	fitted := intercept + slope*lastX
+
+	f.Status = BatteryStatusStable
+	if fitted <= float64(cutoff) || last.Min <= cutoff {
+		zero := 0.0
+		f.DaysToCutoff = &zero
+		f.CutoffAt = &now
+		f.Status = BatteryStatusCritical
+		return f
+	}
+	if slope >= batteryDecliningSlope {
+		return f
+	}
+
+	cutoffAt := last.Day.Add(time.Duration((fitted - float64(cutoff)) / -slope * float64(24*time.Hour)))
+	daysToCutoff := max(cutoffAt.Sub(now).Hours()/24, 0)
+	f.CutoffAt = &cutoffAt
+	f.DaysToCutoff = &daysToCutoff
+	f.Status = BatteryStatusDeclining
+	if daysToCutoff <= batteryCriticalDays {
+		f.Status = BatteryStatusCritical
+	}
+	return f
+}
+
+// ForecastBatteries groups the daily rows by station and forecasts each station
+func ForecastBatteries(rows []db.ListStationBatteryDailyRow, cutoff float32, now time.Time) []BatteryForecast {
+	var forecasts []BatteryForecast
+	for i := 0; i < len(rows); {
+		j := i
+		var days []BatteryDay
+		for ; j < len(rows) && rows[j].StationID == rows[i].StationID; j++ {
+			days = append(days, newBatteryDay(rows[j]))
+		}
+
+		f := ForecastBattery(days, cutoff, now)
+		f.StationID = rows[i].StationID
+		f.Name = rows[i].Name
+		forecasts = append(forecasts, f)
+		i = j
+	}
+	return forecasts
+}
+
+// RankBatteryForecasts orders forecasts by urgency, the stations closest to the cutoff first
+func RankBatteryForecasts(forecasts []BatteryForecast) {
+	rank := map[string]int{
+		BatteryStatusCritical:         0,
+		BatteryStatusDeclining:        1,
+		BatteryStatusStable:           2,
+		BatteryStatusInsufficientData: 3,
+	}
+	sort.SliceStable(forecasts, func(i, j int) bool {
+		a, b := forecasts[i], forecasts[j]
+		if rank[a.Status] != rank[b.Status] {
+			return rank[a.Status] < rank[b.Status]
+		}
+		if a.DaysToCutoff != nil && b.DaysToCutoff != nil && *a.DaysToCutoff != *b.DaysToCutoff {
+			return *a.DaysToCutoff < *b.DaysToCutoff
+		}
+		if a.TrendPerDay != nil && b.TrendPerDay != nil && *a.TrendPerDay != *b.TrendPerDay {
+			return *a.TrendPerDay < *b.TrendPerDay
+		}
+		return a.LastMin < b.LastMin
+	})
+}
+
+func newBatteryDay(row db.ListStationBatteryDailyRow) BatteryDay {
+	d := BatteryDay{
+		Day: row.Day.Time,
+		Min: row.Vb1Min,
+		Max: row.Vb1Max,
+	}
+	if row.Bp1Max.Valid {
+		d.SolarMax = &row.Bp1Max.Float32
+	}
+	return d
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func batteryDays(start time.Time, mins ...float32) []BatteryDay {
+	days := make([]BatteryDay, len(mins))
+	for i, m := range mins {
+		solar := float32(18)
+		days[i] = BatteryDay{
+			Day:      start.AddDate(0, 0, i),
+			Min:      m,
+			Max:      m + 1.5,
+			SolarMax: &solar,
+		}
+	}
+	return days
+}
+
+func TestForecastBattery(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		days  []BatteryDay
+		now   time.Time
+		check func(f BatteryForecast)
+	}{
+		{
+			name: "InsufficientData",
+			days: batteryDays(start, 12.4, 12.3),
+			now:  start.AddDate(0, 0, 2),
+			check: func(f BatteryForecast) {
+				require.Equal(t, BatteryStatusInsufficientData, f.Status)
+				require.Nil(t, f.TrendPerDay)
+				require.InDelta(t, 1.5, f.AvgDailySwing, 0.001)
+			},
+		},
+		{
+			name: "Stable",
+			days: batteryDays(start, 12.4, 12.5, 12.4, 12.5, 12.4),
+			now:  start.AddDate(0, 0, 5),
+			check: func(f BatteryForecast) {
+				require.Equal(t, BatteryStatusStable, f.Status)
+				require.NotNil(t, f.TrendPerDay)
+				require.Nil(t, f.DaysToCutoff)
+				require.InDelta(t, 18, *f.AvgSolarPeak, 0.001)
+			},
+		},
+		{
+			name: "Declining",
+			days: batteryDays(start, 12.5, 12.4, 12.3, 12.2, 12.1),
+			now:  start.AddDate(0, 0, 4),
+			check: func(f BatteryForecast) {
+				require.Equal(t, BatteryStatusDeclining, f.Status)
+				require.InDelta(t, -0.1, *f.TrendPerDay, 0.001)
+				// 12.1 -> 11.5 at 0.1 V/day
+				require.InDelta(t, 6, *f.DaysToCutoff, 0.01)
+				require.WithinDuration(t, start.AddDate(0, 0, 10), *f.CutoffAt, time.Minute)
+			},
+		},
+		{
+			name: "CriticalSoon",
+			days: batteryDays(start, 12.1, 11.9, 11.7),
+			now:  start.AddDate(0, 0, 2),
+			check: func(f BatteryForecast) {
+				require.Equal(t, BatteryStatusCritical, f.Status)
+				require.InDelta(t, 1, *f.DaysToCutoff, 0.01)
+			},
+		},
+		{
+			name: "BelowCutoff",
+			days: batteryDays(start, 11.8, 11.6, 11.4),
+			now:  start.AddDate(0, 0, 3),
+			check: func(f BatteryForecast) {
+				require.Equal(t, BatteryStatusCritical, f.Status)
+				require.Zero(t, *f.DaysToCutoff)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(ForecastBattery(tc.days, DefaultBatteryCutoff, tc.now))
+		})
+	}
+}
+
+func TestForecastBatteries(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	var rows []db.ListStationBatteryDailyRow
+	for stationID, mins := range map[int64][]float32{
+		1: {12.4, 12.5, 12.4},
+		2: {12.3, 12.1, 11.9, 11.7},
+		3: {12.5, 12.4, 12.3, 12.2},
+		4: {12.4},
+	} {
+		for i, m := range mins {
+			rows = append(rows, db.ListStationBatteryDailyRow{
+				StationID: stationID,
+				Day:       pgtype.Date{Time: start.AddDate(0, 0, i), Valid: true},
+				Vb1Min:    m,
+				Vb1Max:    m + 1,
+			})
+		}
+	}
+	// rows come ordered by station from the query
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].StationID < rows[j].StationID })
+
+	forecasts := ForecastBatteries(rows, DefaultBatteryCutoff, start.AddDate(0, 0, 3))
+	require.Len(t, forecasts, 4)
+
+	RankBatteryForecasts(forecasts)
+	got := make([]int64, len(forecasts))
+	for i, f := range forecasts {
+		got[i] = f.StationID
+	}
+	require.Equal(t, []int64{2, 3, 1, 4}, got)
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+type DavisBackfillConfig struct {
+	// Lookback is how far back the gaps are searched
+	Lookback time.Duration
+	// MinGap is the shortest interval without observations considered a gap
+	MinGap time.Duration
+}
+
+var DefaultDavisBackfillConfig = DavisBackfillConfig{
+	Lookback: 72 * time.Hour,
+	MinGap:   30 * time.Minute,
+}
+
+type timeRange struct {
+	start time.Time
+	end   time.Time
+}
+
+// findGaps returns the intervals between start and end longer than minGap without observations.
+// The timestamps must be sorted in ascending order.
+func findGaps(timestamps []time.Time, start, end time.Time, minGap time.Duration) []timeRange {
+	gaps := make([]timeRange, 0)
+	prev := start
+	for _, ts := range append(timestamps, end) {
+		if ts.Before(prev) {
+			continue
+		}
+		if ts.Sub(prev) > minGap {
+			gaps = append(gaps, timeRange{start: prev, end: ts})
+		}
+		prev = ts
+	}
+	return gaps
+}
+
+// BackfillDavisObservations fills the gaps of the WeatherLink v2 stations with their archive records
+func BackfillDavisObservations(ctx context.Context, factory sensor.DavisFactory, config DavisBackfillConfig, store db.Store, logger *zerolog.Logger) error {
+	serviceName := "BackfillDavisObservations"
+	stations, err := store.ListWeatherlinkStations(ctx, db.ListWeatherlinkStationsParams{})
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return err
+	}
+
+	count := 0
+	countSuccess := 0
+	for _, dStn := range stations {
+		stn, err := store.GetStation(ctx, dStn.StationID)
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+			continue
+		}
+		if stn.Status.String == "INACTIVE" || !dStn.ApiKey.Valid || dStn.ApiKey.String == "" || !dStn.ApiSecret.Valid || dStn.ApiSecret.String == "" {
+			continue
+		}
+		// the historic API needs the WeatherLink station id set by the station sync
+		if !dStn.WlStationID.Valid {
+			continue
+		}
+
+		end := time.Now().Truncate(time.Minute)
+		start := end.Add(-config.Lookback)
+		timestamps, err := store.ListStationMOObservationTimestamps(ctx, db.ListStationMOObservationTimestampsParams{
+			StationID: stn.ID,
+			StartDate: pgtype.Timestamptz{Time: start, Valid: true},
+			EndDate:   pgtype.Timestamptz{Time: end, Valid: true},
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+			continue
+		}
+		tsSlice := make([]time.Time, len(timestamps))
+		for i, ts := range timestamps {
+			tsSlice[i] = ts.Time
+		}
+
+		davis := factory(sensor.DavisAPICredentials{
+			APIKey:    dStn.ApiKey.String,
+			APISecret: dStn.ApiSecret.String,
+			StationID: int(dStn.WlStationID.Int32),
+		})
+		for _, gap := range findGaps(tsSlice, start, end, config.MinGap) {
+			obsSlice, err := davis.FetchHistoric(ctx, gap.start, gap.end)
+			if err != nil {
+				logDavisError(logger, serviceName, stn.ID, err)
+				break
+			}
+
+			for _, obs := range obsSlice {
+				// the gap bounds are existing observations
+				if !obs.Timestamp.Time.After(gap.start) || !obs.Timestamp.Time.Before(gap.end) {
+					continue
+				}
+				count++
+
+				err = storeDavis(stn.ID, obs, ctx, store)
+				if err != nil {
+					if db.ErrorCode(err) == db.UniqueViolation {
+						continue
+					}
+					logger.Error().Err(err).Str("service", serviceName).Msg("cannot create new data")
+					continue
+				}
+				countSuccess++
+			}
+		}
+	}
+	logger.Info().Str("service", serviceName).Str("success", fmt.Sprintf("%d/%d", countSuccess, count)).Msg("backfill data successful")
+	return nil
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	mocksensor "github.com/emiliogozo/panahon-api-go/internal/mocks/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindGaps(t *testing.T) {
+	start := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	end := start.Add(6 * time.Hour)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+
+	testCases := []struct {
+		name       string
+		timestamps []time.Time
+		gaps       []timeRange
+	}{
+		{
+			name:       "NoData",
+			timestamps: []time.Time{},
+			gaps:       []timeRange{{start: start, end: end}},
+		},
+		{
+			name:       "NoGap",
+			timestamps: []time.Time{at(10), at(30), at(50), at(70), at(90), at(110), at(130), at(150), at(170), at(190), at(210), at(230), at(250), at(270), at(290), at(310), at(330), at(350)},
+			gaps:       []timeRange{},
+		},
+		{
+			name:       "Gaps",
+			timestamps: []time.Time{at(60), at(70), at(80), at(200), at(210)},
+			gaps: []timeRange{
+				{start: start, end: at(60)},
+				{start: at(80), end: at(200)},
+				{start: at(210), end: end},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			gaps := findGaps(tc.timestamps, start, end, 30*time.Minute)
+			require.Equal(t, tc.gaps, gaps)
+		})
+	}
+}
+
+func TestBackfillDavisObservations(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore)
+		checkResponse func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore)
+	}{
+		{
+			name: "Default",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				stns := []db.ObservationsStation{
+					{ID: 24, Status: pgtype.Text{String: "ONLINE", Valid: true}},
+					{ID: 37, Status: pgtype.Text{String: "INACTIVE", Valid: true}},
+					{ID: 555, Status: pgtype.Text{String: "ONLINE", Valid: true}},
+					{ID: 612, Status: pgtype.Text{String: "ONLINE", Valid: true}},
+				}
+				davisStns := make([]db.Weatherlink, len(stns))
+				for i, stn := range stns {
+					davisStns[i] = db.Weatherlink{
+						StationID:   stn.ID,
+						ApiKey:      pgtype.Text{String: util.RandomString(12), Valid: true},
+						ApiSecret:   pgtype.Text{String: util.RandomString(24), Valid: true},
+						WlStationID: pgtype.Int4{Int32: int32(100 + i), Valid: true},
+					}
+				}
+				// no api secret
+				davisStns[2].ApiSecret = pgtype.Text{}
+				// not yet synced
+				davisStns[3].WlStationID = pgtype.Int4{}
+
+				store.EXPECT().ListWeatherlinkStations(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListWeatherlinkStationsParams")).
+					Return(davisStns, nil)
+				for _, stn := range stns {
+					store.EXPECT().GetStation(mock.AnythingOfType("backgroundCtx"), stn.ID).Return(stn, nil).Once()
+				}
+
+				// a single 2 hour gap 3 hours ago
+				gapEnd := time.Now().Add(-3 * time.Hour).Truncate(time.Minute)
+				gapStart := gapEnd.Add(-2 * time.Hour)
+				store.EXPECT().ListStationMOObservationTimestamps(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListStationMOObservationTimestampsParams")).
+					RunAndReturn(func(ctx context.Context, arg db.ListStationMOObservationTimestampsParams) ([]pgtype.Timestamptz, error) {
+						require.Equal(t, int64(24), arg.StationID)
+						timestamps := make([]pgtype.Timestamptz, 0)
+						for ts := arg.StartDate.Time; !ts.After(arg.EndDate.Time); ts = ts.Add(10 * time.Minute) {
+							if ts.After(gapStart) && ts.Before(gapEnd) {
+								continue
+							}
+							timestamps = append(timestamps, pgtype.Timestamptz{Time: ts, Valid: true})
+						}
+						return timestamps, nil
+					}).Once()
+
+				davisSensor.EXPECT().FetchHistoric(mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+					RunAndReturn(func(ctx context.Context, start, end time.Time) ([]sensor.DavisCurrentObservation, error) {
+						require.True(t, start.After(gapStart.Add(-10*time.Minute)) && !start.After(gapStart))
+						require.True(t, !end.Before(gapEnd) && end.Before(gapEnd.Add(10*time.Minute)))
+						obsSlice := make([]sensor.DavisCurrentObservation, 0)
+						for ts := start; !ts.After(end); ts = ts.Add(15 * time.Minute) {
+							obsSlice = append(obsSlice, sensor.DavisCurrentObservation{
+								Temp:      pgtype.Float4{Float32: 28.5, Valid: true},
+								Uvi:       pgtype.Float4{Float32: 7.5, Valid: true},
+								Timestamp: pgtype.Timestamptz{Time: ts, Valid: true},
+							})
+						}
+						return obsSlice, nil
+					}).Once()
+
+				store.EXPECT().CreateStationMOObservation(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.CreateStationMOObservationParams")).
+					Run(func(ctx context.Context, arg db.CreateStationMOObservationParams) {
+						require.Equal(t, int64(24), arg.StationID)
+						require.InDelta(t, 28.5, arg.Temp.Float32, 0.01)
+						require.InDelta(t, 7.5, arg.Uvi.Float32, 0.01)
+					}).
+					Return(db.ObservationsMoObservation{}, nil)
+			},
+			checkResponse: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				davisSensor.AssertExpectations(t)
+				store.AssertExpectations(t)
+				for _, call := range store.Calls {
+					if call.Method != "CreateStationMOObservation" {
+						continue
+					}
+					arg := call.Arguments.Get(1).(db.CreateStationMOObservationParams)
+					require.False(t, arg.Timestamp.Time.Before(time.Now().Add(-5*time.Hour-10*time.Minute)))
+				}
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			davisSensor := mocksensor.NewMockDavisSensor(t)
+			tc.buildStubs(davisSensor, store)
+
+			sensorFactory := func(cred sensor.DavisAPICredentials) sensor.DavisSensor {
+				require.NotZero(t, cred.StationID)
+				return davisSensor
+			}
+
+			config := util.Config{
+				EnableFileLogging: false,
+			}
+			logger := util.NewLogger(config)
+
+			err := BackfillDavisObservations(context.Background(), sensorFactory, DefaultDavisBackfillConfig, store, logger)
+			require.NoError(t, err)
+
+			tc.checkResponse(davisSensor, store)
+		})
+	}
+}
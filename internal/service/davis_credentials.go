@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+// DecryptDavisCredentials decrypts the secrets stored in the weatherlink table, the api key and station uuid are not encrypted
+func DecryptDavisCredentials(secrets *util.SecretCipher, creds sensor.DavisAPICredentials) (sensor.DavisAPICredentials, error) {
+	var err error
+	if creds.Pass, err = secrets.Decrypt(creds.Pass); err != nil {
+		return sensor.DavisAPICredentials{}, err
+	}
+	if creds.APIToken, err = secrets.Decrypt(creds.APIToken); err != nil {
+		return sensor.DavisAPICredentials{}, err
+	}
+	if creds.APISecret, err = secrets.Decrypt(creds.APISecret); err != nil {
+		return sensor.DavisAPICredentials{}, err
+	}
+	return creds, nil
+}
+
+// NewDavisFactory creates Davis sensors sharing the client.
+// Credentials that can not be decrypted are dropped so the fetch fails as invalid credentials.
+func NewDavisFactory(client sensor.Fetcher, secrets *util.SecretCipher, logger *zerolog.Logger) sensor.DavisFactory {
+	return func(creds sensor.DavisAPICredentials) sensor.DavisSensor {
+		decrypted, err := DecryptDavisCredentials(secrets, creds)
+		if err != nil {
+			logger.Error().Err(err).Str("service", "DavisFactory").Msg("cannot decrypt api credentials")
+		}
+		return sensor.NewDavisWithClient(decrypted, client)
+	}
+}
+
+// EncryptWeatherlinkSecrets encrypts the secrets still stored as plaintext, e.g. the v1 credentials moved out of the station url
+func EncryptWeatherlinkSecrets(ctx context.Context, store db.Store, secrets *util.SecretCipher, logger *zerolog.Logger) error {
+	serviceName := "EncryptWeatherlinkSecrets"
+	stations, err := store.ListWeatherlinkStations(ctx, db.ListWeatherlinkStationsParams{})
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return err
+	}
+
+	count := 0
+	for _, wl := range stations {
+		arg := db.UpdateWeatherlinkStationParams{StationID: wl.StationID}
+		changed := false
+		for _, f := range []struct {
+			src pgtype.Text
+			dst *pgtype.Text
+		}{
+			{wl.ApiSecret, &arg.ApiSecret},
+			{wl.V1Pass, &arg.V1Pass},
+			{wl.V1ApiToken, &arg.V1ApiToken},
+		} {
+			if !f.src.Valid || f.src.String == "" || util.IsEncryptedSecret(f.src.String) {
+				continue
+			}
+			encrypted, err := secrets.Encrypt(f.src.String)
+			if err != nil {
+				return err
+			}
+			*f.dst = util.ToPgText(encrypted)
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+
+		if _, err := store.UpdateWeatherlinkStation(ctx, arg); err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Int64("station_id", wl.StationID).Msg("cannot update secrets")
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		logger.Info().Str("service", serviceName).Int("count", count).Msg("encrypted plaintext secrets")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptDavisCredentials(t *testing.T) {
+	secrets, err := util.NewSecretCipher(util.RandomString(32))
+	require.NoError(t, err)
+
+	encSecret, err := secrets.Encrypt("v2secret")
+	require.NoError(t, err)
+	encPass, err := secrets.Encrypt("v1pass")
+	require.NoError(t, err)
+
+	creds, err := DecryptDavisCredentials(secrets, sensor.DavisAPICredentials{
+		User:      "v1user",
+		Pass:      encPass,
+		APIToken:  "plaintoken",
+		APIKey:    "v2key",
+		APISecret: encSecret,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sensor.DavisAPICredentials{
+		User:      "v1user",
+		Pass:      "v1pass",
+		APIToken:  "plaintoken",
+		APIKey:    "v2key",
+		APISecret: "v2secret",
+	}, creds)
+
+	_, err = DecryptDavisCredentials(nil, sensor.DavisAPICredentials{APIKey: "v2key", APISecret: encSecret})
+	require.ErrorIs(t, err, util.ErrSecretKeyMissing)
+}
+
+func TestEncryptWeatherlinkSecrets(t *testing.T) {
+	secrets, err := util.NewSecretCipher(util.RandomString(32))
+	require.NoError(t, err)
+	encSecret, err := secrets.Encrypt(util.RandomString(24))
+	require.NoError(t, err)
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().ListWeatherlinkStations(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListWeatherlinkStationsParams")).
+		Return([]db.Weatherlink{
+			{StationID: 24, ApiKey: util.ToPgText("key"), ApiSecret: util.ToPgText("plainsecret")},
+			{StationID: 37, ApiKey: util.ToPgText("key"), ApiSecret: util.ToPgText(encSecret)},
+			{StationID: 555, V1User: util.ToPgText("user"), V1Pass: util.ToPgText("pass"), V1ApiToken: util.ToPgText("token")},
+			{StationID: 1117, Uuid: util.ToPgText(util.RandomString(32))},
+		}, nil)
+	store.EXPECT().UpdateWeatherlinkStation(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.UpdateWeatherlinkStationParams")).
+		Run(func(ctx context.Context, arg db.UpdateWeatherlinkStationParams) {
+			switch arg.StationID {
+			case 24:
+				require.True(t, util.IsEncryptedSecret(arg.ApiSecret.String))
+				secret, err := secrets.Decrypt(arg.ApiSecret.String)
+				require.NoError(t, err)
+				require.Equal(t, "plainsecret", secret)
+				require.False(t, arg.V1Pass.Valid)
+			case 555:
+				require.False(t, arg.ApiSecret.Valid)
+				require.True(t, util.IsEncryptedSecret(arg.V1Pass.String))
+				require.True(t, util.IsEncryptedSecret(arg.V1ApiToken.String))
+			default:
+				t.Errorf("unexpected station id %d", arg.StationID)
+			}
+		}).
+		Return(db.Weatherlink{}, nil).Twice()
+
+	logger := util.NewLogger(util.Config{EnableFileLogging: false})
+	err = EncryptWeatherlinkSecrets(context.Background(), store, secrets, logger)
+	require.NoError(t, err)
+	store.AssertExpectations(t)
+}
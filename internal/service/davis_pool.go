@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+)
+
+// WeatherLink allows 10 requests per second, stay below it with all jobs combined
+const (
+	DavisRateLimit = 5
+	DavisRateBurst = 5
+)
+
+var (
+	errDavisCircuitOpen   = errors.New("circuit open, skipping station")
+	errDavisNoObservation = errors.New("no observation")
+)
+
+type DavisPoolConfig struct {
+	Workers          int
+	RequestTimeout   time.Duration
+	MaxRetries       int
+	MinBackoff       time.Duration
+	MaxBackoff       time.Duration
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+var DefaultDavisPoolConfig = DavisPoolConfig{
+	Workers:          8,
+	RequestTimeout:   30 * time.Second,
+	MaxRetries:       3,
+	MinBackoff:       2 * time.Second,
+	MaxBackoff:       30 * time.Second,
+	BreakerThreshold: 5,
+	BreakerCooldown:  time.Hour,
+}
+
+// DavisPool fetches the latest observations of many Davis stations concurrently.
+// The circuit breaker state is kept across runs so a pool should live as long as its job.
+type DavisPool struct {
+	factory sensor.DavisFactory
+	config  DavisPoolConfig
+	breaker *util.CircuitBreaker[int64]
+}
+
+type davisJob struct {
+	stationID int64
+	creds     sensor.DavisAPICredentials
+}
+
+type davisResult struct {
+	obs sensor.DavisCurrentObservation
+	err error
+}
+
+func NewDavisPool(factory sensor.DavisFactory, config DavisPoolConfig) *DavisPool {
+	if config.Workers <= 0 {
+		config.Workers = DefaultDavisPoolConfig.Workers
+	}
+	if config.RequestTimeout <= 0 {
+		config.RequestTimeout = DefaultDavisPoolConfig.RequestTimeout
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = config.MinBackoff
+	}
+	if config.BreakerThreshold <= 0 {
+		config.BreakerThreshold = DefaultDavisPoolConfig.BreakerThreshold
+	}
+
+	return &DavisPool{
+		factory: factory,
+		config:  config,
+		breaker: util.NewCircuitBreaker[int64](config.BreakerThreshold, config.BreakerCooldown),
+	}
+}
+
+// fetch runs the jobs on the worker pool, results are returned in job order
+func (p *DavisPool) fetch(ctx context.Context, jobs []davisJob) []davisResult {
+	results := make([]davisResult, len(jobs))
+
+	idx := make(chan int)
+	var wg sync.WaitGroup
+	for range min(p.config.Workers, len(jobs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idx {
+				results[i] = p.fetchStation(ctx, jobs[i])
+			}
+		}()
+	}
+
+	for i := range jobs {
+		idx <- i
+	}
+	close(idx)
+	wg.Wait()
+
+	return results
+}
+
+func (p *DavisPool) fetchStation(ctx context.Context, job davisJob) davisResult {
+	if !p.breaker.Allow(job.stationID) {
+		return davisResult{err: errDavisCircuitOpen}
+	}
+
+	obs, err := p.fetchWithRetry(ctx, job)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.breaker.Failure(job.stationID)
+		}
+		return davisResult{err: err}
+	}
+
+	p.breaker.Success(job.stationID)
+	return davisResult{obs: obs}
+}
+
+func (p *DavisPool) fetchWithRetry(ctx context.Context, job davisJob) (sensor.DavisCurrentObservation, error) {
+	davis := p.factory(job.creds)
+	backoff := p.config.MinBackoff
+	for attempt := 0; ; attempt++ {
+		reqCtx, cancel := context.WithTimeout(ctx, p.config.RequestTimeout)
+		obsSlice, err := davis.FetchLatest(reqCtx)
+		cancel()
+		if err == nil && len(obsSlice) == 0 {
+			err = errDavisNoObservation
+		}
+		if err == nil {
+			return obsSlice[0], nil
+		}
+
+		if errors.Is(err, sensor.ErrDavisInvalidCredentials) || attempt >= p.config.MaxRetries {
+			return sensor.DavisCurrentObservation{}, err
+		}
+
+		// jitter keeps the workers from retrying in lockstep
+		var wait time.Duration
+		if backoff > 0 {
+			wait = rand.N(backoff) + backoff/2
+		}
+		select {
+		case <-ctx.Done():
+			return sensor.DavisCurrentObservation{}, ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff = min(2*backoff, p.config.MaxBackoff)
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mocksensor "github.com/emiliogozo/panahon-api-go/internal/mocks/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDavisPool(t *testing.T) {
+	errAPI := errors.New("api error")
+	testConfig := DavisPoolConfig{
+		Workers:          4,
+		RequestTimeout:   time.Second,
+		MaxRetries:       2,
+		MinBackoff:       time.Millisecond,
+		MaxBackoff:       2 * time.Millisecond,
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Hour,
+	}
+	newObs := func(temp float32) []sensor.DavisCurrentObservation {
+		return []sensor.DavisCurrentObservation{{Temp: pgtype.Float4{Float32: temp, Valid: true}}}
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(davisSensor *mocksensor.MockDavisSensor)
+		checkPool  func(pool *DavisPool)
+	}{
+		{
+			name: "Retry",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor) {
+				davisSensor.EXPECT().FetchLatest(mock.Anything).Return(nil, errAPI).Twice()
+				davisSensor.EXPECT().FetchLatest(mock.Anything).Return(newObs(28), nil).Once()
+			},
+			checkPool: func(pool *DavisPool) {
+				res := pool.fetch(context.Background(), []davisJob{{stationID: 1}})
+				require.NoError(t, res[0].err)
+				require.Equal(t, float32(28), res[0].obs.Temp.Float32)
+			},
+		},
+		{
+			name: "NoRetryInvalidCredentials",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor) {
+				davisSensor.EXPECT().FetchLatest(mock.Anything).Return(nil, sensor.ErrDavisInvalidCredentials).Once()
+			},
+			checkPool: func(pool *DavisPool) {
+				res := pool.fetch(context.Background(), []davisJob{{stationID: 1}})
+				require.ErrorIs(t, res[0].err, sensor.ErrDavisInvalidCredentials)
+			},
+		},
+		{
+			name: "NoObservation",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor) {
+				davisSensor.EXPECT().FetchLatest(mock.Anything).Return([]sensor.DavisCurrentObservation{}, nil).Times(3)
+			},
+			checkPool: func(pool *DavisPool) {
+				res := pool.fetch(context.Background(), []davisJob{{stationID: 1}})
+				require.ErrorIs(t, res[0].err, errDavisNoObservation)
+			},
+		},
+		{
+			name: "CircuitBreaker",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor) {
+				// two runs of three attempts each open the circuit
+				davisSensor.EXPECT().FetchLatest(mock.Anything).Return(nil, errAPI).Times(6)
+			},
+			checkPool: func(pool *DavisPool) {
+				for range 2 {
+					res := pool.fetch(context.Background(), []davisJob{{stationID: 1}})
+					require.ErrorIs(t, res[0].err, errAPI)
+				}
+
+				res := pool.fetch(context.Background(), []davisJob{{stationID: 1}})
+				require.ErrorIs(t, res[0].err, errDavisCircuitOpen)
+			},
+		},
+		{
+			name: "Timeout",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor) {
+				davisSensor.EXPECT().FetchLatest(mock.Anything).
+					RunAndReturn(func(ctx context.Context) ([]sensor.DavisCurrentObservation, error) {
+						deadline, ok := ctx.Deadline()
+						require.True(t, ok)
+						require.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+						return newObs(28), nil
+					}).Once()
+			},
+			checkPool: func(pool *DavisPool) {
+				res := pool.fetch(context.Background(), []davisJob{{stationID: 1}})
+				require.NoError(t, res[0].err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			davisSensor := mocksensor.NewMockDavisSensor(t)
+			tc.buildStubs(davisSensor)
+
+			pool := NewDavisPool(func(cred sensor.DavisAPICredentials) sensor.DavisSensor {
+				return davisSensor
+			}, testConfig)
+			tc.checkPool(pool)
+		})
+	}
+}
+
+func TestDavisPoolConcurrency(t *testing.T) {
+	const (
+		nJobs    = 12
+		nWorkers = 4
+	)
+
+	var running, maxRunning atomic.Int32
+	davisSensor := mocksensor.NewMockDavisSensor(t)
+	davisSensor.EXPECT().FetchLatest(mock.Anything).
+		RunAndReturn(func(ctx context.Context) ([]sensor.DavisCurrentObservation, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			return []sensor.DavisCurrentObservation{{}}, nil
+		})
+
+	pool := NewDavisPool(func(cred sensor.DavisAPICredentials) sensor.DavisSensor {
+		return davisSensor
+	}, DavisPoolConfig{Workers: nWorkers})
+
+	jobs := make([]davisJob, nJobs)
+	for i := range jobs {
+		jobs[i] = davisJob{stationID: int64(i + 1)}
+	}
+
+	start := time.Now()
+	results := pool.fetch(context.Background(), jobs)
+	require.Len(t, results, nJobs)
+	for _, res := range results {
+		require.NoError(t, res.err)
+	}
+	require.Equal(t, int32(nWorkers), maxRunning.Load())
+	require.Less(t, time.Since(start), nJobs*20*time.Millisecond)
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+const (
+	WeatherlinkChangeLocation = "location"
+	WeatherlinkChangeFirmware = "firmware_version"
+
+	// about 10 m, smaller changes are GPS jitter
+	weatherlinkLocationTolerance = 1e-4
+)
+
+// SyncWeatherlinkStations creates or updates the stations visible to each WeatherLink v2 API key.
+// Location and firmware changes of known stations are flagged for review.
+func SyncWeatherlinkStations(ctx context.Context, factory sensor.DavisFactory, store db.Store, logger *zerolog.Logger) error {
+	serviceName := "SyncWeatherlinkStations"
+	wlStations, err := store.ListWeatherlinkStations(ctx, db.ListWeatherlinkStationsParams{})
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return err
+	}
+
+	// rows added before the sync are linked to the stations of their key by device id, or by name and location
+	keys := make([]string, 0)
+	unlinked := make(map[string][]db.Weatherlink)
+	secrets := make(map[string]string)
+	stations := make(map[int64]db.ObservationsStation)
+	for _, wl := range wlStations {
+		if !wl.ApiKey.Valid || wl.ApiKey.String == "" || !wl.ApiSecret.Valid || wl.ApiSecret.String == "" {
+			continue
+		}
+		key := wl.ApiKey.String
+		if _, ok := secrets[key]; !ok {
+			keys = append(keys, key)
+			secrets[key] = wl.ApiSecret.String
+		}
+		if !wl.WlStationID.Valid {
+			stn, err := store.GetStation(ctx, wl.StationID)
+			if err != nil {
+				logger.Error().Err(err).Str("service", serviceName).Int64("station_id", wl.StationID).Msg("database error")
+				continue
+			}
+			stations[wl.StationID] = stn
+			unlinked[key] = append(unlinked[key], wl)
+		}
+	}
+
+	countCreated := 0
+	countUpdated := 0
+	countFlagged := 0
+	for _, key := range keys {
+		davis := factory(sensor.DavisAPICredentials{
+			APIKey:    key,
+			APISecret: secrets[key],
+		})
+		dStns, err := davis.FetchStations(ctx)
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Msg("api error")
+			continue
+		}
+
+		for _, dStn := range dStns {
+			wl, err := store.GetWeatherlinkStationByWLID(ctx, db.GetWeatherlinkStationByWLIDParams{
+				ApiKey:      util.ToPgText(key),
+				WlStationID: pgtype.Int4{Int32: int32(dStn.StationID), Valid: true},
+			})
+			if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+				logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+				continue
+			}
+
+			if errors.Is(err, db.ErrRecordNotFound) {
+				if len(unlinked[key]) == 0 {
+					_, err = store.CreateWeatherlinkStationTx(ctx, newCreateWeatherlinkStationTxParams(key, secrets[key], dStn))
+					if err != nil {
+						logger.Error().Err(err).Str("service", serviceName).Int("wl_station_id", dStn.StationID).Msg("cannot create station")
+						continue
+					}
+					countCreated++
+					continue
+				}
+				matches := matchUnlinkedWeatherlink(unlinked[key], stations, dStn)
+				if len(matches) != 1 {
+					logger.Warn().Str("service", serviceName).
+						Int("wl_station_id", dStn.StationID).
+						Int("matches", len(matches)).
+						Msg("cannot link station, no single unlinked station matches")
+					continue
+				}
+				i := matches[0]
+				wl = unlinked[key][i]
+				unlinked[key] = append(unlinked[key][:i], unlinked[key][i+1:]...)
+			}
+
+			nFlagged, err := flagWeatherlinkChanges(ctx, store, stations, wl, dStn)
+			if err != nil {
+				logger.Error().Err(err).Str("service", serviceName).Int64("station_id", wl.StationID).Msg("cannot flag changes")
+				continue
+			}
+			countFlagged += nFlagged
+
+			_, err = store.UpdateWeatherlinkStationMetadata(ctx, db.UpdateWeatherlinkStationMetadataParams{
+				ID:                wl.ID,
+				WlStationID:       pgtype.Int4{Int32: int32(dStn.StationID), Valid: true},
+				Lat:               pgtype.Float4{Float32: dStn.Lat, Valid: true},
+				Lon:               pgtype.Float4{Float32: dStn.Lon, Valid: true},
+				Elevation:         pgtype.Float4{Float32: dStn.Elevation, Valid: true},
+				FirmwareVersion:   util.ToPgText(dStn.FirmwareVersion),
+				RecordingInterval: pgtype.Int4{Int32: int32(dStn.RecordingInterval), Valid: dStn.RecordingInterval > 0},
+			})
+			if err != nil {
+				logger.Error().Err(err).Str("service", serviceName).Int64("station_id", wl.StationID).Msg("cannot update metadata")
+				continue
+			}
+			countUpdated++
+		}
+	}
+	logger.Info().Str("service", serviceName).
+		Int("created", countCreated).
+		Int("updated", countUpdated).
+		Int("flagged", countFlagged).
+		Msg("sync stations successful")
+	return nil
+}
+
+func newCreateWeatherlinkStationTxParams(key, secret string, dStn sensor.DavisStation) db.CreateWeatherlinkStationTxParams {
+	status := "ONLINE"
+	if !dStn.Active {
+		status = "INACTIVE"
+	}
+	name := dStn.Name
+	if name == "" {
+		name = fmt.Sprintf("WeatherLink %d", dStn.StationID)
+	}
+
+	return db.CreateWeatherlinkStationTxParams{
+		Name:              name,
+		Lat:               pgtype.Float4{Float32: dStn.Lat, Valid: true},
+		Lon:               pgtype.Float4{Float32: dStn.Lon, Valid: true},
+		Elevation:         pgtype.Float4{Float32: dStn.Elevation, Valid: true},
+		Status:            util.ToPgText(status),
+		ApiKey:            util.ToPgText(key),
+		ApiSecret:         util.ToPgText(secret),
+		WlStationID:       pgtype.Int4{Int32: int32(dStn.StationID), Valid: true},
+		FirmwareVersion:   util.ToPgText(dStn.FirmwareVersion),
+		RecordingInterval: pgtype.Int4{Int32: int32(dStn.RecordingInterval), Valid: dStn.RecordingInterval > 0},
+	}
+}
+
+// matchUnlinkedWeatherlink returns the indexes of the unlinked rows of the WeatherLink station.
+// Rows are matched by the device id used as the v1 user, otherwise by the station name and location.
+func matchUnlinkedWeatherlink(rows []db.Weatherlink, stations map[int64]db.ObservationsStation, dStn sensor.DavisStation) []int {
+	matches := make([]int, 0)
+	if dStn.GatewayID != "" {
+		for i, wl := range rows {
+			if wl.V1User.Valid && strings.EqualFold(wl.V1User.String, dStn.GatewayID) {
+				matches = append(matches, i)
+			}
+		}
+		if len(matches) > 0 {
+			return matches
+		}
+	}
+
+	name := strings.TrimSpace(dStn.Name)
+	if name == "" {
+		return matches
+	}
+	for i, wl := range rows {
+		stn := stations[wl.StationID]
+		if strings.EqualFold(strings.TrimSpace(stn.Name), name) && stn.Lat.Valid && stn.Lon.Valid &&
+			math.Abs(float64(stn.Lat.Float32-dStn.Lat)) <= weatherlinkLocationTolerance &&
+			math.Abs(float64(stn.Lon.Float32-dStn.Lon)) <= weatherlinkLocationTolerance {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// flagWeatherlinkChanges compares the metadata against the last sync,
+// or against the station location when the row was never synced
+func flagWeatherlinkChanges(ctx context.Context, store db.Store, stations map[int64]db.ObservationsStation, wl db.Weatherlink, dStn sensor.DavisStation) (int, error) {
+	oldLat, oldLon := wl.Lat, wl.Lon
+	if !wl.SyncedAt.Valid {
+		stn, ok := stations[wl.StationID]
+		if !ok {
+			var err error
+			stn, err = store.GetStation(ctx, wl.StationID)
+			if err != nil {
+				return 0, err
+			}
+		}
+		oldLat, oldLon = stn.Lat, stn.Lon
+	}
+
+	changes := make([]db.CreateWeatherlinkChangeParams, 0)
+	if oldLat.Valid && oldLon.Valid &&
+		(math.Abs(float64(oldLat.Float32-dStn.Lat)) > weatherlinkLocationTolerance ||
+			math.Abs(float64(oldLon.Float32-dStn.Lon)) > weatherlinkLocationTolerance) {
+		changes = append(changes, db.CreateWeatherlinkChangeParams{
+			StationID: wl.StationID,
+			Field:     WeatherlinkChangeLocation,
+			OldValue:  util.ToPgText(formatLocation(oldLat.Float32, oldLon.Float32)),
+			NewValue:  util.ToPgText(formatLocation(dStn.Lat, dStn.Lon)),
+		})
+	}
+	if wl.FirmwareVersion.Valid && wl.FirmwareVersion.String != dStn.FirmwareVersion {
+		changes = append(changes, db.CreateWeatherlinkChangeParams{
+			StationID: wl.StationID,
+			Field:     WeatherlinkChangeFirmware,
+			OldValue:  wl.FirmwareVersion,
+			NewValue:  util.ToPgText(dStn.FirmwareVersion),
+		})
+	}
+
+	for _, arg := range changes {
+		if _, err := store.CreateWeatherlinkChange(ctx, arg); err != nil {
+			return 0, err
+		}
+	}
+	return len(changes), nil
+}
+
+func formatLocation(lat, lon float32) string {
+	return fmt.Sprintf("%.6f,%.6f", lat, lon)
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	mocksensor "github.com/emiliogozo/panahon-api-go/internal/mocks/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncWeatherlinkStations(t *testing.T) {
+	apiKey := util.ToPgText(util.RandomString(12))
+	apiSecret := util.ToPgText(util.RandomString(24))
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore)
+		checkResponse func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore)
+	}{
+		{
+			name: "Default",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				synced := db.Weatherlink{
+					ID:              1,
+					StationID:       24,
+					ApiKey:          apiKey,
+					ApiSecret:       apiSecret,
+					WlStationID:     pgtype.Int4{Int32: 111, Valid: true},
+					Lat:             pgtype.Float4{Float32: 14.0, Valid: true},
+					Lon:             pgtype.Float4{Float32: 121.0, Valid: true},
+					FirmwareVersion: util.ToPgText("1.4.1"),
+					SyncedAt:        pgtype.Timestamptz{Time: time.Now().Add(-24 * time.Hour), Valid: true},
+				}
+				unlinked := db.Weatherlink{
+					ID:        2,
+					StationID: 37,
+					ApiKey:    apiKey,
+					ApiSecret: apiSecret,
+				}
+				dashboard := db.Weatherlink{
+					ID:        3,
+					StationID: 555,
+					Uuid:      util.ToPgText(util.RandomString(32)),
+				}
+				store.EXPECT().ListWeatherlinkStations(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListWeatherlinkStationsParams")).
+					Return([]db.Weatherlink{synced, unlinked, dashboard}, nil)
+
+				davisSensor.EXPECT().FetchStations(mock.Anything).Return([]sensor.DavisStation{
+					{StationID: 111, Lat: 14.5, Lon: 121.0, FirmwareVersion: "1.4.2", Active: true},
+					{StationID: 121, Name: "Baguio", Lat: 16.4, Lon: 120.6, FirmwareVersion: "1.4.2", Active: true},
+					{StationID: 131, Name: "New", Lat: 7.1, Lon: 125.6, FirmwareVersion: "1.4.2", RecordingInterval: 5, Active: true},
+				}, nil).Once()
+
+				// known station, location and firmware changed
+				store.EXPECT().GetWeatherlinkStationByWLID(mock.AnythingOfType("backgroundCtx"), db.GetWeatherlinkStationByWLIDParams{
+					ApiKey:      apiKey,
+					WlStationID: pgtype.Int4{Int32: 111, Valid: true},
+				}).Return(synced, nil).Once()
+				store.EXPECT().CreateWeatherlinkChange(mock.AnythingOfType("backgroundCtx"), db.CreateWeatherlinkChangeParams{
+					StationID: 24,
+					Field:     WeatherlinkChangeLocation,
+					OldValue:  util.ToPgText("14.000000,121.000000"),
+					NewValue:  util.ToPgText("14.500000,121.000000"),
+				}).Return(db.WeatherlinkChange{}, nil).Once()
+				store.EXPECT().CreateWeatherlinkChange(mock.AnythingOfType("backgroundCtx"), db.CreateWeatherlinkChangeParams{
+					StationID: 24,
+					Field:     WeatherlinkChangeFirmware,
+					OldValue:  util.ToPgText("1.4.1"),
+					NewValue:  util.ToPgText("1.4.2"),
+				}).Return(db.WeatherlinkChange{}, nil).Once()
+
+				// unlinked row of the same key, matched by the station name and location
+				store.EXPECT().GetStation(mock.AnythingOfType("backgroundCtx"), int64(37)).Return(db.ObservationsStation{
+					ID:   37,
+					Name: "baguio ",
+					Lat:  pgtype.Float4{Float32: 16.4, Valid: true},
+					Lon:  pgtype.Float4{Float32: 120.6, Valid: true},
+				}, nil).Once()
+				store.EXPECT().GetWeatherlinkStationByWLID(mock.AnythingOfType("backgroundCtx"), db.GetWeatherlinkStationByWLIDParams{
+					ApiKey:      apiKey,
+					WlStationID: pgtype.Int4{Int32: 121, Valid: true},
+				}).Return(db.Weatherlink{}, db.ErrRecordNotFound).Once()
+
+				store.EXPECT().UpdateWeatherlinkStationMetadata(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.UpdateWeatherlinkStationMetadataParams")).
+					Run(func(ctx context.Context, arg db.UpdateWeatherlinkStationMetadataParams) {
+						switch arg.ID {
+						case synced.ID:
+							require.Equal(t, int32(111), arg.WlStationID.Int32)
+							require.Equal(t, "1.4.2", arg.FirmwareVersion.String)
+						case unlinked.ID:
+							require.Equal(t, int32(121), arg.WlStationID.Int32)
+						default:
+							t.Errorf("unexpected weatherlink id %d", arg.ID)
+						}
+					}).
+					Return(db.Weatherlink{}, nil).Twice()
+
+				// station not in the db yet
+				store.EXPECT().GetWeatherlinkStationByWLID(mock.AnythingOfType("backgroundCtx"), db.GetWeatherlinkStationByWLIDParams{
+					ApiKey:      apiKey,
+					WlStationID: pgtype.Int4{Int32: 131, Valid: true},
+				}).Return(db.Weatherlink{}, db.ErrRecordNotFound).Once()
+				store.EXPECT().CreateWeatherlinkStationTx(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.CreateWeatherlinkStationTxParams")).
+					Run(func(ctx context.Context, arg db.CreateWeatherlinkStationTxParams) {
+						require.Equal(t, "New", arg.Name)
+						require.Equal(t, "ONLINE", arg.Status.String)
+						require.Equal(t, apiKey, arg.ApiKey)
+						require.Equal(t, apiSecret, arg.ApiSecret)
+						require.Equal(t, int32(131), arg.WlStationID.Int32)
+						require.Equal(t, int32(5), arg.RecordingInterval.Int32)
+					}).
+					Return(db.CreateWeatherlinkStationTxResult{}, nil).Once()
+			},
+			checkResponse: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				davisSensor.AssertExpectations(t)
+				store.AssertExpectations(t)
+			},
+		},
+		{
+			name: "LinkByDeviceID",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				byName := db.Weatherlink{ID: 2, StationID: 37, ApiKey: apiKey, ApiSecret: apiSecret}
+				byDID := db.Weatherlink{ID: 3, StationID: 38, ApiKey: apiKey, ApiSecret: apiSecret, V1User: util.ToPgText("001d0a00abcd")}
+				store.EXPECT().ListWeatherlinkStations(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListWeatherlinkStationsParams")).
+					Return([]db.Weatherlink{byName, byDID}, nil)
+				store.EXPECT().GetStation(mock.AnythingOfType("backgroundCtx"), int64(37)).Return(db.ObservationsStation{
+					ID:   37,
+					Name: "Baguio",
+					Lat:  pgtype.Float4{Float32: 16.4, Valid: true},
+					Lon:  pgtype.Float4{Float32: 120.6, Valid: true},
+				}, nil).Once()
+				store.EXPECT().GetStation(mock.AnythingOfType("backgroundCtx"), int64(38)).Return(db.ObservationsStation{
+					ID:  38,
+					Lat: pgtype.Float4{Float32: 16.4, Valid: true},
+					Lon: pgtype.Float4{Float32: 120.6, Valid: true},
+				}, nil).Once()
+
+				davisSensor.EXPECT().FetchStations(mock.Anything).Return([]sensor.DavisStation{
+					{StationID: 121, GatewayID: "001D0A00ABCD", Name: "Baguio", Lat: 16.4, Lon: 120.6, Active: true},
+				}, nil).Once()
+				store.EXPECT().GetWeatherlinkStationByWLID(mock.AnythingOfType("backgroundCtx"), mock.Anything).
+					Return(db.Weatherlink{}, db.ErrRecordNotFound).Once()
+				store.EXPECT().UpdateWeatherlinkStationMetadata(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.UpdateWeatherlinkStationMetadataParams) bool {
+					return arg.ID == byDID.ID && arg.WlStationID.Int32 == 121
+				})).Return(db.Weatherlink{}, nil).Once()
+			},
+			checkResponse: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				davisSensor.AssertExpectations(t)
+				store.AssertExpectations(t)
+			},
+		},
+		{
+			name: "AmbiguousMatch",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				store.EXPECT().ListWeatherlinkStations(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListWeatherlinkStationsParams")).
+					Return([]db.Weatherlink{
+						{ID: 2, StationID: 37, ApiKey: apiKey, ApiSecret: apiSecret},
+						{ID: 3, StationID: 38, ApiKey: apiKey, ApiSecret: apiSecret},
+					}, nil)
+				for _, id := range []int64{37, 38} {
+					store.EXPECT().GetStation(mock.AnythingOfType("backgroundCtx"), id).Return(db.ObservationsStation{
+						ID:   id,
+						Name: "Baguio",
+						Lat:  pgtype.Float4{Float32: 16.4, Valid: true},
+						Lon:  pgtype.Float4{Float32: 120.6, Valid: true},
+					}, nil).Once()
+				}
+
+				davisSensor.EXPECT().FetchStations(mock.Anything).Return([]sensor.DavisStation{
+					{StationID: 121, Name: "Baguio", Lat: 16.4, Lon: 120.6, Active: true},
+					{StationID: 131, Name: "Davao", Lat: 7.1, Lon: 125.6, Active: true},
+				}, nil).Once()
+				store.EXPECT().GetWeatherlinkStationByWLID(mock.AnythingOfType("backgroundCtx"), mock.Anything).
+					Return(db.Weatherlink{}, db.ErrRecordNotFound).Twice()
+			},
+			checkResponse: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				davisSensor.AssertExpectations(t)
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateWeatherlinkStationMetadata", mock.Anything, mock.Anything)
+				store.AssertNotCalled(t, "CreateWeatherlinkStationTx", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "APIError",
+			buildStubs: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				store.EXPECT().ListWeatherlinkStations(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListWeatherlinkStationsParams")).
+					Return([]db.Weatherlink{{ID: 1, StationID: 24, ApiKey: apiKey, ApiSecret: apiSecret}}, nil)
+				store.EXPECT().GetStation(mock.AnythingOfType("backgroundCtx"), int64(24)).Return(db.ObservationsStation{ID: 24}, nil).Once()
+				davisSensor.EXPECT().FetchStations(mock.Anything).Return(nil, sensor.ErrDavisInvalidCredentials).Once()
+			},
+			checkResponse: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
+				davisSensor.AssertExpectations(t)
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateWeatherlinkStationMetadata", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			davisSensor := mocksensor.NewMockDavisSensor(t)
+			tc.buildStubs(davisSensor, store)
+
+			sensorFactory := func(cred sensor.DavisAPICredentials) sensor.DavisSensor {
+				return davisSensor
+			}
+
+			config := util.Config{
+				EnableFileLogging: false,
+			}
+			logger := util.NewLogger(config)
+
+			err := SyncWeatherlinkStations(context.Background(), sensorFactory, store, logger)
+			require.NoError(t, err)
+
+			tc.checkResponse(davisSensor, store)
+		})
+	}
+}
@@ -2,14 +2,11 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net/url"
-	"time"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	"github.com/emiliogozo/panahon-api-go/internal/sensor"
-	"github.com/emiliogozo/panahon-api-go/internal/util"
-	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog"
 )
 
@@ -26,208 +23,202 @@ func InsertCurrentObservations(ctx context.Context, store db.Store, logger *zero
 		return err
 	}
 	obs = append(obs, obs1...)
-	count := len(obs)
-	countOnline := 0
-	for _, o := range obs {
-		statusStr := "OFFLINE"
-		if time.Since(o.Timestamp.Time) < time.Hour {
-			statusStr = "ONLINE"
-			countOnline++
-		}
-		if _, err := store.UpdateStation(ctx, db.UpdateStationParams{
-			ID:     o.StationID,
-			Status: pgtype.Text{String: statusStr, Valid: true},
-		}); err != nil {
-			logger.Error().Err(err).Str("service", serviceName).Msg("update status error")
-		}
-	}
-	logger.Info().Str("service", serviceName).Str("online", fmt.Sprintf("%d/%d", countOnline, count)).Msg("insert data successful")
-	return nil
+	logger.Info().Str("service", serviceName).Int("count", len(obs)).Msg("insert data successful")
+
+	return UpdateStationStatuses(ctx, store, DefaultStationStatusConfig, logger)
 }
 
-func InsertCurrentDavisObservations(ctx context.Context, davisFactory sensor.DavisFactory, store db.Store, logger *zerolog.Logger) error {
+func InsertCurrentDavisObservations(ctx context.Context, davisPool *DavisPool, store db.Store, logger *zerolog.Logger) error {
 	serviceName := "InsertCurrentDavisObservations"
-	stations, err := store.ListStations(ctx, db.ListStationsParams{})
+	stations, err := store.ListWeatherlinkStations(ctx, db.ListWeatherlinkStationsParams{})
 	if err != nil {
 		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
 		return err
 	}
-	count := 0
-	countSuccess := 0
-	for _, stn := range stations {
-		if stn.StationType.String != "MO" || !stn.StationUrl.Valid || stn.Status.String == "INACTIVE" {
+	jobs := make([]davisJob, 0)
+	for _, dStn := range stations {
+		if !dStn.V1User.Valid || dStn.V1User.String == "" || !dStn.V1Pass.Valid || dStn.V1Pass.String == "" || !dStn.V1ApiToken.Valid || dStn.V1ApiToken.String == "" {
 			continue
 		}
-
-		sleepDuration := time.Duration(util.RandomInt(1, 5)) * time.Second
-		parsedUrl, err := url.Parse(stn.StationUrl.String)
+		stn, err := store.GetStation(ctx, dStn.StationID)
 		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Msg("database error")
 			continue
 		}
-		creds := sensor.DavisAPICredentials{
-			User:     parsedUrl.Query().Get("user"),
-			Pass:     parsedUrl.Query().Get("pass"),
-			APIToken: parsedUrl.Query().Get("apiToken"),
+		if stn.StationType.String != "MO" || stn.Status.String == StationStatusInactive {
+			continue
 		}
-		davis := davisFactory(creds, sleepDuration)
-		davisObs, err := davis.FetchLatest()
-		if err != nil {
-			logger.Error().Err(err).Str("service", serviceName).Msg("api error")
+
+		jobs = append(jobs, davisJob{
+			stationID: stn.ID,
+			creds: sensor.DavisAPICredentials{
+				User:     dStn.V1User.String,
+				Pass:     dStn.V1Pass.String,
+				APIToken: dStn.V1ApiToken.String,
+			},
+		})
+	}
+
+	count := 0
+	countSuccess := 0
+	for i, res := range davisPool.fetch(ctx, jobs) {
+		stnID := jobs[i].stationID
+		if res.err != nil {
+			logDavisError(logger, serviceName, stnID, res.err)
 			continue
 		}
 		count++
 
-		err = storeDavisToCurrentObservation(stn.ID, davisObs[0], ctx, store)
+		err = storeDavisToCurrentObservation(stnID, res.obs, ctx, store)
 		if err != nil {
 			logger.Error().Err(err).Str("service", serviceName).Msg("cannot create new data")
 			continue
 		}
 		countSuccess++
-		statusStr := "OFFLINE"
-		if time.Since(davisObs[0].Timestamp.Time) < time.Hour {
-			statusStr = "ONLINE"
-		}
-
-		_, err = store.UpdateStation(ctx, db.UpdateStationParams{
-			ID:     stn.ID,
-			Status: pgtype.Text{String: statusStr, Valid: true},
-		})
-		if err != nil {
-			logger.Error().Err(err).Str("service", serviceName).Msg("update status error")
-		}
 	}
 	logger.Info().Str("service", serviceName).Str("success", fmt.Sprintf("%d/%d", countSuccess, count)).Msg("insert data successful")
 	return nil
 }
 
-func InsertCurrentDavisObservationsV2(ctx context.Context, davisFactory sensor.DavisFactory, store db.Store, logger *zerolog.Logger) error {
+func InsertCurrentDavisObservationsV2(ctx context.Context, davisPool *DavisPool, store db.Store, logger *zerolog.Logger) error {
 	serviceName := "InsertCurrentDavisObservationsV2"
 	stations, err := store.ListWeatherlinkStations(ctx, db.ListWeatherlinkStationsParams{})
 	if err != nil {
 		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
 		return err
 	}
-	count := 0
-	countSuccess := 0
+	jobs := make([]davisJob, 0)
 	for _, dStn := range stations {
 		stn, err := store.GetStation(ctx, dStn.StationID)
 		if err != nil {
 			logger.Error().Err(err).Str("service", serviceName).Msg("database error")
 			continue
 		}
-		if stn.Status.String == "INACTIVE" || !dStn.ApiKey.Valid || dStn.ApiKey.String == "" || !dStn.ApiSecret.Valid || dStn.ApiSecret.String == "" {
+		if stn.Status.String == StationStatusInactive || !dStn.ApiKey.Valid || dStn.ApiKey.String == "" || !dStn.ApiSecret.Valid || dStn.ApiSecret.String == "" {
 			// logger.Info().Str("service", serviceName).Str("station", stn.Name).Msg("inactive or invalid api credentials")
 			continue
 		}
 
-		sleepDuration := time.Duration(util.RandomInt(1, 5)) * time.Second
+		jobs = append(jobs, davisJob{
+			stationID: stn.ID,
+			creds: sensor.DavisAPICredentials{
+				APIKey:    dStn.ApiKey.String,
+				APISecret: dStn.ApiSecret.String,
+			},
+		})
+	}
 
-		creds := sensor.DavisAPICredentials{
-			APIKey:    dStn.ApiKey.String,
-			APISecret: dStn.ApiSecret.String,
-		}
-		davis := davisFactory(creds, sleepDuration)
-		davisObs, err := davis.FetchLatest()
-		if err != nil {
-			logger.Error().Err(err).Str("service", serviceName).Msg("api error")
+	count := 0
+	countSuccess := 0
+	for i, res := range davisPool.fetch(ctx, jobs) {
+		stnID := jobs[i].stationID
+		if res.err != nil {
+			logDavisError(logger, serviceName, stnID, res.err)
 			continue
 		}
 		count++
 
-		err = storeDavis(stn.ID, davisObs[0], ctx, store)
+		err = storeDavis(stnID, res.obs, ctx, store)
 		if err != nil {
 			logger.Error().Err(err).Str("service", serviceName).Msg("cannot create new data")
 			continue
 		}
 		countSuccess++
-		// statusStr := "OFFLINE"
-		// if time.Since(davisObs[0].Timestamp.Time) < time.Hour {
-		// 	statusStr = "ONLINE"
-		// }
-		//
-		// _, err = store.UpdateStation(ctx, db.UpdateStationParams{
-		// 	ID:     stn.ID,
-		// 	Status: pgtype.Text{String: statusStr, Valid: true},
-		// })
-		// if err != nil {
-		// 	logger.Error().Err(err).Str("service", serviceName).Msg("update status error")
-		// }
 	}
 	logger.Info().Str("service", serviceName).Str("success", fmt.Sprintf("%d/%d", countSuccess, count)).Msg("insert data successful")
 	return nil
 }
 
-func InsertCurrentDavisObservationsDashboard(ctx context.Context, davisFactory sensor.DavisFactory, store db.Store, logger *zerolog.Logger) error {
+func InsertCurrentDavisObservationsDashboard(ctx context.Context, davisPool *DavisPool, store db.Store, logger *zerolog.Logger) error {
 	serviceName := "InsertCurrentDavisObservationsDashboard"
 	stations, err := store.ListWeatherlinkStations(ctx, db.ListWeatherlinkStationsParams{})
 	if err != nil {
 		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
 		return err
 	}
-	count := 0
-	countSuccess := 0
+	jobs := make([]davisJob, 0)
 	for _, dStn := range stations {
 		stn, err := store.GetStation(ctx, dStn.StationID)
 		if err != nil {
 			logger.Error().Err(err).Str("service", serviceName).Msg("database error")
 			continue
 		}
-		if stn.Status.String == "INACTIVE" || !dStn.Uuid.Valid || dStn.Uuid.String == "" {
+		if stn.Status.String == StationStatusInactive || !dStn.Uuid.Valid || dStn.Uuid.String == "" {
 			// logger.Info().Str("service", serviceName).Str("station", stn.Name).Msg("inactive or missing station uuid")
 			continue
 		}
 
-		sleepDuration := time.Duration(util.RandomInt(1, 3)) * time.Second
+		jobs = append(jobs, davisJob{
+			stationID: stn.ID,
+			creds: sensor.DavisAPICredentials{
+				StnUUID: dStn.Uuid.String,
+			},
+		})
+	}
 
-		creds := sensor.DavisAPICredentials{
-			StnUUID: dStn.Uuid.String,
-		}
-		davis := davisFactory(creds, sleepDuration)
-		davisObs, err := davis.FetchLatest()
-		if err != nil {
-			logger.Error().Err(err).Str("service", serviceName).Msg("api error")
+	count := 0
+	countSuccess := 0
+	for i, res := range davisPool.fetch(ctx, jobs) {
+		stnID := jobs[i].stationID
+		if res.err != nil {
+			logDavisError(logger, serviceName, stnID, res.err)
 			continue
 		}
 		count++
-		logger.Debug().Interface("davis", davisObs).Str("service", serviceName)
+		logger.Debug().Interface("davis", res.obs).Str("service", serviceName)
 
-		err = storeDavis(stn.ID, davisObs[0], ctx, store)
+		err = storeDavis(stnID, res.obs, ctx, store)
 		if err != nil {
 			logger.Error().Err(err).Str("service", serviceName).Msg("cannot create new data")
 			continue
 		}
 		countSuccess++
-		// statusStr := "OFFLINE"
-		// if time.Since(davisObs[0].Timestamp.Time) < time.Hour {
-		// 	statusStr = "ONLINE"
-		// }
-		//
-		// _, err = store.UpdateStation(ctx, db.UpdateStationParams{
-		// 	ID:     stn.ID,
-		// 	Status: pgtype.Text{String: statusStr, Valid: true},
-		// })
-		// if err != nil {
-		// 	logger.Error().Err(err).Str("service", serviceName).Msg("update status error")
-		// }
 	}
 	logger.Info().Str("service", serviceName).Str("success", fmt.Sprintf("%d/%d", countSuccess, count)).Msg("insert data successful")
 	return nil
 }
 
+func logDavisError(logger *zerolog.Logger, serviceName string, stnID int64, err error) {
+	if errors.Is(err, errDavisCircuitOpen) {
+		logger.Warn().Err(err).Str("service", serviceName).Int64("station_id", stnID).Msg("api error")
+		return
+	}
+	logger.Error().Err(err).Str("service", serviceName).Int64("station_id", stnID).Msg("api error")
+}
+
 func storeDavis(stnID int64, o sensor.DavisCurrentObservation, ctx context.Context, store db.Store) error {
 	_, err := store.CreateStationMOObservation(ctx, db.CreateStationMOObservationParams{
-		StationID: stnID,
-		Rr:        o.Rr,
-		Temp:      o.Temp,
-		Rh:        o.Rh,
-		Wdir:      o.Wdir,
-		Wspd:      o.Wspd,
-		Wspdx:     o.Wspdx,
-		Srad:      o.Srad,
-		Pres:      o.Pres,
-		Hi:        o.Hi,
-		QcLevel:   0,
-		Timestamp: o.Timestamp,
+		StationID:   stnID,
+		Rr:          o.Rr,
+		Temp:        o.Temp,
+		Rh:          o.Rh,
+		Wdir:        o.Wdir,
+		Wspd:        o.Wspd,
+		Wspdx:       o.Wspdx,
+		Srad:        o.Srad,
+		Pres:        o.Pres,
+		Hi:          o.Hi,
+		Td:          o.Td,
+		Wchill:      o.Wchill,
+		Rain:        o.Rain,
+		Tx:          o.Tx,
+		Tn:          o.Tn,
+		TxTimestamp: o.TxTimestamp,
+		TnTimestamp: o.TnTimestamp,
+		Wrun:        o.Wrun,
+		Thwi:        o.Thwi,
+		Thswi:       o.Thswi,
+		Senergy:     o.Senergy,
+		Sradx:       o.Sradx,
+		Uvi:         o.Uvi,
+		Uvdose:      o.Uvdose,
+		Uvx:         o.Uvx,
+		Hdd:         o.Hdd,
+		Cdd:         o.Cdd,
+		Et:          o.Et,
+		Wdirx:       o.Wdirx,
+		QcLevel:     0,
+		Timestamp:   o.Timestamp,
 	})
 	return err
 }
@@ -26,32 +26,42 @@ func TestInsertCurrentDavisObservations(t *testing.T) {
 			name: "Default",
 			buildStubs: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
 				stns := make([]db.ObservationsStation, 4)
+				davisStns := make([]db.Weatherlink, 4)
 				davisObsSlice := make([]sensor.DavisCurrentObservation, 0)
-				stnStatus := make([]string, 0)
 				for i := range stns {
 					stn := db.ObservationsStation{
+						ID:   int64(i + 1),
 						Name: fmt.Sprintf("stn%03d", i),
 						StationType: pgtype.Text{
 							String: "MO",
 							Valid:  true,
 						},
-						StationUrl: pgtype.Text{
-							String: "http://api.com/current/a.json",
-							Valid:  true,
-						},
 						Status: pgtype.Text{
 							String: "ONLINE",
 							Valid:  true,
 						},
 					}
-					if i == 1 {
-						stn.StationUrl.Valid = false
-					}
 					if i == 2 {
 						stn.Status.String = "INACTIVE"
 					}
 					stns[i] = stn
 
+					dStn := db.Weatherlink{
+						StationID:  stn.ID,
+						V1User:     util.ToPgText(util.RandomString(8)),
+						V1Pass:     util.ToPgText(util.RandomString(12)),
+						V1ApiToken: util.ToPgText(util.RandomString(32)),
+					}
+					if i == 1 {
+						// v2 credentials only
+						dStn = db.Weatherlink{
+							StationID: stn.ID,
+							ApiKey:    util.ToPgText(util.RandomString(12)),
+							ApiSecret: util.ToPgText(util.RandomString(24)),
+						}
+					}
+					davisStns[i] = dStn
+
 					dObs := sensor.DavisCurrentObservation{
 						Rr: pgtype.Float4{
 							Float32: util.RandomFloat[float32](2.5, 10.6),
@@ -67,43 +77,40 @@ func TestInsertCurrentDavisObservations(t *testing.T) {
 							Time:  time.Now(),
 							Valid: true,
 						}
-						stnStatus = append(stnStatus, "ONLINE")
-					} else {
-						if i == 3 {
-							dObs.Timestamp = pgtype.Timestamptz{
-								Time:  time.Now().Add(-2 * time.Hour),
-								Valid: true,
-							}
+					} else if i == 3 {
+						dObs.Timestamp = pgtype.Timestamptz{
+							Time:  time.Now().Add(-2 * time.Hour),
+							Valid: true,
 						}
-						stnStatus = append(stnStatus, "OFFLINE")
 					}
 					davisObsSlice = append(davisObsSlice, dObs)
 				}
 
-				store.EXPECT().ListStations(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListStationsParams")).
-					Return(stns, nil)
+				store.EXPECT().ListWeatherlinkStations(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.ListWeatherlinkStationsParams")).
+					Return(davisStns, nil)
 
 				for i, stn := range stns {
 					dObs := davisObsSlice[i]
-					if stn.StationType.String != "MO" || !stn.StationUrl.Valid || stn.Status.String == "INACTIVE" {
+					if !davisStns[i].V1User.Valid {
+						continue
+					}
+					store.EXPECT().GetStation(mock.AnythingOfType("backgroundCtx"), stn.ID).Return(stn, nil).Once()
+					if stn.Status.String == "INACTIVE" {
 						continue
 					}
-					davisSensor.EXPECT().FetchLatest().Return([]sensor.DavisCurrentObservation{dObs}, nil).Once()
+					davisSensor.EXPECT().FetchLatest(mock.Anything).Return([]sensor.DavisCurrentObservation{dObs}, nil).Once()
 					store.EXPECT().CreateCurrentObservation(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.CreateCurrentObservationParams")).
 						Run(func(ctx context.Context, arg db.CreateCurrentObservationParams) {
 							require.InDelta(t, dObs.Rr.Float32, arg.Rain.Float32, 0.01)
 						}).
 						Return(db.ObservationsCurrent{}, nil).Once()
-					store.EXPECT().UpdateStation(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.UpdateStationParams")).
-						Run(func(ctx context.Context, arg db.UpdateStationParams) {
-							require.Equal(t, stnStatus[i], arg.Status.String)
-						}).
-						Return(db.ObservationsStation{}, nil).Once()
 				}
 			},
 			checkResponse: func(davisSensor *mocksensor.MockDavisSensor, store *mockdb.MockStore) {
 				davisSensor.AssertExpectations(t)
 				store.AssertExpectations(t)
+				// the status is evaluated for all stations by UpdateStationStatuses
+				store.AssertNotCalled(t, "UpdateStation", mock.Anything, mock.Anything)
 			},
 		},
 	}
@@ -115,7 +122,7 @@ func TestInsertCurrentDavisObservations(t *testing.T) {
 			davisSensor := mocksensor.NewMockDavisSensor(t)
 			tc.buildStubs(davisSensor, store)
 
-			sensorFactory := func(cred sensor.DavisAPICredentials, sleepDuration time.Duration) sensor.DavisSensor {
+			sensorFactory := func(cred sensor.DavisAPICredentials) sensor.DavisSensor {
 				return davisSensor
 			}
 
@@ -125,7 +132,7 @@ func TestInsertCurrentDavisObservations(t *testing.T) {
 			logger := util.NewLogger(config)
 
 			ctx := context.Background()
-			InsertCurrentDavisObservations(ctx, sensorFactory, store, logger)
+			InsertCurrentDavisObservations(ctx, NewDavisPool(sensorFactory, DavisPoolConfig{Workers: 1}), store, logger)
 			tc.checkResponse(davisSensor, store)
 		})
 	}
@@ -221,7 +228,7 @@ func TestInsertCurrentDavisObservationsV2(t *testing.T) {
 					if stn.Status.String == "INACTIVE" || !((dStn.ApiKey.Valid && dStn.ApiKey.String != "") && (dStn.ApiSecret.Valid && dStn.ApiSecret.String != "")) {
 						continue
 					}
-					davisSensor.EXPECT().FetchLatest().Return([]sensor.DavisCurrentObservation{dObs}, nil).Once()
+					davisSensor.EXPECT().FetchLatest(mock.Anything).Return([]sensor.DavisCurrentObservation{dObs}, nil).Once()
 					store.EXPECT().CreateStationMOObservation(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.CreateStationMOObservationParams")).
 						Run(func(ctx context.Context, arg db.CreateStationMOObservationParams) {
 							require.InDelta(t, dObs.Rr.Float32, arg.Rr.Float32, 0.01)
@@ -248,7 +255,7 @@ func TestInsertCurrentDavisObservationsV2(t *testing.T) {
 			davisSensor := mocksensor.NewMockDavisSensor(t)
 			tc.buildStubs(davisSensor, store)
 
-			sensorFactory := func(cred sensor.DavisAPICredentials, sleepDuration time.Duration) sensor.DavisSensor {
+			sensorFactory := func(cred sensor.DavisAPICredentials) sensor.DavisSensor {
 				return davisSensor
 			}
 
@@ -258,7 +265,7 @@ func TestInsertCurrentDavisObservationsV2(t *testing.T) {
 			logger := util.NewLogger(config)
 
 			ctx := context.Background()
-			InsertCurrentDavisObservationsV2(ctx, sensorFactory, store, logger)
+			InsertCurrentDavisObservationsV2(ctx, NewDavisPool(sensorFactory, DavisPoolConfig{Workers: 1}), store, logger)
 			tc.checkResponse(davisSensor, store)
 		})
 	}
@@ -315,6 +322,22 @@ func TestInsertCurrentDavisObservationsDashboard(t *testing.T) {
 							Float32: util.RandomFloat[float32](22.0, 32.9),
 							Valid:   true,
 						},
+						Tx: pgtype.Float4{
+							Float32: util.RandomFloat[float32](30.0, 35.9),
+							Valid:   true,
+						},
+						Tn: pgtype.Float4{
+							Float32: util.RandomFloat[float32](18.0, 21.9),
+							Valid:   true,
+						},
+						TxTimestamp: pgtype.Timestamptz{
+							Time:  time.Now().Add(-4 * time.Hour).Truncate(time.Second),
+							Valid: true,
+						},
+						TnTimestamp: pgtype.Timestamptz{
+							Time:  time.Now().Add(-10 * time.Hour).Truncate(time.Second),
+							Valid: true,
+						},
 					}
 					if i == 0 || i == 3 {
 						dObs.Timestamp = pgtype.Timestamptz{
@@ -344,10 +367,14 @@ func TestInsertCurrentDavisObservationsDashboard(t *testing.T) {
 					if stn.Status.String == "INACTIVE" || !dStn.Uuid.Valid || dStn.Uuid.String == "" {
 						continue
 					}
-					davisSensor.EXPECT().FetchLatest().Return([]sensor.DavisCurrentObservation{dObs}, nil).Once()
+					davisSensor.EXPECT().FetchLatest(mock.Anything).Return([]sensor.DavisCurrentObservation{dObs}, nil).Once()
 					store.EXPECT().CreateStationMOObservation(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.CreateStationMOObservationParams")).
 						Run(func(ctx context.Context, arg db.CreateStationMOObservationParams) {
 							require.InDelta(t, dObs.Rr.Float32, arg.Rr.Float32, 0.01)
+							require.Equal(t, dObs.Tx, arg.Tx)
+							require.Equal(t, dObs.Tn, arg.Tn)
+							require.Equal(t, dObs.TxTimestamp, arg.TxTimestamp)
+							require.Equal(t, dObs.TnTimestamp, arg.TnTimestamp)
 						}).
 						Return(db.ObservationsMoObservation{}, nil).Once()
 					// store.EXPECT().UpdateStation(mock.AnythingOfType("backgroundCtx"), mock.AnythingOfType("db.UpdateStationParams")).
@@ -371,7 +398,7 @@ func TestInsertCurrentDavisObservationsDashboard(t *testing.T) {
 			davisSensor := mocksensor.NewMockDavisSensor(t)
 			tc.buildStubs(davisSensor, store)
 
-			sensorFactory := func(cred sensor.DavisAPICredentials, sleepDuration time.Duration) sensor.DavisSensor {
+			sensorFactory := func(cred sensor.DavisAPICredentials) sensor.DavisSensor {
 				return davisSensor
 			}
 
@@ -381,7 +408,7 @@ func TestInsertCurrentDavisObservationsDashboard(t *testing.T) {
 			logger := util.NewLogger(config)
 
 			ctx := context.Background()
-			InsertCurrentDavisObservationsDashboard(ctx, sensorFactory, store, logger)
+			InsertCurrentDavisObservationsDashboard(ctx, NewDavisPool(sensorFactory, DavisPoolConfig{Workers: 1}), store, logger)
 			tc.checkResponse(davisSensor, store)
 		})
 	}
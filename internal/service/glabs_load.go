@@ -0,0 +1,213 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/notify/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+// GLabsLoadStatusSuccess is the callback status of a delivered load, any other callback status is a failure
+const GLabsLoadStatusSuccess = "SUCCESS"
+
+type LoadTopUpConfig struct {
+	// ExpiryLead is how long before the load expiry a top-up is requested
+	ExpiryLead time.Duration
+	// SilentAfter is how long a station may go without observations before a top-up is requested
+	SilentAfter time.Duration
+	// RequestCooldown is the minimum time between top-ups of the same SIM
+	RequestCooldown time.Duration
+}
+
+var DefaultLoadTopUpConfig = LoadTopUpConfig{
+	ExpiryLead:      72 * time.Hour,
+	SilentAfter:     6 * time.Hour,
+	RequestCooldown: 24 * time.Hour,
+}
+
+type RewardRequest struct {
+	MobileNumber string // mobile number in 63XXXXXXXXXX format
+	Promo        string
+}
+
+type RewardResult struct {
+	TransactionID int32
+	Status        string
+}
+
+// RewardSender requests prepaid load for a subscriber
+type RewardSender interface {
+	SendReward(ctx context.Context, req RewardRequest) (RewardResult, error)
+}
+
+// GLabsRewardsClient requests load through the Globe Labs rewards API.
+// The outcome is posted later to the load callback with the same transaction id.
+type GLabsRewardsClient struct {
+	baseURL      string
+	appID        string
+	appSecret    string
+	rewardsToken string
+	client       *http.Client
+}
+
+func NewGLabsRewardsClient(baseURL, appID, appSecret, rewardsToken string, client *http.Client) *GLabsRewardsClient {
+	if baseURL == "" {
+		baseURL = sms.DefaultGLabsAPIURL
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &GLabsRewardsClient{
+		baseURL:      strings.TrimRight(baseURL, "/"),
+		appID:        appID,
+		appSecret:    appSecret,
+		rewardsToken: rewardsToken,
+		client:       client,
+	}
+}
+
+type gLabsRewardReq struct {
+	OutboundRewardRequest struct {
+		AppID        string `json:"app_id"`
+		AppSecret    string `json:"app_secret"`
+		RewardsToken string `json:"rewards_token"`
+		Address      string `json:"address"`
+		Promo        string `json:"promo"`
+	} `json:"outboundRewardRequest"`
+}
+
+type gLabsRewardRes struct {
+	OutboundRewardRequest struct {
+		TransactionID json.Number `json:"transaction_id"`
+		Status        string      `json:"status"`
+	} `json:"outboundRewardRequest"`
+}
+
+func (c *GLabsRewardsClient) SendReward(ctx context.Context, r RewardRequest) (RewardResult, error) {
+	var reqBody gLabsRewardReq
+	reqBody.OutboundRewardRequest.AppID = c.appID
+	reqBody.OutboundRewardRequest.AppSecret = c.appSecret
+	reqBody.OutboundRewardRequest.RewardsToken = c.rewardsToken
+	reqBody.OutboundRewardRequest.Address = strings.TrimPrefix(r.MobileNumber, "63")
+	reqBody.OutboundRewardRequest.Promo = r.Promo
+
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return RewardResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/rewards/v1/transactions/send", bytes.NewReader(data))
+	if err != nil {
+		return RewardResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return RewardResult{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return RewardResult{}, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return RewardResult{}, fmt.Errorf("rewards api error: status %d: %s", resp.StatusCode, body)
+	}
+
+	var res gLabsRewardRes
+	if err := json.Unmarshal(body, &res); err != nil {
+		return RewardResult{}, fmt.Errorf("invalid rewards api response: %w", err)
+	}
+	txID, err := strconv.ParseInt(res.OutboundRewardRequest.TransactionID.String(), 10, 32)
+	if err != nil {
+		return RewardResult{}, fmt.Errorf("invalid rewards transaction id: %w", err)
+	}
+
+	return RewardResult{
+		TransactionID: int32(txID),
+		Status:        res.OutboundRewardRequest.Status,
+	}, nil
+}
+
+// TopUpStationLoads requests load for the SIMs whose load is about to expire or whose station went silent.
+// The requests are recorded in glabs_load and reconciled when the callback arrives.
+func TopUpStationLoads(ctx context.Context, store db.Store, rewards RewardSender, config LoadTopUpConfig, alertNumber string, logger *zerolog.Logger) {
+	serviceName := "TopUpStationLoads"
+	now := time.Now()
+	sims, err := store.ListSimCardsDueForLoad(ctx, db.ListSimCardsDueForLoadParams{
+		RequestedBefore: pgtype.Timestamptz{Time: now.Add(-config.RequestCooldown), Valid: true},
+		ExpiresBefore:   pgtype.Timestamptz{Time: now.Add(config.ExpiryLead), Valid: true},
+		SilentSince:     pgtype.Timestamptz{Time: now.Add(-config.SilentAfter), Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return
+	}
+
+	for _, sim := range sims {
+		res, err := rewards.SendReward(ctx, RewardRequest{
+			MobileNumber: sim.MobileNumber,
+			Promo:        sim.LoadPromo.String,
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).
+				Str("mobile_number", sim.MobileNumber).
+				Str("promo", sim.LoadPromo.String).
+				Msg("top-up request failed")
+			AlertFailedLoad(ctx, store, alertNumber, sim.MobileNumber, sim.LoadPromo.String, err.Error(), logger)
+		} else {
+			_, err = store.CreateGLabsLoad(ctx, db.CreateGLabsLoadParams{
+				Promo:         sim.LoadPromo,
+				TransactionID: pgtype.Int4{Int32: res.TransactionID, Valid: true},
+				Status:        util.ToPgText(res.Status),
+				MobileNumber:  sim.MobileNumber,
+			})
+			if err != nil {
+				logger.Error().Err(err).Str("service", serviceName).
+					Str("mobile_number", sim.MobileNumber).
+					Int32("transaction_id", res.TransactionID).
+					Msg("cannot record top-up request")
+			}
+		}
+
+		// failed requests also wait for the cooldown so a rejected promo is not retried and alerted on every run
+		if err := store.MarkSimCardLoadRequested(ctx, sim.MobileNumber); err != nil {
+			logger.Error().Err(err).Str("service", serviceName).
+				Str("mobile_number", sim.MobileNumber).
+				Msg("database error")
+		}
+	}
+}
+
+// AlertFailedLoad logs a failed top-up and texts the alert number when one is configured
+func AlertFailedLoad(ctx context.Context, store db.Store, alertNumber, mobileNumber, promo, reason string, logger *zerolog.Logger) {
+	logger.Error().Str("service", "GLabsLoad").
+		Str("mobile_number", mobileNumber).
+		Str("promo", promo).
+		Str("reason", reason).
+		Msg("load top-up failed")
+
+	if alertNumber == "" {
+		return
+	}
+
+	text := fmt.Sprintf("Load top-up failed for %s (%s): %s", mobileNumber, promo, reason)
+	if _, err := sms.Enqueue(ctx, store, alertNumber, text); err != nil {
+		logger.Error().Err(err).Str("service", "GLabsLoad").
+			Str("mobile_number", mobileNumber).
+			Msg("cannot queue load alert")
+	}
+}
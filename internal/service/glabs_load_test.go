@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRewardSender struct {
+	requests []RewardRequest
+	result   RewardResult
+	err      error
+}
+
+func (f *fakeRewardSender) SendReward(_ context.Context, req RewardRequest) (RewardResult, error) {
+	f.requests = append(f.requests, req)
+	return f.result, f.err
+}
+
+func TestGLabsRewardsClient(t *testing.T) {
+	var got gLabsRewardReq
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/rewards/v1/transactions/send", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"outboundRewardRequest":{"transaction_id":"1234567","status":"Accepted","address":"9171234567","promo":"LOAD50"}}`))
+	}))
+	defer server.Close()
+
+	client := NewGLabsRewardsClient(server.URL, "app", "secret", "token", server.Client())
+	res, err := client.SendReward(context.Background(), RewardRequest{MobileNumber: "639171234567", Promo: "LOAD50"})
+	require.NoError(t, err)
+	require.Equal(t, RewardResult{TransactionID: 1234567, Status: "Accepted"}, res)
+	require.Equal(t, "9171234567", got.OutboundRewardRequest.Address)
+	require.Equal(t, "LOAD50", got.OutboundRewardRequest.Promo)
+	require.Equal(t, "token", got.OutboundRewardRequest.RewardsToken)
+}
+
+func TestGLabsRewardsClientError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid promo"}`))
+	}))
+	defer server.Close()
+
+	client := NewGLabsRewardsClient(server.URL, "app", "secret", "token", server.Client())
+	_, err := client.SendReward(context.Background(), RewardRequest{MobileNumber: "639171234567", Promo: "LOAD50"})
+	require.ErrorContains(t, err, "invalid promo")
+}
+
+func TestTopUpStationLoads(t *testing.T) {
+	sim := db.SimCard{
+		MobileNumber: gofakeit.Regex("639[0-9]{9}"),
+		LoadPromo:    util.ToPgText("LOAD50"),
+	}
+	alertNumber := gofakeit.Regex("639[0-9]{9}")
+	config := DefaultLoadTopUpConfig
+
+	listStub := func(store *mockdb.MockStore) {
+		store.EXPECT().ListSimCardsDueForLoad(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.ListSimCardsDueForLoadParams) bool {
+			return time.Until(arg.ExpiresBefore.Time) > config.ExpiryLead-time.Minute &&
+				time.Since(arg.SilentSince.Time) > config.SilentAfter-time.Minute &&
+				time.Since(arg.RequestedBefore.Time) > config.RequestCooldown-time.Minute
+		})).Return([]db.SimCard{sim}, nil)
+	}
+
+	testCases := []struct {
+		name       string
+		rewards    *fakeRewardSender
+		buildStubs func(store *mockdb.MockStore)
+	}{
+		{
+			name:    "Requested",
+			rewards: &fakeRewardSender{result: RewardResult{TransactionID: 42, Status: "Accepted"}},
+			buildStubs: func(store *mockdb.MockStore) {
+				listStub(store)
+				store.EXPECT().CreateGLabsLoad(mock.AnythingOfType("backgroundCtx"), db.CreateGLabsLoadParams{
+					Promo:         sim.LoadPromo,
+					TransactionID: pgtype.Int4{Int32: 42, Valid: true},
+					Status:        util.ToPgText("Accepted"),
+					MobileNumber:  sim.MobileNumber,
+				}).Return(db.GlabsLoad{}, nil)
+				store.EXPECT().MarkSimCardLoadRequested(mock.AnythingOfType("backgroundCtx"), sim.MobileNumber).Return(nil)
+			},
+		},
+		{
+			name:    "RequestFailed",
+			rewards: &fakeRewardSender{err: errors.New("rewards api error")},
+			buildStubs: func(store *mockdb.MockStore) {
+				listStub(store)
+				store.EXPECT().CreateSmsOutbox(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.CreateSmsOutboxParams) bool {
+					return arg.MobileNumber == alertNumber
+				})).Return(db.SmsOutbox{}, nil)
+				store.EXPECT().MarkSimCardLoadRequested(mock.AnythingOfType("backgroundCtx"), sim.MobileNumber).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			logger := util.NewLogger(util.Config{EnableFileLogging: false})
+			TopUpStationLoads(context.Background(), store, tc.rewards, config, alertNumber, logger)
+
+			store.AssertExpectations(t)
+			require.Equal(t, []RewardRequest{{MobileNumber: sim.MobileNumber, Promo: "LOAD50"}}, tc.rewards.requests)
+		})
+	}
+}
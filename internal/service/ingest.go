@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StoreStationObservation stores a parsed observation together with the station health
+func StoreStationObservation(ctx context.Context, store db.Store, stationID int64, obs sensor.StationObservation, health sensor.StationHealth) (db.ObservationsObservation, db.ObservationsStationhealth, error) {
+	obsArg := db.CreateStationObservationParams{
+		StationID:          stationID,
+		Pres:               util.ToFloat4(obs.Pres),
+		Rr:                 util.ToFloat4(obs.Rr),
+		RainTips:           util.ToInt4(obs.RainTips),
+		RainCumulativeTips: util.ToInt4(obs.RainCumulativeTips),
+		RainAccum:          util.ToFloat4(obs.RainAccum),
+		Rh:                 util.ToFloat4(obs.Rh),
+		Temp:               util.ToFloat4(obs.Temp),
+		Td:                 util.ToFloat4(obs.Td),
+		Wdir:               util.ToFloat4(obs.Wdir),
+		Wspd:               util.ToFloat4(obs.Wspd),
+		Wspdx:              util.ToFloat4(obs.Wspdx),
+		Srad:               util.ToFloat4(obs.Srad),
+		Mslp:               util.ToFloat4(obs.Mslp),
+		Hi:                 util.ToFloat4(obs.Hi),
+		Wchill:             util.ToFloat4(obs.Wchill),
+		Timestamp: pgtype.Timestamptz{
+			Time:  obs.Timestamp,
+			Valid: true,
+		},
+	}
+
+	dbObs, err := store.CreateStationObservation(ctx, obsArg)
+	if err != nil {
+		return db.ObservationsObservation{}, db.ObservationsStationhealth{}, err
+	}
+
+	healthArg := db.CreateStationHealthParams{
+		StationID:         stationID,
+		Vb1:               util.ToFloat4(health.Vb1),
+		Vb2:               util.ToFloat4(health.Vb2),
+		Curr:              util.ToFloat4(health.Curr),
+		Bp1:               util.ToFloat4(health.Bp1),
+		Bp2:               util.ToFloat4(health.Bp2),
+		Cm:                util.ToPgText(health.Cm),
+		Ss:                util.ToInt4(health.Ss),
+		TempArq:           util.ToFloat4(health.TempArq),
+		RhArq:             util.ToFloat4(health.RhArq),
+		Fpm:               util.ToPgText(health.Fpm),
+		MinutesDifference: util.ToInt4(&health.MinutesDifference),
+		DataCount:         util.ToInt4(&health.DataCount),
+		DataStatus:        util.ToPgText(health.DataStatus),
+		Timestamp: pgtype.Timestamptz{
+			Time:  health.Timestamp,
+			Valid: true,
+		},
+		Message:  util.ToPgText(health.Message),
+		ErrorMsg: util.ToPgText(health.ErrorMsg),
+	}
+
+	dbHealth, err := store.CreateStationHealth(ctx, healthArg)
+	if err != nil {
+		return dbObs, db.ObservationsStationhealth{}, err
+	}
+
+	return dbObs, dbHealth, nil
+}
+
+// GetClockSettings returns the clock settings of the station and whether they were explicitly configured
+func GetClockSettings(ctx context.Context, store db.Store, stationID int64) (sensor.ClockSettings, bool, error) {
+	clock, err := store.GetStationClock(ctx, stationID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return sensor.ClockSettings{Timezone: sensor.DefaultTimezone}, false, nil
+		}
+		return sensor.ClockSettings{}, false, err
+	}
+
+	return sensor.ClockSettings{
+		Timezone:    clock.Timezone,
+		ClockOffset: clock.ClockOffset,
+	}, true, nil
+}
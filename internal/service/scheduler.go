@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/notify/sms"
 	"github.com/emiliogozo/panahon-api-go/internal/sensor"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/go-co-op/gocron/v2"
 	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
 )
 
 func ScheduleJobs(ctx context.Context, store db.Store, conf util.Config, logger *zerolog.Logger) {
@@ -17,6 +20,20 @@ func ScheduleJobs(ctx context.Context, store db.Store, conf util.Config, logger
 		logger.Fatal().Err(err).Str("service", "Initialization").Msg("error scheduling job")
 	}
 
+	// all Davis jobs share one client so their requests stay within the API limits
+	davisClient := sensor.NewRateLimitedFetcher(
+		&http.Client{Timeout: DefaultDavisPoolConfig.RequestTimeout},
+		rate.NewLimiter(DavisRateLimit, DavisRateBurst),
+	)
+	secrets, err := util.LoadSecretCipher(conf.SecretEncryptionKey)
+	if err != nil {
+		logger.Fatal().Err(err).Str("service", "Initialization").Msg("cannot create secret cipher")
+	}
+	davisFactory := NewDavisFactory(davisClient, secrets, logger)
+
+	smsSender := sms.NewGLabsClient(conf.GlabsAPIURL, conf.GlabsShortCode, &http.Client{Timeout: 30 * time.Second})
+	rewards := NewGLabsRewardsClient(conf.GlabsAPIURL, conf.GlabsAppID, conf.GlabsAppSecret, conf.GlabsRewardsToken, &http.Client{Timeout: 30 * time.Second})
+
 	for _, job := range conf.CronJobs {
 		var (
 			jobFunc   any
@@ -24,10 +41,6 @@ func ScheduleJobs(ctx context.Context, store db.Store, conf util.Config, logger
 			cronSched string
 			jobName   string
 		)
-
-		davisFactory := func(creds sensor.DavisAPICredentials, sleepDuration time.Duration) sensor.DavisSensor {
-			return sensor.NewDavis(creds, sleepDuration)
-		}
 		switch job.Name {
 		case "lufft":
 			jobName = job.Name
@@ -38,17 +51,42 @@ func ScheduleJobs(ctx context.Context, store db.Store, conf util.Config, logger
 			jobName = job.Name
 			cronSched = job.Schedule
 			jobFunc = InsertCurrentDavisObservations
-			jobParams = []any{ctx, davisFactory, store, logger}
+			jobParams = []any{ctx, NewDavisPool(davisFactory, DefaultDavisPoolConfig), store, logger}
 		case "davisV2":
 			jobName = job.Name
 			cronSched = job.Schedule
 			jobFunc = InsertCurrentDavisObservationsV2
-			jobParams = []any{ctx, davisFactory, store, logger}
+			jobParams = []any{ctx, NewDavisPool(davisFactory, DefaultDavisPoolConfig), store, logger}
 		case "davisDashboard":
 			jobName = job.Name
 			cronSched = job.Schedule
 			jobFunc = InsertCurrentDavisObservationsDashboard
+			jobParams = []any{ctx, NewDavisPool(davisFactory, DefaultDavisPoolConfig), store, logger}
+		case "davisBackfill":
+			jobName = job.Name
+			cronSched = job.Schedule
+			jobFunc = BackfillDavisObservations
+			jobParams = []any{ctx, davisFactory, DefaultDavisBackfillConfig, store, logger}
+		case "davisSync":
+			jobName = job.Name
+			cronSched = job.Schedule
+			jobFunc = SyncWeatherlinkStations
 			jobParams = []any{ctx, davisFactory, store, logger}
+		case "smsCleanup":
+			jobName = job.Name
+			cronSched = job.Schedule
+			jobFunc = DeleteStaleSmsParts
+			jobParams = []any{ctx, store, logger}
+		case "smsOutbox":
+			jobName = job.Name
+			cronSched = job.Schedule
+			jobFunc = sms.NewQueue(store, smsSender, sms.DefaultQueueConfig, logger).Dispatch
+			jobParams = []any{ctx}
+		case "glabsLoad":
+			jobName = job.Name
+			cronSched = job.Schedule
+			jobFunc = TopUpStationLoads
+			jobParams = []any{ctx, store, rewards, DefaultLoadTopUpConfig, conf.GlabsLoadAlertNumber, logger}
 		default:
 			logger.Warn().Str("service", job.Name).Msg("cron job not supported")
 			continue
@@ -58,6 +96,7 @@ func ScheduleJobs(ctx context.Context, store db.Store, conf util.Config, logger
 			gocron.CronJob(cronSched, false),
 			gocron.NewTask(jobFunc, jobParams...),
 			gocron.WithName(jobName),
+			gocron.WithSingletonMode(gocron.LimitModeReschedule),
 		); err != nil {
 			logger.Fatal().Err(err).Str("service", jobName).Msg("error scheduling job")
 		}
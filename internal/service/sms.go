@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+// SmsPartMaxAge is how long the parts of an incomplete multipart SMS are kept
+const SmsPartMaxAge = 24 * time.Hour
+
+// DeleteStaleSmsParts removes multipart SMS parts that were never completed
+func DeleteStaleSmsParts(ctx context.Context, store db.Store, logger *zerolog.Logger) {
+	cutoff := pgtype.Timestamptz{Time: time.Now().Add(-SmsPartMaxAge), Valid: true}
+	if err := store.DeleteStaleSmsParts(ctx, cutoff); err != nil {
+		logger.Error().Err(err).Str("service", "DeleteStaleSmsParts").Msg("database error")
+	}
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteStaleSmsParts(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().DeleteStaleSmsParts(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(cutoff pgtype.Timestamptz) bool {
+		age := time.Since(cutoff.Time)
+		return cutoff.Valid && age >= SmsPartMaxAge && age < SmsPartMaxAge+time.Minute
+	})).Return(nil)
+
+	logger := util.NewLogger(util.Config{EnableFileLogging: false})
+	DeleteStaleSmsParts(context.Background(), store, logger)
+	store.AssertExpectations(t)
+}
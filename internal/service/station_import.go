@@ -0,0 +1,398 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MaxStationImportRows limits the stations of a single import
+const MaxStationImportRows = 5000
+
+// stationImportColumns are the CSV columns and GeoJSON properties read on import,
+// the export writes the same columns so that it can be imported back
+var stationImportColumns = []string{
+	"name", "lat", "lon", "elevation", "date_installed", "mobile_number", "station_type", "station_type2",
+	"station_url", "status", "province", "region", "address", "reporting_interval",
+}
+
+// StationImportRow is a station read from an import file together with the problems found in it
+type StationImportRow struct {
+	Row     int                    `json:"row"` // line of the CSV file or position of the GeoJSON feature
+	Name    string                 `json:"name"`
+	ID      int64                  `json:"id,omitempty"` // ID of the created station
+	Errors  []string               `json:"errors,omitempty"`
+	Station db.CreateStationParams `json:"-"`
+} //@name StationImportRow
+
+func (r *StationImportRow) addError(format string, a ...any) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, a...))
+}
+
+// StationFeatureCollection is a GeoJSON FeatureCollection of stations
+type StationFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []StationFeature `json:"features"`
+} //@name StationFeatureCollection
+
+type StationFeature struct {
+	Type       string         `json:"type"`
+	Geometry   *PointGeometry `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+} //@name StationFeature
+
+type PointGeometry struct {
+	Type string `json:"type"`
+	// longitude, latitude and optionally the elevation
+	Coordinates []float64 `json:"coordinates"`
+} //@name PointGeometry
+
+// ParseStationsCSV reads the stations of a CSV file with a header row, columns are matched by name and unknown columns are ignored.
+// Problems with the values are reported per row, the error is only set when the file itself cannot be read.
+func ParseStationsCSV(r io.Reader) ([]StationImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("missing CSV header")
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, h := range header {
+		h = strings.TrimPrefix(h, "\ufeff") // spreadsheet byte order mark
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, errors.New("missing name column")
+	}
+
+	var rows []StationImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == MaxStationImportRows {
+			return nil, fmt.Errorf("more than %d stations", MaxStationImportRows)
+		}
+		line, _ := reader.FieldPos(0)
+
+		fields := make(map[string]string, len(stationImportColumns))
+		for _, col := range stationImportColumns {
+			if i, ok := columns[col]; ok && i < len(record) {
+				fields[col] = strings.TrimSpace(record[i])
+			}
+		}
+		row := newStationImportRow(line, fields)
+		if len(record) != len(header) {
+			row.addError("expected %d columns, got %d", len(header), len(record))
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// ParseStationsGeoJSON reads the stations of a GeoJSON FeatureCollection of points, the properties use the CSV column names.
+// The point coordinates take precedence over the lat, lon and elevation properties.
+func ParseStationsGeoJSON(r io.Reader) ([]StationImportRow, error) {
+	// the geometry is checked per feature, other geometry types must not fail the whole file
+	var fc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry *struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]any `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.NewDecoder(r).Decode(&fc); err != nil {
+		return nil, err
+	}
+	if fc.Type != "FeatureCollection" {
+		return nil, errors.New("expected a GeoJSON FeatureCollection")
+	}
+	if len(fc.Features) > MaxStationImportRows {
+		return nil, fmt.Errorf("more than %d stations", MaxStationImportRows)
+	}
+
+	rows := make([]StationImportRow, len(fc.Features))
+	for i, f := range fc.Features {
+		fields := make(map[string]string, len(stationImportColumns))
+		for _, col := range stationImportColumns {
+			switch v := f.Properties[col].(type) {
+			case nil:
+			case string:
+				fields[col] = strings.TrimSpace(v)
+			case float64:
+				fields[col] = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				fields[col] = fmt.Sprint(v)
+			}
+		}
+
+		notPoint := false
+		if g := f.Geometry; g != nil {
+			var coords []float64
+			if g.Type != "Point" || json.Unmarshal(g.Coordinates, &coords) != nil || len(coords) < 2 {
+				notPoint = true
+			} else {
+				fields["lon"] = strconv.FormatFloat(coords[0], 'f', -1, 64)
+				fields["lat"] = strconv.FormatFloat(coords[1], 'f', -1, 64)
+				if len(coords) > 2 {
+					fields["elevation"] = strconv.FormatFloat(coords[2], 'f', -1, 64)
+				}
+			}
+		}
+
+		rows[i] = newStationImportRow(i+1, fields)
+		if notPoint {
+			rows[i].addError("geometry must be a point")
+		}
+	}
+
+	return rows, nil
+}
+
+// newStationImportRow converts the column values to the station parameters
+func newStationImportRow(row int, fields map[string]string) StationImportRow {
+	res := StationImportRow{
+		Row:  row,
+		Name: fields["name"],
+	}
+	arg := db.CreateStationParams{
+		Name:         fields["name"],
+		StationType:  util.ToPgText(fields["station_type"]),
+		StationType2: util.ToPgText(fields["station_type2"]),
+		StationUrl:   util.ToPgText(fields["station_url"]),
+		Address:      util.ToPgText(fields["address"]),
+	}
+	if arg.Name == "" {
+		res.addError("name is required")
+	}
+
+	parseFloat := func(col string) pgtype.Float4 {
+		s := fields[col]
+		if s == "" {
+			return pgtype.Float4{}
+		}
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			res.addError("invalid %s: %s", col, s)
+			return pgtype.Float4{}
+		}
+		return pgtype.Float4{Float32: float32(f), Valid: true}
+	}
+	arg.Lat = parseFloat("lat")
+	arg.Lon = parseFloat("lon")
+	arg.Elevation = parseFloat("elevation")
+	switch {
+	case arg.Lat.Valid != arg.Lon.Valid:
+		res.addError("lat and lon must be set together")
+	case !arg.Lat.Valid:
+	case arg.Lat.Float32 < -90 || arg.Lat.Float32 > 90:
+		res.addError("lat is out of range: %s", fields["lat"])
+	case arg.Lon.Float32 < -180 || arg.Lon.Float32 > 180:
+		res.addError("lon is out of range: %s", fields["lon"])
+	case arg.Lat.Float32 == 0 && arg.Lon.Float32 == 0:
+		// usually an unset location exported as zeros
+		res.addError("lat and lon are both 0")
+	}
+
+	if s := fields["date_installed"]; s != "" {
+		arg.DateInstalled = util.ToPgDate(s)
+		if !arg.DateInstalled.Valid {
+			res.addError("invalid date_installed, expected YYYY-MM-DD: %s", s)
+		}
+	}
+
+	if s := fields["mobile_number"]; s != "" {
+		mobileNumber, ok := util.ParseMobileNumber(s)
+		if !ok {
+			res.addError("invalid mobile_number: %s", s)
+		}
+		arg.MobileNumber = util.ToPgText(mobileNumber)
+	}
+
+	if s := fields["status"]; s != "" {
+		status := strings.ToUpper(s)
+		if _, ok := stationStatusTransitions[status]; !ok {
+			res.addError("unknown status: %s", s)
+		}
+		arg.Status = util.ToPgText(status)
+	}
+
+	if s := fields["province"]; s != "" {
+		province, ok := util.ParseProvince(s)
+		if !ok {
+			res.addError("unknown province: %s", s)
+		}
+		arg.Province = util.ToPgText(string(province))
+	}
+	if s := fields["region"]; s != "" {
+		region, ok := util.ParseRegion(s)
+		if !ok {
+			res.addError("unknown region: %s", s)
+		}
+		arg.Region = util.ToPgText(string(region))
+	}
+
+	if s := fields["reporting_interval"]; s != "" {
+		interval, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || interval < 1 || interval > 1440 {
+			res.addError("reporting_interval must be between 1 and 1440 minutes: %s", s)
+		} else {
+			arg.ReportingInterval = pgtype.Int4{Int32: int32(interval), Valid: true}
+		}
+	}
+
+	res.Station = arg
+	return res
+}
+
+// StationImportMobileNumbers lists the mobile numbers of the import rows
+func StationImportMobileNumbers(rows []StationImportRow) []string {
+	var res []string
+	for _, r := range rows {
+		if r.Station.MobileNumber.Valid {
+			res = append(res, r.Station.MobileNumber.String)
+		}
+	}
+	return res
+}
+
+// ValidateStationImport reports the mobile numbers repeated within the rows or already used by a station.
+// It returns the number of rows with errors.
+func ValidateStationImport(rows []StationImportRow, existing []db.ObservationsStation) int {
+	used := make(map[string]int64, len(existing))
+	for _, stn := range existing {
+		used[stn.MobileNumber.String] = stn.ID
+	}
+
+	seen := make(map[string]int)
+	invalid := 0
+	for i := range rows {
+		r := &rows[i]
+		if mobileNumber := r.Station.MobileNumber; mobileNumber.Valid {
+			if id, ok := used[mobileNumber.String]; ok {
+				r.addError("mobile_number %s is used by station %d", mobileNumber.String, id)
+			}
+			if row, ok := seen[mobileNumber.String]; ok {
+				r.addError("mobile_number %s is repeated from row %d", mobileNumber.String, row)
+			} else {
+				seen[mobileNumber.String] = r.Row
+			}
+		}
+		if len(r.Errors) > 0 {
+			invalid++
+		}
+	}
+	return invalid
+}
+
+// stationExportValues returns the station values in the order of stationImportColumns
+func stationExportValues(stn db.ObservationsStation) []any {
+	float := func(f pgtype.Float4) any {
+		if !f.Valid {
+			return nil
+		}
+		return f.Float32
+	}
+	text := func(t pgtype.Text) any {
+		if !t.Valid {
+			return nil
+		}
+		return t.String
+	}
+	var dateInstalled, reportingInterval any
+	if stn.DateInstalled.Valid {
+		dateInstalled = stn.DateInstalled.Time.Format("2006-01-02")
+	}
+	if stn.ReportingInterval.Valid {
+		reportingInterval = stn.ReportingInterval.Int32
+	}
+	return []any{
+		stn.Name, float(stn.Lat), float(stn.Lon), float(stn.Elevation), dateInstalled, text(stn.MobileNumber),
+		text(stn.StationType), text(stn.StationType2), text(stn.StationUrl), text(stn.Status),
+		text(stn.Province), text(stn.Region), text(stn.Address), reportingInterval,
+	}
+}
+
+// WriteStationsCSV writes the stations with the import columns preceded by the station ID
+func WriteStationsCSV(w io.Writer, stations []db.ObservationsStation) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"id"}, stationImportColumns...)); err != nil {
+		return err
+	}
+	for _, stn := range stations {
+		record := []string{strconv.FormatInt(stn.ID, 10)}
+		for _, v := range stationExportValues(stn) {
+			switch v := v.(type) {
+			case nil:
+				record = append(record, "")
+			case float32:
+				record = append(record, strconv.FormatFloat(float64(v), 'f', -1, 32))
+			default:
+				record = append(record, fmt.Sprint(v))
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// NewStationFeatureCollection converts the stations to GeoJSON, stations without a location have no geometry
+func NewStationFeatureCollection(stations []db.ObservationsStation) StationFeatureCollection {
+	res := StationFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]StationFeature, len(stations)),
+	}
+	for i, stn := range stations {
+		props := map[string]any{"id": stn.ID}
+		for j, v := range stationExportValues(stn) {
+			col := stationImportColumns[j]
+			if col == "lat" || col == "lon" || col == "elevation" {
+				continue
+			}
+			props[col] = v
+		}
+
+		f := StationFeature{Type: "Feature", Properties: props}
+		if stn.Lat.Valid && stn.Lon.Valid {
+			f.Geometry = &PointGeometry{
+				Type:        "Point",
+				Coordinates: []float64{exportFloat(stn.Lon.Float32), exportFloat(stn.Lat.Float32)},
+			}
+			if stn.Elevation.Valid {
+				f.Geometry.Coordinates = append(f.Geometry.Coordinates, exportFloat(stn.Elevation.Float32))
+			}
+		}
+		res.Features[i] = f
+	}
+	return res
+}
+
+// exportFloat widens f without the float32 rounding noise, 14.6 stays 14.6 instead of 14.600000381
+func exportFloat(f float32) float64 {
+	res, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'f', -1, 32), 64)
+	return res
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStationsCSV(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		data := "Name,lat,lon,mobile_number,province,region,status,reporting_interval,notes\n" +
+			"Los Banos,14.17,121.24,09171234567,laguna,IV-A,online,10,ignored\n" +
+			"Calamba,,,,,,,,\n"
+		rows, err := ParseStationsCSV(strings.NewReader(data))
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		require.Empty(t, rows[0].Errors)
+		require.Equal(t, 2, rows[0].Row)
+		stn := rows[0].Station
+		require.Equal(t, "Los Banos", stn.Name)
+		require.InDelta(t, 14.17, stn.Lat.Float32, 0.0001)
+		require.Equal(t, "639171234567", stn.MobileNumber.String)
+		require.Equal(t, "Laguna", stn.Province.String)
+		require.Equal(t, "Calabarzon", stn.Region.String)
+		require.Equal(t, StationStatusOnline, stn.Status.String)
+		require.Equal(t, int32(10), stn.ReportingInterval.Int32)
+
+		require.Empty(t, rows[1].Errors)
+		require.False(t, rows[1].Station.Lat.Valid)
+		require.False(t, rows[1].Station.MobileNumber.Valid)
+	})
+
+	t.Run("InvalidValues", func(t *testing.T) {
+		data := "name,lat,lon,mobile_number,province,region,date_installed,reporting_interval\n" +
+			",95,121,12345,Manila,Region 4,2024/01/01,0\n" +
+			"Bay,14.1,,,,,,\n" +
+			"Null Island,0,0\n"
+		rows, err := ParseStationsCSV(strings.NewReader(data))
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Len(t, rows[0].Errors, 7)
+		require.Equal(t, []string{"lat and lon must be set together"}, rows[1].Errors)
+		require.Equal(t, []string{"lat and lon are both 0", "expected 8 columns, got 3"}, rows[2].Errors)
+	})
+
+	t.Run("MissingName", func(t *testing.T) {
+		_, err := ParseStationsCSV(strings.NewReader("lat,lon\n14,121\n"))
+		require.Error(t, err)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		_, err := ParseStationsCSV(strings.NewReader(""))
+		require.Error(t, err)
+	})
+}
+
+func TestParseStationsGeoJSON(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		data := `{"type":"FeatureCollection","features":[
+			{"type":"Feature","geometry":{"type":"Point","coordinates":[121.24,14.17,21]},"properties":{"name":"Los Banos","lat":1,"reporting_interval":5}},
+			{"type":"Feature","geometry":null,"properties":{"name":"Calamba","province":"Laguna"}},
+			{"type":"Feature","geometry":{"type":"LineString","coordinates":[[121,14],[122,15]]},"properties":{"name":"Line"}}
+		]}`
+		rows, err := ParseStationsGeoJSON(strings.NewReader(data))
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+
+		require.Empty(t, rows[0].Errors)
+		require.InDelta(t, 14.17, rows[0].Station.Lat.Float32, 0.0001)
+		require.InDelta(t, 121.24, rows[0].Station.Lon.Float32, 0.0001)
+		require.InDelta(t, 21, rows[0].Station.Elevation.Float32, 0.0001)
+		require.Equal(t, int32(5), rows[0].Station.ReportingInterval.Int32)
+
+		require.Empty(t, rows[1].Errors)
+		require.Equal(t, 2, rows[1].Row)
+		require.Equal(t, "Laguna", rows[1].Station.Province.String)
+
+		require.Equal(t, []string{"geometry must be a point"}, rows[2].Errors)
+	})
+
+	t.Run("NotFeatureCollection", func(t *testing.T) {
+		_, err := ParseStationsGeoJSON(strings.NewReader(`{"type":"Feature"}`))
+		require.Error(t, err)
+	})
+}
+
+func TestValidateStationImport(t *testing.T) {
+	mobileNumber := util.RandomMobileNumber()
+	rows := []StationImportRow{
+		{Row: 2, Name: "A", Station: db.CreateStationParams{Name: "A", MobileNumber: util.ToPgText(mobileNumber)}},
+		{Row: 3, Name: "B", Station: db.CreateStationParams{Name: "B", MobileNumber: util.ToPgText("639170000001")}},
+		{Row: 4, Name: "C", Station: db.CreateStationParams{Name: "C", MobileNumber: util.ToPgText(mobileNumber)}},
+		{Row: 5, Name: "D", Station: db.CreateStationParams{Name: "D"}},
+	}
+	require.Equal(t, []string{mobileNumber, "639170000001", mobileNumber}, StationImportMobileNumbers(rows))
+
+	existing := []db.ObservationsStation{{ID: 7, MobileNumber: util.ToPgText("639170000001")}}
+	invalid := ValidateStationImport(rows, existing)
+	require.Equal(t, 2, invalid)
+	require.Empty(t, rows[0].Errors)
+	require.Equal(t, []string{"mobile_number 639170000001 is used by station 7"}, rows[1].Errors)
+	require.Equal(t, []string{"mobile_number " + mobileNumber + " is repeated from row 2"}, rows[2].Errors)
+	require.Empty(t, rows[3].Errors)
+}
+
+func TestStationExport(t *testing.T) {
+	stations := []db.ObservationsStation{
+		{
+			ID:                1,
+			Name:              "Los Banos",
+			Lat:               pgtype.Float4{Float32: 14.17, Valid: true},
+			Lon:               pgtype.Float4{Float32: 121.24, Valid: true},
+			MobileNumber:      util.ToPgText("639171234567"),
+			Province:          util.ToPgText("Laguna"),
+			ReportingInterval: pgtype.Int4{Int32: 10, Valid: true},
+		},
+		{ID: 2, Name: "Calamba"},
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := WriteStationsCSV(&buf, stations)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 3)
+		require.Equal(t, "id,"+strings.Join(stationImportColumns, ","), lines[0])
+		require.Equal(t, "1,Los Banos,14.17,121.24,,,639171234567,,,,,Laguna,,,10", lines[1])
+		require.Equal(t, "2,Calamba,,,,,,,,,,,,,", lines[2])
+
+		// the export can be imported back
+		rows, err := ParseStationsCSV(&buf)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Empty(t, rows[0].Errors)
+		require.Equal(t, stations[0].Lat, rows[0].Station.Lat)
+		require.Equal(t, stations[0].MobileNumber, rows[0].Station.MobileNumber)
+	})
+
+	t.Run("GeoJSON", func(t *testing.T) {
+		fc := NewStationFeatureCollection(stations)
+		require.Len(t, fc.Features, 2)
+		require.Equal(t, []float64{121.24, 14.17}, fc.Features[0].Geometry.Coordinates)
+		require.Nil(t, fc.Features[1].Geometry)
+
+		data, err := json.Marshal(fc)
+		require.NoError(t, err)
+		rows, err := ParseStationsGeoJSON(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Empty(t, rows[0].Errors)
+		require.Equal(t, stations[0].Province, rows[0].Station.Province)
+		require.Equal(t, stations[0].ReportingInterval, rows[0].Station.ReportingInterval)
+		require.Equal(t, stations[1].Name, rows[1].Station.Name)
+	})
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+// maintenance window statuses, a window is PLANNED until it starts, OPEN while the station is
+// under maintenance and CLOSED once it ends
+const (
+	MaintenanceWindowPlanned = "PLANNED"
+	MaintenanceWindowOpen    = "OPEN"
+	MaintenanceWindowClosed  = "CLOSED"
+)
+
+// canLeaveMaintenance only ends the MAINTENANCE status, stations an operator has moved elsewhere are left alone
+func canLeaveMaintenance(from, to string) bool {
+	return from == StationStatusMaintenance && CanTransitionStationStatus(from, to)
+}
+
+// ApplyMaintenanceWindows puts stations into MAINTENANCE when their planned maintenance window starts
+// and back to ONLINE when the last open window ends, the status evaluation then takes over again.
+func ApplyMaintenanceWindows(ctx context.Context, store db.Store, now time.Time, logger *zerolog.Logger) error {
+	serviceName := "ApplyMaintenanceWindows"
+	ts := pgtype.Timestamptz{Time: now, Valid: true}
+
+	closing, err := store.ListStationMaintenanceWindowsToClose(ctx, ts)
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return err
+	}
+	countClosed := 0
+	for _, m := range closing {
+		err := store.UpdateStationMaintenanceWindowStatus(ctx, db.UpdateStationMaintenanceWindowStatusParams{
+			ID:           m.ID,
+			WindowStatus: pgtype.Text{String: MaintenanceWindowClosed, Valid: true},
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Int64("maintenance_id", m.ID).Msg("close window error")
+			continue
+		}
+		countClosed++
+		if m.WindowStatus.String != MaintenanceWindowOpen {
+			// the window passed without being opened
+			continue
+		}
+
+		open, err := store.CountOpenStationMaintenanceWindows(ctx, m.StationID)
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Int64("station_id", m.StationID).Msg("database error")
+			continue
+		}
+		if open > 0 {
+			continue
+		}
+		_, err = store.UpdateStationStatusTx(ctx, db.UpdateStationStatusTxParams{
+			StationID:     m.StationID,
+			Status:        StationStatusOnline,
+			Reason:        fmt.Sprintf("maintenance window %d ended", m.ID),
+			CanTransition: canLeaveMaintenance,
+		})
+		if err != nil && !errors.Is(err, db.ErrInvalidStatusTransition) {
+			logger.Error().Err(err).Str("service", serviceName).Int64("station_id", m.StationID).Msg("update status error")
+		}
+	}
+
+	opening, err := store.ListStationMaintenanceWindowsToOpen(ctx, ts)
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return err
+	}
+	countOpened := 0
+	for _, m := range opening {
+		_, err := store.UpdateStationStatusTx(ctx, db.UpdateStationStatusTxParams{
+			StationID:     m.StationID,
+			Status:        StationStatusMaintenance,
+			Reason:        fmt.Sprintf("maintenance window %d started", m.ID),
+			CanTransition: CanTransitionStationStatus,
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Int64("station_id", m.StationID).Msg("update status error")
+			continue
+		}
+		err = store.UpdateStationMaintenanceWindowStatus(ctx, db.UpdateStationMaintenanceWindowStatusParams{
+			ID:           m.ID,
+			WindowStatus: pgtype.Text{String: MaintenanceWindowOpen, Valid: true},
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Int64("maintenance_id", m.ID).Msg("open window error")
+			continue
+		}
+		countOpened++
+	}
+
+	if countOpened > 0 || countClosed > 0 {
+		logger.Info().Str("service", serviceName).
+			Int("opened", countOpened).
+			Int("closed", countClosed).
+			Msg("maintenance windows updated")
+	}
+	return nil
+}
+
+// InMaintenanceWindow reports whether ts falls in one of the maintenance windows
+func InMaintenanceWindow(windows []db.StationMaintenance, ts time.Time) bool {
+	for _, m := range windows {
+		if !m.WindowStart.Valid || !m.WindowEnd.Valid {
+			continue
+		}
+		if !ts.Before(m.WindowStart.Time) && ts.Before(m.WindowEnd.Time) {
+			return true
+		}
+	}
+	return false
+}
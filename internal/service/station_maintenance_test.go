@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMaintenanceWindows(t *testing.T) {
+	now := time.Now()
+	window := func(id, stationID int64, status string) db.StationMaintenance {
+		return db.StationMaintenance{
+			ID:           id,
+			StationID:    stationID,
+			WindowStart:  pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+			WindowEnd:    pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true},
+			WindowStatus: util.ToPgText(status),
+		}
+	}
+	closing := []db.StationMaintenance{
+		window(1, 10, MaintenanceWindowOpen),
+		// never opened
+		window(2, 20, MaintenanceWindowPlanned),
+		// another window of the station is still open
+		window(3, 30, MaintenanceWindowOpen),
+	}
+	opening := []db.StationMaintenance{window(4, 40, MaintenanceWindowPlanned)}
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().ListStationMaintenanceWindowsToClose(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(ts pgtype.Timestamptz) bool {
+		return ts.Time.Equal(now)
+	})).Return(closing, nil)
+	store.EXPECT().UpdateStationMaintenanceWindowStatus(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.UpdateStationMaintenanceWindowStatusParams) bool {
+		return arg.ID <= 3 && arg.WindowStatus.String == MaintenanceWindowClosed
+	})).Return(nil).Times(3)
+	store.EXPECT().CountOpenStationMaintenanceWindows(mock.AnythingOfType("backgroundCtx"), int64(10)).Return(0, nil).Once()
+	store.EXPECT().CountOpenStationMaintenanceWindows(mock.AnythingOfType("backgroundCtx"), int64(30)).Return(1, nil).Once()
+	store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.UpdateStationStatusTxParams) bool {
+		return arg.StationID == 10 && arg.Status == StationStatusOnline &&
+			arg.CanTransition(StationStatusMaintenance, StationStatusOnline) && !arg.CanTransition(StationStatusInactive, StationStatusOnline)
+	})).Return(db.UpdateStationStatusTxResult{Changed: true}, nil).Once()
+
+	store.EXPECT().ListStationMaintenanceWindowsToOpen(mock.AnythingOfType("backgroundCtx"), mock.Anything).Return(opening, nil)
+	store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.UpdateStationStatusTxParams) bool {
+		return arg.StationID == 40 && arg.Status == StationStatusMaintenance
+	})).Return(db.UpdateStationStatusTxResult{Changed: true}, nil).Once()
+	store.EXPECT().UpdateStationMaintenanceWindowStatus(mock.AnythingOfType("backgroundCtx"), db.UpdateStationMaintenanceWindowStatusParams{
+		ID:           4,
+		WindowStatus: util.ToPgText(MaintenanceWindowOpen),
+	}).Return(nil).Once()
+
+	logger := util.NewLogger(util.Config{EnableFileLogging: false})
+	err := ApplyMaintenanceWindows(context.Background(), store, now, logger)
+	require.NoError(t, err)
+	store.AssertExpectations(t)
+	store.AssertNumberOfCalls(t, "UpdateStationStatusTx", 2)
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	start := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	windows := []db.StationMaintenance{
+		// a visit without a maintenance window
+		{ID: 1},
+		{
+			ID:          2,
+			WindowStart: pgtype.Timestamptz{Time: start, Valid: true},
+			WindowEnd:   pgtype.Timestamptz{Time: start.Add(2 * time.Hour), Valid: true},
+		},
+	}
+
+	require.True(t, InMaintenanceWindow(windows, start))
+	require.True(t, InMaintenanceWindow(windows, start.Add(time.Hour)))
+	require.False(t, InMaintenanceWindow(windows, start.Add(2*time.Hour)))
+	require.False(t, InMaintenanceWindow(windows, start.Add(-time.Minute)))
+}
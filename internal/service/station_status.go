@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/rs/zerolog"
+)
+
+const (
+	StationStatusOnline      = "ONLINE"
+	StationStatusDelayed     = "DELAYED"
+	StationStatusOffline     = "OFFLINE"
+	StationStatusInactive    = "INACTIVE"
+	StationStatusMaintenance = "MAINTENANCE"
+)
+
+// stationStatusTransitions lists the statuses each status may move to.
+// INACTIVE and MAINTENANCE are set by operators and are left alone by the status job,
+// leaving them goes through ONLINE so that the next evaluation picks the station up again.
+var stationStatusTransitions = map[string][]string{
+	StationStatusOnline:      {StationStatusDelayed, StationStatusOffline, StationStatusInactive, StationStatusMaintenance},
+	StationStatusDelayed:     {StationStatusOnline, StationStatusOffline, StationStatusInactive, StationStatusMaintenance},
+	StationStatusOffline:     {StationStatusOnline, StationStatusDelayed, StationStatusInactive, StationStatusMaintenance},
+	StationStatusInactive:    {StationStatusOnline, StationStatusMaintenance},
+	StationStatusMaintenance: {StationStatusOnline, StationStatusInactive},
+}
+
+type StatusThresholds struct {
+	// Delayed is the age of the last observation after which a station is DELAYED
+	Delayed time.Duration
+	// Offline is the age of the last observation after which a station is OFFLINE
+	Offline time.Duration
+}
+
+type StationStatusConfig struct {
+	Default StatusThresholds
+	// ByType overrides the thresholds by station type
+	ByType map[string]StatusThresholds
+}
+
+var DefaultStationStatusConfig = StationStatusConfig{
+	Default: StatusThresholds{
+		Delayed: 30 * time.Minute,
+		Offline: time.Hour,
+	},
+	ByType: map[string]StatusThresholds{
+		"MO": {
+			Delayed: 20 * time.Minute,
+			Offline: time.Hour,
+		},
+	},
+}
+
+func (c StationStatusConfig) Thresholds(stationType string) StatusThresholds {
+	if th, ok := c.ByType[stationType]; ok {
+		return th
+	}
+	return c.Default
+}
+
+// IsStationStatus reports whether status is one of the station states
+func IsStationStatus(status string) bool {
+	_, ok := stationStatusTransitions[status]
+	return ok
+}
+
+// CanTransitionStationStatus reports whether a station may move between the statuses.
+// Stations with a status outside the state machine may move to any status.
+func CanTransitionStationStatus(from, to string) bool {
+	if !IsStationStatus(to) {
+		return false
+	}
+	allowed, ok := stationStatusTransitions[from]
+	if !ok {
+		return true
+	}
+	return slices.Contains(allowed, to)
+}
+
+func isManualStationStatus(status string) bool {
+	return status == StationStatusInactive || status == StationStatusMaintenance
+}
+
+// canAutoTransition is CanTransitionStationStatus for the status job, which never ends operator set statuses
+func canAutoTransition(from, to string) bool {
+	return !isManualStationStatus(from) && CanTransitionStationStatus(from, to)
+}
+
+// EvaluateStationStatus returns the status of a station from the age of its last observation.
+// lastObservedAt is zero when the station has no observations.
+func EvaluateStationStatus(current string, lastObservedAt time.Time, th StatusThresholds, now time.Time) string {
+	if isManualStationStatus(current) {
+		return current
+	}
+	if lastObservedAt.IsZero() {
+		return StationStatusOffline
+	}
+
+	age := now.Sub(lastObservedAt)
+	switch {
+	case age < th.Delayed:
+		return StationStatusOnline
+	case age < th.Offline:
+		return StationStatusDelayed
+	default:
+		return StationStatusOffline
+	}
+}
+
+// UpdateStationStatuses applies the maintenance windows then evaluates every station from its latest current observation and records the transitions
+func UpdateStationStatuses(ctx context.Context, store db.Store, config StationStatusConfig, logger *zerolog.Logger) error {
+	serviceName := "UpdateStationStatuses"
+	if err := ApplyMaintenanceWindows(ctx, store, time.Now(), logger); err != nil {
+		return err
+	}
+
+	stations, err := store.ListStationsLastObserved(ctx)
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return err
+	}
+
+	now := time.Now()
+	countOnline := 0
+	countChanged := 0
+	for _, stn := range stations {
+		status := EvaluateStationStatus(stn.Status.String, stn.LastObservedAt.Time, config.Thresholds(stn.StationType.String), now)
+		if status == StationStatusOnline {
+			countOnline++
+		}
+		if stn.Status.Valid && stn.Status.String == status {
+			continue
+		}
+
+		reason := "no observations"
+		if stn.LastObservedAt.Valid {
+			reason = fmt.Sprintf("last observation %s ago", now.Sub(stn.LastObservedAt.Time).Round(time.Minute))
+		}
+		res, err := store.UpdateStationStatusTx(ctx, db.UpdateStationStatusTxParams{
+			StationID:      stn.ID,
+			Status:         status,
+			Reason:         reason,
+			LastObservedAt: stn.LastObservedAt,
+			CanTransition:  canAutoTransition,
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrInvalidStatusTransition) {
+				// an operator changed the status since it was read
+				continue
+			}
+			logger.Error().Err(err).Str("service", serviceName).Int64("station_id", stn.ID).Msg("update status error")
+			continue
+		}
+		if res.Changed {
+			countChanged++
+		}
+	}
+	logger.Info().Str("service", serviceName).
+		Str("online", fmt.Sprintf("%d/%d", countOnline, len(stations))).
+		Int("changed", countChanged).
+		Msg("update status successful")
+	return nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateStationStatus(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	th := StatusThresholds{Delayed: 30 * time.Minute, Offline: time.Hour}
+
+	testCases := []struct {
+		name           string
+		current        string
+		lastObservedAt time.Time
+		want           string
+	}{
+		{"Online", StationStatusOffline, now.Add(-10 * time.Minute), StationStatusOnline},
+		{"Delayed", StationStatusOnline, now.Add(-45 * time.Minute), StationStatusDelayed},
+		{"Offline", StationStatusDelayed, now.Add(-2 * time.Hour), StationStatusOffline},
+		{"NoObservations", "", time.Time{}, StationStatusOffline},
+		{"Maintenance", StationStatusMaintenance, now, StationStatusMaintenance},
+		{"Inactive", StationStatusInactive, now, StationStatusInactive},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, EvaluateStationStatus(tc.current, tc.lastObservedAt, th, now))
+		})
+	}
+}
+
+func TestCanTransitionStationStatus(t *testing.T) {
+	require.True(t, CanTransitionStationStatus(StationStatusOnline, StationStatusMaintenance))
+	require.True(t, CanTransitionStationStatus(StationStatusMaintenance, StationStatusOnline))
+	require.True(t, CanTransitionStationStatus("", StationStatusOnline))
+	require.True(t, CanTransitionStationStatus("ACTIVE", StationStatusOffline))
+	require.False(t, CanTransitionStationStatus(StationStatusMaintenance, StationStatusOffline))
+	require.False(t, CanTransitionStationStatus(StationStatusInactive, StationStatusDelayed))
+	require.False(t, CanTransitionStationStatus(StationStatusOnline, "BROKEN"))
+
+	require.False(t, canAutoTransition(StationStatusMaintenance, StationStatusOnline))
+	require.True(t, canAutoTransition(StationStatusDelayed, StationStatusOnline))
+}
+
+func TestStationStatusThresholds(t *testing.T) {
+	require.Equal(t, DefaultStationStatusConfig.ByType["MO"], DefaultStationStatusConfig.Thresholds("MO"))
+	require.Equal(t, DefaultStationStatusConfig.Default, DefaultStationStatusConfig.Thresholds("LUFFT"))
+}
+
+func TestUpdateStationStatuses(t *testing.T) {
+	now := time.Now()
+	stations := []db.ListStationsLastObservedRow{
+		{ID: 1, Status: util.ToPgText(StationStatusOnline), LastObservedAt: pgtype.Timestamptz{Time: now.Add(-5 * time.Minute), Valid: true}},
+		{ID: 2, Status: util.ToPgText(StationStatusOnline), LastObservedAt: pgtype.Timestamptz{Time: now.Add(-3 * time.Hour), Valid: true}},
+		{ID: 3, StationType: util.ToPgText("MO"), Status: util.ToPgText(StationStatusOffline), LastObservedAt: pgtype.Timestamptz{Time: now.Add(-30 * time.Minute), Valid: true}},
+		{ID: 4, Status: util.ToPgText(StationStatusMaintenance)},
+		{ID: 5},
+	}
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().ListStationMaintenanceWindowsToClose(mock.AnythingOfType("backgroundCtx"), mock.Anything).Return([]db.StationMaintenance{}, nil)
+	store.EXPECT().ListStationMaintenanceWindowsToOpen(mock.AnythingOfType("backgroundCtx"), mock.Anything).Return([]db.StationMaintenance{}, nil)
+	store.EXPECT().ListStationsLastObserved(mock.AnythingOfType("backgroundCtx")).Return(stations, nil)
+	store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.UpdateStationStatusTxParams) bool {
+		return arg.StationID == 2 && arg.Status == StationStatusOffline && arg.LastObservedAt.Valid
+	})).Return(db.UpdateStationStatusTxResult{Changed: true}, nil).Once()
+	store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.UpdateStationStatusTxParams) bool {
+		return arg.StationID == 3 && arg.Status == StationStatusDelayed
+	})).Return(db.UpdateStationStatusTxResult{Changed: true}, nil).Once()
+	store.EXPECT().UpdateStationStatusTx(mock.AnythingOfType("backgroundCtx"), mock.MatchedBy(func(arg db.UpdateStationStatusTxParams) bool {
+		return arg.StationID == 5 && arg.Status == StationStatusOffline && arg.Reason == "no observations"
+	})).Return(db.UpdateStationStatusTxResult{}, db.ErrInvalidStatusTransition).Once()
+
+	logger := util.NewLogger(util.Config{EnableFileLogging: false})
+	err := UpdateStationStatuses(context.Background(), store, DefaultStationStatusConfig, logger)
+	require.NoError(t, err)
+	store.AssertExpectations(t)
+}
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitBreaker tracks consecutive failures per key.
+// After threshold failures the key is skipped until the cooldown has passed,
+// then a single attempt is allowed and another failure opens it again.
+type CircuitBreaker[K comparable] struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	states    map[K]*breakerState
+	now       func() time.Time
+}
+
+type breakerState struct {
+	failures  int
+	openUntil time.Time
+}
+
+func NewCircuitBreaker[K comparable](threshold int, cooldown time.Duration) *CircuitBreaker[K] {
+	return &CircuitBreaker[K]{
+		threshold: threshold,
+		cooldown:  cooldown,
+		states:    make(map[K]*breakerState),
+		now:       time.Now,
+	}
+}
+
+// Allow reports whether a request for the key may be attempted
+func (b *CircuitBreaker[K]) Allow(key K) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	st, ok := b.states[key]
+	if !ok || st.failures < b.threshold {
+		return true
+	}
+	return !b.now().Before(st.openUntil)
+}
+
+// Success closes the circuit of the key
+func (b *CircuitBreaker[K]) Success(key K) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.states, key)
+}
+
+// Failure records a failure and opens the circuit once the threshold is reached
+func (b *CircuitBreaker[K]) Failure(key K) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	st, ok := b.states[key]
+	if !ok {
+		st = new(breakerState)
+		b.states[key] = st
+	}
+	st.failures++
+	if st.failures >= b.threshold {
+		st.openUntil = b.now().Add(b.cooldown)
+	}
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	timeNow := time.Now()
+	b := NewCircuitBreaker[int64](3, time.Hour)
+	b.now = func() time.Time { return timeNow }
+
+	for range 2 {
+		b.Failure(1)
+		require.True(t, b.Allow(1))
+	}
+	b.Failure(1)
+	require.False(t, b.Allow(1))
+	require.True(t, b.Allow(2))
+
+	// half open after the cooldown
+	timeNow = timeNow.Add(time.Hour)
+	require.True(t, b.Allow(1))
+	b.Failure(1)
+	require.False(t, b.Allow(1))
+
+	timeNow = timeNow.Add(time.Hour)
+	require.True(t, b.Allow(1))
+	b.Success(1)
+	b.Failure(1)
+	require.True(t, b.Allow(1))
+}
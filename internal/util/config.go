@@ -15,15 +15,26 @@ type Config struct {
 	DBSource             string        `mapstructure:"DB_SOURCE"`
 	MigrationPath        string        `mapstructure:"MIGRATION_PATH"`
 	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	UMBServerAddress     string        `mapstructure:"UMB_SERVER_ADDRESS"`
+	UMBAllowedSources    string        `mapstructure:"UMB_ALLOWED_SOURCES"`
 	CookieDomain         string        `mapstructure:"COOKIE_DOMAIN"`
 	CookiePath           string        `mapstructure:"COOKIE_PATH"`
 	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	SecretEncryptionKey  string        `mapstructure:"SECRET_ENCRYPTION_KEY"`
 	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
 	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
 	APIBasePath          string        `mapstructure:"API_BASE_PATH"`
 	SwagAPIBasePath      string        `mapstructure:"SWAG_API_BASE_PATH"`
 	GlabsAppID           string        `mapstructure:"GLABS_APP_ID"`
 	GlabsAppSecret       string        `mapstructure:"GLABS_APP_SECRET"`
+	GlabsShortCode       string        `mapstructure:"GLABS_SHORT_CODE"`
+	GlabsAPIURL          string        `mapstructure:"GLABS_API_URL"`
+	GlabsRewardsToken    string        `mapstructure:"GLABS_REWARDS_TOKEN"`
+	GlabsLoadAlertNumber string        `mapstructure:"GLABS_LOAD_ALERT_NUMBER"`
+	GlabsCallbackToken   string        `mapstructure:"GLABS_CALLBACK_TOKEN"`
+	LufftCommandsEnabled bool          `mapstructure:"LUFFT_COMMANDS_ENABLED"`
+	MQTTUsername         string        `mapstructure:"MQTT_USERNAME"`
+	MQTTPassword         string        `mapstructure:"MQTT_PASSWORD"`
 	EnableConsoleLogging bool          `mapstructure:"ENABLE_CONSOLE_LOGGING"`
 	EnableFileLogging    bool          `mapstructure:"ENABLE_FILE_LOGGING"`
 	LogLevel             string        `mapstructure:"LOG_LEVEL"`
@@ -33,6 +44,7 @@ type Config struct {
 	LogMaxBackups        int           `mapstructure:"LOG_MAX_BACKUPS"`
 	LogMaxAge            int           `mapstructure:"LOG_MAX_AGE"`
 	CronJobs             []CronJob     `mapstructure:"-"`
+	MQTT                 MQTTConfig    `mapstructure:"-"`
 	DockerTestPGRepo     string        `mapstructure:"DOCKERTEST_PG_REPO"`
 	DockerTestPGTag      string        `mapstructure:"DOCKERTEST_PG_TAG"`
 }
@@ -42,6 +54,25 @@ type CronJob struct {
 	Schedule string `mapstructure:"schedule"`
 }
 
+// MQTTConfig is the MQTT subscriber configuration, credentials are read from the environment
+type MQTTConfig struct {
+	Broker     string        `mapstructure:"broker"`
+	ClientID   string        `mapstructure:"client_id"`
+	QoS        byte          `mapstructure:"qos"`
+	MinBackoff time.Duration `mapstructure:"min_backoff"`
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+	Topics     []MQTTTopic   `mapstructure:"topics"`
+}
+
+// MQTTTopic maps a topic pattern to a station and payload keys to columns.
+// A {device} segment in the pattern is matched to the upload id of an MQTT upload station,
+// otherwise all messages are stored to StationID.
+type MQTTTopic struct {
+	Pattern   string            `mapstructure:"pattern"`
+	StationID int64             `mapstructure:"station_id"`
+	Fields    map[string]string `mapstructure:"fields"`
+}
+
 // LoadConfig read configuration from file or environment variables.
 func LoadConfig(path string) (config Config, err error) {
 	viper.AddConfigPath(path)
@@ -78,5 +109,9 @@ func LoadConfig(path string) (config Config, err error) {
 		return
 	}
 
+	if err = viper.UnmarshalKey("mqtt", &config.MQTT); err != nil {
+		return
+	}
+
 	return
 }
@@ -1,6 +1,10 @@
 package util
 
-import "github.com/brianvoe/gofakeit/v7"
+import (
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v7"
+)
 
 var provinces = []string{
 	"Abra", "Agusan del Norte", "Agusan del Sur", "Aklan", "Albay",
@@ -30,6 +34,17 @@ func (c *Province) Fake(f *gofakeit.Faker) (any, error) {
 	return f.RandomString(provinces), nil
 }
 
+// ParseProvince matches s to a province name regardless of case.
+func ParseProvince(s string) (Province, bool) {
+	s = strings.TrimSpace(s)
+	for _, p := range provinces {
+		if strings.EqualFold(p, s) {
+			return Province(p), true
+		}
+	}
+	return "", false
+}
+
 var regionsNumerical = []string{
 	"I", "II", "III", "IV-A", "IV-B", "V", "VI", "VII", "VIII", "IX", "X", "XI", "XII", "XIII", "NCR", "CAR", "BARMM",
 }
@@ -52,3 +67,14 @@ type Region string
 func (c *Region) Fake(f *gofakeit.Faker) (any, error) {
 	return f.RandomString(regionsNames), nil
 }
+
+// ParseRegion matches s to a region name or its numerical designation (e.g. IV-A) regardless of case.
+func ParseRegion(s string) (Region, bool) {
+	s = strings.TrimSpace(s)
+	for i, r := range regionsNames {
+		if strings.EqualFold(r, s) || strings.EqualFold(regionsNumerical[i], s) {
+			return Region(r), true
+		}
+	}
+	return "", false
+}
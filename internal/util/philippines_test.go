@@ -0,0 +1,51 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProvince(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Province
+		ok       bool
+	}{
+		{input: "Laguna", expected: "Laguna", ok: true},
+		{input: " davao DE oro ", expected: "Davao de Oro", ok: true},
+		{input: "Manila", ok: false},
+		{input: "", ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, ok := ParseProvince(tc.input)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestParseRegion(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Region
+		ok       bool
+	}{
+		{input: "Calabarzon", expected: "Calabarzon", ok: true},
+		{input: "iv-a", expected: "Calabarzon", ok: true},
+		{input: "NCR", expected: "National Capital Region", ok: true},
+		{input: "BARMM", expected: "Bangsamoro Autonomous Region in Muslim Mindanao", ok: true},
+		{input: "Region IV-A", ok: false},
+		{input: "", ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, ok := ParseRegion(tc.input)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
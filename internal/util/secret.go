@@ -0,0 +1,86 @@
+package util
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aead/chacha20poly1305"
+)
+
+// secretPrefix marks values encrypted by SecretCipher, values without it are legacy plaintext
+const secretPrefix = "enc:"
+
+var ErrSecretKeyMissing = errors.New("secret encryption key is not configured")
+
+// SecretCipher encrypts credentials stored in the database with XChaCha20-Poly1305.
+// A nil SecretCipher can still read plaintext values but can not encrypt.
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher creates a SecretCipher, the key must be exactly 32 characters
+func NewSecretCipher(key string) (*SecretCipher, error) {
+	if len(key) != chacha20poly1305.KeySize {
+		return nil, fmt.Errorf("invalid key size: must be exactly %d characters", chacha20poly1305.KeySize)
+	}
+
+	aead, err := chacha20poly1305.NewXCipher([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	return &SecretCipher{aead: aead}, nil
+}
+
+// LoadSecretCipher is like NewSecretCipher but returns a nil cipher when no key is configured
+func LoadSecretCipher(key string) (*SecretCipher, error) {
+	if key == "" {
+		return nil, nil
+	}
+	return NewSecretCipher(key)
+}
+
+// Encrypt returns the prefixed, base64 encoded nonce and ciphertext of the plaintext
+func (c *SecretCipher) Encrypt(plaintext string) (string, error) {
+	if c == nil {
+		return "", ErrSecretKeyMissing
+	}
+
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(plaintext)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return secretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt reverses Encrypt, values that were never encrypted are returned as is
+func (c *SecretCipher) Decrypt(value string) (string, error) {
+	if !IsEncryptedSecret(value) {
+		return value, nil
+	}
+	if c == nil {
+		return "", ErrSecretKeyMissing
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, secretPrefix))
+	if err != nil {
+		return "", fmt.Errorf("invalid encrypted secret: %w", err)
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", errors.New("invalid encrypted secret: too short")
+	}
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("cannot decrypt secret: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+func IsEncryptedSecret(value string) bool {
+	return strings.HasPrefix(value, secretPrefix)
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretCipher(t *testing.T) {
+	c, err := NewSecretCipher(RandomString(32))
+	require.NoError(t, err)
+
+	secret := RandomString(24)
+	encrypted, err := c.Encrypt(secret)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedSecret(encrypted))
+	require.NotContains(t, encrypted, secret)
+
+	encrypted2, err := c.Encrypt(secret)
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, encrypted2)
+
+	decrypted, err := c.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, secret, decrypted)
+
+	// legacy plaintext values pass through
+	decrypted, err = c.Decrypt(secret)
+	require.NoError(t, err)
+	require.Equal(t, secret, decrypted)
+
+	other, err := NewSecretCipher(RandomString(32))
+	require.NoError(t, err)
+	_, err = other.Decrypt(encrypted)
+	require.Error(t, err)
+
+	_, err = c.Decrypt(encrypted[:10])
+	require.Error(t, err)
+
+	_, err = NewSecretCipher(RandomString(16))
+	require.Error(t, err)
+}
+
+func TestNilSecretCipher(t *testing.T) {
+	var c *SecretCipher
+
+	_, err := c.Encrypt("secret")
+	require.ErrorIs(t, err, ErrSecretKeyMissing)
+
+	decrypted, err := c.Decrypt("secret")
+	require.NoError(t, err)
+	require.Equal(t, "secret", decrypted)
+
+	_, err = c.Decrypt("enc:AAAA")
+	require.ErrorIs(t, err, ErrSecretKeyMissing)
+}
@@ -72,6 +72,16 @@ func (f WrappedFloat[T]) Convert(cf T) WrappedFloat[T] {
 	}
 }
 
+func (f WrappedFloat[T]) Apply(fn func(T) T) WrappedFloat[T] {
+	if !f.Valid {
+		return WrappedFloat[T]{Valid: false}
+	}
+	return WrappedFloat[T]{
+		Value: fn(f.Value),
+		Valid: true,
+	}
+}
+
 func (f WrappedFloat[T]) Validate(fn func(T) bool) WrappedFloat[T] {
 	if !f.Valid || !fn(f.Value) {
 		return WrappedFloat[T]{Valid: false}